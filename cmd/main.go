@@ -1,20 +1,35 @@
 package main
 
 import (
-	"fmt"
+	"errors"
+	"flag"
+	"log/slog"
+	"os"
 	internalApp "test-server/internal/app"
+	"test-server/internal/config"
 )
 
 func main() {
-	// app, err := internalApp.NewApp(os.Getenv("CONFIG_FILE"))
-	app, err := internalApp.NewApp("configs/config.yaml")
+	load := func() (*config.Config, error) {
+		return config.Load(os.Args[1:], os.Environ())
+	}
+	c, err := load()
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	}
+	if err != nil {
+		slog.Error("main: error occured while loading config", "error", err)
+		os.Exit(2)
+	}
+
+	app, err := internalApp.NewApp(config.NewReloader(c, load))
 	if err != nil {
-		fmt.Printf("main: error occured while starting the app: %v", err)
-		return
+		slog.Error("main: error occured while starting the app", "error", err)
+		os.Exit(1)
 	}
 
 	if err := app.ListenAndServe(); err != nil {
-		fmt.Printf("main: error occured while starting server: %v", err)
-		return
+		slog.Error("main: error occured while starting server", "error", err)
+		os.Exit(1)
 	}
 }
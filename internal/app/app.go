@@ -2,9 +2,13 @@ package app
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
-	"log"
+	"log/slog"
+	"net"
+	"os"
 	"os/signal"
+	"path/filepath"
 	"strconv"
 	"syscall"
 	"time"
@@ -12,46 +16,303 @@ import (
 	"github.com/gofiber/fiber/v2"
 
 	"test-server/internal/app/handlers"
+	"test-server/internal/auth"
+	"test-server/internal/certs"
 	config "test-server/internal/config"
+	"test-server/internal/domain/model"
+	"test-server/internal/domain/task/archive"
+	"test-server/internal/domain/task/audit"
+	"test-server/internal/domain/task/quota"
 	"test-server/internal/domain/task/repository"
+	"test-server/internal/domain/task/retention"
 	"test-server/internal/domain/task/service"
+	"test-server/internal/domain/task/tasklog"
+	"test-server/internal/health"
+	"test-server/internal/logging"
+	"test-server/internal/metrics"
 	middleware "test-server/internal/middleware"
+	"test-server/internal/tracing"
 )
 
 type App struct {
-	config *config.Config
-	server *fiber.App
+	// config holds values fixed at startup, reloader the live ones.
+	config   *config.Config
+	reloader *config.Reloader
+	server   *fiber.App
+	janitor  *retention.Janitor
+	archiver *archive.Archiver // nil when archiving is disabled
+	certs    *certs.Store      // nil when TLS is disabled
+	audit    *audit.Log        // nil when auditing is disabled
+	taskLogs *tasklog.Store    // nil when task logs are disabled
+	shutdown *health.Shutdown
+
+	tasksRepo    *repository.TasksRepository
+	tasksService *service.TasksService
+
+	shutdownTracing func(context.Context) error
 }
 
-func NewApp(configPath string) (*App, error) {
-	c, err := config.LoadConfig(configPath)
+func NewApp(reloader *config.Reloader) (*App, error) {
+	c := reloader.Current()
+	logger, err := logging.New(os.Stdout, c.Log.Level, c.Log.Format)
 	if err != nil {
-		return nil, fmt.Errorf("config.LoadConfig: %w", err)
+		return nil, fmt.Errorf("logging.New: %w", err)
+	}
+	slog.SetDefault(logger)
+
+	app := &App{config: c, reloader: reloader}
+	app.shutdownTracing, err = tracing.Setup(context.Background(), tracing.Options{
+		Exporter:    c.Tracing.Exporter,
+		File:        c.Tracing.File,
+		Endpoint:    c.Tracing.Endpoint,
+		Insecure:    c.Tracing.Insecure,
+		SampleRatio: c.Tracing.SampleRatio,
+		Headers:     c.Tracing.Headers,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tracing.Setup: %w", err)
+	}
+
+	if c.TLS.CertFile != "" {
+		app.certs, err = certs.NewStore(certs.Options{
+			CertFile:     c.TLS.CertFile,
+			KeyFile:      c.TLS.KeyFile,
+			ClientCAFile: c.TLS.ClientCAFile,
+			ClientAuth:   c.TLS.ClientAuth,
+			MinVersion:   c.TLS.MinVersion,
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	app := &App{config: c}
-	httpServer := app.BootstrapHandlers()
+	httpServer, err := app.BootstrapHandlers()
+	if err != nil {
+		return nil, fmt.Errorf("app.BootstrapHandlers: %w", err)
+	}
 	app.server = httpServer
 
 	return app, nil
 }
 
-func (a *App) BootstrapHandlers() *fiber.App {
-	fiberApp := fiber.New()
-	middleware.CorsMiddleware(fiberApp)
+func (a *App) BootstrapHandlers() (*fiber.App, error) {
+	fiberApp := fiber.New(fiber.Config{DisableStartupMessage: true})
+	middleware.TracingMiddleware(fiberApp)
+	middleware.RequestIDMiddleware(fiberApp)
+	middleware.CorsMiddleware(fiberApp, middleware.CorsOptions{
+		AllowOrigins: func() []string {
+			return a.reloader.Current().Cors.AllowOrigins
+		},
+		AllowCredentials: a.config.Cors.AllowCredentials,
+		AllowMethods:     a.config.Cors.AllowMethods,
+		AllowHeaders:     a.config.Cors.AllowHeaders,
+		MaxAge:           a.config.Cors.MaxAge,
+	})
 	middleware.LoggerMiddleware(fiberApp)
+	middleware.MetricsMiddleware(fiberApp)
+
+	keys, err := auth.NewKeyStore(a.config.Auth.KeyFile, a.config.Auth.Keys)
+	if err != nil {
+		return nil, err
+	}
+	if a.config.Auth.Enabled {
+		authenticators := []auth.Authenticator{keys}
+		if a.config.JWT.JWKSFile != "" || len(a.config.JWT.KeyFiles) > 0 {
+			jwtAuthenticator, err := auth.NewJWTAuthenticator(auth.JWTOptions{
+				JWKSFile:    a.config.JWT.JWKSFile,
+				KeyFiles:    a.config.JWT.KeyFiles,
+				Audience:    a.config.JWT.Audience,
+				Issuer:      a.config.JWT.Issuer,
+				Leeway:      a.config.JWT.Leeway,
+				TenantClaim: a.config.JWT.TenantClaim,
+			})
+			if err != nil {
+				return nil, err
+			}
+			authenticators = append(authenticators, jwtAuthenticator)
+		}
+		if len(a.config.TLS.ClientPrincipals) > 0 {
+			certAuthenticator, err := auth.NewCertAuthenticator(a.config.TLS.ClientPrincipals)
+			if err != nil {
+				return nil, err
+			}
+			middleware.ClientCertMiddleware(fiberApp, certAuthenticator)
+		}
+		middleware.AuthMiddleware(fiberApp, authenticators...)
+	} else {
+		slog.Warn("Authentication is disabled, every request has all scopes")
+		middleware.AuthMiddleware(fiberApp)
+	}
+	// clients are rate limited by principal, so the limiter follows authentication
+	rateLimit := middleware.RateLimitOptions{
+		Rate:        a.config.RateLimit.Rate,
+		Burst:       a.config.RateLimit.Burst,
+		IdleTimeout: a.config.RateLimit.IdleTimeout,
+	}
+	for _, entry := range a.config.RateLimit.Routes {
+		route, err := config.ParseRouteRateLimit(entry)
+		if err != nil {
+			return nil, err
+		}
+		rateLimit.Routes = append(rateLimit.Routes, route)
+	}
+	if rateLimit.Rate > 0 || len(rateLimit.Routes) > 0 {
+		middleware.RateLimitMiddleware(fiberApp, rateLimit)
+	}
+	middleware.TenantMiddleware(fiberApp)
+	read := middleware.RequireScope(auth.ScopeRead)
+	write := middleware.RequireScope(auth.ScopeWrite)
+	admin := middleware.RequireScope(auth.ScopeAdmin)
+
+	retentionPolicy := newRetentionPolicy(a.config)
 
 	tasksRepo := repository.NewTasksRepository()
-	tasksService := service.NewTasksService(a.config.Service.Interval, tasksRepo)
+	loaded, err := tasksRepo.LoadSnapshot(context.Background(), a.config.Service.File)
+	if err != nil {
+		return nil, fmt.Errorf("TasksRepository.LoadSnapshot: %w", err)
+	}
+	slog.Info("Tasks loaded", "file", a.config.Service.File, "tasks", loaded)
+	registry, err := metrics.NewRegistry(tasksRepo.CountTasks)
+	if err != nil {
+		return nil, fmt.Errorf("metrics.NewRegistry: %w", err)
+	}
+	tasksService := service.NewTasksService(a.config.Service.Interval, retentionPolicy, tasksRepo)
+	limiter := quota.NewLimiter(newQuotaPolicy(a.config), tasksRepo)
+	tasksService.SetQuota(limiter)
+	if a.config.Audit.Dir != "" {
+		a.audit, err = audit.Open(a.config.Audit.Dir, int64(a.config.Audit.MaxSizeMB)<<20, a.config.Audit.MaxFiles)
+		if err != nil {
+			return nil, err
+		}
+		tasksService.SetAudit(a.audit)
+		auditHandler := handlers.NewAuditHandler(a.audit)
+		fiberApp.Get("api/audit", admin, auditHandler.ListAudit)
+	}
+	if a.config.TaskLog.Dir != "" {
+		a.taskLogs, err = tasklog.Open(a.config.TaskLog.Dir, int64(a.config.TaskLog.BufferKB)<<10, int64(a.config.TaskLog.MaxSizeMB)<<20)
+		if err != nil {
+			return nil, err
+		}
+		tasksService.SetTaskLogs(a.taskLogs)
+		// logs are removed along with their tasks
+		tasksRepo.SetRemoveHook(func(task model.Task) {
+			a.taskLogs.Remove(task.Tenant, task.ID.String())
+		})
+		taskLogHandler := handlers.NewTaskLogHandler(tasksService)
+		fiberApp.Get("api/tasks/:id/logs", read, taskLogHandler.GetTaskLogs)
+	}
+	interrupted, err := tasksService.InterruptUnfinished(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("TasksService.InterruptUnfinished: %w", err)
+	}
+	if interrupted > 0 {
+		slog.Warn("Unfinished tasks of the previous run were interrupted", "tasks", interrupted)
+	}
+	a.tasksRepo, a.tasksService = tasksRepo, tasksService
 	handler := handlers.NewHandler(tasksService)
+	a.janitor = retention.NewJanitor(retentionPolicy, a.config.Retention.Interval, tasksRepo)
+
+	if a.config.Archive.Dir != "" {
+		archiver, err := archive.NewArchiver(a.config.Archive.Dir, a.config.Archive.After, a.config.Archive.Interval, tasksRepo)
+		if err != nil {
+			return nil, err
+		}
+		a.archiver = archiver
+		tasksService.SetArchive(archiver)
+		archiveHandler := handlers.NewArchiveHandler(tasksService)
+		fiberApp.Get("api/archive/tasks/:id", read, archiveHandler.GetArchivedTask)
+	}
+
+	a.shutdown = &health.Shutdown{}
+	checks := health.NewRegistry(a.config.Health.Timeout)
+	checks.AddReadinessCheck("data_dir", health.DirWritable(filepath.Dir(a.config.Service.File)))
+	if a.archiver != nil {
+		checks.AddReadinessCheck("archive_dir", health.DirWritable(a.config.Archive.Dir))
+	}
+	if a.audit != nil {
+		checks.AddReadinessCheck("audit_dir", health.DirWritable(a.config.Audit.Dir))
+	}
+	if a.taskLogs != nil {
+		checks.AddReadinessCheck("task_log_dir", health.DirWritable(a.config.TaskLog.Dir))
+	}
+	checks.AddReadinessCheck("tasks_in_flight", health.MaxInFlight(tasksService.TasksInFlight, func() int {
+		return a.reloader.Current().Health.MaxInFlight
+	}))
+	checks.AddReadinessCheck("shutdown", a.shutdown.Check)
+	checks.AddLivenessCheck("janitor", a.janitor.Check)
+
+	a.reloader.Subscribe(func(c *config.Config) {
+		if err := logging.SetLevel(slog.Default(), c.Log.Level); err != nil {
+			slog.Error("Log level reload error", "error", err)
+		}
+		tasksService.SetInterval(c.Service.Interval)
+		tasksService.SetRetention(newRetentionPolicy(c))
+		limiter.SetPolicy(newQuotaPolicy(c))
+		a.janitor.SetPolicy(newRetentionPolicy(c))
+		a.janitor.SetInterval(c.Retention.Interval)
+		if a.archiver != nil {
+			a.archiver.SetAfter(c.Archive.After)
+			a.archiver.SetInterval(c.Archive.Interval)
+		}
+	})
+	configHandler := handlers.NewConfigHandler(a.reloader)
+	keysHandler := handlers.NewKeysHandler(keys)
+	quotaHandler := handlers.NewQuotaHandler(limiter)
 
 	fiberApp.Get("/health", func(c *fiber.Ctx) error {
 		return c.SendString("Healthy")
 	})
-	fiberApp.Post("api/tasks", handler.PostRegisterTask)
-	fiberApp.Get("api/tasks/:id", handler.GetTaskInfo)
-	fiberApp.Delete("api/tasks/:id", handler.DeleteTask)
-	return fiberApp
+	fiberApp.Get("/livez", checks.LivenessHandler())
+	fiberApp.Get("/readyz", checks.ReadinessHandler())
+	fiberApp.Get("/metrics", metrics.Handler(registry))
+	fiberApp.Get("api/tasks", read, handler.ListTasks)
+	fiberApp.Post("api/tasks", write, handler.PostRegisterTask)
+	fiberApp.Post("api/tasks\\:batchCreate", write, handler.PostBatchCreateTasks)
+	fiberApp.Post("api/tasks\\:batchDelete", write, handler.PostBatchDeleteTasks)
+	fiberApp.Get("api/tasks/search", read, handler.SearchTasks)
+	fiberApp.Get("api/tasks/:id", read, handler.GetTaskInfo)
+	fiberApp.Get("api/tasks/:id/history", read, handler.GetTaskHistory)
+	fiberApp.Patch("api/tasks/:id", write, handler.PatchTask)
+	fiberApp.Delete("api/tasks/:id", write, handler.DeleteTask)
+	fiberApp.Post("api/tasks/:id/restore", write, handler.RestoreTask)
+	fiberApp.Get("api/quota", read, quotaHandler.GetQuota)
+	fiberApp.Delete("admin/tasks/:id", admin, handler.PurgeTask)
+	fiberApp.Get("admin/config", admin, configHandler.GetConfig)
+	fiberApp.Get("admin/keys", admin, keysHandler.ListKeys)
+	fiberApp.Post("admin/keys", admin, keysHandler.CreateKey)
+	fiberApp.Delete("admin/keys/:id", admin, keysHandler.DeleteKey)
+	return fiberApp, nil
+}
+
+func newRetentionPolicy(c *config.Config) model.RetentionPolicy {
+	return model.RetentionPolicy{
+		Completed:    c.Retention.Completed,
+		Failed:       c.Retention.Failed,
+		MaxTasks:     c.Retention.MaxTasks,
+		DeletedGrace: c.Retention.DeletedGrace,
+	}
+}
+
+// newQuotaPolicy builds the quota policy of the validated config.
+func newQuotaPolicy(c *config.Config) model.QuotaPolicy {
+	policy := model.QuotaPolicy{
+		Default: model.QuotaLimits{
+			MaxRunning:   c.Quota.MaxRunning,
+			MaxStored:    c.Quota.MaxStored,
+			MaxCreations: c.Quota.MaxCreations,
+		},
+		Tenants: make(map[string]model.QuotaLimits, len(c.Quota.Tenants)),
+		Window:  c.Quota.Window,
+	}
+	for _, entry := range c.Quota.Tenants {
+		tenantID, limits, err := model.ParseQuotaOverride(entry)
+		if err != nil {
+			slog.Error("Invalid quota override", "entry", entry, "error", err)
+			continue
+		}
+		policy.Tenants[tenantID] = limits
+	}
+	return policy
 }
 
 func (a *App) ListenAndServe() error {
@@ -59,27 +320,71 @@ func (a *App) ListenAndServe() error {
 	shutdownCtx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
 
+	go a.reloader.Watch(shutdownCtx, config.DefaultWatchInterval)
+	go a.janitor.Run(shutdownCtx)
+	if a.archiver != nil {
+		go a.archiver.Run(shutdownCtx)
+	}
+
 	serverAddress := fmt.Sprintf("%s:%s", a.config.Service.Host, strconv.Itoa(a.config.Service.Port))
+	listener, err := net.Listen(a.server.Config().Network, serverAddress)
+	if err != nil {
+		return fmt.Errorf("app.ListenAndServe: %w", err)
+	}
+	if a.certs != nil {
+		go a.certs.Watch(shutdownCtx, certs.DefaultWatchInterval)
+		listener = tls.NewListener(listener, a.certs.TLSConfig())
+	}
 
 	go func() {
-		fmt.Println("Golang test IO server started")
-		if servErr := a.server.Listen(serverAddress); servErr != nil {
-			log.Fatalf("app.ListenAndServe: failed to start server: %v", servErr)
+		slog.Info("Golang test IO server started", "address", serverAddress, "tls", a.certs != nil)
+		if servErr := a.server.Listener(listener); servErr != nil {
+			slog.Error("app.ListenAndServe: failed to start server", "error", servErr)
+			os.Exit(1)
 		}
 	}()
 
 	<-shutdownCtx.Done()
-	fmt.Println("Shutdown signal received, starting graceful shutdown...")
+	slog.Info("Shutdown signal received, starting graceful shutdown...")
+
+	// Reject new tasks and report not ready first, so load balancers stop routing new requests
+	a.tasksService.StopAccepting()
+	a.shutdown.Start()
+	time.Sleep(a.reloader.Current().Health.ShutdownDelay)
 
 	// Create a timeout context for shutdown
 	timeoutCtx, timeoutCancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer timeoutCancel()
 
+	// End task log streams, they would keep their connections open until the tasks finish
+	if err := a.taskLogs.Close(); err != nil {
+		slog.Error("Task logs closing error", "error", err)
+	}
+
 	// Shutdown HTTP server
 	if err := a.server.ShutdownWithContext(timeoutCtx); err != nil {
-		log.Printf("HTTP server shutdown error: %v", err)
+		slog.Error("HTTP server shutdown error", "error", err)
+	}
+
+	// Wait for running tasks, the unfinished ones are recorded as interrupted
+	drainCtx, drainCancel := context.WithTimeout(context.Background(), a.reloader.Current().Service.DrainTimeout)
+	defer drainCancel()
+	if interrupted := a.tasksService.Drain(drainCtx); interrupted > 0 {
+		slog.Warn("Running tasks were interrupted", "tasks", interrupted)
+	}
+
+	if err := a.tasksRepo.SaveSnapshot(timeoutCtx, a.config.Service.File); err != nil {
+		slog.Error("Tasks saving error", "error", err)
+	}
+	if err := a.audit.Close(); err != nil {
+		slog.Error("Audit log closing error", "error", err)
+	}
+
+	// Flush spans of finished requests
+	if err := a.shutdownTracing(timeoutCtx); err != nil {
+		slog.Error("Tracing shutdown error", "error", err)
 	}
 
-	fmt.Println("Graceful shutdown completed")
+	slog.Info("Graceful shutdown completed")
 	return nil
 }
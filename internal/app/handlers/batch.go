@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"test-server/internal/domain/model"
+)
+
+// maxBatchSize limits the number of items in a single batch request.
+const maxBatchSize = 1000
+
+type batchItemResponse struct {
+	ID    string `json:"task_id,omitempty"`
+	Error string `json:"error,omitempty"`
+	OK    bool   `json:"ok"`
+}
+
+type batchResponse struct {
+	Results []batchItemResponse `json:"data"`
+	Error   string              `json:"error"`
+	OK      bool                `json:"ok"`
+}
+
+func validateBatchSize(size int) error {
+	if size == 0 {
+		return errors.New("batch must contain at least one item")
+	}
+	if size > maxBatchSize {
+		return fmt.Errorf("batch must contain at most %d items", maxBatchSize)
+	}
+	return nil
+}
+
+// mergeBatchResults puts service results into the positions of valid items.
+// Positions of invalid items already hold their validation errors.
+func mergeBatchResults(items []batchItemResponse, validIdx []int, results []model.BatchResult) []batchItemResponse {
+	for i, result := range results {
+		item := &items[validIdx[i]]
+		item.ID = result.ID
+		if result.Err != nil {
+			item.Error = result.Err.Error()
+			continue
+		}
+		item.OK = true
+	}
+	return items
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"test-server/internal/auth"
+)
+
+func (h *KeysHandler) DeleteKey(c *fiber.Ctx) error {
+	err := h.keysService.DeleteKey(c.UserContext(), c.Params("id"))
+	if err != nil {
+		status := fiber.StatusInternalServerError
+		switch {
+		case errors.Is(err, auth.ErrKeyNotFound):
+			status = fiber.StatusNotFound
+		case errors.Is(err, auth.ErrKeyReadOnly):
+			status = fiber.StatusConflict
+		}
+		return c.Status(status).JSON(fiber.Map{
+			"ok":    false,
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"ok":   true,
+		"data": "api key was successfully revoked",
+	})
+}
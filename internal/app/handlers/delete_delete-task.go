@@ -18,14 +18,27 @@ func (h *Handler) DeleteTask(c *fiber.Ctx) error {
 		})
 	}
 
-	err := h.tasksService.DeleteTask(c.UserContext(), taskId)
+	version, err := ifMatchVersion(c, func() (*model.Task, error) {
+		return h.tasksService.TaskInfo(c.UserContext(), taskId)
+	})
+	if err != nil {
+		return ifMatchError(c, err)
+	}
+
+	err = h.tasksService.DeleteTask(c.UserContext(), taskId, version)
 	if err != nil {
 		if errors.Is(err, model.ErrTaskNotFound) {
-			return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
 				"ok":    false,
 				"error": fmt.Errorf("task with provided id wasn't found: %w", err).Error(),
 			})
 		}
+		if errors.Is(err, model.ErrVersionMismatch) {
+			return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{
+				"ok":    false,
+				"error": fmt.Errorf("task doesn't match If-Match header: %w", err).Error(),
+			})
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"ok":    false,
 			"error": fmt.Errorf("failed to find task with provided id: %w", err).Error(),
@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"test-server/internal/domain/model"
+)
+
+var (
+	errInvalidIfMatch = errors.New("If-Match header must be * or a list of entity tags")
+	errIfMatchFailed  = fmt.Errorf("task doesn't match If-Match header: %w", model.ErrVersionMismatch)
+)
+
+// taskETag formats task version as a strong entity tag.
+func taskETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// ifMatchVersion evaluates the If-Match header (RFC 9110, section 13.1.1)
+// and returns the task version the request is conditional on, nil when the
+// header is absent or "*". If-Match uses the strong comparison, so weak
+// tags never match. A list of tags is resolved against the task looked up
+// with current, and the matching version is returned, so the change stays
+// conditional on it. errInvalidIfMatch is returned for malformed headers
+// and errIfMatchFailed when no tag matches.
+func ifMatchVersion(c *fiber.Ctx, current func() (*model.Task, error)) (*int64, error) {
+	header := strings.TrimSpace(c.Get(fiber.HeaderIfMatch))
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	tags, err := parseStrongETags(header)
+	if err != nil {
+		return nil, err
+	}
+	var versions []int64
+	for _, tag := range tags {
+		// other tags are valid, they just never match a task
+		if version, err := strconv.ParseInt(tag, 10, 64); err == nil {
+			versions = append(versions, version)
+		}
+	}
+
+	switch len(versions) {
+	case 0:
+		return nil, errIfMatchFailed
+	case 1:
+		return &versions[0], nil
+	}
+	task, err := current()
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(versions, task.Version) {
+		return nil, errIfMatchFailed
+	}
+	return &task.Version, nil
+}
+
+// parseStrongETags parses a comma separated list of entity tags and returns
+// the opaque tags of the strong ones, without quotes.
+func parseStrongETags(header string) ([]string, error) {
+	var tags []string
+	parsed := 0
+	rest := header
+	for {
+		rest = strings.TrimLeft(rest, " \t")
+		if rest == "" {
+			break
+		}
+		// empty list elements are allowed
+		if rest[0] == ',' {
+			rest = rest[1:]
+			continue
+		}
+
+		weak := strings.HasPrefix(rest, "W/")
+		if weak {
+			rest = rest[2:]
+		}
+		if rest == "" || rest[0] != '"' {
+			return nil, errInvalidIfMatch
+		}
+		end := strings.IndexByte(rest[1:], '"')
+		if end < 0 {
+			return nil, errInvalidIfMatch
+		}
+		tag := rest[1 : end+1]
+		if strings.ContainsFunc(tag, func(r rune) bool { return r < 0x21 || r == 0x7F }) {
+			return nil, errInvalidIfMatch
+		}
+		rest = strings.TrimLeft(rest[end+2:], " \t")
+		if rest != "" && rest[0] != ',' {
+			return nil, errInvalidIfMatch
+		}
+
+		parsed++
+		if !weak {
+			tags = append(tags, tag)
+		}
+	}
+	if parsed == 0 {
+		return nil, errInvalidIfMatch
+	}
+	return tags, nil
+}
+
+// ifMatchError responds to an error of ifMatchVersion.
+func ifMatchError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, errInvalidIfMatch):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"ok":    false,
+			"error": err.Error(),
+		})
+	case errors.Is(err, model.ErrVersionMismatch):
+		return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{
+			"ok":    false,
+			"error": err.Error(),
+		})
+	case errors.Is(err, model.ErrTaskNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"ok":    false,
+			"error": fmt.Errorf("task with provided id wasn't found: %w", err).Error(),
+		})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"ok":    false,
+		"error": fmt.Errorf("failed to find task with provided id: %w", err).Error(),
+	})
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+
+	"test-server/internal/domain/model"
+)
+
+func (h *ArchiveHandler) GetArchivedTask(c *fiber.Ctx) error {
+	taskId := c.Params("id")
+	if !validateTaskId(taskId) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"ok":    false,
+			"error": "error: task id is empty or has incorrect format",
+		})
+	}
+
+	taskInfo, err := h.archiveService.ArchivedTask(c.UserContext(), taskId)
+	if err != nil {
+		if errors.Is(err, model.ErrTaskNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"ok":    false,
+				"error": fmt.Errorf("archived task with provided id wasn't found: %w", err).Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"ok":    false,
+			"error": fmt.Errorf("failed to find archived task with provided id: %w", err).Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(getTaskInfoResponse{
+		OK:          true,
+		Error:       "",
+		TaskDetails: mapTaskToDTO(taskInfo),
+	})
+}
@@ -0,0 +1,14 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetConfig returns the configuration currently in effect, including
+// reloaded values, with secrets redacted.
+func (h *ConfigHandler) GetConfig(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"ok":   true,
+		"data": h.configService.RedactedConfig(),
+	})
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// quotaLimitResponse is the usage of a single limit. Limit is omitted when
+// the tenant isn't limited.
+type quotaLimitResponse struct {
+	Used  int `json:"used"`
+	Limit int `json:"limit,omitempty"`
+}
+
+type quotaResponse struct {
+	Tenant         string             `json:"tenant"`
+	Running        quotaLimitResponse `json:"running"`
+	Stored         quotaLimitResponse `json:"stored"`
+	Creations      quotaLimitResponse `json:"creations"`
+	WindowSeconds  int64              `json:"window_seconds"`
+	WindowResetsAt *time.Time         `json:"window_resets_at,omitempty"`
+}
+
+// GetQuota reports the current usage of the tenant quota against its limits.
+func (h *QuotaHandler) GetQuota(c *fiber.Ctx) error {
+	usage := h.quotaService.Usage(c.UserContext())
+
+	response := quotaResponse{
+		Tenant:        usage.Tenant,
+		Running:       quotaLimitResponse{Used: usage.Running, Limit: usage.Limits.MaxRunning},
+		Stored:        quotaLimitResponse{Used: usage.Stored, Limit: usage.Limits.MaxStored},
+		Creations:     quotaLimitResponse{Used: usage.Creations, Limit: usage.Limits.MaxCreations},
+		WindowSeconds: int64(usage.Window.Seconds()),
+	}
+	if !usage.WindowResetsAt.IsZero() {
+		response.WindowResetsAt = &usage.WindowResetsAt
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"ok":   true,
+		"data": response,
+	})
+}
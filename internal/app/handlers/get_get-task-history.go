@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"test-server/internal/domain/model"
+)
+
+type taskEventResponse struct {
+	Type   string    `json:"type"`
+	Time   time.Time `json:"time"`
+	Status string    `json:"status,omitempty"`
+}
+
+type taskHistoryResponse struct {
+	ID         uuid.UUID           `json:"task_id"`
+	Status     string              `json:"status"`
+	CreatedAt  time.Time           `json:"created_at"`
+	StartedAt  *time.Time          `json:"started_at,omitempty"`
+	FinishedAt *time.Time          `json:"finished_at,omitempty"`
+	Duration   int64               `json:"duration_ms"`
+	Events     []taskEventResponse `json:"events"`
+}
+
+type getTaskHistoryResponse struct {
+	History taskHistoryResponse `json:"data"`
+	Error   string              `json:"error"`
+	OK      bool                `json:"ok"`
+}
+
+// GetTaskHistory returns the lifecycle events of the task, oldest first.
+func (h *Handler) GetTaskHistory(c *fiber.Ctx) error {
+	taskId := c.Params("id")
+	if !validateTaskId(taskId) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"ok":    false,
+			"error": "error: task id is empty or has incorrect format",
+		})
+	}
+
+	task, err := h.tasksService.TaskInfo(c.UserContext(), taskId)
+	if err != nil {
+		if errors.Is(err, model.ErrTaskNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"ok":    false,
+				"error": fmt.Errorf("task with provided id wasn't found: %w", err).Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"ok":    false,
+			"error": fmt.Errorf("failed to find task with provided id: %w", err).Error(),
+		})
+	}
+	if task == nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"ok":    false,
+			"error": "service returned nil task without error",
+		})
+	}
+
+	c.Set(fiber.HeaderETag, taskETag(task.Version))
+	events := make([]taskEventResponse, 0, len(task.Events))
+	for _, event := range task.Events {
+		events = append(events, taskEventResponse{Type: string(event.Type), Time: event.Time, Status: string(event.Status)})
+	}
+	return c.Status(fiber.StatusOK).JSON(getTaskHistoryResponse{
+		OK: true,
+		History: taskHistoryResponse{
+			ID:         task.ID,
+			Status:     string(task.Status),
+			CreatedAt:  task.CreatedAt,
+			StartedAt:  task.StartedAt,
+			FinishedAt: task.FinishedAt,
+			Duration:   task.Duration.Milliseconds(),
+			Events:     events,
+		},
+	})
+}
@@ -12,11 +12,19 @@ import (
 )
 
 type taskInfoResponse struct {
-	ID        uuid.UUID `json:"task_id"`
-	Status    string    `json:"status"`
-	Title     string    `json:"title"`
-	CreatedAt time.Time `json:"created_at"`
-	Duration  int64     `json:"duration_ms"` // Convert to milliseconds for API
+	ID         uuid.UUID         `json:"task_id"`
+	Status     string            `json:"status"`
+	Title      string            `json:"title"`
+	CreatedAt  time.Time         `json:"created_at"`
+	StartedAt  *time.Time        `json:"started_at,omitempty"`
+	FinishedAt *time.Time        `json:"finished_at,omitempty"`
+	Duration   int64             `json:"duration_ms"` // Convert to milliseconds for API
+	Version    int64             `json:"version"`
+	Labels     map[string]string `json:"labels,omitempty"`
+	Metadata   map[string]any    `json:"metadata,omitempty"`
+	ExpiresAt  *time.Time        `json:"expires_at,omitempty"`
+	CreatedBy  string            `json:"created_by,omitempty"`
+	Tenant     string            `json:"tenant,omitempty"`
 }
 
 type getTaskInfoResponse struct {
@@ -37,7 +45,7 @@ func (h *Handler) GetTaskInfo(c *fiber.Ctx) error {
 	taskInfo, err := h.tasksService.TaskInfo(c.UserContext(), taskId)
 	if err != nil {
 		if errors.Is(err, model.ErrTaskNotFound) {
-			return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
 				"ok":    false,
 				"error": fmt.Errorf("task with provided id wasn't found: %w", err).Error(),
 			})
@@ -54,6 +62,7 @@ func (h *Handler) GetTaskInfo(c *fiber.Ctx) error {
 		})
 	}
 
+	c.Set(fiber.HeaderETag, taskETag(taskInfo.Version))
 	taskDTO := mapTaskToDTO(taskInfo)
 	response := getTaskInfoResponse{
 		OK:          true,
@@ -77,10 +86,18 @@ func validateTaskId(s string) bool {
 
 func mapTaskToDTO(task *model.Task) taskInfoResponse {
 	return taskInfoResponse{
-		ID:        task.ID,
-		Status:    string(task.Status), // Convert enum to string
-		Title:     task.Title,
-		CreatedAt: task.CreatedAt,
-		Duration:  task.Duration.Milliseconds(), // Convert to milliseconds
+		ID:         task.ID,
+		Status:     string(task.Status), // Convert enum to string
+		Title:      task.Title,
+		CreatedAt:  task.CreatedAt,
+		StartedAt:  task.StartedAt,
+		FinishedAt: task.FinishedAt,
+		Duration:   task.Duration.Milliseconds(), // Convert to milliseconds
+		Version:    task.Version,
+		Labels:     task.Labels,
+		Metadata:   task.Metadata,
+		ExpiresAt:  task.ExpiresAt,
+		CreatedBy:  task.CreatedBy,
+		Tenant:     task.Tenant,
 	}
 }
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"test-server/internal/domain/model"
+)
+
+const (
+	defaultTaskLogLimit = 1000
+	maxTaskLogLimit     = 10000
+	mimeNDJSON          = "application/x-ndjson"
+)
+
+type taskLogsResponse struct {
+	ID         string          `json:"task_id"`
+	Lines      []model.LogLine `json:"lines"`
+	NextOffset int64           `json:"next_offset"`
+	Finished   bool            `json:"finished"`
+}
+
+type getTaskLogsResponse struct {
+	Logs  taskLogsResponse `json:"data"`
+	Error string           `json:"error"`
+	OK    bool             `json:"ok"`
+}
+
+// GetTaskLogs returns the log of the task selected by the "offset" and
+// "limit" or the "tail" query parameters. With "follow=true" the selected
+// lines and those written afterwards are streamed as JSON lines until the
+// task finishes. Blank lines keep idle streams alive.
+func (h *TaskLogHandler) GetTaskLogs(c *fiber.Ctx) error {
+	taskId := c.Params("id")
+	if !validateTaskId(taskId) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"ok":    false,
+			"error": "error: task id is empty or has incorrect format",
+		})
+	}
+	query, err := parseLogQuery(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"ok":    false,
+			"error": err.Error(),
+		})
+	}
+
+	ctx := c.UserContext()
+	page, err := h.taskLogService.TaskLogs(ctx, taskId, query)
+	if err != nil {
+		if errors.Is(err, model.ErrTaskNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"ok":    false,
+				"error": fmt.Errorf("task with provided id wasn't found: %w", err).Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"ok":    false,
+			"error": fmt.Errorf("failed to read task log: %w", err).Error(),
+		})
+	}
+
+	if !c.QueryBool("follow") {
+		if page.Lines == nil {
+			page.Lines = []model.LogLine{}
+		}
+		return c.Status(fiber.StatusOK).JSON(getTaskLogsResponse{
+			OK: true,
+			Logs: taskLogsResponse{
+				ID:         taskId,
+				Lines:      page.Lines,
+				NextOffset: page.NextOffset,
+				Finished:   page.Finished,
+			},
+		})
+	}
+
+	c.Set(fiber.HeaderContentType, mimeNDJSON)
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		write := func(lines []model.LogLine) error {
+			if len(lines) == 0 {
+				// keep-alive, fails once the client is gone
+				w.WriteByte('\n')
+			}
+			for _, line := range lines {
+				data, err := json.Marshal(line)
+				if err != nil {
+					return err
+				}
+				w.Write(data)
+				w.WriteByte('\n')
+			}
+			return w.Flush()
+		}
+
+		var err error
+		if len(page.Lines) > 0 {
+			err = write(page.Lines)
+		}
+		if err == nil {
+			err = h.taskLogService.FollowTaskLogs(ctx, taskId, page.NextOffset, write)
+		}
+		if err != nil {
+			slog.DebugContext(ctx, "Task log stream ended", "task_id", taskId, "error", err)
+		}
+	})
+	return nil
+}
+
+func parseLogQuery(c *fiber.Ctx) (model.LogQuery, error) {
+	query := model.LogQuery{
+		Tail:  c.QueryInt("tail", 0),
+		Limit: c.QueryInt("limit", defaultTaskLogLimit),
+	}
+	if query.Limit <= 0 || query.Limit > maxTaskLogLimit {
+		return query, fmt.Errorf("%w: limit must be between 1 and %d", model.ErrInvalidLogQuery, maxTaskLogLimit)
+	}
+	if c.Query("tail") != "" && (query.Tail <= 0 || query.Tail > maxTaskLogLimit) {
+		return query, fmt.Errorf("%w: tail must be between 1 and %d", model.ErrInvalidLogQuery, maxTaskLogLimit)
+	}
+
+	if value := c.Query("offset"); value != "" {
+		if query.Tail > 0 {
+			return query, fmt.Errorf("%w: offset and tail are mutually exclusive", model.ErrInvalidLogQuery)
+		}
+		offset, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || offset < 0 {
+			return query, fmt.Errorf("%w: offset must be a non-negative integer, got %q", model.ErrInvalidLogQuery, value)
+		}
+		query.Offset = offset
+	}
+	return query, nil
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"test-server/internal/domain/model"
+)
+
+const (
+	defaultAuditLimit = 100
+	maxAuditLimit     = 1000
+)
+
+type listAuditResponse struct {
+	Entries []model.AuditEntry `json:"data"`
+	Error   string             `json:"error"`
+	OK      bool               `json:"ok"`
+}
+
+// ListAudit returns the most recent audit entries of the tenant, newest
+// first, filtered by the optional "actor", "action", "task_id",
+// "request_id", "since" and "until" (RFC 3339) query parameters.
+func (h *AuditHandler) ListAudit(c *fiber.Ctx) error {
+	filter, err := parseAuditFilter(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"ok":    false,
+			"error": err.Error(),
+		})
+	}
+
+	entries, err := h.auditService.Query(c.UserContext(), filter)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"ok":    false,
+			"error": fmt.Errorf("failed to query audit log: %w", err).Error(),
+		})
+	}
+	if entries == nil {
+		entries = []model.AuditEntry{}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(listAuditResponse{
+		OK:      true,
+		Error:   "",
+		Entries: entries,
+	})
+}
+
+func parseAuditFilter(c *fiber.Ctx) (model.AuditFilter, error) {
+	filter := model.AuditFilter{
+		Actor:     c.Query("actor"),
+		TaskID:    c.Query("task_id"),
+		RequestID: c.Query("request_id"),
+		Limit:     c.QueryInt("limit", defaultAuditLimit),
+	}
+	if filter.Limit <= 0 || filter.Limit > maxAuditLimit {
+		return filter, fmt.Errorf("error: limit must be between 1 and %d", maxAuditLimit)
+	}
+
+	if action := c.Query("action"); action != "" {
+		var err error
+		if filter.Action, err = model.ParseAuditAction(action); err != nil {
+			return filter, err
+		}
+	}
+	for param, dst := range map[string]*time.Time{"since": &filter.Since, "until": &filter.Until} {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return filter, fmt.Errorf("%w: %s must be an RFC 3339 time, got %q", model.ErrInvalidAuditFilter, param, value)
+		}
+		*dst = t
+	}
+	return filter, nil
+}
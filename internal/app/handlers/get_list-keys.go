@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"test-server/internal/auth"
+)
+
+// keyResponse describes a key without its hash.
+type keyResponse struct {
+	ID        string    `json:"id"`
+	Scopes    []string  `json:"scopes"`
+	Tenant    string    `json:"tenant,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitzero"`
+	ReadOnly  bool      `json:"read_only"`
+	// Secret is only returned when the key is created.
+	Secret string `json:"key,omitempty"`
+}
+
+func newKeyResponse(key auth.Key) keyResponse {
+	return keyResponse{ID: key.ID, Scopes: key.Scopes, Tenant: key.Tenant, CreatedAt: key.CreatedAt, ReadOnly: key.ReadOnly}
+}
+
+func (h *KeysHandler) ListKeys(c *fiber.Ctx) error {
+	keys := h.keysService.ListKeys(c.UserContext())
+
+	response := make([]keyResponse, 0, len(keys))
+	for _, key := range keys {
+		response = append(response, newKeyResponse(key))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"ok":   true,
+		"data": response,
+	})
+}
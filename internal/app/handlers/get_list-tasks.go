@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+
+	"test-server/internal/domain/model"
+)
+
+type listTasksResponse struct {
+	Tasks []taskInfoResponse `json:"data"`
+	Error string             `json:"error"`
+	OK    bool               `json:"ok"`
+}
+
+// ListTasks returns tasks filtered by the optional "selector" query parameter,
+// a Kubernetes-style label selector like "team=io,env!=prod".
+func (h *Handler) ListTasks(c *fiber.Ctx) error {
+	selector, err := model.ParseSelector(c.Query("selector"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"ok":    false,
+			"error": err.Error(),
+		})
+	}
+
+	tasks, err := h.tasksService.ListTasks(c.UserContext(), selector)
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidSelector) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"ok":    false,
+				"error": err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"ok":    false,
+			"error": fmt.Errorf("failed to list tasks: %w", err).Error(),
+		})
+	}
+
+	taskDTOs := make([]taskInfoResponse, 0, len(tasks))
+	for i := range tasks {
+		taskDTOs = append(taskDTOs, mapTaskToDTO(&tasks[i]))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(listTasksResponse{
+		OK:    true,
+		Error: "",
+		Tasks: taskDTOs,
+	})
+}
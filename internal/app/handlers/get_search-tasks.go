@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+// SearchTasks performs full-text search over task titles. The last word of
+// the "q" query parameter also matches as a prefix.
+func (h *Handler) SearchTasks(c *fiber.Ctx) error {
+	query := c.Query("q")
+	if query == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"ok":    false,
+			"error": "error: search query is empty",
+		})
+	}
+
+	limit := c.QueryInt("limit", defaultSearchLimit)
+	if limit <= 0 || limit > maxSearchLimit {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"ok":    false,
+			"error": fmt.Sprintf("error: limit must be between 1 and %d", maxSearchLimit),
+		})
+	}
+
+	tasks, err := h.tasksService.SearchTasks(c.UserContext(), query, limit)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"ok":    false,
+			"error": fmt.Errorf("failed to search tasks: %w", err).Error(),
+		})
+	}
+
+	taskDTOs := make([]taskInfoResponse, 0, len(tasks))
+	for i := range tasks {
+		taskDTOs = append(taskDTOs, mapTaskToDTO(&tasks[i]))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(listTasksResponse{
+		OK:    true,
+		Error: "",
+		Tasks: taskDTOs,
+	})
+}
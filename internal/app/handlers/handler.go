@@ -3,14 +3,22 @@ package handlers
 import (
 	"context"
 
+	"test-server/internal/auth"
 	"test-server/internal/domain/model"
 )
 
 //go:generate minimock -i TasksService -o ./mock -s _mock.go
 type TasksService interface {
-	RegisterTask(ctx context.Context, title string) (string, error)
+	RegisterTask(ctx context.Context, spec model.TaskSpec) (string, error)
+	RegisterTasks(ctx context.Context, specs []model.TaskSpec) []model.BatchResult
 	TaskInfo(ctx context.Context, taskId string) (*model.Task, error)
-	DeleteTask(ctx context.Context, taskId string) error
+	ListTasks(ctx context.Context, selector model.LabelSelector) ([]model.Task, error)
+	SearchTasks(ctx context.Context, query string, limit int) ([]model.Task, error)
+	PatchTask(ctx context.Context, taskId string, patch model.TaskPatch) (*model.Task, error)
+	DeleteTask(ctx context.Context, taskId string, version *int64) error
+	DeleteTasks(ctx context.Context, taskIds []string) []model.BatchResult
+	RestoreTask(ctx context.Context, taskId string) (*model.Task, error)
+	PurgeTask(ctx context.Context, taskId string) error
 }
 
 type Handler struct {
@@ -22,3 +30,102 @@ func NewHandler(tasksService TasksService) *Handler {
 		tasksService: tasksService,
 	}
 }
+
+//go:generate minimock -i ArchiveService -o ./mock -s _mock.go
+type ArchiveService interface {
+	ArchivedTask(ctx context.Context, taskId string) (*model.Task, error)
+}
+
+// ArchiveHandler serves read-only access to archived tasks.
+type ArchiveHandler struct {
+	archiveService ArchiveService
+}
+
+func NewArchiveHandler(archiveService ArchiveService) *ArchiveHandler {
+	return &ArchiveHandler{
+		archiveService: archiveService,
+	}
+}
+
+//go:generate minimock -i ConfigService -o ./mock -s _mock.go
+type ConfigService interface {
+	RedactedConfig() map[string]map[string]any
+}
+
+// ConfigHandler serves the effective configuration to administrators.
+type ConfigHandler struct {
+	configService ConfigService
+}
+
+func NewConfigHandler(configService ConfigService) *ConfigHandler {
+	return &ConfigHandler{
+		configService: configService,
+	}
+}
+
+//go:generate minimock -i KeysService -o ./mock -s _mock.go
+type KeysService interface {
+	ListKeys(ctx context.Context) []auth.Key
+	CreateKey(ctx context.Context, id, tenantID string, scopes []string) (*auth.Key, string, error)
+	DeleteKey(ctx context.Context, id string) error
+}
+
+// KeysHandler manages API keys on behalf of administrators.
+type KeysHandler struct {
+	keysService KeysService
+}
+
+func NewKeysHandler(keysService KeysService) *KeysHandler {
+	return &KeysHandler{
+		keysService: keysService,
+	}
+}
+
+//go:generate minimock -i QuotaService -o ./mock -s _mock.go
+type QuotaService interface {
+	Usage(ctx context.Context) model.QuotaUsage
+}
+
+// QuotaHandler reports the quota usage of the tenant of the request.
+type QuotaHandler struct {
+	quotaService QuotaService
+}
+
+func NewQuotaHandler(quotaService QuotaService) *QuotaHandler {
+	return &QuotaHandler{
+		quotaService: quotaService,
+	}
+}
+
+//go:generate minimock -i AuditService -o ./mock -s _mock.go
+type AuditService interface {
+	Query(ctx context.Context, filter model.AuditFilter) ([]model.AuditEntry, error)
+}
+
+// AuditHandler serves the audit log of task mutations.
+type AuditHandler struct {
+	auditService AuditService
+}
+
+func NewAuditHandler(auditService AuditService) *AuditHandler {
+	return &AuditHandler{
+		auditService: auditService,
+	}
+}
+
+//go:generate minimock -i TaskLogService -o ./mock -s _mock.go
+type TaskLogService interface {
+	TaskLogs(ctx context.Context, taskId string, query model.LogQuery) (model.LogPage, error)
+	FollowTaskLogs(ctx context.Context, taskId string, offset int64, fn func([]model.LogLine) error) error
+}
+
+// TaskLogHandler serves the logs written by tasks during their execution.
+type TaskLogHandler struct {
+	taskLogService TaskLogService
+}
+
+func NewTaskLogHandler(taskLogService TaskLogService) *TaskLogHandler {
+	return &TaskLogHandler{
+		taskLogService: taskLogService,
+	}
+}
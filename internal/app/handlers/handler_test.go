@@ -2,12 +2,16 @@ package handlers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http/httptest"
+	"net/url"
 	mocks "test-server/internal/app/handlers/mock"
+	"test-server/internal/auth"
 	"test-server/internal/domain/model"
+	"test-server/internal/tenant"
 	"testing"
 	"time"
 
@@ -25,17 +29,40 @@ func TestTasksHandler_PostRegisterTask(t *testing.T) {
 
 	testTable := []struct {
 		name         string
-		body         map[string]string
+		body         map[string]any
 		mockSetup    func(mc *minimock.Controller) TasksService
 		expectedCode int
 		expectedBody map[string]interface{}
-		wantErr      require.ErrorAssertionFunc
+		// expectedRetryAfter is the expected Retry-After header, empty when unset
+		expectedRetryAfter string
+		wantErr            require.ErrorAssertionFunc
 	}{
 		{
 			name: "success",
-			body: map[string]string{"title": testTaskName},
+			body: map[string]any{"title": testTaskName},
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).RegisterTaskMock.Expect(minimock.AnyContext, model.TaskSpec{Title: testTaskName}).Return("test-id", nil)
+			},
+			expectedCode: 200,
+			expectedBody: map[string]interface{}{
+				"ok":   true,
+				"data": "test-id",
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "with labels and metadata",
+			body: map[string]any{
+				"title":    testTaskName,
+				"labels":   map[string]string{"team": "io"},
+				"metadata": map[string]any{"source": "cli"},
+			},
 			mockSetup: func(mc *minimock.Controller) TasksService {
-				return mocks.NewTasksServiceMock(mc).RegisterTaskMock.Expect(minimock.AnyContext, testTaskName).Return("test-id", nil)
+				return mocks.NewTasksServiceMock(mc).RegisterTaskMock.Expect(minimock.AnyContext, model.TaskSpec{
+					Title:    testTaskName,
+					Labels:   map[string]string{"team": "io"},
+					Metadata: map[string]any{"source": "cli"},
+				}).Return("test-id", nil)
 			},
 			expectedCode: 200,
 			expectedBody: map[string]interface{}{
@@ -44,9 +71,54 @@ func TestTasksHandler_PostRegisterTask(t *testing.T) {
 			},
 			wantErr: require.NoError,
 		},
+		{
+			name: "invalid label",
+			body: map[string]any{
+				"title":  testTaskName,
+				"labels": map[string]string{"team": "not valid"},
+			},
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc)
+			},
+			expectedCode: 400,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": `label value "not valid" is invalid: invalid task label`,
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "service draining",
+			body: map[string]any{"title": testTaskName},
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).RegisterTaskMock.Expect(minimock.AnyContext, model.TaskSpec{Title: testTaskName}).
+					Return("", fmt.Errorf("TasksService.RegisterTask: %w", model.ErrServiceDraining))
+			},
+			expectedCode: 503,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": "TasksService.RegisterTask: service is shutting down and doesn't accept new tasks",
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "quota exceeded",
+			body: map[string]any{"title": testTaskName},
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).RegisterTaskMock.Expect(minimock.AnyContext, model.TaskSpec{Title: testTaskName}).
+					Return("", fmt.Errorf("TasksService.RegisterTask: %w", &model.QuotaExceededError{Limit: model.QuotaCreations, RetryAfter: 1500 * time.Millisecond}))
+			},
+			expectedCode: 429,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": "TasksService.RegisterTask: tenant quota exceeded: max_creations",
+			},
+			expectedRetryAfter: "2",
+			wantErr:            require.NoError,
+		},
 		{
 			name: "invalid JSON body",
-			body: map[string]string{"field": "test"},
+			body: map[string]any{"field": "test"},
 			mockSetup: func(mc *minimock.Controller) TasksService {
 				return mocks.NewTasksServiceMock(mc)
 			},
@@ -92,6 +164,7 @@ func TestTasksHandler_PostRegisterTask(t *testing.T) {
 			require.NoError(t, err)
 
 			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+			assert.Equal(t, tt.expectedRetryAfter, resp.Header.Get(fiber.HeaderRetryAfter))
 
 			// Parse JSON response
 			var responseBody map[string]any
@@ -103,61 +176,205 @@ func TestTasksHandler_PostRegisterTask(t *testing.T) {
 	}
 }
 
-func TestTasksHandler_GetTaskInfo(t *testing.T) {
+func TestTasksHandler_PostBatchCreateTasks(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name               string
+		body               string
+		mockSetup          func(mc *minimock.Controller) TasksService
+		expectedCode       int
+		expectedBody       map[string]interface{}
+		expectedRetryAfter string
+	}{
+		{
+			name: "per-item results",
+			body: `{"tasks": [{"title": "first"}, {"title": ""}, {"title": "third"}]}`,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).RegisterTasksMock.
+					Expect(minimock.AnyContext, []model.TaskSpec{{Title: "first"}, {Title: "third"}}).
+					Return([]model.BatchResult{
+						{ID: "first-id"},
+						{ID: "third-id", Err: model.ErrTaskAlreadyExists},
+					})
+			},
+			expectedCode: 200,
+			expectedBody: map[string]interface{}{
+				"ok":    true,
+				"error": "",
+				"data": []any{
+					map[string]any{"ok": true, "task_id": "first-id"},
+					map[string]any{"ok": false, "error": "request's body doesnt match schema"},
+					map[string]any{"ok": false, "task_id": "third-id", "error": model.ErrTaskAlreadyExists.Error()},
+				},
+			},
+		},
+		{
+			name: "partially over quota",
+			body: `{"tasks": [{"title": "first"}, {"title": "second"}]}`,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).RegisterTasksMock.
+					Expect(minimock.AnyContext, []model.TaskSpec{{Title: "first"}, {Title: "second"}}).
+					Return([]model.BatchResult{
+						{ID: "first-id"},
+						{Err: &model.QuotaExceededError{Limit: model.QuotaStored, RetryAfter: time.Minute}},
+					})
+			},
+			expectedCode: 200,
+			expectedBody: map[string]interface{}{
+				"ok":    true,
+				"error": "",
+				"data": []any{
+					map[string]any{"ok": true, "task_id": "first-id"},
+					map[string]any{"ok": false, "error": "tenant quota exceeded: max_stored"},
+				},
+			},
+			expectedRetryAfter: "60",
+		},
+		{
+			name: "over quota",
+			body: `{"tasks": [{"title": "first"}]}`,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).RegisterTasksMock.
+					Expect(minimock.AnyContext, []model.TaskSpec{{Title: "first"}}).
+					Return([]model.BatchResult{{Err: &model.QuotaExceededError{Limit: model.QuotaRunning, RetryAfter: time.Second}}})
+			},
+			expectedCode: 429,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": "tenant quota exceeded",
+				"data": []any{
+					map[string]any{"ok": false, "error": "tenant quota exceeded: max_running"},
+				},
+			},
+			expectedRetryAfter: "1",
+		},
+		{
+			name: "service draining",
+			body: `{"tasks": [{"title": "first"}, {"title": ""}]}`,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).RegisterTasksMock.
+					Expect(minimock.AnyContext, []model.TaskSpec{{Title: "first"}}).
+					Return([]model.BatchResult{{Err: model.ErrServiceDraining}})
+			},
+			expectedCode: 503,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": model.ErrServiceDraining.Error(),
+				"data": []any{
+					map[string]any{"ok": false, "error": model.ErrServiceDraining.Error()},
+					map[string]any{"ok": false, "error": "request's body doesnt match schema"},
+				},
+			},
+		},
+		{
+			name: "empty batch",
+			body: `{"tasks": []}`,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc)
+			},
+			expectedCode: 400,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": "batch must contain at least one item",
+			},
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			service := tt.mockSetup(mc)
+
+			handler := NewHandler(service)
+
+			app := fiber.New()
+			app.Post("/tasks\\:batchCreate", handler.PostBatchCreateTasks)
+
+			// Create HTTP request
+			req := httptest.NewRequest("POST", "/tasks:batchCreate", bytes.NewReader([]byte(tt.body)))
+			req.Header.Set("Content-Type", "application/json")
+
+			// Execute request
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+			bodyBytes, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+			assert.Equal(t, tt.expectedRetryAfter, resp.Header.Get(fiber.HeaderRetryAfter))
+
+			// Parse JSON response
+			var responseBody map[string]any
+			err = json.Unmarshal(bodyBytes, &responseBody)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedBody, responseBody)
+		})
+	}
+}
+
+func TestTasksHandler_ListTasks(t *testing.T) {
 	t.Parallel()
 
 	testTaskId := "ca545e27-4e9b-4c95-b38b-d72069e33975"
-	id, _ := uuid.Parse(testTaskId)
 	str := "2025-08-23T18:56:28.34065+02:00"
 	timestamp, _ := time.Parse(time.RFC3339, str)
-	testTaskInfo := &model.Task{
-		ID:        id,
-		Status:    model.Completed,
-		Title:     "dummy-title",
-		CreatedAt: timestamp,
-		Duration:  time.Second * 3,
+	selector := model.LabelSelector{
+		{Key: "team", Operator: model.OpEquals, Values: []string{"io"}},
+		{Key: "env", Operator: model.OpNotEquals, Values: []string{"prod"}},
 	}
 
 	testTable := []struct {
 		name         string
-		path         string
+		query        string
 		mockSetup    func(mc *minimock.Controller) TasksService
 		expectedCode int
 		expectedBody map[string]interface{}
-		wantErr      require.ErrorAssertionFunc
 	}{
 		{
-			name: "success",
-			path: testTaskId,
+			name:  "success",
+			query: "?selector=" + url.QueryEscape("team=io,env!=prod"),
 			mockSetup: func(mc *minimock.Controller) TasksService {
-				return mocks.NewTasksServiceMock(mc).TaskInfoMock.Expect(minimock.AnyContext, testTaskId).Return(testTaskInfo, nil)
+				return mocks.NewTasksServiceMock(mc).ListTasksMock.Expect(minimock.AnyContext, selector).Return([]model.Task{{
+					ID:        uuid.MustParse(testTaskId),
+					Status:    model.Pending,
+					Title:     "dummy-title",
+					CreatedAt: timestamp,
+					Version:   1,
+					Labels:    map[string]string{"team": "io"},
+				}}, nil)
 			},
 			expectedCode: 200,
-			expectedBody: map[string]any{
+			expectedBody: map[string]interface{}{
 				"ok":    true,
 				"error": "",
-				"data": map[string]any{
+				"data": []any{map[string]any{
 					"title":       "dummy-title",
 					"task_id":     testTaskId,
-					"status":      "completed",
-					"duration_ms": float64(3000),
+					"status":      "pending",
+					"duration_ms": float64(0),
 					"created_at":  str,
-				},
+					"version":     float64(1),
+					"labels":      map[string]any{"team": "io"},
+				}},
 			},
-			wantErr: require.NoError,
 		},
 		{
-			name: "invalid request's path param",
-			path: "incorrect-path",
+			name:  "invalid selector",
+			query: "?selector=" + url.QueryEscape("team in (io"),
 			mockSetup: func(mc *minimock.Controller) TasksService {
 				return mocks.NewTasksServiceMock(mc)
 			},
 			expectedCode: 400,
 			expectedBody: map[string]interface{}{
 				"ok":    false,
-				"error": "error: task id is empty or has incorrect format",
+				"error": `requirement "team in (io": value set isn't closed: invalid label selector`,
 			},
-			wantErr: require.NoError,
 		},
 	}
 
@@ -171,15 +388,14 @@ func TestTasksHandler_GetTaskInfo(t *testing.T) {
 			handler := NewHandler(service)
 
 			app := fiber.New()
-			app.Get("/tasks/:id", handler.GetTaskInfo)
+			app.Get("/tasks", handler.ListTasks)
 
 			// Create HTTP request
-			req := httptest.NewRequest("GET", fmt.Sprintf("%s/%s", "/tasks", tt.path), &bytes.Reader{})
-			req.Header.Set("Content-Type", "application/json")
+			req := httptest.NewRequest("GET", "/tasks"+tt.query, &bytes.Reader{})
 
 			// Execute request
 			resp, err := app.Test(req)
-			tt.wantErr(t, err)
+			require.NoError(t, err)
 
 			defer resp.Body.Close()
 			bodyBytes, err := io.ReadAll(resp.Body)
@@ -197,44 +413,69 @@ func TestTasksHandler_GetTaskInfo(t *testing.T) {
 	}
 }
 
-func TestTasksHandler_DeleteTask(t *testing.T) {
+func TestTasksHandler_SearchTasks(t *testing.T) {
 	t.Parallel()
 
 	testTaskId := "ca545e27-4e9b-4c95-b38b-d72069e33975"
+	str := "2025-08-23T18:56:28.34065+02:00"
+	timestamp, _ := time.Parse(time.RFC3339, str)
 
 	testTable := []struct {
 		name         string
-		path         string
+		query        string
 		mockSetup    func(mc *minimock.Controller) TasksService
 		expectedCode int
 		expectedBody map[string]interface{}
-		wantErr      require.ErrorAssertionFunc
 	}{
 		{
-			name: "success",
-			path: testTaskId,
+			name:  "success",
+			query: "?q=dummy&limit=5",
 			mockSetup: func(mc *minimock.Controller) TasksService {
-				return mocks.NewTasksServiceMock(mc).DeleteTaskMock.Expect(minimock.AnyContext, testTaskId).Return(nil)
+				return mocks.NewTasksServiceMock(mc).SearchTasksMock.Expect(minimock.AnyContext, "dummy", 5).Return([]model.Task{{
+					ID:        uuid.MustParse(testTaskId),
+					Status:    model.Pending,
+					Title:     "dummy-title",
+					CreatedAt: timestamp,
+					Version:   1,
+				}}, nil)
 			},
 			expectedCode: 200,
 			expectedBody: map[string]interface{}{
-				"ok":   true,
-				"data": "task was successfully removed",
+				"ok":    true,
+				"error": "",
+				"data": []any{map[string]any{
+					"title":       "dummy-title",
+					"task_id":     testTaskId,
+					"status":      "pending",
+					"duration_ms": float64(0),
+					"created_at":  str,
+					"version":     float64(1),
+				}},
 			},
-			wantErr: require.NoError,
 		},
 		{
-			name: "invalid request's path param",
-			path: "incorrect-path",
+			name:  "empty query",
+			query: "",
 			mockSetup: func(mc *minimock.Controller) TasksService {
 				return mocks.NewTasksServiceMock(mc)
 			},
 			expectedCode: 400,
 			expectedBody: map[string]interface{}{
 				"ok":    false,
-				"error": "error: task id is empty or has incorrect format",
+				"error": "error: search query is empty",
+			},
+		},
+		{
+			name:  "limit out of range",
+			query: "?q=dummy&limit=1000",
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc)
+			},
+			expectedCode: 400,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": "error: limit must be between 1 and 100",
 			},
-			wantErr: require.NoError,
 		},
 	}
 
@@ -248,15 +489,14 @@ func TestTasksHandler_DeleteTask(t *testing.T) {
 			handler := NewHandler(service)
 
 			app := fiber.New()
-			app.Delete("/tasks/:id", handler.DeleteTask)
+			app.Get("/tasks/search", handler.SearchTasks)
 
 			// Create HTTP request
-			req := httptest.NewRequest("DELETE", fmt.Sprintf("%s/%s", "/tasks", tt.path), &bytes.Reader{})
-			req.Header.Set("Content-Type", "application/json")
+			req := httptest.NewRequest("GET", "/tasks/search"+tt.query, &bytes.Reader{})
 
 			// Execute request
 			resp, err := app.Test(req)
-			tt.wantErr(t, err)
+			require.NoError(t, err)
 
 			defer resp.Body.Close()
 			bodyBytes, err := io.ReadAll(resp.Body)
@@ -273,3 +513,1364 @@ func TestTasksHandler_DeleteTask(t *testing.T) {
 		})
 	}
 }
+
+func TestTasksHandler_GetTaskInfo(t *testing.T) {
+	t.Parallel()
+
+	testTaskId := "ca545e27-4e9b-4c95-b38b-d72069e33975"
+	id, _ := uuid.Parse(testTaskId)
+	str := "2025-08-23T18:56:28.34065+02:00"
+	timestamp, _ := time.Parse(time.RFC3339, str)
+	testTaskInfo := &model.Task{
+		ID:        id,
+		Status:    model.Completed,
+		Title:     "dummy-title",
+		CreatedAt: timestamp,
+		Duration:  time.Second * 3,
+		Version:   4,
+		CreatedBy: "alice",
+	}
+
+	testTable := []struct {
+		name         string
+		path         string
+		mockSetup    func(mc *minimock.Controller) TasksService
+		expectedCode int
+		expectedETag string
+		expectedBody map[string]interface{}
+		wantErr      require.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			path: testTaskId,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).TaskInfoMock.Expect(minimock.AnyContext, testTaskId).Return(testTaskInfo, nil)
+			},
+			expectedCode: 200,
+			expectedETag: `"4"`,
+			expectedBody: map[string]any{
+				"ok":    true,
+				"error": "",
+				"data": map[string]any{
+					"title":       "dummy-title",
+					"task_id":     testTaskId,
+					"status":      "completed",
+					"duration_ms": float64(3000),
+					"created_at":  str,
+					"version":     float64(4),
+					"created_by":  "alice",
+				},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "invalid request's path param",
+			path: "incorrect-path",
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc)
+			},
+			expectedCode: 400,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": "error: task id is empty or has incorrect format",
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "task not found",
+			path: testTaskId,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).TaskInfoMock.Expect(minimock.AnyContext, testTaskId).Return(nil, model.ErrTaskNotFound)
+			},
+			expectedCode: 404,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": "task with provided id wasn't found: task not found",
+			},
+			wantErr: require.NoError,
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			service := tt.mockSetup(mc)
+
+			handler := NewHandler(service)
+
+			app := fiber.New()
+			app.Get("/tasks/:id", handler.GetTaskInfo)
+
+			// Create HTTP request
+			req := httptest.NewRequest("GET", fmt.Sprintf("%s/%s", "/tasks", tt.path), &bytes.Reader{})
+			req.Header.Set("Content-Type", "application/json")
+
+			// Execute request
+			resp, err := app.Test(req)
+			tt.wantErr(t, err)
+
+			defer resp.Body.Close()
+			bodyBytes, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+			assert.Equal(t, tt.expectedETag, resp.Header.Get("ETag"))
+
+			// Parse JSON response
+			var responseBody map[string]any
+			err = json.Unmarshal(bodyBytes, &responseBody)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedBody, responseBody)
+		})
+	}
+}
+
+func TestTasksHandler_GetTaskHistory(t *testing.T) {
+	t.Parallel()
+
+	testTaskId := "ca545e27-4e9b-4c95-b38b-d72069e33975"
+	id, _ := uuid.Parse(testTaskId)
+	created := time.Date(2025, 8, 23, 10, 0, 0, 0, time.UTC)
+	started := created.Add(time.Second)
+	finished := started.Add(3 * time.Second)
+	testTask := &model.Task{
+		ID:         id,
+		Status:     model.Completed,
+		CreatedAt:  created,
+		StartedAt:  &started,
+		FinishedAt: &finished,
+		Duration:   finished.Sub(started),
+		Version:    3,
+		Events: []model.TaskEvent{
+			{Type: model.EventQueued, Time: created},
+			{Type: model.EventStarted, Time: started},
+			{Type: model.EventFinished, Time: finished, Status: model.Completed},
+		},
+	}
+
+	testTable := []struct {
+		name         string
+		path         string
+		mockSetup    func(mc *minimock.Controller) TasksService
+		expectedCode int
+		expectedBody map[string]any
+	}{
+		{
+			name: "success",
+			path: testTaskId,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).TaskInfoMock.Expect(minimock.AnyContext, testTaskId).Return(testTask, nil)
+			},
+			expectedCode: 200,
+			expectedBody: map[string]any{
+				"ok":    true,
+				"error": "",
+				"data": map[string]any{
+					"task_id":     testTaskId,
+					"status":      "completed",
+					"created_at":  "2025-08-23T10:00:00Z",
+					"started_at":  "2025-08-23T10:00:01Z",
+					"finished_at": "2025-08-23T10:00:04Z",
+					"duration_ms": float64(3000),
+					"events": []any{
+						map[string]any{"type": "queued", "time": "2025-08-23T10:00:00Z"},
+						map[string]any{"type": "started", "time": "2025-08-23T10:00:01Z"},
+						map[string]any{"type": "finished", "time": "2025-08-23T10:00:04Z", "status": "completed"},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid request's path param",
+			path: "incorrect-path",
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc)
+			},
+			expectedCode: 400,
+			expectedBody: map[string]any{
+				"ok":    false,
+				"error": "error: task id is empty or has incorrect format",
+			},
+		},
+		{
+			name: "task not found",
+			path: testTaskId,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).TaskInfoMock.Expect(minimock.AnyContext, testTaskId).Return(nil, model.ErrTaskNotFound)
+			},
+			expectedCode: 404,
+			expectedBody: map[string]any{
+				"ok":    false,
+				"error": "task with provided id wasn't found: task not found",
+			},
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			handler := NewHandler(tt.mockSetup(mc))
+
+			app := fiber.New()
+			app.Get("/tasks/:id/history", handler.GetTaskHistory)
+
+			req := httptest.NewRequest("GET", fmt.Sprintf("/tasks/%s/history", tt.path), nil)
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+
+			var responseBody map[string]any
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&responseBody))
+			assert.Equal(t, tt.expectedBody, responseBody)
+		})
+	}
+}
+
+func TestParseTaskMergePatch_ReadOnlyFields(t *testing.T) {
+	t.Parallel()
+
+	fields := []string{"task_id", "status", "created_at", "started_at", "finished_at", "duration_ms",
+		"labels", "metadata", "expires_at", "created_by", "tenant"}
+	for _, field := range fields {
+		_, err := parseTaskMergePatch([]byte(fmt.Sprintf(`{%q: null}`, field)))
+		assert.EqualError(t, err, fmt.Sprintf("field %q is read-only", field))
+	}
+
+	_, err := parseTaskMergePatch([]byte(`{"owner": "bob"}`))
+	assert.EqualError(t, err, `unknown field "owner"`)
+}
+
+func TestTasksHandler_PatchTask(t *testing.T) {
+	t.Parallel()
+
+	testTaskId := "ca545e27-4e9b-4c95-b38b-d72069e33975"
+	str := "2025-08-23T18:56:28.34065+02:00"
+	timestamp, _ := time.Parse(time.RFC3339, str)
+	newTitle := "renamed-title"
+	version := int64(2)
+	patchedTask := &model.Task{
+		ID:        uuid.MustParse(testTaskId),
+		Status:    model.Pending,
+		Title:     newTitle,
+		CreatedAt: timestamp,
+		Version:   3,
+	}
+
+	testTable := []struct {
+		name         string
+		path         string
+		body         string
+		ifMatch      string
+		mockSetup    func(mc *minimock.Controller) TasksService
+		expectedCode int
+		expectedBody map[string]interface{}
+	}{
+		{
+			name: "success",
+			path: testTaskId,
+			body: `{"title": "renamed-title", "version": 2}`,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).PatchTaskMock.
+					Expect(minimock.AnyContext, testTaskId, model.TaskPatch{Title: &newTitle, Version: &version}).
+					Return(patchedTask, nil)
+			},
+			expectedCode: 200,
+			expectedBody: map[string]any{
+				"ok":    true,
+				"error": "",
+				"data": map[string]any{
+					"title":       newTitle,
+					"task_id":     testTaskId,
+					"status":      "pending",
+					"duration_ms": float64(0),
+					"created_at":  str,
+					"version":     float64(3),
+				},
+			},
+		},
+		{
+			name: "null version is no condition",
+			path: testTaskId,
+			body: `{"title": "renamed-title", "version": null}`,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).PatchTaskMock.
+					Expect(minimock.AnyContext, testTaskId, model.TaskPatch{Title: &newTitle}).
+					Return(patchedTask, nil)
+			},
+			expectedCode: 200,
+			expectedBody: map[string]any{
+				"ok":    true,
+				"error": "",
+				"data": map[string]any{
+					"title":       newTitle,
+					"task_id":     testTaskId,
+					"status":      "pending",
+					"duration_ms": float64(0),
+					"created_at":  str,
+					"version":     float64(3),
+				},
+			},
+		},
+		{
+			name: "removing title",
+			path: testTaskId,
+			body: `{"title": null}`,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc)
+			},
+			expectedCode: 400,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": "title can't be removed or empty",
+			},
+		},
+		{
+			name: "read-only field",
+			path: testTaskId,
+			body: `{"status": "completed"}`,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc)
+			},
+			expectedCode: 400,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": `field "status" is read-only`,
+			},
+		},
+		{
+			name: "field not editable in task status",
+			path: testTaskId,
+			body: `{"title": "renamed-title"}`,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).PatchTaskMock.
+					Expect(minimock.AnyContext, testTaskId, model.TaskPatch{Title: &newTitle}).
+					Return(nil, model.ErrFieldNotEditable)
+			},
+			expectedCode: 422,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": model.ErrFieldNotEditable.Error(),
+			},
+		},
+		{
+			name: "version mismatch",
+			path: testTaskId,
+			body: `{"title": "renamed-title", "version": 2}`,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).PatchTaskMock.
+					Expect(minimock.AnyContext, testTaskId, model.TaskPatch{Title: &newTitle, Version: &version}).
+					Return(nil, model.ErrVersionMismatch)
+			},
+			expectedCode: 409,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": "task was modified concurrently: task version mismatch",
+			},
+		},
+		{
+			name:    "If-Match mismatch",
+			path:    testTaskId,
+			body:    `{"title": "renamed-title"}`,
+			ifMatch: `"2"`,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).PatchTaskMock.
+					Expect(minimock.AnyContext, testTaskId, model.TaskPatch{Title: &newTitle, Version: &version}).
+					Return(nil, model.ErrVersionMismatch)
+			},
+			expectedCode: 412,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": "task doesn't match If-Match header: task version mismatch",
+			},
+		},
+		{
+			name: "task not found",
+			path: testTaskId,
+			body: `{"title": "renamed-title"}`,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).PatchTaskMock.
+					Expect(minimock.AnyContext, testTaskId, model.TaskPatch{Title: &newTitle}).
+					Return(nil, model.ErrTaskNotFound)
+			},
+			expectedCode: 404,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": "task with provided id wasn't found: task not found",
+			},
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			service := tt.mockSetup(mc)
+
+			handler := NewHandler(service)
+
+			app := fiber.New()
+			app.Patch("/tasks/:id", handler.PatchTask)
+
+			// Create HTTP request
+			req := httptest.NewRequest("PATCH", fmt.Sprintf("%s/%s", "/tasks", tt.path), bytes.NewReader([]byte(tt.body)))
+			req.Header.Set("Content-Type", "application/merge-patch+json")
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+
+			// Execute request
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+			bodyBytes, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+
+			// Parse JSON response
+			var responseBody map[string]any
+			err = json.Unmarshal(bodyBytes, &responseBody)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedBody, responseBody)
+		})
+	}
+}
+
+func TestTasksHandler_DeleteTask(t *testing.T) {
+	t.Parallel()
+
+	testTaskId := "ca545e27-4e9b-4c95-b38b-d72069e33975"
+
+	testTable := []struct {
+		name         string
+		path         string
+		ifMatch      string
+		mockSetup    func(mc *minimock.Controller) TasksService
+		expectedCode int
+		expectedBody map[string]interface{}
+		wantErr      require.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			path: testTaskId,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).DeleteTaskMock.Expect(minimock.AnyContext, testTaskId, nil).Return(nil)
+			},
+			expectedCode: 200,
+			expectedBody: map[string]interface{}{
+				"ok":   true,
+				"data": "task was successfully removed",
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:    "If-Match mismatch",
+			path:    testTaskId,
+			ifMatch: `"7"`,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				version := int64(7)
+				return mocks.NewTasksServiceMock(mc).DeleteTaskMock.Expect(minimock.AnyContext, testTaskId, &version).Return(model.ErrVersionMismatch)
+			},
+			expectedCode: 412,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": "task doesn't match If-Match header: task version mismatch",
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:    "malformed If-Match",
+			path:    testTaskId,
+			ifMatch: `"7`,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc)
+			},
+			expectedCode: 400,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": errInvalidIfMatch.Error(),
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:    "weak If-Match never matches",
+			path:    testTaskId,
+			ifMatch: `W/"7"`,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc)
+			},
+			expectedCode: 412,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": "task doesn't match If-Match header: task version mismatch",
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:    "If-Match list",
+			path:    testTaskId,
+			ifMatch: `W/"8", "5", "7"`,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				version := int64(7)
+				return mocks.NewTasksServiceMock(mc).
+					TaskInfoMock.Expect(minimock.AnyContext, testTaskId).Return(&model.Task{Version: 7}, nil).
+					DeleteTaskMock.Expect(minimock.AnyContext, testTaskId, &version).Return(nil)
+			},
+			expectedCode: 200,
+			expectedBody: map[string]interface{}{
+				"ok":   true,
+				"data": "task was successfully removed",
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:    "If-Match list mismatch",
+			path:    testTaskId,
+			ifMatch: `"5", "6"`,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).
+					TaskInfoMock.Expect(minimock.AnyContext, testTaskId).Return(&model.Task{Version: 7}, nil)
+			},
+			expectedCode: 412,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": "task doesn't match If-Match header: task version mismatch",
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:    "If-Match any",
+			path:    testTaskId,
+			ifMatch: `*`,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).DeleteTaskMock.Expect(minimock.AnyContext, testTaskId, nil).Return(nil)
+			},
+			expectedCode: 200,
+			expectedBody: map[string]interface{}{
+				"ok":   true,
+				"data": "task was successfully removed",
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "task not found",
+			path: testTaskId,
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).DeleteTaskMock.Expect(minimock.AnyContext, testTaskId, nil).Return(model.ErrTaskNotFound)
+			},
+			expectedCode: 404,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": "task with provided id wasn't found: task not found",
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "invalid request's path param",
+			path: "incorrect-path",
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc)
+			},
+			expectedCode: 400,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": "error: task id is empty or has incorrect format",
+			},
+			wantErr: require.NoError,
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			service := tt.mockSetup(mc)
+
+			handler := NewHandler(service)
+
+			app := fiber.New()
+			app.Delete("/tasks/:id", handler.DeleteTask)
+
+			// Create HTTP request
+			req := httptest.NewRequest("DELETE", fmt.Sprintf("%s/%s", "/tasks", tt.path), &bytes.Reader{})
+			req.Header.Set("Content-Type", "application/json")
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+
+			// Execute request
+			resp, err := app.Test(req)
+			tt.wantErr(t, err)
+
+			defer resp.Body.Close()
+			bodyBytes, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+
+			// Parse JSON response
+			var responseBody map[string]any
+			err = json.Unmarshal(bodyBytes, &responseBody)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedBody, responseBody)
+		})
+	}
+}
+
+func TestTasksHandler_PostBatchDeleteTasks(t *testing.T) {
+	t.Parallel()
+
+	testTaskId := "ca545e27-4e9b-4c95-b38b-d72069e33975"
+
+	mc := minimock.NewController(t)
+	service := mocks.NewTasksServiceMock(mc).DeleteTasksMock.
+		Expect(minimock.AnyContext, []string{testTaskId}).
+		Return([]model.BatchResult{{ID: testTaskId, Err: model.ErrTaskNotFound}})
+
+	handler := NewHandler(service)
+
+	app := fiber.New()
+	app.Post("/tasks\\:batchDelete", handler.PostBatchDeleteTasks)
+
+	body := fmt.Sprintf(`{"task_ids": ["incorrect-id", %q]}`, testTaskId)
+	req := httptest.NewRequest("POST", "/tasks:batchDelete", bytes.NewReader([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+	bodyBytes, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var responseBody map[string]any
+	err = json.Unmarshal(bodyBytes, &responseBody)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{
+		"ok":    true,
+		"error": "",
+		"data": []any{
+			map[string]any{"ok": false, "task_id": "incorrect-id", "error": "error: task id is empty or has incorrect format"},
+			map[string]any{"ok": false, "task_id": testTaskId, "error": model.ErrTaskNotFound.Error()},
+		},
+	}, responseBody)
+}
+
+func TestTasksHandler_RestoreTask(t *testing.T) {
+	t.Parallel()
+
+	testTaskId := "ca545e27-4e9b-4c95-b38b-d72069e33975"
+	str := "2025-08-23T18:56:28.34065+02:00"
+	timestamp, _ := time.Parse(time.RFC3339, str)
+
+	testTable := []struct {
+		name         string
+		mockSetup    func(mc *minimock.Controller) TasksService
+		expectedCode int
+		expectedBody map[string]interface{}
+	}{
+		{
+			name: "success",
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).RestoreTaskMock.Expect(minimock.AnyContext, testTaskId).Return(&model.Task{
+					ID:        uuid.MustParse(testTaskId),
+					Status:    model.Completed,
+					Title:     "dummy-title",
+					CreatedAt: timestamp,
+					Version:   4,
+				}, nil)
+			},
+			expectedCode: 200,
+			expectedBody: map[string]any{
+				"ok":    true,
+				"error": "",
+				"data": map[string]any{
+					"title":       "dummy-title",
+					"task_id":     testTaskId,
+					"status":      "completed",
+					"duration_ms": float64(0),
+					"created_at":  str,
+					"version":     float64(4),
+				},
+			},
+		},
+		{
+			name: "task isn't deleted",
+			mockSetup: func(mc *minimock.Controller) TasksService {
+				return mocks.NewTasksServiceMock(mc).RestoreTaskMock.Expect(minimock.AnyContext, testTaskId).Return(nil, model.ErrTaskNotDeleted)
+			},
+			expectedCode: 409,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": "task with provided id can't be restored: task isn't deleted",
+			},
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			service := tt.mockSetup(mc)
+
+			handler := NewHandler(service)
+
+			app := fiber.New()
+			app.Post("/tasks/:id/restore", handler.RestoreTask)
+
+			// Create HTTP request
+			req := httptest.NewRequest("POST", "/tasks/"+testTaskId+"/restore", &bytes.Reader{})
+
+			// Execute request
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+			bodyBytes, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+
+			// Parse JSON response
+			var responseBody map[string]any
+			err = json.Unmarshal(bodyBytes, &responseBody)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedBody, responseBody)
+		})
+	}
+}
+
+func TestTasksHandler_PurgeTask(t *testing.T) {
+	t.Parallel()
+
+	testTaskId := "ca545e27-4e9b-4c95-b38b-d72069e33975"
+
+	mc := minimock.NewController(t)
+	service := mocks.NewTasksServiceMock(mc).PurgeTaskMock.Expect(minimock.AnyContext, testTaskId).Return(nil)
+
+	handler := NewHandler(service)
+
+	app := fiber.New()
+	app.Delete("/admin/tasks/:id", handler.PurgeTask)
+
+	req := httptest.NewRequest("DELETE", "/admin/tasks/"+testTaskId, &bytes.Reader{})
+
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+	bodyBytes, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var responseBody map[string]any
+	err = json.Unmarshal(bodyBytes, &responseBody)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{
+		"ok":   true,
+		"data": "task was successfully purged",
+	}, responseBody)
+}
+
+func TestArchiveHandler_GetArchivedTask(t *testing.T) {
+	t.Parallel()
+
+	testTaskId := "ca545e27-4e9b-4c95-b38b-d72069e33975"
+	str := "2025-08-23T18:56:28.34065+02:00"
+	timestamp, _ := time.Parse(time.RFC3339, str)
+
+	testTable := []struct {
+		name         string
+		mockSetup    func(mc *minimock.Controller) ArchiveService
+		expectedCode int
+		expectedBody map[string]interface{}
+	}{
+		{
+			name: "success",
+			mockSetup: func(mc *minimock.Controller) ArchiveService {
+				return mocks.NewArchiveServiceMock(mc).ArchivedTaskMock.Expect(minimock.AnyContext, testTaskId).Return(&model.Task{
+					ID:        uuid.MustParse(testTaskId),
+					Status:    model.Completed,
+					Title:     "dummy-title",
+					CreatedAt: timestamp,
+					Duration:  time.Second * 3,
+					Version:   2,
+				}, nil)
+			},
+			expectedCode: 200,
+			expectedBody: map[string]any{
+				"ok":    true,
+				"error": "",
+				"data": map[string]any{
+					"title":       "dummy-title",
+					"task_id":     testTaskId,
+					"status":      "completed",
+					"duration_ms": float64(3000),
+					"created_at":  str,
+					"version":     float64(2),
+				},
+			},
+		},
+		{
+			name: "not archived",
+			mockSetup: func(mc *minimock.Controller) ArchiveService {
+				return mocks.NewArchiveServiceMock(mc).ArchivedTaskMock.Expect(minimock.AnyContext, testTaskId).Return(nil, model.ErrTaskNotFound)
+			},
+			expectedCode: 404,
+			expectedBody: map[string]interface{}{
+				"ok":    false,
+				"error": "archived task with provided id wasn't found: task not found",
+			},
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			service := tt.mockSetup(mc)
+
+			handler := NewArchiveHandler(service)
+
+			app := fiber.New()
+			app.Get("/archive/tasks/:id", handler.GetArchivedTask)
+
+			// Create HTTP request
+			req := httptest.NewRequest("GET", "/archive/tasks/"+testTaskId, &bytes.Reader{})
+
+			// Execute request
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+			bodyBytes, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+
+			// Parse JSON response
+			var responseBody map[string]any
+			err = json.Unmarshal(bodyBytes, &responseBody)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedBody, responseBody)
+		})
+	}
+}
+
+func TestConfigHandler_GetConfig(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	service := mocks.NewConfigServiceMock(mc).RedactedConfigMock.Return(map[string]map[string]any{
+		"service": {"port": 8080, "drain_timeout": "30s"},
+		"tracing": {"headers": "REDACTED"},
+	})
+
+	app := fiber.New()
+	app.Get("/admin/config", NewConfigHandler(service).GetConfig)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/admin/config", nil))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var responseBody map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&responseBody))
+	assert.Equal(t, map[string]any{
+		"ok": true,
+		"data": map[string]any{
+			"service": map[string]any{"port": float64(8080), "drain_timeout": "30s"},
+			"tracing": map[string]any{"headers": "REDACTED"},
+		},
+	}, responseBody)
+}
+
+func TestKeysHandler_ListKeys(t *testing.T) {
+	t.Parallel()
+
+	str := "2025-08-23T18:56:28.34065+02:00"
+	timestamp, _ := time.Parse(time.RFC3339, str)
+
+	mc := minimock.NewController(t)
+	service := mocks.NewKeysServiceMock(mc).ListKeysMock.Expect(minimock.AnyContext).Return([]auth.Key{
+		{ID: "ci", Hash: auth.HashKey("secret"), Scopes: []string{auth.ScopeRead}, ReadOnly: true},
+		{ID: "ops", Hash: auth.HashKey("other"), Scopes: []string{auth.ScopeAdmin}, CreatedAt: timestamp},
+	})
+
+	app := fiber.New()
+	app.Get("/admin/keys", NewKeysHandler(service).ListKeys)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/admin/keys", nil))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var responseBody map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&responseBody))
+	assert.Equal(t, map[string]any{
+		"ok": true,
+		"data": []any{
+			map[string]any{"id": "ci", "scopes": []any{"tasks:read"}, "read_only": true},
+			map[string]any{"id": "ops", "scopes": []any{"tasks:admin"}, "created_at": str, "read_only": false},
+		},
+	}, responseBody, "hashes must not be exposed")
+}
+
+func TestKeysHandler_CreateKey(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name         string
+		body         string
+		mockSetup    func(mc *minimock.Controller) KeysService
+		expectedCode int
+		expectedBody map[string]any
+	}{
+		{
+			name: "success",
+			body: `{"id":"ci","tenant":"acme","scopes":["tasks:read"]}`,
+			mockSetup: func(mc *minimock.Controller) KeysService {
+				return mocks.NewKeysServiceMock(mc).CreateKeyMock.Expect(minimock.AnyContext, "ci", "acme", []string{auth.ScopeRead}).
+					Return(&auth.Key{ID: "ci", Hash: auth.HashKey("tsk_secret"), Scopes: []string{auth.ScopeRead}, Tenant: "acme"}, "tsk_secret", nil)
+			},
+			expectedCode: 201,
+			expectedBody: map[string]any{
+				"ok":   true,
+				"data": map[string]any{"id": "ci", "scopes": []any{"tasks:read"}, "tenant": "acme", "read_only": false, "key": "tsk_secret"},
+			},
+		},
+		{
+			name: "invalid tenant",
+			body: `{"tenant":"a/b","scopes":["tasks:read"]}`,
+			mockSetup: func(mc *minimock.Controller) KeysService {
+				return mocks.NewKeysServiceMock(mc).CreateKeyMock.Expect(minimock.AnyContext, "", "a/b", []string{auth.ScopeRead}).
+					Return(nil, "", tenant.ErrInvalidTenant)
+			},
+			expectedCode: 400,
+			expectedBody: map[string]any{"ok": false, "error": tenant.ErrInvalidTenant.Error()},
+		},
+		{
+			name: "invalid scope",
+			body: `{"scopes":["tasks:all"]}`,
+			mockSetup: func(mc *minimock.Controller) KeysService {
+				return mocks.NewKeysServiceMock(mc).CreateKeyMock.Expect(minimock.AnyContext, "", "", []string{"tasks:all"}).
+					Return(nil, "", fmt.Errorf("%w: %q", auth.ErrInvalidScope, "tasks:all"))
+			},
+			expectedCode: 400,
+			expectedBody: map[string]any{"ok": false, "error": `invalid scope: "tasks:all"`},
+		},
+		{
+			name: "duplicate id",
+			body: `{"id":"ci","scopes":["tasks:read"]}`,
+			mockSetup: func(mc *minimock.Controller) KeysService {
+				return mocks.NewKeysServiceMock(mc).CreateKeyMock.Expect(minimock.AnyContext, "ci", "", []string{auth.ScopeRead}).
+					Return(nil, "", auth.ErrKeyExists)
+			},
+			expectedCode: 409,
+			expectedBody: map[string]any{"ok": false, "error": "api key with this id already exists"},
+		},
+		{
+			name: "invalid body",
+			body: `{`,
+			mockSetup: func(mc *minimock.Controller) KeysService {
+				return mocks.NewKeysServiceMock(mc)
+			},
+			expectedCode: 400,
+			expectedBody: map[string]any{"ok": false, "error": "Cannot parse JSON"},
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			app := fiber.New()
+			app.Post("/admin/keys", NewKeysHandler(tt.mockSetup(mc)).CreateKey)
+
+			req := httptest.NewRequest("POST", "/admin/keys", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+
+			var responseBody map[string]any
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&responseBody))
+			assert.Equal(t, tt.expectedBody, responseBody)
+		})
+	}
+}
+
+func TestKeysHandler_DeleteKey(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name         string
+		err          error
+		expectedCode int
+		expectedBody map[string]any
+	}{
+		{
+			name:         "success",
+			expectedCode: 200,
+			expectedBody: map[string]any{"ok": true, "data": "api key was successfully revoked"},
+		},
+		{
+			name:         "not found",
+			err:          auth.ErrKeyNotFound,
+			expectedCode: 404,
+			expectedBody: map[string]any{"ok": false, "error": "api key not found"},
+		},
+		{
+			name:         "config key",
+			err:          auth.ErrKeyReadOnly,
+			expectedCode: 409,
+			expectedBody: map[string]any{"ok": false, "error": "api key is defined in config and can't be changed"},
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			service := mocks.NewKeysServiceMock(mc).DeleteKeyMock.Expect(minimock.AnyContext, "ci").Return(tt.err)
+
+			app := fiber.New()
+			app.Delete("/admin/keys/:id", NewKeysHandler(service).DeleteKey)
+
+			resp, err := app.Test(httptest.NewRequest("DELETE", "/admin/keys/ci", nil))
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+
+			var responseBody map[string]any
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&responseBody))
+			assert.Equal(t, tt.expectedBody, responseBody)
+		})
+	}
+}
+
+func TestQuotaHandler_GetQuota(t *testing.T) {
+	t.Parallel()
+
+	resetsAt := time.Date(2025, 8, 25, 12, 1, 0, 0, time.UTC)
+	mc := minimock.NewController(t)
+	service := mocks.NewQuotaServiceMock(mc).UsageMock.Return(model.QuotaUsage{
+		Tenant:         "acme",
+		Limits:         model.QuotaLimits{MaxRunning: 2, MaxCreations: 10},
+		Running:        1,
+		Stored:         42,
+		Creations:      3,
+		Window:         time.Minute,
+		WindowResetsAt: resetsAt,
+	})
+
+	app := fiber.New()
+	app.Get("/api/quota", NewQuotaHandler(service).GetQuota)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/quota", nil))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var responseBody map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&responseBody))
+	assert.Equal(t, map[string]any{
+		"ok": true,
+		"data": map[string]any{
+			"tenant":           "acme",
+			"running":          map[string]any{"used": float64(1), "limit": float64(2)},
+			"stored":           map[string]any{"used": float64(42)},
+			"creations":        map[string]any{"used": float64(3), "limit": float64(10)},
+			"window_seconds":   float64(60),
+			"window_resets_at": "2025-08-25T12:01:00Z",
+		},
+	}, responseBody)
+}
+
+func TestAuditHandler_ListAudit(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2025, 8, 25, 12, 0, 0, 0, time.UTC)
+	entry := model.AuditEntry{
+		Time:      since.Add(time.Minute),
+		Actor:     "alice",
+		Tenant:    "acme",
+		Action:    model.AuditUpdate,
+		TaskID:    "task-1",
+		RequestID: "req-1",
+		Changes:   []model.AuditChange{{Field: "title", Before: "draft", After: "final"}},
+	}
+
+	testTable := []struct {
+		name         string
+		query        string
+		mockSetup    func(mc *minimock.Controller) AuditService
+		expectedCode int
+		expectedBody map[string]any
+	}{
+		{
+			name:  "success",
+			query: "?actor=alice&action=update&task_id=task-1&since=2025-08-25T12:00:00Z&limit=10",
+			mockSetup: func(mc *minimock.Controller) AuditService {
+				return mocks.NewAuditServiceMock(mc).QueryMock.Expect(minimock.AnyContext, model.AuditFilter{
+					Actor:  "alice",
+					Action: model.AuditUpdate,
+					TaskID: "task-1",
+					Since:  since,
+					Limit:  10,
+				}).Return([]model.AuditEntry{entry}, nil)
+			},
+			expectedCode: 200,
+			expectedBody: map[string]any{
+				"ok":    true,
+				"error": "",
+				"data": []any{map[string]any{
+					"time":       "2025-08-25T12:01:00Z",
+					"actor":      "alice",
+					"tenant":     "acme",
+					"action":     "update",
+					"task_id":    "task-1",
+					"request_id": "req-1",
+					"changes":    []any{map[string]any{"field": "title", "before": "draft", "after": "final"}},
+				}},
+			},
+		},
+		{
+			name: "no entries",
+			mockSetup: func(mc *minimock.Controller) AuditService {
+				return mocks.NewAuditServiceMock(mc).QueryMock.Expect(minimock.AnyContext, model.AuditFilter{Limit: defaultAuditLimit}).Return(nil, nil)
+			},
+			expectedCode: 200,
+			expectedBody: map[string]any{"ok": true, "error": "", "data": []any{}},
+		},
+		{
+			name:  "unknown action",
+			query: "?action=steal",
+			mockSetup: func(mc *minimock.Controller) AuditService {
+				return mocks.NewAuditServiceMock(mc)
+			},
+			expectedCode: 400,
+			expectedBody: map[string]any{"ok": false, "error": `invalid audit filter: unknown audit action "steal"`},
+		},
+		{
+			name:  "invalid time",
+			query: "?until=yesterday",
+			mockSetup: func(mc *minimock.Controller) AuditService {
+				return mocks.NewAuditServiceMock(mc)
+			},
+			expectedCode: 400,
+			expectedBody: map[string]any{"ok": false, "error": `invalid audit filter: until must be an RFC 3339 time, got "yesterday"`},
+		},
+		{
+			name:  "limit out of range",
+			query: "?limit=5000",
+			mockSetup: func(mc *minimock.Controller) AuditService {
+				return mocks.NewAuditServiceMock(mc)
+			},
+			expectedCode: 400,
+			expectedBody: map[string]any{"ok": false, "error": "error: limit must be between 1 and 1000"},
+		},
+		{
+			name: "query error",
+			mockSetup: func(mc *minimock.Controller) AuditService {
+				return mocks.NewAuditServiceMock(mc).QueryMock.Return(nil, fmt.Errorf("disk failure"))
+			},
+			expectedCode: 500,
+			expectedBody: map[string]any{"ok": false, "error": "failed to query audit log: disk failure"},
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			app := fiber.New()
+			app.Get("/api/audit", NewAuditHandler(tt.mockSetup(mc)).ListAudit)
+
+			resp, err := app.Test(httptest.NewRequest("GET", "/api/audit"+tt.query, nil))
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+
+			var responseBody map[string]any
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&responseBody))
+			assert.Equal(t, tt.expectedBody, responseBody)
+		})
+	}
+}
+
+func TestTaskLogHandler_GetTaskLogs(t *testing.T) {
+	t.Parallel()
+
+	testTaskId := "ca545e27-4e9b-4c95-b38b-d72069e33975"
+	at := time.Date(2025, 8, 25, 12, 0, 0, 0, time.UTC)
+	lines := []model.LogLine{
+		{Offset: 0, Time: at, Level: model.LogInfo, Message: "started"},
+		{Offset: 1, Time: at, Level: model.LogError, Message: "failed"},
+	}
+
+	testTable := []struct {
+		name           string
+		query          string
+		mockSetup      func(mc *minimock.Controller) TaskLogService
+		expectedCode   int
+		expectedBody   map[string]any
+		expectedStream string
+	}{
+		{
+			name:  "offset",
+			query: "?offset=1&limit=10",
+			mockSetup: func(mc *minimock.Controller) TaskLogService {
+				return mocks.NewTaskLogServiceMock(mc).TaskLogsMock.Expect(minimock.AnyContext, testTaskId, model.LogQuery{Offset: 1, Limit: 10}).
+					Return(model.LogPage{Lines: lines[1:], NextOffset: 2, Finished: true}, nil)
+			},
+			expectedCode: 200,
+			expectedBody: map[string]any{
+				"ok":    true,
+				"error": "",
+				"data": map[string]any{
+					"task_id":     testTaskId,
+					"lines":       []any{map[string]any{"offset": float64(1), "time": "2025-08-25T12:00:00Z", "level": "error", "message": "failed"}},
+					"next_offset": float64(2),
+					"finished":    true,
+				},
+			},
+		},
+		{
+			name:  "tail of an empty log",
+			query: "?tail=5",
+			mockSetup: func(mc *minimock.Controller) TaskLogService {
+				return mocks.NewTaskLogServiceMock(mc).TaskLogsMock.Expect(minimock.AnyContext, testTaskId, model.LogQuery{Tail: 5, Limit: defaultTaskLogLimit}).
+					Return(model.LogPage{}, nil)
+			},
+			expectedCode: 200,
+			expectedBody: map[string]any{
+				"ok":    true,
+				"error": "",
+				"data":  map[string]any{"task_id": testTaskId, "lines": []any{}, "next_offset": float64(0), "finished": false},
+			},
+		},
+		{
+			name:  "follow",
+			query: "?tail=1&follow=true",
+			mockSetup: func(mc *minimock.Controller) TaskLogService {
+				return mocks.NewTaskLogServiceMock(mc).
+					TaskLogsMock.Expect(minimock.AnyContext, testTaskId, model.LogQuery{Tail: 1, Limit: defaultTaskLogLimit}).
+					Return(model.LogPage{Lines: lines[:1], NextOffset: 1}, nil).
+					FollowTaskLogsMock.Set(func(ctx context.Context, taskId string, offset int64, fn func([]model.LogLine) error) error {
+					assert.Equal(t, int64(1), offset)
+					if err := fn(nil); err != nil {
+						return err
+					}
+					return fn(lines[1:])
+				})
+			},
+			expectedCode: 200,
+			expectedStream: `{"offset":0,"time":"2025-08-25T12:00:00Z","level":"info","message":"started"}` + "\n\n" +
+				`{"offset":1,"time":"2025-08-25T12:00:00Z","level":"error","message":"failed"}` + "\n",
+		},
+		{
+			name:  "offset and tail",
+			query: "?offset=1&tail=1",
+			mockSetup: func(mc *minimock.Controller) TaskLogService {
+				return mocks.NewTaskLogServiceMock(mc)
+			},
+			expectedCode: 400,
+			expectedBody: map[string]any{"ok": false, "error": "invalid task log query: offset and tail are mutually exclusive"},
+		},
+		{
+			name:  "invalid offset",
+			query: "?offset=-1",
+			mockSetup: func(mc *minimock.Controller) TaskLogService {
+				return mocks.NewTaskLogServiceMock(mc)
+			},
+			expectedCode: 400,
+			expectedBody: map[string]any{"ok": false, "error": `invalid task log query: offset must be a non-negative integer, got "-1"`},
+		},
+		{
+			name:  "limit out of range",
+			query: "?limit=20000",
+			mockSetup: func(mc *minimock.Controller) TaskLogService {
+				return mocks.NewTaskLogServiceMock(mc)
+			},
+			expectedCode: 400,
+			expectedBody: map[string]any{"ok": false, "error": "invalid task log query: limit must be between 1 and 10000"},
+		},
+		{
+			name:  "zero tail",
+			query: "?tail=0",
+			mockSetup: func(mc *minimock.Controller) TaskLogService {
+				return mocks.NewTaskLogServiceMock(mc)
+			},
+			expectedCode: 400,
+			expectedBody: map[string]any{"ok": false, "error": "invalid task log query: tail must be between 1 and 10000"},
+		},
+		{
+			name: "task not found",
+			mockSetup: func(mc *minimock.Controller) TaskLogService {
+				return mocks.NewTaskLogServiceMock(mc).TaskLogsMock.Return(model.LogPage{}, model.ErrTaskNotFound)
+			},
+			expectedCode: 404,
+			expectedBody: map[string]any{"ok": false, "error": "task with provided id wasn't found: task not found"},
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			app := fiber.New()
+			app.Get("/api/tasks/:id/logs", NewTaskLogHandler(tt.mockSetup(mc)).GetTaskLogs)
+
+			resp, err := app.Test(httptest.NewRequest("GET", "/api/tasks/"+testTaskId+"/logs"+tt.query, nil))
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+
+			if tt.expectedStream != "" {
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				assert.Equal(t, mimeNDJSON, resp.Header.Get("Content-Type"))
+				assert.Equal(t, tt.expectedStream, string(body))
+				return
+			}
+			var responseBody map[string]any
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&responseBody))
+			assert.Equal(t, tt.expectedBody, responseBody)
+		})
+	}
+}
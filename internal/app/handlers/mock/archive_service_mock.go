@@ -0,0 +1,418 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mock
+
+//go:generate minimock -i test-server/internal/app/handlers.ArchiveService -o archive_service_mock.go -n ArchiveServiceMock -p mock
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"test-server/internal/domain/model"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ArchiveServiceMock implements mm_handlers.ArchiveService
+type ArchiveServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcArchivedTask          func(ctx context.Context, taskId string) (tp1 *model.Task, err error)
+	funcArchivedTaskOrigin    string
+	inspectFuncArchivedTask   func(ctx context.Context, taskId string)
+	afterArchivedTaskCounter  uint64
+	beforeArchivedTaskCounter uint64
+	ArchivedTaskMock          mArchiveServiceMockArchivedTask
+}
+
+// NewArchiveServiceMock returns a mock for mm_handlers.ArchiveService
+func NewArchiveServiceMock(t minimock.Tester) *ArchiveServiceMock {
+	m := &ArchiveServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ArchivedTaskMock = mArchiveServiceMockArchivedTask{mock: m}
+	m.ArchivedTaskMock.callArgs = []*ArchiveServiceMockArchivedTaskParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mArchiveServiceMockArchivedTask struct {
+	optional           bool
+	mock               *ArchiveServiceMock
+	defaultExpectation *ArchiveServiceMockArchivedTaskExpectation
+	expectations       []*ArchiveServiceMockArchivedTaskExpectation
+
+	callArgs []*ArchiveServiceMockArchivedTaskParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ArchiveServiceMockArchivedTaskExpectation specifies expectation struct of the ArchiveService.ArchivedTask
+type ArchiveServiceMockArchivedTaskExpectation struct {
+	mock               *ArchiveServiceMock
+	params             *ArchiveServiceMockArchivedTaskParams
+	paramPtrs          *ArchiveServiceMockArchivedTaskParamPtrs
+	expectationOrigins ArchiveServiceMockArchivedTaskExpectationOrigins
+	results            *ArchiveServiceMockArchivedTaskResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ArchiveServiceMockArchivedTaskParams contains parameters of the ArchiveService.ArchivedTask
+type ArchiveServiceMockArchivedTaskParams struct {
+	ctx    context.Context
+	taskId string
+}
+
+// ArchiveServiceMockArchivedTaskParamPtrs contains pointers to parameters of the ArchiveService.ArchivedTask
+type ArchiveServiceMockArchivedTaskParamPtrs struct {
+	ctx    *context.Context
+	taskId *string
+}
+
+// ArchiveServiceMockArchivedTaskResults contains results of the ArchiveService.ArchivedTask
+type ArchiveServiceMockArchivedTaskResults struct {
+	tp1 *model.Task
+	err error
+}
+
+// ArchiveServiceMockArchivedTaskOrigins contains origins of expectations of the ArchiveService.ArchivedTask
+type ArchiveServiceMockArchivedTaskExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originTaskId string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmArchivedTask *mArchiveServiceMockArchivedTask) Optional() *mArchiveServiceMockArchivedTask {
+	mmArchivedTask.optional = true
+	return mmArchivedTask
+}
+
+// Expect sets up expected params for ArchiveService.ArchivedTask
+func (mmArchivedTask *mArchiveServiceMockArchivedTask) Expect(ctx context.Context, taskId string) *mArchiveServiceMockArchivedTask {
+	if mmArchivedTask.mock.funcArchivedTask != nil {
+		mmArchivedTask.mock.t.Fatalf("ArchiveServiceMock.ArchivedTask mock is already set by Set")
+	}
+
+	if mmArchivedTask.defaultExpectation == nil {
+		mmArchivedTask.defaultExpectation = &ArchiveServiceMockArchivedTaskExpectation{}
+	}
+
+	if mmArchivedTask.defaultExpectation.paramPtrs != nil {
+		mmArchivedTask.mock.t.Fatalf("ArchiveServiceMock.ArchivedTask mock is already set by ExpectParams functions")
+	}
+
+	mmArchivedTask.defaultExpectation.params = &ArchiveServiceMockArchivedTaskParams{ctx, taskId}
+	mmArchivedTask.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmArchivedTask.expectations {
+		if minimock.Equal(e.params, mmArchivedTask.defaultExpectation.params) {
+			mmArchivedTask.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmArchivedTask.defaultExpectation.params)
+		}
+	}
+
+	return mmArchivedTask
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ArchiveService.ArchivedTask
+func (mmArchivedTask *mArchiveServiceMockArchivedTask) ExpectCtxParam1(ctx context.Context) *mArchiveServiceMockArchivedTask {
+	if mmArchivedTask.mock.funcArchivedTask != nil {
+		mmArchivedTask.mock.t.Fatalf("ArchiveServiceMock.ArchivedTask mock is already set by Set")
+	}
+
+	if mmArchivedTask.defaultExpectation == nil {
+		mmArchivedTask.defaultExpectation = &ArchiveServiceMockArchivedTaskExpectation{}
+	}
+
+	if mmArchivedTask.defaultExpectation.params != nil {
+		mmArchivedTask.mock.t.Fatalf("ArchiveServiceMock.ArchivedTask mock is already set by Expect")
+	}
+
+	if mmArchivedTask.defaultExpectation.paramPtrs == nil {
+		mmArchivedTask.defaultExpectation.paramPtrs = &ArchiveServiceMockArchivedTaskParamPtrs{}
+	}
+	mmArchivedTask.defaultExpectation.paramPtrs.ctx = &ctx
+	mmArchivedTask.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmArchivedTask
+}
+
+// ExpectTaskIdParam2 sets up expected param taskId for ArchiveService.ArchivedTask
+func (mmArchivedTask *mArchiveServiceMockArchivedTask) ExpectTaskIdParam2(taskId string) *mArchiveServiceMockArchivedTask {
+	if mmArchivedTask.mock.funcArchivedTask != nil {
+		mmArchivedTask.mock.t.Fatalf("ArchiveServiceMock.ArchivedTask mock is already set by Set")
+	}
+
+	if mmArchivedTask.defaultExpectation == nil {
+		mmArchivedTask.defaultExpectation = &ArchiveServiceMockArchivedTaskExpectation{}
+	}
+
+	if mmArchivedTask.defaultExpectation.params != nil {
+		mmArchivedTask.mock.t.Fatalf("ArchiveServiceMock.ArchivedTask mock is already set by Expect")
+	}
+
+	if mmArchivedTask.defaultExpectation.paramPtrs == nil {
+		mmArchivedTask.defaultExpectation.paramPtrs = &ArchiveServiceMockArchivedTaskParamPtrs{}
+	}
+	mmArchivedTask.defaultExpectation.paramPtrs.taskId = &taskId
+	mmArchivedTask.defaultExpectation.expectationOrigins.originTaskId = minimock.CallerInfo(1)
+
+	return mmArchivedTask
+}
+
+// Inspect accepts an inspector function that has same arguments as the ArchiveService.ArchivedTask
+func (mmArchivedTask *mArchiveServiceMockArchivedTask) Inspect(f func(ctx context.Context, taskId string)) *mArchiveServiceMockArchivedTask {
+	if mmArchivedTask.mock.inspectFuncArchivedTask != nil {
+		mmArchivedTask.mock.t.Fatalf("Inspect function is already set for ArchiveServiceMock.ArchivedTask")
+	}
+
+	mmArchivedTask.mock.inspectFuncArchivedTask = f
+
+	return mmArchivedTask
+}
+
+// Return sets up results that will be returned by ArchiveService.ArchivedTask
+func (mmArchivedTask *mArchiveServiceMockArchivedTask) Return(tp1 *model.Task, err error) *ArchiveServiceMock {
+	if mmArchivedTask.mock.funcArchivedTask != nil {
+		mmArchivedTask.mock.t.Fatalf("ArchiveServiceMock.ArchivedTask mock is already set by Set")
+	}
+
+	if mmArchivedTask.defaultExpectation == nil {
+		mmArchivedTask.defaultExpectation = &ArchiveServiceMockArchivedTaskExpectation{mock: mmArchivedTask.mock}
+	}
+	mmArchivedTask.defaultExpectation.results = &ArchiveServiceMockArchivedTaskResults{tp1, err}
+	mmArchivedTask.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmArchivedTask.mock
+}
+
+// Set uses given function f to mock the ArchiveService.ArchivedTask method
+func (mmArchivedTask *mArchiveServiceMockArchivedTask) Set(f func(ctx context.Context, taskId string) (tp1 *model.Task, err error)) *ArchiveServiceMock {
+	if mmArchivedTask.defaultExpectation != nil {
+		mmArchivedTask.mock.t.Fatalf("Default expectation is already set for the ArchiveService.ArchivedTask method")
+	}
+
+	if len(mmArchivedTask.expectations) > 0 {
+		mmArchivedTask.mock.t.Fatalf("Some expectations are already set for the ArchiveService.ArchivedTask method")
+	}
+
+	mmArchivedTask.mock.funcArchivedTask = f
+	mmArchivedTask.mock.funcArchivedTaskOrigin = minimock.CallerInfo(1)
+	return mmArchivedTask.mock
+}
+
+// When sets expectation for the ArchiveService.ArchivedTask which will trigger the result defined by the following
+// Then helper
+func (mmArchivedTask *mArchiveServiceMockArchivedTask) When(ctx context.Context, taskId string) *ArchiveServiceMockArchivedTaskExpectation {
+	if mmArchivedTask.mock.funcArchivedTask != nil {
+		mmArchivedTask.mock.t.Fatalf("ArchiveServiceMock.ArchivedTask mock is already set by Set")
+	}
+
+	expectation := &ArchiveServiceMockArchivedTaskExpectation{
+		mock:               mmArchivedTask.mock,
+		params:             &ArchiveServiceMockArchivedTaskParams{ctx, taskId},
+		expectationOrigins: ArchiveServiceMockArchivedTaskExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmArchivedTask.expectations = append(mmArchivedTask.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ArchiveService.ArchivedTask return parameters for the expectation previously defined by the When method
+func (e *ArchiveServiceMockArchivedTaskExpectation) Then(tp1 *model.Task, err error) *ArchiveServiceMock {
+	e.results = &ArchiveServiceMockArchivedTaskResults{tp1, err}
+	return e.mock
+}
+
+// Times sets number of times ArchiveService.ArchivedTask should be invoked
+func (mmArchivedTask *mArchiveServiceMockArchivedTask) Times(n uint64) *mArchiveServiceMockArchivedTask {
+	if n == 0 {
+		mmArchivedTask.mock.t.Fatalf("Times of ArchiveServiceMock.ArchivedTask mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmArchivedTask.expectedInvocations, n)
+	mmArchivedTask.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmArchivedTask
+}
+
+func (mmArchivedTask *mArchiveServiceMockArchivedTask) invocationsDone() bool {
+	if len(mmArchivedTask.expectations) == 0 && mmArchivedTask.defaultExpectation == nil && mmArchivedTask.mock.funcArchivedTask == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmArchivedTask.mock.afterArchivedTaskCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmArchivedTask.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ArchivedTask implements mm_handlers.ArchiveService
+func (mmArchivedTask *ArchiveServiceMock) ArchivedTask(ctx context.Context, taskId string) (tp1 *model.Task, err error) {
+	mm_atomic.AddUint64(&mmArchivedTask.beforeArchivedTaskCounter, 1)
+	defer mm_atomic.AddUint64(&mmArchivedTask.afterArchivedTaskCounter, 1)
+
+	mmArchivedTask.t.Helper()
+
+	if mmArchivedTask.inspectFuncArchivedTask != nil {
+		mmArchivedTask.inspectFuncArchivedTask(ctx, taskId)
+	}
+
+	mm_params := ArchiveServiceMockArchivedTaskParams{ctx, taskId}
+
+	// Record call args
+	mmArchivedTask.ArchivedTaskMock.mutex.Lock()
+	mmArchivedTask.ArchivedTaskMock.callArgs = append(mmArchivedTask.ArchivedTaskMock.callArgs, &mm_params)
+	mmArchivedTask.ArchivedTaskMock.mutex.Unlock()
+
+	for _, e := range mmArchivedTask.ArchivedTaskMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tp1, e.results.err
+		}
+	}
+
+	if mmArchivedTask.ArchivedTaskMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmArchivedTask.ArchivedTaskMock.defaultExpectation.Counter, 1)
+		mm_want := mmArchivedTask.ArchivedTaskMock.defaultExpectation.params
+		mm_want_ptrs := mmArchivedTask.ArchivedTaskMock.defaultExpectation.paramPtrs
+
+		mm_got := ArchiveServiceMockArchivedTaskParams{ctx, taskId}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmArchivedTask.t.Errorf("ArchiveServiceMock.ArchivedTask got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmArchivedTask.ArchivedTaskMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.taskId != nil && !minimock.Equal(*mm_want_ptrs.taskId, mm_got.taskId) {
+				mmArchivedTask.t.Errorf("ArchiveServiceMock.ArchivedTask got unexpected parameter taskId, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmArchivedTask.ArchivedTaskMock.defaultExpectation.expectationOrigins.originTaskId, *mm_want_ptrs.taskId, mm_got.taskId, minimock.Diff(*mm_want_ptrs.taskId, mm_got.taskId))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmArchivedTask.t.Errorf("ArchiveServiceMock.ArchivedTask got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmArchivedTask.ArchivedTaskMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmArchivedTask.ArchivedTaskMock.defaultExpectation.results
+		if mm_results == nil {
+			mmArchivedTask.t.Fatal("No results are set for the ArchiveServiceMock.ArchivedTask")
+		}
+		return (*mm_results).tp1, (*mm_results).err
+	}
+	if mmArchivedTask.funcArchivedTask != nil {
+		return mmArchivedTask.funcArchivedTask(ctx, taskId)
+	}
+	mmArchivedTask.t.Fatalf("Unexpected call to ArchiveServiceMock.ArchivedTask. %v %v", ctx, taskId)
+	return
+}
+
+// ArchivedTaskAfterCounter returns a count of finished ArchiveServiceMock.ArchivedTask invocations
+func (mmArchivedTask *ArchiveServiceMock) ArchivedTaskAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmArchivedTask.afterArchivedTaskCounter)
+}
+
+// ArchivedTaskBeforeCounter returns a count of ArchiveServiceMock.ArchivedTask invocations
+func (mmArchivedTask *ArchiveServiceMock) ArchivedTaskBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmArchivedTask.beforeArchivedTaskCounter)
+}
+
+// Calls returns a list of arguments used in each call to ArchiveServiceMock.ArchivedTask.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmArchivedTask *mArchiveServiceMockArchivedTask) Calls() []*ArchiveServiceMockArchivedTaskParams {
+	mmArchivedTask.mutex.RLock()
+
+	argCopy := make([]*ArchiveServiceMockArchivedTaskParams, len(mmArchivedTask.callArgs))
+	copy(argCopy, mmArchivedTask.callArgs)
+
+	mmArchivedTask.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockArchivedTaskDone returns true if the count of the ArchivedTask invocations corresponds
+// the number of defined expectations
+func (m *ArchiveServiceMock) MinimockArchivedTaskDone() bool {
+	if m.ArchivedTaskMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ArchivedTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ArchivedTaskMock.invocationsDone()
+}
+
+// MinimockArchivedTaskInspect logs each unmet expectation
+func (m *ArchiveServiceMock) MinimockArchivedTaskInspect() {
+	for _, e := range m.ArchivedTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ArchiveServiceMock.ArchivedTask at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterArchivedTaskCounter := mm_atomic.LoadUint64(&m.afterArchivedTaskCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ArchivedTaskMock.defaultExpectation != nil && afterArchivedTaskCounter < 1 {
+		if m.ArchivedTaskMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ArchiveServiceMock.ArchivedTask at\n%s", m.ArchivedTaskMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ArchiveServiceMock.ArchivedTask at\n%s with params: %#v", m.ArchivedTaskMock.defaultExpectation.expectationOrigins.origin, *m.ArchivedTaskMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcArchivedTask != nil && afterArchivedTaskCounter < 1 {
+		m.t.Errorf("Expected call to ArchiveServiceMock.ArchivedTask at\n%s", m.funcArchivedTaskOrigin)
+	}
+
+	if !m.ArchivedTaskMock.invocationsDone() && afterArchivedTaskCounter > 0 {
+		m.t.Errorf("Expected %d calls to ArchiveServiceMock.ArchivedTask at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ArchivedTaskMock.expectedInvocations), m.ArchivedTaskMock.expectedInvocationsOrigin, afterArchivedTaskCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ArchiveServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockArchivedTaskInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ArchiveServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ArchiveServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockArchivedTaskDone()
+}
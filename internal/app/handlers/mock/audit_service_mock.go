@@ -0,0 +1,418 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mock
+
+//go:generate minimock -i test-server/internal/app/handlers.AuditService -o audit_service_mock.go -n AuditServiceMock -p mock
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"test-server/internal/domain/model"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// AuditServiceMock implements mm_handlers.AuditService
+type AuditServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcQuery          func(ctx context.Context, filter model.AuditFilter) (aa1 []model.AuditEntry, err error)
+	funcQueryOrigin    string
+	inspectFuncQuery   func(ctx context.Context, filter model.AuditFilter)
+	afterQueryCounter  uint64
+	beforeQueryCounter uint64
+	QueryMock          mAuditServiceMockQuery
+}
+
+// NewAuditServiceMock returns a mock for mm_handlers.AuditService
+func NewAuditServiceMock(t minimock.Tester) *AuditServiceMock {
+	m := &AuditServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.QueryMock = mAuditServiceMockQuery{mock: m}
+	m.QueryMock.callArgs = []*AuditServiceMockQueryParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuditServiceMockQuery struct {
+	optional           bool
+	mock               *AuditServiceMock
+	defaultExpectation *AuditServiceMockQueryExpectation
+	expectations       []*AuditServiceMockQueryExpectation
+
+	callArgs []*AuditServiceMockQueryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditServiceMockQueryExpectation specifies expectation struct of the AuditService.Query
+type AuditServiceMockQueryExpectation struct {
+	mock               *AuditServiceMock
+	params             *AuditServiceMockQueryParams
+	paramPtrs          *AuditServiceMockQueryParamPtrs
+	expectationOrigins AuditServiceMockQueryExpectationOrigins
+	results            *AuditServiceMockQueryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditServiceMockQueryParams contains parameters of the AuditService.Query
+type AuditServiceMockQueryParams struct {
+	ctx    context.Context
+	filter model.AuditFilter
+}
+
+// AuditServiceMockQueryParamPtrs contains pointers to parameters of the AuditService.Query
+type AuditServiceMockQueryParamPtrs struct {
+	ctx    *context.Context
+	filter *model.AuditFilter
+}
+
+// AuditServiceMockQueryResults contains results of the AuditService.Query
+type AuditServiceMockQueryResults struct {
+	aa1 []model.AuditEntry
+	err error
+}
+
+// AuditServiceMockQueryOrigins contains origins of expectations of the AuditService.Query
+type AuditServiceMockQueryExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmQuery *mAuditServiceMockQuery) Optional() *mAuditServiceMockQuery {
+	mmQuery.optional = true
+	return mmQuery
+}
+
+// Expect sets up expected params for AuditService.Query
+func (mmQuery *mAuditServiceMockQuery) Expect(ctx context.Context, filter model.AuditFilter) *mAuditServiceMockQuery {
+	if mmQuery.mock.funcQuery != nil {
+		mmQuery.mock.t.Fatalf("AuditServiceMock.Query mock is already set by Set")
+	}
+
+	if mmQuery.defaultExpectation == nil {
+		mmQuery.defaultExpectation = &AuditServiceMockQueryExpectation{}
+	}
+
+	if mmQuery.defaultExpectation.paramPtrs != nil {
+		mmQuery.mock.t.Fatalf("AuditServiceMock.Query mock is already set by ExpectParams functions")
+	}
+
+	mmQuery.defaultExpectation.params = &AuditServiceMockQueryParams{ctx, filter}
+	mmQuery.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmQuery.expectations {
+		if minimock.Equal(e.params, mmQuery.defaultExpectation.params) {
+			mmQuery.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmQuery.defaultExpectation.params)
+		}
+	}
+
+	return mmQuery
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditService.Query
+func (mmQuery *mAuditServiceMockQuery) ExpectCtxParam1(ctx context.Context) *mAuditServiceMockQuery {
+	if mmQuery.mock.funcQuery != nil {
+		mmQuery.mock.t.Fatalf("AuditServiceMock.Query mock is already set by Set")
+	}
+
+	if mmQuery.defaultExpectation == nil {
+		mmQuery.defaultExpectation = &AuditServiceMockQueryExpectation{}
+	}
+
+	if mmQuery.defaultExpectation.params != nil {
+		mmQuery.mock.t.Fatalf("AuditServiceMock.Query mock is already set by Expect")
+	}
+
+	if mmQuery.defaultExpectation.paramPtrs == nil {
+		mmQuery.defaultExpectation.paramPtrs = &AuditServiceMockQueryParamPtrs{}
+	}
+	mmQuery.defaultExpectation.paramPtrs.ctx = &ctx
+	mmQuery.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmQuery
+}
+
+// ExpectFilterParam2 sets up expected param filter for AuditService.Query
+func (mmQuery *mAuditServiceMockQuery) ExpectFilterParam2(filter model.AuditFilter) *mAuditServiceMockQuery {
+	if mmQuery.mock.funcQuery != nil {
+		mmQuery.mock.t.Fatalf("AuditServiceMock.Query mock is already set by Set")
+	}
+
+	if mmQuery.defaultExpectation == nil {
+		mmQuery.defaultExpectation = &AuditServiceMockQueryExpectation{}
+	}
+
+	if mmQuery.defaultExpectation.params != nil {
+		mmQuery.mock.t.Fatalf("AuditServiceMock.Query mock is already set by Expect")
+	}
+
+	if mmQuery.defaultExpectation.paramPtrs == nil {
+		mmQuery.defaultExpectation.paramPtrs = &AuditServiceMockQueryParamPtrs{}
+	}
+	mmQuery.defaultExpectation.paramPtrs.filter = &filter
+	mmQuery.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmQuery
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditService.Query
+func (mmQuery *mAuditServiceMockQuery) Inspect(f func(ctx context.Context, filter model.AuditFilter)) *mAuditServiceMockQuery {
+	if mmQuery.mock.inspectFuncQuery != nil {
+		mmQuery.mock.t.Fatalf("Inspect function is already set for AuditServiceMock.Query")
+	}
+
+	mmQuery.mock.inspectFuncQuery = f
+
+	return mmQuery
+}
+
+// Return sets up results that will be returned by AuditService.Query
+func (mmQuery *mAuditServiceMockQuery) Return(aa1 []model.AuditEntry, err error) *AuditServiceMock {
+	if mmQuery.mock.funcQuery != nil {
+		mmQuery.mock.t.Fatalf("AuditServiceMock.Query mock is already set by Set")
+	}
+
+	if mmQuery.defaultExpectation == nil {
+		mmQuery.defaultExpectation = &AuditServiceMockQueryExpectation{mock: mmQuery.mock}
+	}
+	mmQuery.defaultExpectation.results = &AuditServiceMockQueryResults{aa1, err}
+	mmQuery.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmQuery.mock
+}
+
+// Set uses given function f to mock the AuditService.Query method
+func (mmQuery *mAuditServiceMockQuery) Set(f func(ctx context.Context, filter model.AuditFilter) (aa1 []model.AuditEntry, err error)) *AuditServiceMock {
+	if mmQuery.defaultExpectation != nil {
+		mmQuery.mock.t.Fatalf("Default expectation is already set for the AuditService.Query method")
+	}
+
+	if len(mmQuery.expectations) > 0 {
+		mmQuery.mock.t.Fatalf("Some expectations are already set for the AuditService.Query method")
+	}
+
+	mmQuery.mock.funcQuery = f
+	mmQuery.mock.funcQueryOrigin = minimock.CallerInfo(1)
+	return mmQuery.mock
+}
+
+// When sets expectation for the AuditService.Query which will trigger the result defined by the following
+// Then helper
+func (mmQuery *mAuditServiceMockQuery) When(ctx context.Context, filter model.AuditFilter) *AuditServiceMockQueryExpectation {
+	if mmQuery.mock.funcQuery != nil {
+		mmQuery.mock.t.Fatalf("AuditServiceMock.Query mock is already set by Set")
+	}
+
+	expectation := &AuditServiceMockQueryExpectation{
+		mock:               mmQuery.mock,
+		params:             &AuditServiceMockQueryParams{ctx, filter},
+		expectationOrigins: AuditServiceMockQueryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmQuery.expectations = append(mmQuery.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditService.Query return parameters for the expectation previously defined by the When method
+func (e *AuditServiceMockQueryExpectation) Then(aa1 []model.AuditEntry, err error) *AuditServiceMock {
+	e.results = &AuditServiceMockQueryResults{aa1, err}
+	return e.mock
+}
+
+// Times sets number of times AuditService.Query should be invoked
+func (mmQuery *mAuditServiceMockQuery) Times(n uint64) *mAuditServiceMockQuery {
+	if n == 0 {
+		mmQuery.mock.t.Fatalf("Times of AuditServiceMock.Query mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmQuery.expectedInvocations, n)
+	mmQuery.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmQuery
+}
+
+func (mmQuery *mAuditServiceMockQuery) invocationsDone() bool {
+	if len(mmQuery.expectations) == 0 && mmQuery.defaultExpectation == nil && mmQuery.mock.funcQuery == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmQuery.mock.afterQueryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmQuery.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Query implements mm_handlers.AuditService
+func (mmQuery *AuditServiceMock) Query(ctx context.Context, filter model.AuditFilter) (aa1 []model.AuditEntry, err error) {
+	mm_atomic.AddUint64(&mmQuery.beforeQueryCounter, 1)
+	defer mm_atomic.AddUint64(&mmQuery.afterQueryCounter, 1)
+
+	mmQuery.t.Helper()
+
+	if mmQuery.inspectFuncQuery != nil {
+		mmQuery.inspectFuncQuery(ctx, filter)
+	}
+
+	mm_params := AuditServiceMockQueryParams{ctx, filter}
+
+	// Record call args
+	mmQuery.QueryMock.mutex.Lock()
+	mmQuery.QueryMock.callArgs = append(mmQuery.QueryMock.callArgs, &mm_params)
+	mmQuery.QueryMock.mutex.Unlock()
+
+	for _, e := range mmQuery.QueryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.aa1, e.results.err
+		}
+	}
+
+	if mmQuery.QueryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmQuery.QueryMock.defaultExpectation.Counter, 1)
+		mm_want := mmQuery.QueryMock.defaultExpectation.params
+		mm_want_ptrs := mmQuery.QueryMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditServiceMockQueryParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmQuery.t.Errorf("AuditServiceMock.Query got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmQuery.QueryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmQuery.t.Errorf("AuditServiceMock.Query got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmQuery.QueryMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmQuery.t.Errorf("AuditServiceMock.Query got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmQuery.QueryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmQuery.QueryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmQuery.t.Fatal("No results are set for the AuditServiceMock.Query")
+		}
+		return (*mm_results).aa1, (*mm_results).err
+	}
+	if mmQuery.funcQuery != nil {
+		return mmQuery.funcQuery(ctx, filter)
+	}
+	mmQuery.t.Fatalf("Unexpected call to AuditServiceMock.Query. %v %v", ctx, filter)
+	return
+}
+
+// QueryAfterCounter returns a count of finished AuditServiceMock.Query invocations
+func (mmQuery *AuditServiceMock) QueryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmQuery.afterQueryCounter)
+}
+
+// QueryBeforeCounter returns a count of AuditServiceMock.Query invocations
+func (mmQuery *AuditServiceMock) QueryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmQuery.beforeQueryCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditServiceMock.Query.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmQuery *mAuditServiceMockQuery) Calls() []*AuditServiceMockQueryParams {
+	mmQuery.mutex.RLock()
+
+	argCopy := make([]*AuditServiceMockQueryParams, len(mmQuery.callArgs))
+	copy(argCopy, mmQuery.callArgs)
+
+	mmQuery.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockQueryDone returns true if the count of the Query invocations corresponds
+// the number of defined expectations
+func (m *AuditServiceMock) MinimockQueryDone() bool {
+	if m.QueryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.QueryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.QueryMock.invocationsDone()
+}
+
+// MinimockQueryInspect logs each unmet expectation
+func (m *AuditServiceMock) MinimockQueryInspect() {
+	for _, e := range m.QueryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditServiceMock.Query at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterQueryCounter := mm_atomic.LoadUint64(&m.afterQueryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.QueryMock.defaultExpectation != nil && afterQueryCounter < 1 {
+		if m.QueryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditServiceMock.Query at\n%s", m.QueryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditServiceMock.Query at\n%s with params: %#v", m.QueryMock.defaultExpectation.expectationOrigins.origin, *m.QueryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcQuery != nil && afterQueryCounter < 1 {
+		m.t.Errorf("Expected call to AuditServiceMock.Query at\n%s", m.funcQueryOrigin)
+	}
+
+	if !m.QueryMock.invocationsDone() && afterQueryCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditServiceMock.Query at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.QueryMock.expectedInvocations), m.QueryMock.expectedInvocationsOrigin, afterQueryCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuditServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockQueryInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuditServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuditServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockQueryDone()
+}
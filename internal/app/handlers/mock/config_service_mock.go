@@ -0,0 +1,258 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mock
+
+//go:generate minimock -i test-server/internal/app/handlers.ConfigService -o config_service_mock.go -n ConfigServiceMock -p mock
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ConfigServiceMock implements mm_handlers.ConfigService
+type ConfigServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcRedactedConfig          func() (m1 map[string]map[string]any)
+	funcRedactedConfigOrigin    string
+	inspectFuncRedactedConfig   func()
+	afterRedactedConfigCounter  uint64
+	beforeRedactedConfigCounter uint64
+	RedactedConfigMock          mConfigServiceMockRedactedConfig
+}
+
+// NewConfigServiceMock returns a mock for mm_handlers.ConfigService
+func NewConfigServiceMock(t minimock.Tester) *ConfigServiceMock {
+	m := &ConfigServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.RedactedConfigMock = mConfigServiceMockRedactedConfig{mock: m}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mConfigServiceMockRedactedConfig struct {
+	optional           bool
+	mock               *ConfigServiceMock
+	defaultExpectation *ConfigServiceMockRedactedConfigExpectation
+	expectations       []*ConfigServiceMockRedactedConfigExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ConfigServiceMockRedactedConfigExpectation specifies expectation struct of the ConfigService.RedactedConfig
+type ConfigServiceMockRedactedConfigExpectation struct {
+	mock *ConfigServiceMock
+
+	results      *ConfigServiceMockRedactedConfigResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// ConfigServiceMockRedactedConfigResults contains results of the ConfigService.RedactedConfig
+type ConfigServiceMockRedactedConfigResults struct {
+	m1 map[string]map[string]any
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRedactedConfig *mConfigServiceMockRedactedConfig) Optional() *mConfigServiceMockRedactedConfig {
+	mmRedactedConfig.optional = true
+	return mmRedactedConfig
+}
+
+// Expect sets up expected params for ConfigService.RedactedConfig
+func (mmRedactedConfig *mConfigServiceMockRedactedConfig) Expect() *mConfigServiceMockRedactedConfig {
+	if mmRedactedConfig.mock.funcRedactedConfig != nil {
+		mmRedactedConfig.mock.t.Fatalf("ConfigServiceMock.RedactedConfig mock is already set by Set")
+	}
+
+	if mmRedactedConfig.defaultExpectation == nil {
+		mmRedactedConfig.defaultExpectation = &ConfigServiceMockRedactedConfigExpectation{}
+	}
+
+	return mmRedactedConfig
+}
+
+// Inspect accepts an inspector function that has same arguments as the ConfigService.RedactedConfig
+func (mmRedactedConfig *mConfigServiceMockRedactedConfig) Inspect(f func()) *mConfigServiceMockRedactedConfig {
+	if mmRedactedConfig.mock.inspectFuncRedactedConfig != nil {
+		mmRedactedConfig.mock.t.Fatalf("Inspect function is already set for ConfigServiceMock.RedactedConfig")
+	}
+
+	mmRedactedConfig.mock.inspectFuncRedactedConfig = f
+
+	return mmRedactedConfig
+}
+
+// Return sets up results that will be returned by ConfigService.RedactedConfig
+func (mmRedactedConfig *mConfigServiceMockRedactedConfig) Return(m1 map[string]map[string]any) *ConfigServiceMock {
+	if mmRedactedConfig.mock.funcRedactedConfig != nil {
+		mmRedactedConfig.mock.t.Fatalf("ConfigServiceMock.RedactedConfig mock is already set by Set")
+	}
+
+	if mmRedactedConfig.defaultExpectation == nil {
+		mmRedactedConfig.defaultExpectation = &ConfigServiceMockRedactedConfigExpectation{mock: mmRedactedConfig.mock}
+	}
+	mmRedactedConfig.defaultExpectation.results = &ConfigServiceMockRedactedConfigResults{m1}
+	mmRedactedConfig.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRedactedConfig.mock
+}
+
+// Set uses given function f to mock the ConfigService.RedactedConfig method
+func (mmRedactedConfig *mConfigServiceMockRedactedConfig) Set(f func() (m1 map[string]map[string]any)) *ConfigServiceMock {
+	if mmRedactedConfig.defaultExpectation != nil {
+		mmRedactedConfig.mock.t.Fatalf("Default expectation is already set for the ConfigService.RedactedConfig method")
+	}
+
+	if len(mmRedactedConfig.expectations) > 0 {
+		mmRedactedConfig.mock.t.Fatalf("Some expectations are already set for the ConfigService.RedactedConfig method")
+	}
+
+	mmRedactedConfig.mock.funcRedactedConfig = f
+	mmRedactedConfig.mock.funcRedactedConfigOrigin = minimock.CallerInfo(1)
+	return mmRedactedConfig.mock
+}
+
+// Times sets number of times ConfigService.RedactedConfig should be invoked
+func (mmRedactedConfig *mConfigServiceMockRedactedConfig) Times(n uint64) *mConfigServiceMockRedactedConfig {
+	if n == 0 {
+		mmRedactedConfig.mock.t.Fatalf("Times of ConfigServiceMock.RedactedConfig mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRedactedConfig.expectedInvocations, n)
+	mmRedactedConfig.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRedactedConfig
+}
+
+func (mmRedactedConfig *mConfigServiceMockRedactedConfig) invocationsDone() bool {
+	if len(mmRedactedConfig.expectations) == 0 && mmRedactedConfig.defaultExpectation == nil && mmRedactedConfig.mock.funcRedactedConfig == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRedactedConfig.mock.afterRedactedConfigCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRedactedConfig.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RedactedConfig implements mm_handlers.ConfigService
+func (mmRedactedConfig *ConfigServiceMock) RedactedConfig() (m1 map[string]map[string]any) {
+	mm_atomic.AddUint64(&mmRedactedConfig.beforeRedactedConfigCounter, 1)
+	defer mm_atomic.AddUint64(&mmRedactedConfig.afterRedactedConfigCounter, 1)
+
+	mmRedactedConfig.t.Helper()
+
+	if mmRedactedConfig.inspectFuncRedactedConfig != nil {
+		mmRedactedConfig.inspectFuncRedactedConfig()
+	}
+
+	if mmRedactedConfig.RedactedConfigMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRedactedConfig.RedactedConfigMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmRedactedConfig.RedactedConfigMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRedactedConfig.t.Fatal("No results are set for the ConfigServiceMock.RedactedConfig")
+		}
+		return (*mm_results).m1
+	}
+	if mmRedactedConfig.funcRedactedConfig != nil {
+		return mmRedactedConfig.funcRedactedConfig()
+	}
+	mmRedactedConfig.t.Fatalf("Unexpected call to ConfigServiceMock.RedactedConfig.")
+	return
+}
+
+// RedactedConfigAfterCounter returns a count of finished ConfigServiceMock.RedactedConfig invocations
+func (mmRedactedConfig *ConfigServiceMock) RedactedConfigAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRedactedConfig.afterRedactedConfigCounter)
+}
+
+// RedactedConfigBeforeCounter returns a count of ConfigServiceMock.RedactedConfig invocations
+func (mmRedactedConfig *ConfigServiceMock) RedactedConfigBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRedactedConfig.beforeRedactedConfigCounter)
+}
+
+// MinimockRedactedConfigDone returns true if the count of the RedactedConfig invocations corresponds
+// the number of defined expectations
+func (m *ConfigServiceMock) MinimockRedactedConfigDone() bool {
+	if m.RedactedConfigMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RedactedConfigMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RedactedConfigMock.invocationsDone()
+}
+
+// MinimockRedactedConfigInspect logs each unmet expectation
+func (m *ConfigServiceMock) MinimockRedactedConfigInspect() {
+	for _, e := range m.RedactedConfigMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to ConfigServiceMock.RedactedConfig")
+		}
+	}
+
+	afterRedactedConfigCounter := mm_atomic.LoadUint64(&m.afterRedactedConfigCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RedactedConfigMock.defaultExpectation != nil && afterRedactedConfigCounter < 1 {
+		m.t.Errorf("Expected call to ConfigServiceMock.RedactedConfig at\n%s", m.RedactedConfigMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRedactedConfig != nil && afterRedactedConfigCounter < 1 {
+		m.t.Errorf("Expected call to ConfigServiceMock.RedactedConfig at\n%s", m.funcRedactedConfigOrigin)
+	}
+
+	if !m.RedactedConfigMock.invocationsDone() && afterRedactedConfigCounter > 0 {
+		m.t.Errorf("Expected %d calls to ConfigServiceMock.RedactedConfig at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RedactedConfigMock.expectedInvocations), m.RedactedConfigMock.expectedInvocationsOrigin, afterRedactedConfigCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ConfigServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockRedactedConfigInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ConfigServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ConfigServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockRedactedConfigDone()
+}
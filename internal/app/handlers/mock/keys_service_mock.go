@@ -0,0 +1,1160 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mock
+
+//go:generate minimock -i test-server/internal/app/handlers.KeysService -o keys_service_mock.go -n KeysServiceMock -p mock
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"test-server/internal/auth"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// KeysServiceMock implements mm_handlers.KeysService
+type KeysServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateKey          func(ctx context.Context, id string, tenantID string, scopes []string) (kp1 *auth.Key, s1 string, err error)
+	funcCreateKeyOrigin    string
+	inspectFuncCreateKey   func(ctx context.Context, id string, tenantID string, scopes []string)
+	afterCreateKeyCounter  uint64
+	beforeCreateKeyCounter uint64
+	CreateKeyMock          mKeysServiceMockCreateKey
+
+	funcDeleteKey          func(ctx context.Context, id string) (err error)
+	funcDeleteKeyOrigin    string
+	inspectFuncDeleteKey   func(ctx context.Context, id string)
+	afterDeleteKeyCounter  uint64
+	beforeDeleteKeyCounter uint64
+	DeleteKeyMock          mKeysServiceMockDeleteKey
+
+	funcListKeys          func(ctx context.Context) (ka1 []auth.Key)
+	funcListKeysOrigin    string
+	inspectFuncListKeys   func(ctx context.Context)
+	afterListKeysCounter  uint64
+	beforeListKeysCounter uint64
+	ListKeysMock          mKeysServiceMockListKeys
+}
+
+// NewKeysServiceMock returns a mock for mm_handlers.KeysService
+func NewKeysServiceMock(t minimock.Tester) *KeysServiceMock {
+	m := &KeysServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateKeyMock = mKeysServiceMockCreateKey{mock: m}
+	m.CreateKeyMock.callArgs = []*KeysServiceMockCreateKeyParams{}
+
+	m.DeleteKeyMock = mKeysServiceMockDeleteKey{mock: m}
+	m.DeleteKeyMock.callArgs = []*KeysServiceMockDeleteKeyParams{}
+
+	m.ListKeysMock = mKeysServiceMockListKeys{mock: m}
+	m.ListKeysMock.callArgs = []*KeysServiceMockListKeysParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mKeysServiceMockCreateKey struct {
+	optional           bool
+	mock               *KeysServiceMock
+	defaultExpectation *KeysServiceMockCreateKeyExpectation
+	expectations       []*KeysServiceMockCreateKeyExpectation
+
+	callArgs []*KeysServiceMockCreateKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// KeysServiceMockCreateKeyExpectation specifies expectation struct of the KeysService.CreateKey
+type KeysServiceMockCreateKeyExpectation struct {
+	mock               *KeysServiceMock
+	params             *KeysServiceMockCreateKeyParams
+	paramPtrs          *KeysServiceMockCreateKeyParamPtrs
+	expectationOrigins KeysServiceMockCreateKeyExpectationOrigins
+	results            *KeysServiceMockCreateKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// KeysServiceMockCreateKeyParams contains parameters of the KeysService.CreateKey
+type KeysServiceMockCreateKeyParams struct {
+	ctx      context.Context
+	id       string
+	tenantID string
+	scopes   []string
+}
+
+// KeysServiceMockCreateKeyParamPtrs contains pointers to parameters of the KeysService.CreateKey
+type KeysServiceMockCreateKeyParamPtrs struct {
+	ctx      *context.Context
+	id       *string
+	tenantID *string
+	scopes   *[]string
+}
+
+// KeysServiceMockCreateKeyResults contains results of the KeysService.CreateKey
+type KeysServiceMockCreateKeyResults struct {
+	kp1 *auth.Key
+	s1  string
+	err error
+}
+
+// KeysServiceMockCreateKeyOrigins contains origins of expectations of the KeysService.CreateKey
+type KeysServiceMockCreateKeyExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originId       string
+	originTenantID string
+	originScopes   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateKey *mKeysServiceMockCreateKey) Optional() *mKeysServiceMockCreateKey {
+	mmCreateKey.optional = true
+	return mmCreateKey
+}
+
+// Expect sets up expected params for KeysService.CreateKey
+func (mmCreateKey *mKeysServiceMockCreateKey) Expect(ctx context.Context, id string, tenantID string, scopes []string) *mKeysServiceMockCreateKey {
+	if mmCreateKey.mock.funcCreateKey != nil {
+		mmCreateKey.mock.t.Fatalf("KeysServiceMock.CreateKey mock is already set by Set")
+	}
+
+	if mmCreateKey.defaultExpectation == nil {
+		mmCreateKey.defaultExpectation = &KeysServiceMockCreateKeyExpectation{}
+	}
+
+	if mmCreateKey.defaultExpectation.paramPtrs != nil {
+		mmCreateKey.mock.t.Fatalf("KeysServiceMock.CreateKey mock is already set by ExpectParams functions")
+	}
+
+	mmCreateKey.defaultExpectation.params = &KeysServiceMockCreateKeyParams{ctx, id, tenantID, scopes}
+	mmCreateKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateKey.expectations {
+		if minimock.Equal(e.params, mmCreateKey.defaultExpectation.params) {
+			mmCreateKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateKey.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for KeysService.CreateKey
+func (mmCreateKey *mKeysServiceMockCreateKey) ExpectCtxParam1(ctx context.Context) *mKeysServiceMockCreateKey {
+	if mmCreateKey.mock.funcCreateKey != nil {
+		mmCreateKey.mock.t.Fatalf("KeysServiceMock.CreateKey mock is already set by Set")
+	}
+
+	if mmCreateKey.defaultExpectation == nil {
+		mmCreateKey.defaultExpectation = &KeysServiceMockCreateKeyExpectation{}
+	}
+
+	if mmCreateKey.defaultExpectation.params != nil {
+		mmCreateKey.mock.t.Fatalf("KeysServiceMock.CreateKey mock is already set by Expect")
+	}
+
+	if mmCreateKey.defaultExpectation.paramPtrs == nil {
+		mmCreateKey.defaultExpectation.paramPtrs = &KeysServiceMockCreateKeyParamPtrs{}
+	}
+	mmCreateKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateKey
+}
+
+// ExpectIdParam2 sets up expected param id for KeysService.CreateKey
+func (mmCreateKey *mKeysServiceMockCreateKey) ExpectIdParam2(id string) *mKeysServiceMockCreateKey {
+	if mmCreateKey.mock.funcCreateKey != nil {
+		mmCreateKey.mock.t.Fatalf("KeysServiceMock.CreateKey mock is already set by Set")
+	}
+
+	if mmCreateKey.defaultExpectation == nil {
+		mmCreateKey.defaultExpectation = &KeysServiceMockCreateKeyExpectation{}
+	}
+
+	if mmCreateKey.defaultExpectation.params != nil {
+		mmCreateKey.mock.t.Fatalf("KeysServiceMock.CreateKey mock is already set by Expect")
+	}
+
+	if mmCreateKey.defaultExpectation.paramPtrs == nil {
+		mmCreateKey.defaultExpectation.paramPtrs = &KeysServiceMockCreateKeyParamPtrs{}
+	}
+	mmCreateKey.defaultExpectation.paramPtrs.id = &id
+	mmCreateKey.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmCreateKey
+}
+
+// ExpectTenantIDParam3 sets up expected param tenantID for KeysService.CreateKey
+func (mmCreateKey *mKeysServiceMockCreateKey) ExpectTenantIDParam3(tenantID string) *mKeysServiceMockCreateKey {
+	if mmCreateKey.mock.funcCreateKey != nil {
+		mmCreateKey.mock.t.Fatalf("KeysServiceMock.CreateKey mock is already set by Set")
+	}
+
+	if mmCreateKey.defaultExpectation == nil {
+		mmCreateKey.defaultExpectation = &KeysServiceMockCreateKeyExpectation{}
+	}
+
+	if mmCreateKey.defaultExpectation.params != nil {
+		mmCreateKey.mock.t.Fatalf("KeysServiceMock.CreateKey mock is already set by Expect")
+	}
+
+	if mmCreateKey.defaultExpectation.paramPtrs == nil {
+		mmCreateKey.defaultExpectation.paramPtrs = &KeysServiceMockCreateKeyParamPtrs{}
+	}
+	mmCreateKey.defaultExpectation.paramPtrs.tenantID = &tenantID
+	mmCreateKey.defaultExpectation.expectationOrigins.originTenantID = minimock.CallerInfo(1)
+
+	return mmCreateKey
+}
+
+// ExpectScopesParam4 sets up expected param scopes for KeysService.CreateKey
+func (mmCreateKey *mKeysServiceMockCreateKey) ExpectScopesParam4(scopes []string) *mKeysServiceMockCreateKey {
+	if mmCreateKey.mock.funcCreateKey != nil {
+		mmCreateKey.mock.t.Fatalf("KeysServiceMock.CreateKey mock is already set by Set")
+	}
+
+	if mmCreateKey.defaultExpectation == nil {
+		mmCreateKey.defaultExpectation = &KeysServiceMockCreateKeyExpectation{}
+	}
+
+	if mmCreateKey.defaultExpectation.params != nil {
+		mmCreateKey.mock.t.Fatalf("KeysServiceMock.CreateKey mock is already set by Expect")
+	}
+
+	if mmCreateKey.defaultExpectation.paramPtrs == nil {
+		mmCreateKey.defaultExpectation.paramPtrs = &KeysServiceMockCreateKeyParamPtrs{}
+	}
+	mmCreateKey.defaultExpectation.paramPtrs.scopes = &scopes
+	mmCreateKey.defaultExpectation.expectationOrigins.originScopes = minimock.CallerInfo(1)
+
+	return mmCreateKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the KeysService.CreateKey
+func (mmCreateKey *mKeysServiceMockCreateKey) Inspect(f func(ctx context.Context, id string, tenantID string, scopes []string)) *mKeysServiceMockCreateKey {
+	if mmCreateKey.mock.inspectFuncCreateKey != nil {
+		mmCreateKey.mock.t.Fatalf("Inspect function is already set for KeysServiceMock.CreateKey")
+	}
+
+	mmCreateKey.mock.inspectFuncCreateKey = f
+
+	return mmCreateKey
+}
+
+// Return sets up results that will be returned by KeysService.CreateKey
+func (mmCreateKey *mKeysServiceMockCreateKey) Return(kp1 *auth.Key, s1 string, err error) *KeysServiceMock {
+	if mmCreateKey.mock.funcCreateKey != nil {
+		mmCreateKey.mock.t.Fatalf("KeysServiceMock.CreateKey mock is already set by Set")
+	}
+
+	if mmCreateKey.defaultExpectation == nil {
+		mmCreateKey.defaultExpectation = &KeysServiceMockCreateKeyExpectation{mock: mmCreateKey.mock}
+	}
+	mmCreateKey.defaultExpectation.results = &KeysServiceMockCreateKeyResults{kp1, s1, err}
+	mmCreateKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateKey.mock
+}
+
+// Set uses given function f to mock the KeysService.CreateKey method
+func (mmCreateKey *mKeysServiceMockCreateKey) Set(f func(ctx context.Context, id string, tenantID string, scopes []string) (kp1 *auth.Key, s1 string, err error)) *KeysServiceMock {
+	if mmCreateKey.defaultExpectation != nil {
+		mmCreateKey.mock.t.Fatalf("Default expectation is already set for the KeysService.CreateKey method")
+	}
+
+	if len(mmCreateKey.expectations) > 0 {
+		mmCreateKey.mock.t.Fatalf("Some expectations are already set for the KeysService.CreateKey method")
+	}
+
+	mmCreateKey.mock.funcCreateKey = f
+	mmCreateKey.mock.funcCreateKeyOrigin = minimock.CallerInfo(1)
+	return mmCreateKey.mock
+}
+
+// When sets expectation for the KeysService.CreateKey which will trigger the result defined by the following
+// Then helper
+func (mmCreateKey *mKeysServiceMockCreateKey) When(ctx context.Context, id string, tenantID string, scopes []string) *KeysServiceMockCreateKeyExpectation {
+	if mmCreateKey.mock.funcCreateKey != nil {
+		mmCreateKey.mock.t.Fatalf("KeysServiceMock.CreateKey mock is already set by Set")
+	}
+
+	expectation := &KeysServiceMockCreateKeyExpectation{
+		mock:               mmCreateKey.mock,
+		params:             &KeysServiceMockCreateKeyParams{ctx, id, tenantID, scopes},
+		expectationOrigins: KeysServiceMockCreateKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateKey.expectations = append(mmCreateKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up KeysService.CreateKey return parameters for the expectation previously defined by the When method
+func (e *KeysServiceMockCreateKeyExpectation) Then(kp1 *auth.Key, s1 string, err error) *KeysServiceMock {
+	e.results = &KeysServiceMockCreateKeyResults{kp1, s1, err}
+	return e.mock
+}
+
+// Times sets number of times KeysService.CreateKey should be invoked
+func (mmCreateKey *mKeysServiceMockCreateKey) Times(n uint64) *mKeysServiceMockCreateKey {
+	if n == 0 {
+		mmCreateKey.mock.t.Fatalf("Times of KeysServiceMock.CreateKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateKey.expectedInvocations, n)
+	mmCreateKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateKey
+}
+
+func (mmCreateKey *mKeysServiceMockCreateKey) invocationsDone() bool {
+	if len(mmCreateKey.expectations) == 0 && mmCreateKey.defaultExpectation == nil && mmCreateKey.mock.funcCreateKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateKey.mock.afterCreateKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateKey implements mm_handlers.KeysService
+func (mmCreateKey *KeysServiceMock) CreateKey(ctx context.Context, id string, tenantID string, scopes []string) (kp1 *auth.Key, s1 string, err error) {
+	mm_atomic.AddUint64(&mmCreateKey.beforeCreateKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateKey.afterCreateKeyCounter, 1)
+
+	mmCreateKey.t.Helper()
+
+	if mmCreateKey.inspectFuncCreateKey != nil {
+		mmCreateKey.inspectFuncCreateKey(ctx, id, tenantID, scopes)
+	}
+
+	mm_params := KeysServiceMockCreateKeyParams{ctx, id, tenantID, scopes}
+
+	// Record call args
+	mmCreateKey.CreateKeyMock.mutex.Lock()
+	mmCreateKey.CreateKeyMock.callArgs = append(mmCreateKey.CreateKeyMock.callArgs, &mm_params)
+	mmCreateKey.CreateKeyMock.mutex.Unlock()
+
+	for _, e := range mmCreateKey.CreateKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.kp1, e.results.s1, e.results.err
+		}
+	}
+
+	if mmCreateKey.CreateKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateKey.CreateKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateKey.CreateKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateKey.CreateKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := KeysServiceMockCreateKeyParams{ctx, id, tenantID, scopes}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateKey.t.Errorf("KeysServiceMock.CreateKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateKey.CreateKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmCreateKey.t.Errorf("KeysServiceMock.CreateKey got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateKey.CreateKeyMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.tenantID != nil && !minimock.Equal(*mm_want_ptrs.tenantID, mm_got.tenantID) {
+				mmCreateKey.t.Errorf("KeysServiceMock.CreateKey got unexpected parameter tenantID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateKey.CreateKeyMock.defaultExpectation.expectationOrigins.originTenantID, *mm_want_ptrs.tenantID, mm_got.tenantID, minimock.Diff(*mm_want_ptrs.tenantID, mm_got.tenantID))
+			}
+
+			if mm_want_ptrs.scopes != nil && !minimock.Equal(*mm_want_ptrs.scopes, mm_got.scopes) {
+				mmCreateKey.t.Errorf("KeysServiceMock.CreateKey got unexpected parameter scopes, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateKey.CreateKeyMock.defaultExpectation.expectationOrigins.originScopes, *mm_want_ptrs.scopes, mm_got.scopes, minimock.Diff(*mm_want_ptrs.scopes, mm_got.scopes))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateKey.t.Errorf("KeysServiceMock.CreateKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateKey.CreateKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateKey.CreateKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateKey.t.Fatal("No results are set for the KeysServiceMock.CreateKey")
+		}
+		return (*mm_results).kp1, (*mm_results).s1, (*mm_results).err
+	}
+	if mmCreateKey.funcCreateKey != nil {
+		return mmCreateKey.funcCreateKey(ctx, id, tenantID, scopes)
+	}
+	mmCreateKey.t.Fatalf("Unexpected call to KeysServiceMock.CreateKey. %v %v %v %v", ctx, id, tenantID, scopes)
+	return
+}
+
+// CreateKeyAfterCounter returns a count of finished KeysServiceMock.CreateKey invocations
+func (mmCreateKey *KeysServiceMock) CreateKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateKey.afterCreateKeyCounter)
+}
+
+// CreateKeyBeforeCounter returns a count of KeysServiceMock.CreateKey invocations
+func (mmCreateKey *KeysServiceMock) CreateKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateKey.beforeCreateKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to KeysServiceMock.CreateKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateKey *mKeysServiceMockCreateKey) Calls() []*KeysServiceMockCreateKeyParams {
+	mmCreateKey.mutex.RLock()
+
+	argCopy := make([]*KeysServiceMockCreateKeyParams, len(mmCreateKey.callArgs))
+	copy(argCopy, mmCreateKey.callArgs)
+
+	mmCreateKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateKeyDone returns true if the count of the CreateKey invocations corresponds
+// the number of defined expectations
+func (m *KeysServiceMock) MinimockCreateKeyDone() bool {
+	if m.CreateKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateKeyMock.invocationsDone()
+}
+
+// MinimockCreateKeyInspect logs each unmet expectation
+func (m *KeysServiceMock) MinimockCreateKeyInspect() {
+	for _, e := range m.CreateKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to KeysServiceMock.CreateKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateKeyCounter := mm_atomic.LoadUint64(&m.afterCreateKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateKeyMock.defaultExpectation != nil && afterCreateKeyCounter < 1 {
+		if m.CreateKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to KeysServiceMock.CreateKey at\n%s", m.CreateKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to KeysServiceMock.CreateKey at\n%s with params: %#v", m.CreateKeyMock.defaultExpectation.expectationOrigins.origin, *m.CreateKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateKey != nil && afterCreateKeyCounter < 1 {
+		m.t.Errorf("Expected call to KeysServiceMock.CreateKey at\n%s", m.funcCreateKeyOrigin)
+	}
+
+	if !m.CreateKeyMock.invocationsDone() && afterCreateKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to KeysServiceMock.CreateKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateKeyMock.expectedInvocations), m.CreateKeyMock.expectedInvocationsOrigin, afterCreateKeyCounter)
+	}
+}
+
+type mKeysServiceMockDeleteKey struct {
+	optional           bool
+	mock               *KeysServiceMock
+	defaultExpectation *KeysServiceMockDeleteKeyExpectation
+	expectations       []*KeysServiceMockDeleteKeyExpectation
+
+	callArgs []*KeysServiceMockDeleteKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// KeysServiceMockDeleteKeyExpectation specifies expectation struct of the KeysService.DeleteKey
+type KeysServiceMockDeleteKeyExpectation struct {
+	mock               *KeysServiceMock
+	params             *KeysServiceMockDeleteKeyParams
+	paramPtrs          *KeysServiceMockDeleteKeyParamPtrs
+	expectationOrigins KeysServiceMockDeleteKeyExpectationOrigins
+	results            *KeysServiceMockDeleteKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// KeysServiceMockDeleteKeyParams contains parameters of the KeysService.DeleteKey
+type KeysServiceMockDeleteKeyParams struct {
+	ctx context.Context
+	id  string
+}
+
+// KeysServiceMockDeleteKeyParamPtrs contains pointers to parameters of the KeysService.DeleteKey
+type KeysServiceMockDeleteKeyParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// KeysServiceMockDeleteKeyResults contains results of the KeysService.DeleteKey
+type KeysServiceMockDeleteKeyResults struct {
+	err error
+}
+
+// KeysServiceMockDeleteKeyOrigins contains origins of expectations of the KeysService.DeleteKey
+type KeysServiceMockDeleteKeyExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteKey *mKeysServiceMockDeleteKey) Optional() *mKeysServiceMockDeleteKey {
+	mmDeleteKey.optional = true
+	return mmDeleteKey
+}
+
+// Expect sets up expected params for KeysService.DeleteKey
+func (mmDeleteKey *mKeysServiceMockDeleteKey) Expect(ctx context.Context, id string) *mKeysServiceMockDeleteKey {
+	if mmDeleteKey.mock.funcDeleteKey != nil {
+		mmDeleteKey.mock.t.Fatalf("KeysServiceMock.DeleteKey mock is already set by Set")
+	}
+
+	if mmDeleteKey.defaultExpectation == nil {
+		mmDeleteKey.defaultExpectation = &KeysServiceMockDeleteKeyExpectation{}
+	}
+
+	if mmDeleteKey.defaultExpectation.paramPtrs != nil {
+		mmDeleteKey.mock.t.Fatalf("KeysServiceMock.DeleteKey mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteKey.defaultExpectation.params = &KeysServiceMockDeleteKeyParams{ctx, id}
+	mmDeleteKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteKey.expectations {
+		if minimock.Equal(e.params, mmDeleteKey.defaultExpectation.params) {
+			mmDeleteKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteKey.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for KeysService.DeleteKey
+func (mmDeleteKey *mKeysServiceMockDeleteKey) ExpectCtxParam1(ctx context.Context) *mKeysServiceMockDeleteKey {
+	if mmDeleteKey.mock.funcDeleteKey != nil {
+		mmDeleteKey.mock.t.Fatalf("KeysServiceMock.DeleteKey mock is already set by Set")
+	}
+
+	if mmDeleteKey.defaultExpectation == nil {
+		mmDeleteKey.defaultExpectation = &KeysServiceMockDeleteKeyExpectation{}
+	}
+
+	if mmDeleteKey.defaultExpectation.params != nil {
+		mmDeleteKey.mock.t.Fatalf("KeysServiceMock.DeleteKey mock is already set by Expect")
+	}
+
+	if mmDeleteKey.defaultExpectation.paramPtrs == nil {
+		mmDeleteKey.defaultExpectation.paramPtrs = &KeysServiceMockDeleteKeyParamPtrs{}
+	}
+	mmDeleteKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteKey
+}
+
+// ExpectIdParam2 sets up expected param id for KeysService.DeleteKey
+func (mmDeleteKey *mKeysServiceMockDeleteKey) ExpectIdParam2(id string) *mKeysServiceMockDeleteKey {
+	if mmDeleteKey.mock.funcDeleteKey != nil {
+		mmDeleteKey.mock.t.Fatalf("KeysServiceMock.DeleteKey mock is already set by Set")
+	}
+
+	if mmDeleteKey.defaultExpectation == nil {
+		mmDeleteKey.defaultExpectation = &KeysServiceMockDeleteKeyExpectation{}
+	}
+
+	if mmDeleteKey.defaultExpectation.params != nil {
+		mmDeleteKey.mock.t.Fatalf("KeysServiceMock.DeleteKey mock is already set by Expect")
+	}
+
+	if mmDeleteKey.defaultExpectation.paramPtrs == nil {
+		mmDeleteKey.defaultExpectation.paramPtrs = &KeysServiceMockDeleteKeyParamPtrs{}
+	}
+	mmDeleteKey.defaultExpectation.paramPtrs.id = &id
+	mmDeleteKey.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the KeysService.DeleteKey
+func (mmDeleteKey *mKeysServiceMockDeleteKey) Inspect(f func(ctx context.Context, id string)) *mKeysServiceMockDeleteKey {
+	if mmDeleteKey.mock.inspectFuncDeleteKey != nil {
+		mmDeleteKey.mock.t.Fatalf("Inspect function is already set for KeysServiceMock.DeleteKey")
+	}
+
+	mmDeleteKey.mock.inspectFuncDeleteKey = f
+
+	return mmDeleteKey
+}
+
+// Return sets up results that will be returned by KeysService.DeleteKey
+func (mmDeleteKey *mKeysServiceMockDeleteKey) Return(err error) *KeysServiceMock {
+	if mmDeleteKey.mock.funcDeleteKey != nil {
+		mmDeleteKey.mock.t.Fatalf("KeysServiceMock.DeleteKey mock is already set by Set")
+	}
+
+	if mmDeleteKey.defaultExpectation == nil {
+		mmDeleteKey.defaultExpectation = &KeysServiceMockDeleteKeyExpectation{mock: mmDeleteKey.mock}
+	}
+	mmDeleteKey.defaultExpectation.results = &KeysServiceMockDeleteKeyResults{err}
+	mmDeleteKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteKey.mock
+}
+
+// Set uses given function f to mock the KeysService.DeleteKey method
+func (mmDeleteKey *mKeysServiceMockDeleteKey) Set(f func(ctx context.Context, id string) (err error)) *KeysServiceMock {
+	if mmDeleteKey.defaultExpectation != nil {
+		mmDeleteKey.mock.t.Fatalf("Default expectation is already set for the KeysService.DeleteKey method")
+	}
+
+	if len(mmDeleteKey.expectations) > 0 {
+		mmDeleteKey.mock.t.Fatalf("Some expectations are already set for the KeysService.DeleteKey method")
+	}
+
+	mmDeleteKey.mock.funcDeleteKey = f
+	mmDeleteKey.mock.funcDeleteKeyOrigin = minimock.CallerInfo(1)
+	return mmDeleteKey.mock
+}
+
+// When sets expectation for the KeysService.DeleteKey which will trigger the result defined by the following
+// Then helper
+func (mmDeleteKey *mKeysServiceMockDeleteKey) When(ctx context.Context, id string) *KeysServiceMockDeleteKeyExpectation {
+	if mmDeleteKey.mock.funcDeleteKey != nil {
+		mmDeleteKey.mock.t.Fatalf("KeysServiceMock.DeleteKey mock is already set by Set")
+	}
+
+	expectation := &KeysServiceMockDeleteKeyExpectation{
+		mock:               mmDeleteKey.mock,
+		params:             &KeysServiceMockDeleteKeyParams{ctx, id},
+		expectationOrigins: KeysServiceMockDeleteKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteKey.expectations = append(mmDeleteKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up KeysService.DeleteKey return parameters for the expectation previously defined by the When method
+func (e *KeysServiceMockDeleteKeyExpectation) Then(err error) *KeysServiceMock {
+	e.results = &KeysServiceMockDeleteKeyResults{err}
+	return e.mock
+}
+
+// Times sets number of times KeysService.DeleteKey should be invoked
+func (mmDeleteKey *mKeysServiceMockDeleteKey) Times(n uint64) *mKeysServiceMockDeleteKey {
+	if n == 0 {
+		mmDeleteKey.mock.t.Fatalf("Times of KeysServiceMock.DeleteKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteKey.expectedInvocations, n)
+	mmDeleteKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteKey
+}
+
+func (mmDeleteKey *mKeysServiceMockDeleteKey) invocationsDone() bool {
+	if len(mmDeleteKey.expectations) == 0 && mmDeleteKey.defaultExpectation == nil && mmDeleteKey.mock.funcDeleteKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteKey.mock.afterDeleteKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteKey implements mm_handlers.KeysService
+func (mmDeleteKey *KeysServiceMock) DeleteKey(ctx context.Context, id string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteKey.beforeDeleteKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteKey.afterDeleteKeyCounter, 1)
+
+	mmDeleteKey.t.Helper()
+
+	if mmDeleteKey.inspectFuncDeleteKey != nil {
+		mmDeleteKey.inspectFuncDeleteKey(ctx, id)
+	}
+
+	mm_params := KeysServiceMockDeleteKeyParams{ctx, id}
+
+	// Record call args
+	mmDeleteKey.DeleteKeyMock.mutex.Lock()
+	mmDeleteKey.DeleteKeyMock.callArgs = append(mmDeleteKey.DeleteKeyMock.callArgs, &mm_params)
+	mmDeleteKey.DeleteKeyMock.mutex.Unlock()
+
+	for _, e := range mmDeleteKey.DeleteKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteKey.DeleteKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteKey.DeleteKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteKey.DeleteKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteKey.DeleteKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := KeysServiceMockDeleteKeyParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteKey.t.Errorf("KeysServiceMock.DeleteKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteKey.DeleteKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteKey.t.Errorf("KeysServiceMock.DeleteKey got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteKey.DeleteKeyMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteKey.t.Errorf("KeysServiceMock.DeleteKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteKey.DeleteKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteKey.DeleteKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteKey.t.Fatal("No results are set for the KeysServiceMock.DeleteKey")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteKey.funcDeleteKey != nil {
+		return mmDeleteKey.funcDeleteKey(ctx, id)
+	}
+	mmDeleteKey.t.Fatalf("Unexpected call to KeysServiceMock.DeleteKey. %v %v", ctx, id)
+	return
+}
+
+// DeleteKeyAfterCounter returns a count of finished KeysServiceMock.DeleteKey invocations
+func (mmDeleteKey *KeysServiceMock) DeleteKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteKey.afterDeleteKeyCounter)
+}
+
+// DeleteKeyBeforeCounter returns a count of KeysServiceMock.DeleteKey invocations
+func (mmDeleteKey *KeysServiceMock) DeleteKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteKey.beforeDeleteKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to KeysServiceMock.DeleteKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteKey *mKeysServiceMockDeleteKey) Calls() []*KeysServiceMockDeleteKeyParams {
+	mmDeleteKey.mutex.RLock()
+
+	argCopy := make([]*KeysServiceMockDeleteKeyParams, len(mmDeleteKey.callArgs))
+	copy(argCopy, mmDeleteKey.callArgs)
+
+	mmDeleteKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteKeyDone returns true if the count of the DeleteKey invocations corresponds
+// the number of defined expectations
+func (m *KeysServiceMock) MinimockDeleteKeyDone() bool {
+	if m.DeleteKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteKeyMock.invocationsDone()
+}
+
+// MinimockDeleteKeyInspect logs each unmet expectation
+func (m *KeysServiceMock) MinimockDeleteKeyInspect() {
+	for _, e := range m.DeleteKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to KeysServiceMock.DeleteKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteKeyCounter := mm_atomic.LoadUint64(&m.afterDeleteKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteKeyMock.defaultExpectation != nil && afterDeleteKeyCounter < 1 {
+		if m.DeleteKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to KeysServiceMock.DeleteKey at\n%s", m.DeleteKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to KeysServiceMock.DeleteKey at\n%s with params: %#v", m.DeleteKeyMock.defaultExpectation.expectationOrigins.origin, *m.DeleteKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteKey != nil && afterDeleteKeyCounter < 1 {
+		m.t.Errorf("Expected call to KeysServiceMock.DeleteKey at\n%s", m.funcDeleteKeyOrigin)
+	}
+
+	if !m.DeleteKeyMock.invocationsDone() && afterDeleteKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to KeysServiceMock.DeleteKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteKeyMock.expectedInvocations), m.DeleteKeyMock.expectedInvocationsOrigin, afterDeleteKeyCounter)
+	}
+}
+
+type mKeysServiceMockListKeys struct {
+	optional           bool
+	mock               *KeysServiceMock
+	defaultExpectation *KeysServiceMockListKeysExpectation
+	expectations       []*KeysServiceMockListKeysExpectation
+
+	callArgs []*KeysServiceMockListKeysParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// KeysServiceMockListKeysExpectation specifies expectation struct of the KeysService.ListKeys
+type KeysServiceMockListKeysExpectation struct {
+	mock               *KeysServiceMock
+	params             *KeysServiceMockListKeysParams
+	paramPtrs          *KeysServiceMockListKeysParamPtrs
+	expectationOrigins KeysServiceMockListKeysExpectationOrigins
+	results            *KeysServiceMockListKeysResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// KeysServiceMockListKeysParams contains parameters of the KeysService.ListKeys
+type KeysServiceMockListKeysParams struct {
+	ctx context.Context
+}
+
+// KeysServiceMockListKeysParamPtrs contains pointers to parameters of the KeysService.ListKeys
+type KeysServiceMockListKeysParamPtrs struct {
+	ctx *context.Context
+}
+
+// KeysServiceMockListKeysResults contains results of the KeysService.ListKeys
+type KeysServiceMockListKeysResults struct {
+	ka1 []auth.Key
+}
+
+// KeysServiceMockListKeysOrigins contains origins of expectations of the KeysService.ListKeys
+type KeysServiceMockListKeysExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListKeys *mKeysServiceMockListKeys) Optional() *mKeysServiceMockListKeys {
+	mmListKeys.optional = true
+	return mmListKeys
+}
+
+// Expect sets up expected params for KeysService.ListKeys
+func (mmListKeys *mKeysServiceMockListKeys) Expect(ctx context.Context) *mKeysServiceMockListKeys {
+	if mmListKeys.mock.funcListKeys != nil {
+		mmListKeys.mock.t.Fatalf("KeysServiceMock.ListKeys mock is already set by Set")
+	}
+
+	if mmListKeys.defaultExpectation == nil {
+		mmListKeys.defaultExpectation = &KeysServiceMockListKeysExpectation{}
+	}
+
+	if mmListKeys.defaultExpectation.paramPtrs != nil {
+		mmListKeys.mock.t.Fatalf("KeysServiceMock.ListKeys mock is already set by ExpectParams functions")
+	}
+
+	mmListKeys.defaultExpectation.params = &KeysServiceMockListKeysParams{ctx}
+	mmListKeys.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListKeys.expectations {
+		if minimock.Equal(e.params, mmListKeys.defaultExpectation.params) {
+			mmListKeys.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListKeys.defaultExpectation.params)
+		}
+	}
+
+	return mmListKeys
+}
+
+// ExpectCtxParam1 sets up expected param ctx for KeysService.ListKeys
+func (mmListKeys *mKeysServiceMockListKeys) ExpectCtxParam1(ctx context.Context) *mKeysServiceMockListKeys {
+	if mmListKeys.mock.funcListKeys != nil {
+		mmListKeys.mock.t.Fatalf("KeysServiceMock.ListKeys mock is already set by Set")
+	}
+
+	if mmListKeys.defaultExpectation == nil {
+		mmListKeys.defaultExpectation = &KeysServiceMockListKeysExpectation{}
+	}
+
+	if mmListKeys.defaultExpectation.params != nil {
+		mmListKeys.mock.t.Fatalf("KeysServiceMock.ListKeys mock is already set by Expect")
+	}
+
+	if mmListKeys.defaultExpectation.paramPtrs == nil {
+		mmListKeys.defaultExpectation.paramPtrs = &KeysServiceMockListKeysParamPtrs{}
+	}
+	mmListKeys.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListKeys.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListKeys
+}
+
+// Inspect accepts an inspector function that has same arguments as the KeysService.ListKeys
+func (mmListKeys *mKeysServiceMockListKeys) Inspect(f func(ctx context.Context)) *mKeysServiceMockListKeys {
+	if mmListKeys.mock.inspectFuncListKeys != nil {
+		mmListKeys.mock.t.Fatalf("Inspect function is already set for KeysServiceMock.ListKeys")
+	}
+
+	mmListKeys.mock.inspectFuncListKeys = f
+
+	return mmListKeys
+}
+
+// Return sets up results that will be returned by KeysService.ListKeys
+func (mmListKeys *mKeysServiceMockListKeys) Return(ka1 []auth.Key) *KeysServiceMock {
+	if mmListKeys.mock.funcListKeys != nil {
+		mmListKeys.mock.t.Fatalf("KeysServiceMock.ListKeys mock is already set by Set")
+	}
+
+	if mmListKeys.defaultExpectation == nil {
+		mmListKeys.defaultExpectation = &KeysServiceMockListKeysExpectation{mock: mmListKeys.mock}
+	}
+	mmListKeys.defaultExpectation.results = &KeysServiceMockListKeysResults{ka1}
+	mmListKeys.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListKeys.mock
+}
+
+// Set uses given function f to mock the KeysService.ListKeys method
+func (mmListKeys *mKeysServiceMockListKeys) Set(f func(ctx context.Context) (ka1 []auth.Key)) *KeysServiceMock {
+	if mmListKeys.defaultExpectation != nil {
+		mmListKeys.mock.t.Fatalf("Default expectation is already set for the KeysService.ListKeys method")
+	}
+
+	if len(mmListKeys.expectations) > 0 {
+		mmListKeys.mock.t.Fatalf("Some expectations are already set for the KeysService.ListKeys method")
+	}
+
+	mmListKeys.mock.funcListKeys = f
+	mmListKeys.mock.funcListKeysOrigin = minimock.CallerInfo(1)
+	return mmListKeys.mock
+}
+
+// When sets expectation for the KeysService.ListKeys which will trigger the result defined by the following
+// Then helper
+func (mmListKeys *mKeysServiceMockListKeys) When(ctx context.Context) *KeysServiceMockListKeysExpectation {
+	if mmListKeys.mock.funcListKeys != nil {
+		mmListKeys.mock.t.Fatalf("KeysServiceMock.ListKeys mock is already set by Set")
+	}
+
+	expectation := &KeysServiceMockListKeysExpectation{
+		mock:               mmListKeys.mock,
+		params:             &KeysServiceMockListKeysParams{ctx},
+		expectationOrigins: KeysServiceMockListKeysExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListKeys.expectations = append(mmListKeys.expectations, expectation)
+	return expectation
+}
+
+// Then sets up KeysService.ListKeys return parameters for the expectation previously defined by the When method
+func (e *KeysServiceMockListKeysExpectation) Then(ka1 []auth.Key) *KeysServiceMock {
+	e.results = &KeysServiceMockListKeysResults{ka1}
+	return e.mock
+}
+
+// Times sets number of times KeysService.ListKeys should be invoked
+func (mmListKeys *mKeysServiceMockListKeys) Times(n uint64) *mKeysServiceMockListKeys {
+	if n == 0 {
+		mmListKeys.mock.t.Fatalf("Times of KeysServiceMock.ListKeys mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListKeys.expectedInvocations, n)
+	mmListKeys.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListKeys
+}
+
+func (mmListKeys *mKeysServiceMockListKeys) invocationsDone() bool {
+	if len(mmListKeys.expectations) == 0 && mmListKeys.defaultExpectation == nil && mmListKeys.mock.funcListKeys == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListKeys.mock.afterListKeysCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListKeys.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListKeys implements mm_handlers.KeysService
+func (mmListKeys *KeysServiceMock) ListKeys(ctx context.Context) (ka1 []auth.Key) {
+	mm_atomic.AddUint64(&mmListKeys.beforeListKeysCounter, 1)
+	defer mm_atomic.AddUint64(&mmListKeys.afterListKeysCounter, 1)
+
+	mmListKeys.t.Helper()
+
+	if mmListKeys.inspectFuncListKeys != nil {
+		mmListKeys.inspectFuncListKeys(ctx)
+	}
+
+	mm_params := KeysServiceMockListKeysParams{ctx}
+
+	// Record call args
+	mmListKeys.ListKeysMock.mutex.Lock()
+	mmListKeys.ListKeysMock.callArgs = append(mmListKeys.ListKeysMock.callArgs, &mm_params)
+	mmListKeys.ListKeysMock.mutex.Unlock()
+
+	for _, e := range mmListKeys.ListKeysMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ka1
+		}
+	}
+
+	if mmListKeys.ListKeysMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListKeys.ListKeysMock.defaultExpectation.Counter, 1)
+		mm_want := mmListKeys.ListKeysMock.defaultExpectation.params
+		mm_want_ptrs := mmListKeys.ListKeysMock.defaultExpectation.paramPtrs
+
+		mm_got := KeysServiceMockListKeysParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListKeys.t.Errorf("KeysServiceMock.ListKeys got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListKeys.ListKeysMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListKeys.t.Errorf("KeysServiceMock.ListKeys got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListKeys.ListKeysMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListKeys.ListKeysMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListKeys.t.Fatal("No results are set for the KeysServiceMock.ListKeys")
+		}
+		return (*mm_results).ka1
+	}
+	if mmListKeys.funcListKeys != nil {
+		return mmListKeys.funcListKeys(ctx)
+	}
+	mmListKeys.t.Fatalf("Unexpected call to KeysServiceMock.ListKeys. %v", ctx)
+	return
+}
+
+// ListKeysAfterCounter returns a count of finished KeysServiceMock.ListKeys invocations
+func (mmListKeys *KeysServiceMock) ListKeysAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListKeys.afterListKeysCounter)
+}
+
+// ListKeysBeforeCounter returns a count of KeysServiceMock.ListKeys invocations
+func (mmListKeys *KeysServiceMock) ListKeysBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListKeys.beforeListKeysCounter)
+}
+
+// Calls returns a list of arguments used in each call to KeysServiceMock.ListKeys.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListKeys *mKeysServiceMockListKeys) Calls() []*KeysServiceMockListKeysParams {
+	mmListKeys.mutex.RLock()
+
+	argCopy := make([]*KeysServiceMockListKeysParams, len(mmListKeys.callArgs))
+	copy(argCopy, mmListKeys.callArgs)
+
+	mmListKeys.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListKeysDone returns true if the count of the ListKeys invocations corresponds
+// the number of defined expectations
+func (m *KeysServiceMock) MinimockListKeysDone() bool {
+	if m.ListKeysMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListKeysMock.invocationsDone()
+}
+
+// MinimockListKeysInspect logs each unmet expectation
+func (m *KeysServiceMock) MinimockListKeysInspect() {
+	for _, e := range m.ListKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to KeysServiceMock.ListKeys at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListKeysCounter := mm_atomic.LoadUint64(&m.afterListKeysCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListKeysMock.defaultExpectation != nil && afterListKeysCounter < 1 {
+		if m.ListKeysMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to KeysServiceMock.ListKeys at\n%s", m.ListKeysMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to KeysServiceMock.ListKeys at\n%s with params: %#v", m.ListKeysMock.defaultExpectation.expectationOrigins.origin, *m.ListKeysMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListKeys != nil && afterListKeysCounter < 1 {
+		m.t.Errorf("Expected call to KeysServiceMock.ListKeys at\n%s", m.funcListKeysOrigin)
+	}
+
+	if !m.ListKeysMock.invocationsDone() && afterListKeysCounter > 0 {
+		m.t.Errorf("Expected %d calls to KeysServiceMock.ListKeys at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListKeysMock.expectedInvocations), m.ListKeysMock.expectedInvocationsOrigin, afterListKeysCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *KeysServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateKeyInspect()
+
+			m.MinimockDeleteKeyInspect()
+
+			m.MinimockListKeysInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *KeysServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *KeysServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateKeyDone() &&
+		m.MinimockDeleteKeyDone() &&
+		m.MinimockListKeysDone()
+}
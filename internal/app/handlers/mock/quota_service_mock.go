@@ -0,0 +1,386 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mock
+
+//go:generate minimock -i test-server/internal/app/handlers.QuotaService -o quota_service_mock.go -n QuotaServiceMock -p mock
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"test-server/internal/domain/model"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// QuotaServiceMock implements mm_handlers.QuotaService
+type QuotaServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcUsage          func(ctx context.Context) (q1 model.QuotaUsage)
+	funcUsageOrigin    string
+	inspectFuncUsage   func(ctx context.Context)
+	afterUsageCounter  uint64
+	beforeUsageCounter uint64
+	UsageMock          mQuotaServiceMockUsage
+}
+
+// NewQuotaServiceMock returns a mock for mm_handlers.QuotaService
+func NewQuotaServiceMock(t minimock.Tester) *QuotaServiceMock {
+	m := &QuotaServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.UsageMock = mQuotaServiceMockUsage{mock: m}
+	m.UsageMock.callArgs = []*QuotaServiceMockUsageParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mQuotaServiceMockUsage struct {
+	optional           bool
+	mock               *QuotaServiceMock
+	defaultExpectation *QuotaServiceMockUsageExpectation
+	expectations       []*QuotaServiceMockUsageExpectation
+
+	callArgs []*QuotaServiceMockUsageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// QuotaServiceMockUsageExpectation specifies expectation struct of the QuotaService.Usage
+type QuotaServiceMockUsageExpectation struct {
+	mock               *QuotaServiceMock
+	params             *QuotaServiceMockUsageParams
+	paramPtrs          *QuotaServiceMockUsageParamPtrs
+	expectationOrigins QuotaServiceMockUsageExpectationOrigins
+	results            *QuotaServiceMockUsageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// QuotaServiceMockUsageParams contains parameters of the QuotaService.Usage
+type QuotaServiceMockUsageParams struct {
+	ctx context.Context
+}
+
+// QuotaServiceMockUsageParamPtrs contains pointers to parameters of the QuotaService.Usage
+type QuotaServiceMockUsageParamPtrs struct {
+	ctx *context.Context
+}
+
+// QuotaServiceMockUsageResults contains results of the QuotaService.Usage
+type QuotaServiceMockUsageResults struct {
+	q1 model.QuotaUsage
+}
+
+// QuotaServiceMockUsageOrigins contains origins of expectations of the QuotaService.Usage
+type QuotaServiceMockUsageExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUsage *mQuotaServiceMockUsage) Optional() *mQuotaServiceMockUsage {
+	mmUsage.optional = true
+	return mmUsage
+}
+
+// Expect sets up expected params for QuotaService.Usage
+func (mmUsage *mQuotaServiceMockUsage) Expect(ctx context.Context) *mQuotaServiceMockUsage {
+	if mmUsage.mock.funcUsage != nil {
+		mmUsage.mock.t.Fatalf("QuotaServiceMock.Usage mock is already set by Set")
+	}
+
+	if mmUsage.defaultExpectation == nil {
+		mmUsage.defaultExpectation = &QuotaServiceMockUsageExpectation{}
+	}
+
+	if mmUsage.defaultExpectation.paramPtrs != nil {
+		mmUsage.mock.t.Fatalf("QuotaServiceMock.Usage mock is already set by ExpectParams functions")
+	}
+
+	mmUsage.defaultExpectation.params = &QuotaServiceMockUsageParams{ctx}
+	mmUsage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUsage.expectations {
+		if minimock.Equal(e.params, mmUsage.defaultExpectation.params) {
+			mmUsage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUsage.defaultExpectation.params)
+		}
+	}
+
+	return mmUsage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for QuotaService.Usage
+func (mmUsage *mQuotaServiceMockUsage) ExpectCtxParam1(ctx context.Context) *mQuotaServiceMockUsage {
+	if mmUsage.mock.funcUsage != nil {
+		mmUsage.mock.t.Fatalf("QuotaServiceMock.Usage mock is already set by Set")
+	}
+
+	if mmUsage.defaultExpectation == nil {
+		mmUsage.defaultExpectation = &QuotaServiceMockUsageExpectation{}
+	}
+
+	if mmUsage.defaultExpectation.params != nil {
+		mmUsage.mock.t.Fatalf("QuotaServiceMock.Usage mock is already set by Expect")
+	}
+
+	if mmUsage.defaultExpectation.paramPtrs == nil {
+		mmUsage.defaultExpectation.paramPtrs = &QuotaServiceMockUsageParamPtrs{}
+	}
+	mmUsage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUsage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUsage
+}
+
+// Inspect accepts an inspector function that has same arguments as the QuotaService.Usage
+func (mmUsage *mQuotaServiceMockUsage) Inspect(f func(ctx context.Context)) *mQuotaServiceMockUsage {
+	if mmUsage.mock.inspectFuncUsage != nil {
+		mmUsage.mock.t.Fatalf("Inspect function is already set for QuotaServiceMock.Usage")
+	}
+
+	mmUsage.mock.inspectFuncUsage = f
+
+	return mmUsage
+}
+
+// Return sets up results that will be returned by QuotaService.Usage
+func (mmUsage *mQuotaServiceMockUsage) Return(q1 model.QuotaUsage) *QuotaServiceMock {
+	if mmUsage.mock.funcUsage != nil {
+		mmUsage.mock.t.Fatalf("QuotaServiceMock.Usage mock is already set by Set")
+	}
+
+	if mmUsage.defaultExpectation == nil {
+		mmUsage.defaultExpectation = &QuotaServiceMockUsageExpectation{mock: mmUsage.mock}
+	}
+	mmUsage.defaultExpectation.results = &QuotaServiceMockUsageResults{q1}
+	mmUsage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUsage.mock
+}
+
+// Set uses given function f to mock the QuotaService.Usage method
+func (mmUsage *mQuotaServiceMockUsage) Set(f func(ctx context.Context) (q1 model.QuotaUsage)) *QuotaServiceMock {
+	if mmUsage.defaultExpectation != nil {
+		mmUsage.mock.t.Fatalf("Default expectation is already set for the QuotaService.Usage method")
+	}
+
+	if len(mmUsage.expectations) > 0 {
+		mmUsage.mock.t.Fatalf("Some expectations are already set for the QuotaService.Usage method")
+	}
+
+	mmUsage.mock.funcUsage = f
+	mmUsage.mock.funcUsageOrigin = minimock.CallerInfo(1)
+	return mmUsage.mock
+}
+
+// When sets expectation for the QuotaService.Usage which will trigger the result defined by the following
+// Then helper
+func (mmUsage *mQuotaServiceMockUsage) When(ctx context.Context) *QuotaServiceMockUsageExpectation {
+	if mmUsage.mock.funcUsage != nil {
+		mmUsage.mock.t.Fatalf("QuotaServiceMock.Usage mock is already set by Set")
+	}
+
+	expectation := &QuotaServiceMockUsageExpectation{
+		mock:               mmUsage.mock,
+		params:             &QuotaServiceMockUsageParams{ctx},
+		expectationOrigins: QuotaServiceMockUsageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUsage.expectations = append(mmUsage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up QuotaService.Usage return parameters for the expectation previously defined by the When method
+func (e *QuotaServiceMockUsageExpectation) Then(q1 model.QuotaUsage) *QuotaServiceMock {
+	e.results = &QuotaServiceMockUsageResults{q1}
+	return e.mock
+}
+
+// Times sets number of times QuotaService.Usage should be invoked
+func (mmUsage *mQuotaServiceMockUsage) Times(n uint64) *mQuotaServiceMockUsage {
+	if n == 0 {
+		mmUsage.mock.t.Fatalf("Times of QuotaServiceMock.Usage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUsage.expectedInvocations, n)
+	mmUsage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUsage
+}
+
+func (mmUsage *mQuotaServiceMockUsage) invocationsDone() bool {
+	if len(mmUsage.expectations) == 0 && mmUsage.defaultExpectation == nil && mmUsage.mock.funcUsage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUsage.mock.afterUsageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUsage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Usage implements mm_handlers.QuotaService
+func (mmUsage *QuotaServiceMock) Usage(ctx context.Context) (q1 model.QuotaUsage) {
+	mm_atomic.AddUint64(&mmUsage.beforeUsageCounter, 1)
+	defer mm_atomic.AddUint64(&mmUsage.afterUsageCounter, 1)
+
+	mmUsage.t.Helper()
+
+	if mmUsage.inspectFuncUsage != nil {
+		mmUsage.inspectFuncUsage(ctx)
+	}
+
+	mm_params := QuotaServiceMockUsageParams{ctx}
+
+	// Record call args
+	mmUsage.UsageMock.mutex.Lock()
+	mmUsage.UsageMock.callArgs = append(mmUsage.UsageMock.callArgs, &mm_params)
+	mmUsage.UsageMock.mutex.Unlock()
+
+	for _, e := range mmUsage.UsageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.q1
+		}
+	}
+
+	if mmUsage.UsageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUsage.UsageMock.defaultExpectation.Counter, 1)
+		mm_want := mmUsage.UsageMock.defaultExpectation.params
+		mm_want_ptrs := mmUsage.UsageMock.defaultExpectation.paramPtrs
+
+		mm_got := QuotaServiceMockUsageParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUsage.t.Errorf("QuotaServiceMock.Usage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUsage.UsageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUsage.t.Errorf("QuotaServiceMock.Usage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUsage.UsageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUsage.UsageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUsage.t.Fatal("No results are set for the QuotaServiceMock.Usage")
+		}
+		return (*mm_results).q1
+	}
+	if mmUsage.funcUsage != nil {
+		return mmUsage.funcUsage(ctx)
+	}
+	mmUsage.t.Fatalf("Unexpected call to QuotaServiceMock.Usage. %v", ctx)
+	return
+}
+
+// UsageAfterCounter returns a count of finished QuotaServiceMock.Usage invocations
+func (mmUsage *QuotaServiceMock) UsageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUsage.afterUsageCounter)
+}
+
+// UsageBeforeCounter returns a count of QuotaServiceMock.Usage invocations
+func (mmUsage *QuotaServiceMock) UsageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUsage.beforeUsageCounter)
+}
+
+// Calls returns a list of arguments used in each call to QuotaServiceMock.Usage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUsage *mQuotaServiceMockUsage) Calls() []*QuotaServiceMockUsageParams {
+	mmUsage.mutex.RLock()
+
+	argCopy := make([]*QuotaServiceMockUsageParams, len(mmUsage.callArgs))
+	copy(argCopy, mmUsage.callArgs)
+
+	mmUsage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUsageDone returns true if the count of the Usage invocations corresponds
+// the number of defined expectations
+func (m *QuotaServiceMock) MinimockUsageDone() bool {
+	if m.UsageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UsageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UsageMock.invocationsDone()
+}
+
+// MinimockUsageInspect logs each unmet expectation
+func (m *QuotaServiceMock) MinimockUsageInspect() {
+	for _, e := range m.UsageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to QuotaServiceMock.Usage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUsageCounter := mm_atomic.LoadUint64(&m.afterUsageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UsageMock.defaultExpectation != nil && afterUsageCounter < 1 {
+		if m.UsageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to QuotaServiceMock.Usage at\n%s", m.UsageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to QuotaServiceMock.Usage at\n%s with params: %#v", m.UsageMock.defaultExpectation.expectationOrigins.origin, *m.UsageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUsage != nil && afterUsageCounter < 1 {
+		m.t.Errorf("Expected call to QuotaServiceMock.Usage at\n%s", m.funcUsageOrigin)
+	}
+
+	if !m.UsageMock.invocationsDone() && afterUsageCounter > 0 {
+		m.t.Errorf("Expected %d calls to QuotaServiceMock.Usage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UsageMock.expectedInvocations), m.UsageMock.expectedInvocationsOrigin, afterUsageCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *QuotaServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockUsageInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *QuotaServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *QuotaServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockUsageDone()
+}
@@ -0,0 +1,866 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mock
+
+//go:generate minimock -i test-server/internal/app/handlers.TaskLogService -o task_log_service_mock.go -n TaskLogServiceMock -p mock
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"test-server/internal/domain/model"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// TaskLogServiceMock implements mm_handlers.TaskLogService
+type TaskLogServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcFollowTaskLogs          func(ctx context.Context, taskId string, offset int64, fn func([]model.LogLine) error) (err error)
+	funcFollowTaskLogsOrigin    string
+	inspectFuncFollowTaskLogs   func(ctx context.Context, taskId string, offset int64, fn func([]model.LogLine) error)
+	afterFollowTaskLogsCounter  uint64
+	beforeFollowTaskLogsCounter uint64
+	FollowTaskLogsMock          mTaskLogServiceMockFollowTaskLogs
+
+	funcTaskLogs          func(ctx context.Context, taskId string, query model.LogQuery) (l1 model.LogPage, err error)
+	funcTaskLogsOrigin    string
+	inspectFuncTaskLogs   func(ctx context.Context, taskId string, query model.LogQuery)
+	afterTaskLogsCounter  uint64
+	beforeTaskLogsCounter uint64
+	TaskLogsMock          mTaskLogServiceMockTaskLogs
+}
+
+// NewTaskLogServiceMock returns a mock for mm_handlers.TaskLogService
+func NewTaskLogServiceMock(t minimock.Tester) *TaskLogServiceMock {
+	m := &TaskLogServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.FollowTaskLogsMock = mTaskLogServiceMockFollowTaskLogs{mock: m}
+	m.FollowTaskLogsMock.callArgs = []*TaskLogServiceMockFollowTaskLogsParams{}
+
+	m.TaskLogsMock = mTaskLogServiceMockTaskLogs{mock: m}
+	m.TaskLogsMock.callArgs = []*TaskLogServiceMockTaskLogsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mTaskLogServiceMockFollowTaskLogs struct {
+	optional           bool
+	mock               *TaskLogServiceMock
+	defaultExpectation *TaskLogServiceMockFollowTaskLogsExpectation
+	expectations       []*TaskLogServiceMockFollowTaskLogsExpectation
+
+	callArgs []*TaskLogServiceMockFollowTaskLogsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TaskLogServiceMockFollowTaskLogsExpectation specifies expectation struct of the TaskLogService.FollowTaskLogs
+type TaskLogServiceMockFollowTaskLogsExpectation struct {
+	mock               *TaskLogServiceMock
+	params             *TaskLogServiceMockFollowTaskLogsParams
+	paramPtrs          *TaskLogServiceMockFollowTaskLogsParamPtrs
+	expectationOrigins TaskLogServiceMockFollowTaskLogsExpectationOrigins
+	results            *TaskLogServiceMockFollowTaskLogsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TaskLogServiceMockFollowTaskLogsParams contains parameters of the TaskLogService.FollowTaskLogs
+type TaskLogServiceMockFollowTaskLogsParams struct {
+	ctx    context.Context
+	taskId string
+	offset int64
+	fn     func([]model.LogLine) error
+}
+
+// TaskLogServiceMockFollowTaskLogsParamPtrs contains pointers to parameters of the TaskLogService.FollowTaskLogs
+type TaskLogServiceMockFollowTaskLogsParamPtrs struct {
+	ctx    *context.Context
+	taskId *string
+	offset *int64
+	fn     *func([]model.LogLine) error
+}
+
+// TaskLogServiceMockFollowTaskLogsResults contains results of the TaskLogService.FollowTaskLogs
+type TaskLogServiceMockFollowTaskLogsResults struct {
+	err error
+}
+
+// TaskLogServiceMockFollowTaskLogsOrigins contains origins of expectations of the TaskLogService.FollowTaskLogs
+type TaskLogServiceMockFollowTaskLogsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originTaskId string
+	originOffset string
+	originFn     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFollowTaskLogs *mTaskLogServiceMockFollowTaskLogs) Optional() *mTaskLogServiceMockFollowTaskLogs {
+	mmFollowTaskLogs.optional = true
+	return mmFollowTaskLogs
+}
+
+// Expect sets up expected params for TaskLogService.FollowTaskLogs
+func (mmFollowTaskLogs *mTaskLogServiceMockFollowTaskLogs) Expect(ctx context.Context, taskId string, offset int64, fn func([]model.LogLine) error) *mTaskLogServiceMockFollowTaskLogs {
+	if mmFollowTaskLogs.mock.funcFollowTaskLogs != nil {
+		mmFollowTaskLogs.mock.t.Fatalf("TaskLogServiceMock.FollowTaskLogs mock is already set by Set")
+	}
+
+	if mmFollowTaskLogs.defaultExpectation == nil {
+		mmFollowTaskLogs.defaultExpectation = &TaskLogServiceMockFollowTaskLogsExpectation{}
+	}
+
+	if mmFollowTaskLogs.defaultExpectation.paramPtrs != nil {
+		mmFollowTaskLogs.mock.t.Fatalf("TaskLogServiceMock.FollowTaskLogs mock is already set by ExpectParams functions")
+	}
+
+	mmFollowTaskLogs.defaultExpectation.params = &TaskLogServiceMockFollowTaskLogsParams{ctx, taskId, offset, fn}
+	mmFollowTaskLogs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFollowTaskLogs.expectations {
+		if minimock.Equal(e.params, mmFollowTaskLogs.defaultExpectation.params) {
+			mmFollowTaskLogs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFollowTaskLogs.defaultExpectation.params)
+		}
+	}
+
+	return mmFollowTaskLogs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TaskLogService.FollowTaskLogs
+func (mmFollowTaskLogs *mTaskLogServiceMockFollowTaskLogs) ExpectCtxParam1(ctx context.Context) *mTaskLogServiceMockFollowTaskLogs {
+	if mmFollowTaskLogs.mock.funcFollowTaskLogs != nil {
+		mmFollowTaskLogs.mock.t.Fatalf("TaskLogServiceMock.FollowTaskLogs mock is already set by Set")
+	}
+
+	if mmFollowTaskLogs.defaultExpectation == nil {
+		mmFollowTaskLogs.defaultExpectation = &TaskLogServiceMockFollowTaskLogsExpectation{}
+	}
+
+	if mmFollowTaskLogs.defaultExpectation.params != nil {
+		mmFollowTaskLogs.mock.t.Fatalf("TaskLogServiceMock.FollowTaskLogs mock is already set by Expect")
+	}
+
+	if mmFollowTaskLogs.defaultExpectation.paramPtrs == nil {
+		mmFollowTaskLogs.defaultExpectation.paramPtrs = &TaskLogServiceMockFollowTaskLogsParamPtrs{}
+	}
+	mmFollowTaskLogs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFollowTaskLogs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFollowTaskLogs
+}
+
+// ExpectTaskIdParam2 sets up expected param taskId for TaskLogService.FollowTaskLogs
+func (mmFollowTaskLogs *mTaskLogServiceMockFollowTaskLogs) ExpectTaskIdParam2(taskId string) *mTaskLogServiceMockFollowTaskLogs {
+	if mmFollowTaskLogs.mock.funcFollowTaskLogs != nil {
+		mmFollowTaskLogs.mock.t.Fatalf("TaskLogServiceMock.FollowTaskLogs mock is already set by Set")
+	}
+
+	if mmFollowTaskLogs.defaultExpectation == nil {
+		mmFollowTaskLogs.defaultExpectation = &TaskLogServiceMockFollowTaskLogsExpectation{}
+	}
+
+	if mmFollowTaskLogs.defaultExpectation.params != nil {
+		mmFollowTaskLogs.mock.t.Fatalf("TaskLogServiceMock.FollowTaskLogs mock is already set by Expect")
+	}
+
+	if mmFollowTaskLogs.defaultExpectation.paramPtrs == nil {
+		mmFollowTaskLogs.defaultExpectation.paramPtrs = &TaskLogServiceMockFollowTaskLogsParamPtrs{}
+	}
+	mmFollowTaskLogs.defaultExpectation.paramPtrs.taskId = &taskId
+	mmFollowTaskLogs.defaultExpectation.expectationOrigins.originTaskId = minimock.CallerInfo(1)
+
+	return mmFollowTaskLogs
+}
+
+// ExpectOffsetParam3 sets up expected param offset for TaskLogService.FollowTaskLogs
+func (mmFollowTaskLogs *mTaskLogServiceMockFollowTaskLogs) ExpectOffsetParam3(offset int64) *mTaskLogServiceMockFollowTaskLogs {
+	if mmFollowTaskLogs.mock.funcFollowTaskLogs != nil {
+		mmFollowTaskLogs.mock.t.Fatalf("TaskLogServiceMock.FollowTaskLogs mock is already set by Set")
+	}
+
+	if mmFollowTaskLogs.defaultExpectation == nil {
+		mmFollowTaskLogs.defaultExpectation = &TaskLogServiceMockFollowTaskLogsExpectation{}
+	}
+
+	if mmFollowTaskLogs.defaultExpectation.params != nil {
+		mmFollowTaskLogs.mock.t.Fatalf("TaskLogServiceMock.FollowTaskLogs mock is already set by Expect")
+	}
+
+	if mmFollowTaskLogs.defaultExpectation.paramPtrs == nil {
+		mmFollowTaskLogs.defaultExpectation.paramPtrs = &TaskLogServiceMockFollowTaskLogsParamPtrs{}
+	}
+	mmFollowTaskLogs.defaultExpectation.paramPtrs.offset = &offset
+	mmFollowTaskLogs.defaultExpectation.expectationOrigins.originOffset = minimock.CallerInfo(1)
+
+	return mmFollowTaskLogs
+}
+
+// ExpectFnParam4 sets up expected param fn for TaskLogService.FollowTaskLogs
+func (mmFollowTaskLogs *mTaskLogServiceMockFollowTaskLogs) ExpectFnParam4(fn func([]model.LogLine) error) *mTaskLogServiceMockFollowTaskLogs {
+	if mmFollowTaskLogs.mock.funcFollowTaskLogs != nil {
+		mmFollowTaskLogs.mock.t.Fatalf("TaskLogServiceMock.FollowTaskLogs mock is already set by Set")
+	}
+
+	if mmFollowTaskLogs.defaultExpectation == nil {
+		mmFollowTaskLogs.defaultExpectation = &TaskLogServiceMockFollowTaskLogsExpectation{}
+	}
+
+	if mmFollowTaskLogs.defaultExpectation.params != nil {
+		mmFollowTaskLogs.mock.t.Fatalf("TaskLogServiceMock.FollowTaskLogs mock is already set by Expect")
+	}
+
+	if mmFollowTaskLogs.defaultExpectation.paramPtrs == nil {
+		mmFollowTaskLogs.defaultExpectation.paramPtrs = &TaskLogServiceMockFollowTaskLogsParamPtrs{}
+	}
+	mmFollowTaskLogs.defaultExpectation.paramPtrs.fn = &fn
+	mmFollowTaskLogs.defaultExpectation.expectationOrigins.originFn = minimock.CallerInfo(1)
+
+	return mmFollowTaskLogs
+}
+
+// Inspect accepts an inspector function that has same arguments as the TaskLogService.FollowTaskLogs
+func (mmFollowTaskLogs *mTaskLogServiceMockFollowTaskLogs) Inspect(f func(ctx context.Context, taskId string, offset int64, fn func([]model.LogLine) error)) *mTaskLogServiceMockFollowTaskLogs {
+	if mmFollowTaskLogs.mock.inspectFuncFollowTaskLogs != nil {
+		mmFollowTaskLogs.mock.t.Fatalf("Inspect function is already set for TaskLogServiceMock.FollowTaskLogs")
+	}
+
+	mmFollowTaskLogs.mock.inspectFuncFollowTaskLogs = f
+
+	return mmFollowTaskLogs
+}
+
+// Return sets up results that will be returned by TaskLogService.FollowTaskLogs
+func (mmFollowTaskLogs *mTaskLogServiceMockFollowTaskLogs) Return(err error) *TaskLogServiceMock {
+	if mmFollowTaskLogs.mock.funcFollowTaskLogs != nil {
+		mmFollowTaskLogs.mock.t.Fatalf("TaskLogServiceMock.FollowTaskLogs mock is already set by Set")
+	}
+
+	if mmFollowTaskLogs.defaultExpectation == nil {
+		mmFollowTaskLogs.defaultExpectation = &TaskLogServiceMockFollowTaskLogsExpectation{mock: mmFollowTaskLogs.mock}
+	}
+	mmFollowTaskLogs.defaultExpectation.results = &TaskLogServiceMockFollowTaskLogsResults{err}
+	mmFollowTaskLogs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFollowTaskLogs.mock
+}
+
+// Set uses given function f to mock the TaskLogService.FollowTaskLogs method
+func (mmFollowTaskLogs *mTaskLogServiceMockFollowTaskLogs) Set(f func(ctx context.Context, taskId string, offset int64, fn func([]model.LogLine) error) (err error)) *TaskLogServiceMock {
+	if mmFollowTaskLogs.defaultExpectation != nil {
+		mmFollowTaskLogs.mock.t.Fatalf("Default expectation is already set for the TaskLogService.FollowTaskLogs method")
+	}
+
+	if len(mmFollowTaskLogs.expectations) > 0 {
+		mmFollowTaskLogs.mock.t.Fatalf("Some expectations are already set for the TaskLogService.FollowTaskLogs method")
+	}
+
+	mmFollowTaskLogs.mock.funcFollowTaskLogs = f
+	mmFollowTaskLogs.mock.funcFollowTaskLogsOrigin = minimock.CallerInfo(1)
+	return mmFollowTaskLogs.mock
+}
+
+// When sets expectation for the TaskLogService.FollowTaskLogs which will trigger the result defined by the following
+// Then helper
+func (mmFollowTaskLogs *mTaskLogServiceMockFollowTaskLogs) When(ctx context.Context, taskId string, offset int64, fn func([]model.LogLine) error) *TaskLogServiceMockFollowTaskLogsExpectation {
+	if mmFollowTaskLogs.mock.funcFollowTaskLogs != nil {
+		mmFollowTaskLogs.mock.t.Fatalf("TaskLogServiceMock.FollowTaskLogs mock is already set by Set")
+	}
+
+	expectation := &TaskLogServiceMockFollowTaskLogsExpectation{
+		mock:               mmFollowTaskLogs.mock,
+		params:             &TaskLogServiceMockFollowTaskLogsParams{ctx, taskId, offset, fn},
+		expectationOrigins: TaskLogServiceMockFollowTaskLogsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFollowTaskLogs.expectations = append(mmFollowTaskLogs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TaskLogService.FollowTaskLogs return parameters for the expectation previously defined by the When method
+func (e *TaskLogServiceMockFollowTaskLogsExpectation) Then(err error) *TaskLogServiceMock {
+	e.results = &TaskLogServiceMockFollowTaskLogsResults{err}
+	return e.mock
+}
+
+// Times sets number of times TaskLogService.FollowTaskLogs should be invoked
+func (mmFollowTaskLogs *mTaskLogServiceMockFollowTaskLogs) Times(n uint64) *mTaskLogServiceMockFollowTaskLogs {
+	if n == 0 {
+		mmFollowTaskLogs.mock.t.Fatalf("Times of TaskLogServiceMock.FollowTaskLogs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFollowTaskLogs.expectedInvocations, n)
+	mmFollowTaskLogs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFollowTaskLogs
+}
+
+func (mmFollowTaskLogs *mTaskLogServiceMockFollowTaskLogs) invocationsDone() bool {
+	if len(mmFollowTaskLogs.expectations) == 0 && mmFollowTaskLogs.defaultExpectation == nil && mmFollowTaskLogs.mock.funcFollowTaskLogs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFollowTaskLogs.mock.afterFollowTaskLogsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFollowTaskLogs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FollowTaskLogs implements mm_handlers.TaskLogService
+func (mmFollowTaskLogs *TaskLogServiceMock) FollowTaskLogs(ctx context.Context, taskId string, offset int64, fn func([]model.LogLine) error) (err error) {
+	mm_atomic.AddUint64(&mmFollowTaskLogs.beforeFollowTaskLogsCounter, 1)
+	defer mm_atomic.AddUint64(&mmFollowTaskLogs.afterFollowTaskLogsCounter, 1)
+
+	mmFollowTaskLogs.t.Helper()
+
+	if mmFollowTaskLogs.inspectFuncFollowTaskLogs != nil {
+		mmFollowTaskLogs.inspectFuncFollowTaskLogs(ctx, taskId, offset, fn)
+	}
+
+	mm_params := TaskLogServiceMockFollowTaskLogsParams{ctx, taskId, offset, fn}
+
+	// Record call args
+	mmFollowTaskLogs.FollowTaskLogsMock.mutex.Lock()
+	mmFollowTaskLogs.FollowTaskLogsMock.callArgs = append(mmFollowTaskLogs.FollowTaskLogsMock.callArgs, &mm_params)
+	mmFollowTaskLogs.FollowTaskLogsMock.mutex.Unlock()
+
+	for _, e := range mmFollowTaskLogs.FollowTaskLogsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmFollowTaskLogs.FollowTaskLogsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFollowTaskLogs.FollowTaskLogsMock.defaultExpectation.Counter, 1)
+		mm_want := mmFollowTaskLogs.FollowTaskLogsMock.defaultExpectation.params
+		mm_want_ptrs := mmFollowTaskLogs.FollowTaskLogsMock.defaultExpectation.paramPtrs
+
+		mm_got := TaskLogServiceMockFollowTaskLogsParams{ctx, taskId, offset, fn}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFollowTaskLogs.t.Errorf("TaskLogServiceMock.FollowTaskLogs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFollowTaskLogs.FollowTaskLogsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.taskId != nil && !minimock.Equal(*mm_want_ptrs.taskId, mm_got.taskId) {
+				mmFollowTaskLogs.t.Errorf("TaskLogServiceMock.FollowTaskLogs got unexpected parameter taskId, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFollowTaskLogs.FollowTaskLogsMock.defaultExpectation.expectationOrigins.originTaskId, *mm_want_ptrs.taskId, mm_got.taskId, minimock.Diff(*mm_want_ptrs.taskId, mm_got.taskId))
+			}
+
+			if mm_want_ptrs.offset != nil && !minimock.Equal(*mm_want_ptrs.offset, mm_got.offset) {
+				mmFollowTaskLogs.t.Errorf("TaskLogServiceMock.FollowTaskLogs got unexpected parameter offset, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFollowTaskLogs.FollowTaskLogsMock.defaultExpectation.expectationOrigins.originOffset, *mm_want_ptrs.offset, mm_got.offset, minimock.Diff(*mm_want_ptrs.offset, mm_got.offset))
+			}
+
+			if mm_want_ptrs.fn != nil && !minimock.Equal(*mm_want_ptrs.fn, mm_got.fn) {
+				mmFollowTaskLogs.t.Errorf("TaskLogServiceMock.FollowTaskLogs got unexpected parameter fn, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFollowTaskLogs.FollowTaskLogsMock.defaultExpectation.expectationOrigins.originFn, *mm_want_ptrs.fn, mm_got.fn, minimock.Diff(*mm_want_ptrs.fn, mm_got.fn))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFollowTaskLogs.t.Errorf("TaskLogServiceMock.FollowTaskLogs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFollowTaskLogs.FollowTaskLogsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFollowTaskLogs.FollowTaskLogsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFollowTaskLogs.t.Fatal("No results are set for the TaskLogServiceMock.FollowTaskLogs")
+		}
+		return (*mm_results).err
+	}
+	if mmFollowTaskLogs.funcFollowTaskLogs != nil {
+		return mmFollowTaskLogs.funcFollowTaskLogs(ctx, taskId, offset, fn)
+	}
+	mmFollowTaskLogs.t.Fatalf("Unexpected call to TaskLogServiceMock.FollowTaskLogs. %v %v %v %v", ctx, taskId, offset, fn)
+	return
+}
+
+// FollowTaskLogsAfterCounter returns a count of finished TaskLogServiceMock.FollowTaskLogs invocations
+func (mmFollowTaskLogs *TaskLogServiceMock) FollowTaskLogsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFollowTaskLogs.afterFollowTaskLogsCounter)
+}
+
+// FollowTaskLogsBeforeCounter returns a count of TaskLogServiceMock.FollowTaskLogs invocations
+func (mmFollowTaskLogs *TaskLogServiceMock) FollowTaskLogsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFollowTaskLogs.beforeFollowTaskLogsCounter)
+}
+
+// Calls returns a list of arguments used in each call to TaskLogServiceMock.FollowTaskLogs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFollowTaskLogs *mTaskLogServiceMockFollowTaskLogs) Calls() []*TaskLogServiceMockFollowTaskLogsParams {
+	mmFollowTaskLogs.mutex.RLock()
+
+	argCopy := make([]*TaskLogServiceMockFollowTaskLogsParams, len(mmFollowTaskLogs.callArgs))
+	copy(argCopy, mmFollowTaskLogs.callArgs)
+
+	mmFollowTaskLogs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFollowTaskLogsDone returns true if the count of the FollowTaskLogs invocations corresponds
+// the number of defined expectations
+func (m *TaskLogServiceMock) MinimockFollowTaskLogsDone() bool {
+	if m.FollowTaskLogsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FollowTaskLogsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FollowTaskLogsMock.invocationsDone()
+}
+
+// MinimockFollowTaskLogsInspect logs each unmet expectation
+func (m *TaskLogServiceMock) MinimockFollowTaskLogsInspect() {
+	for _, e := range m.FollowTaskLogsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TaskLogServiceMock.FollowTaskLogs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFollowTaskLogsCounter := mm_atomic.LoadUint64(&m.afterFollowTaskLogsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FollowTaskLogsMock.defaultExpectation != nil && afterFollowTaskLogsCounter < 1 {
+		if m.FollowTaskLogsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TaskLogServiceMock.FollowTaskLogs at\n%s", m.FollowTaskLogsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TaskLogServiceMock.FollowTaskLogs at\n%s with params: %#v", m.FollowTaskLogsMock.defaultExpectation.expectationOrigins.origin, *m.FollowTaskLogsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFollowTaskLogs != nil && afterFollowTaskLogsCounter < 1 {
+		m.t.Errorf("Expected call to TaskLogServiceMock.FollowTaskLogs at\n%s", m.funcFollowTaskLogsOrigin)
+	}
+
+	if !m.FollowTaskLogsMock.invocationsDone() && afterFollowTaskLogsCounter > 0 {
+		m.t.Errorf("Expected %d calls to TaskLogServiceMock.FollowTaskLogs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FollowTaskLogsMock.expectedInvocations), m.FollowTaskLogsMock.expectedInvocationsOrigin, afterFollowTaskLogsCounter)
+	}
+}
+
+type mTaskLogServiceMockTaskLogs struct {
+	optional           bool
+	mock               *TaskLogServiceMock
+	defaultExpectation *TaskLogServiceMockTaskLogsExpectation
+	expectations       []*TaskLogServiceMockTaskLogsExpectation
+
+	callArgs []*TaskLogServiceMockTaskLogsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TaskLogServiceMockTaskLogsExpectation specifies expectation struct of the TaskLogService.TaskLogs
+type TaskLogServiceMockTaskLogsExpectation struct {
+	mock               *TaskLogServiceMock
+	params             *TaskLogServiceMockTaskLogsParams
+	paramPtrs          *TaskLogServiceMockTaskLogsParamPtrs
+	expectationOrigins TaskLogServiceMockTaskLogsExpectationOrigins
+	results            *TaskLogServiceMockTaskLogsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TaskLogServiceMockTaskLogsParams contains parameters of the TaskLogService.TaskLogs
+type TaskLogServiceMockTaskLogsParams struct {
+	ctx    context.Context
+	taskId string
+	query  model.LogQuery
+}
+
+// TaskLogServiceMockTaskLogsParamPtrs contains pointers to parameters of the TaskLogService.TaskLogs
+type TaskLogServiceMockTaskLogsParamPtrs struct {
+	ctx    *context.Context
+	taskId *string
+	query  *model.LogQuery
+}
+
+// TaskLogServiceMockTaskLogsResults contains results of the TaskLogService.TaskLogs
+type TaskLogServiceMockTaskLogsResults struct {
+	l1  model.LogPage
+	err error
+}
+
+// TaskLogServiceMockTaskLogsOrigins contains origins of expectations of the TaskLogService.TaskLogs
+type TaskLogServiceMockTaskLogsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originTaskId string
+	originQuery  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTaskLogs *mTaskLogServiceMockTaskLogs) Optional() *mTaskLogServiceMockTaskLogs {
+	mmTaskLogs.optional = true
+	return mmTaskLogs
+}
+
+// Expect sets up expected params for TaskLogService.TaskLogs
+func (mmTaskLogs *mTaskLogServiceMockTaskLogs) Expect(ctx context.Context, taskId string, query model.LogQuery) *mTaskLogServiceMockTaskLogs {
+	if mmTaskLogs.mock.funcTaskLogs != nil {
+		mmTaskLogs.mock.t.Fatalf("TaskLogServiceMock.TaskLogs mock is already set by Set")
+	}
+
+	if mmTaskLogs.defaultExpectation == nil {
+		mmTaskLogs.defaultExpectation = &TaskLogServiceMockTaskLogsExpectation{}
+	}
+
+	if mmTaskLogs.defaultExpectation.paramPtrs != nil {
+		mmTaskLogs.mock.t.Fatalf("TaskLogServiceMock.TaskLogs mock is already set by ExpectParams functions")
+	}
+
+	mmTaskLogs.defaultExpectation.params = &TaskLogServiceMockTaskLogsParams{ctx, taskId, query}
+	mmTaskLogs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmTaskLogs.expectations {
+		if minimock.Equal(e.params, mmTaskLogs.defaultExpectation.params) {
+			mmTaskLogs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTaskLogs.defaultExpectation.params)
+		}
+	}
+
+	return mmTaskLogs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TaskLogService.TaskLogs
+func (mmTaskLogs *mTaskLogServiceMockTaskLogs) ExpectCtxParam1(ctx context.Context) *mTaskLogServiceMockTaskLogs {
+	if mmTaskLogs.mock.funcTaskLogs != nil {
+		mmTaskLogs.mock.t.Fatalf("TaskLogServiceMock.TaskLogs mock is already set by Set")
+	}
+
+	if mmTaskLogs.defaultExpectation == nil {
+		mmTaskLogs.defaultExpectation = &TaskLogServiceMockTaskLogsExpectation{}
+	}
+
+	if mmTaskLogs.defaultExpectation.params != nil {
+		mmTaskLogs.mock.t.Fatalf("TaskLogServiceMock.TaskLogs mock is already set by Expect")
+	}
+
+	if mmTaskLogs.defaultExpectation.paramPtrs == nil {
+		mmTaskLogs.defaultExpectation.paramPtrs = &TaskLogServiceMockTaskLogsParamPtrs{}
+	}
+	mmTaskLogs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmTaskLogs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmTaskLogs
+}
+
+// ExpectTaskIdParam2 sets up expected param taskId for TaskLogService.TaskLogs
+func (mmTaskLogs *mTaskLogServiceMockTaskLogs) ExpectTaskIdParam2(taskId string) *mTaskLogServiceMockTaskLogs {
+	if mmTaskLogs.mock.funcTaskLogs != nil {
+		mmTaskLogs.mock.t.Fatalf("TaskLogServiceMock.TaskLogs mock is already set by Set")
+	}
+
+	if mmTaskLogs.defaultExpectation == nil {
+		mmTaskLogs.defaultExpectation = &TaskLogServiceMockTaskLogsExpectation{}
+	}
+
+	if mmTaskLogs.defaultExpectation.params != nil {
+		mmTaskLogs.mock.t.Fatalf("TaskLogServiceMock.TaskLogs mock is already set by Expect")
+	}
+
+	if mmTaskLogs.defaultExpectation.paramPtrs == nil {
+		mmTaskLogs.defaultExpectation.paramPtrs = &TaskLogServiceMockTaskLogsParamPtrs{}
+	}
+	mmTaskLogs.defaultExpectation.paramPtrs.taskId = &taskId
+	mmTaskLogs.defaultExpectation.expectationOrigins.originTaskId = minimock.CallerInfo(1)
+
+	return mmTaskLogs
+}
+
+// ExpectQueryParam3 sets up expected param query for TaskLogService.TaskLogs
+func (mmTaskLogs *mTaskLogServiceMockTaskLogs) ExpectQueryParam3(query model.LogQuery) *mTaskLogServiceMockTaskLogs {
+	if mmTaskLogs.mock.funcTaskLogs != nil {
+		mmTaskLogs.mock.t.Fatalf("TaskLogServiceMock.TaskLogs mock is already set by Set")
+	}
+
+	if mmTaskLogs.defaultExpectation == nil {
+		mmTaskLogs.defaultExpectation = &TaskLogServiceMockTaskLogsExpectation{}
+	}
+
+	if mmTaskLogs.defaultExpectation.params != nil {
+		mmTaskLogs.mock.t.Fatalf("TaskLogServiceMock.TaskLogs mock is already set by Expect")
+	}
+
+	if mmTaskLogs.defaultExpectation.paramPtrs == nil {
+		mmTaskLogs.defaultExpectation.paramPtrs = &TaskLogServiceMockTaskLogsParamPtrs{}
+	}
+	mmTaskLogs.defaultExpectation.paramPtrs.query = &query
+	mmTaskLogs.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
+
+	return mmTaskLogs
+}
+
+// Inspect accepts an inspector function that has same arguments as the TaskLogService.TaskLogs
+func (mmTaskLogs *mTaskLogServiceMockTaskLogs) Inspect(f func(ctx context.Context, taskId string, query model.LogQuery)) *mTaskLogServiceMockTaskLogs {
+	if mmTaskLogs.mock.inspectFuncTaskLogs != nil {
+		mmTaskLogs.mock.t.Fatalf("Inspect function is already set for TaskLogServiceMock.TaskLogs")
+	}
+
+	mmTaskLogs.mock.inspectFuncTaskLogs = f
+
+	return mmTaskLogs
+}
+
+// Return sets up results that will be returned by TaskLogService.TaskLogs
+func (mmTaskLogs *mTaskLogServiceMockTaskLogs) Return(l1 model.LogPage, err error) *TaskLogServiceMock {
+	if mmTaskLogs.mock.funcTaskLogs != nil {
+		mmTaskLogs.mock.t.Fatalf("TaskLogServiceMock.TaskLogs mock is already set by Set")
+	}
+
+	if mmTaskLogs.defaultExpectation == nil {
+		mmTaskLogs.defaultExpectation = &TaskLogServiceMockTaskLogsExpectation{mock: mmTaskLogs.mock}
+	}
+	mmTaskLogs.defaultExpectation.results = &TaskLogServiceMockTaskLogsResults{l1, err}
+	mmTaskLogs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmTaskLogs.mock
+}
+
+// Set uses given function f to mock the TaskLogService.TaskLogs method
+func (mmTaskLogs *mTaskLogServiceMockTaskLogs) Set(f func(ctx context.Context, taskId string, query model.LogQuery) (l1 model.LogPage, err error)) *TaskLogServiceMock {
+	if mmTaskLogs.defaultExpectation != nil {
+		mmTaskLogs.mock.t.Fatalf("Default expectation is already set for the TaskLogService.TaskLogs method")
+	}
+
+	if len(mmTaskLogs.expectations) > 0 {
+		mmTaskLogs.mock.t.Fatalf("Some expectations are already set for the TaskLogService.TaskLogs method")
+	}
+
+	mmTaskLogs.mock.funcTaskLogs = f
+	mmTaskLogs.mock.funcTaskLogsOrigin = minimock.CallerInfo(1)
+	return mmTaskLogs.mock
+}
+
+// When sets expectation for the TaskLogService.TaskLogs which will trigger the result defined by the following
+// Then helper
+func (mmTaskLogs *mTaskLogServiceMockTaskLogs) When(ctx context.Context, taskId string, query model.LogQuery) *TaskLogServiceMockTaskLogsExpectation {
+	if mmTaskLogs.mock.funcTaskLogs != nil {
+		mmTaskLogs.mock.t.Fatalf("TaskLogServiceMock.TaskLogs mock is already set by Set")
+	}
+
+	expectation := &TaskLogServiceMockTaskLogsExpectation{
+		mock:               mmTaskLogs.mock,
+		params:             &TaskLogServiceMockTaskLogsParams{ctx, taskId, query},
+		expectationOrigins: TaskLogServiceMockTaskLogsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmTaskLogs.expectations = append(mmTaskLogs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TaskLogService.TaskLogs return parameters for the expectation previously defined by the When method
+func (e *TaskLogServiceMockTaskLogsExpectation) Then(l1 model.LogPage, err error) *TaskLogServiceMock {
+	e.results = &TaskLogServiceMockTaskLogsResults{l1, err}
+	return e.mock
+}
+
+// Times sets number of times TaskLogService.TaskLogs should be invoked
+func (mmTaskLogs *mTaskLogServiceMockTaskLogs) Times(n uint64) *mTaskLogServiceMockTaskLogs {
+	if n == 0 {
+		mmTaskLogs.mock.t.Fatalf("Times of TaskLogServiceMock.TaskLogs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTaskLogs.expectedInvocations, n)
+	mmTaskLogs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmTaskLogs
+}
+
+func (mmTaskLogs *mTaskLogServiceMockTaskLogs) invocationsDone() bool {
+	if len(mmTaskLogs.expectations) == 0 && mmTaskLogs.defaultExpectation == nil && mmTaskLogs.mock.funcTaskLogs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTaskLogs.mock.afterTaskLogsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTaskLogs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// TaskLogs implements mm_handlers.TaskLogService
+func (mmTaskLogs *TaskLogServiceMock) TaskLogs(ctx context.Context, taskId string, query model.LogQuery) (l1 model.LogPage, err error) {
+	mm_atomic.AddUint64(&mmTaskLogs.beforeTaskLogsCounter, 1)
+	defer mm_atomic.AddUint64(&mmTaskLogs.afterTaskLogsCounter, 1)
+
+	mmTaskLogs.t.Helper()
+
+	if mmTaskLogs.inspectFuncTaskLogs != nil {
+		mmTaskLogs.inspectFuncTaskLogs(ctx, taskId, query)
+	}
+
+	mm_params := TaskLogServiceMockTaskLogsParams{ctx, taskId, query}
+
+	// Record call args
+	mmTaskLogs.TaskLogsMock.mutex.Lock()
+	mmTaskLogs.TaskLogsMock.callArgs = append(mmTaskLogs.TaskLogsMock.callArgs, &mm_params)
+	mmTaskLogs.TaskLogsMock.mutex.Unlock()
+
+	for _, e := range mmTaskLogs.TaskLogsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.l1, e.results.err
+		}
+	}
+
+	if mmTaskLogs.TaskLogsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTaskLogs.TaskLogsMock.defaultExpectation.Counter, 1)
+		mm_want := mmTaskLogs.TaskLogsMock.defaultExpectation.params
+		mm_want_ptrs := mmTaskLogs.TaskLogsMock.defaultExpectation.paramPtrs
+
+		mm_got := TaskLogServiceMockTaskLogsParams{ctx, taskId, query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmTaskLogs.t.Errorf("TaskLogServiceMock.TaskLogs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTaskLogs.TaskLogsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.taskId != nil && !minimock.Equal(*mm_want_ptrs.taskId, mm_got.taskId) {
+				mmTaskLogs.t.Errorf("TaskLogServiceMock.TaskLogs got unexpected parameter taskId, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTaskLogs.TaskLogsMock.defaultExpectation.expectationOrigins.originTaskId, *mm_want_ptrs.taskId, mm_got.taskId, minimock.Diff(*mm_want_ptrs.taskId, mm_got.taskId))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmTaskLogs.t.Errorf("TaskLogServiceMock.TaskLogs got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTaskLogs.TaskLogsMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTaskLogs.t.Errorf("TaskLogServiceMock.TaskLogs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmTaskLogs.TaskLogsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTaskLogs.TaskLogsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTaskLogs.t.Fatal("No results are set for the TaskLogServiceMock.TaskLogs")
+		}
+		return (*mm_results).l1, (*mm_results).err
+	}
+	if mmTaskLogs.funcTaskLogs != nil {
+		return mmTaskLogs.funcTaskLogs(ctx, taskId, query)
+	}
+	mmTaskLogs.t.Fatalf("Unexpected call to TaskLogServiceMock.TaskLogs. %v %v %v", ctx, taskId, query)
+	return
+}
+
+// TaskLogsAfterCounter returns a count of finished TaskLogServiceMock.TaskLogs invocations
+func (mmTaskLogs *TaskLogServiceMock) TaskLogsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTaskLogs.afterTaskLogsCounter)
+}
+
+// TaskLogsBeforeCounter returns a count of TaskLogServiceMock.TaskLogs invocations
+func (mmTaskLogs *TaskLogServiceMock) TaskLogsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTaskLogs.beforeTaskLogsCounter)
+}
+
+// Calls returns a list of arguments used in each call to TaskLogServiceMock.TaskLogs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTaskLogs *mTaskLogServiceMockTaskLogs) Calls() []*TaskLogServiceMockTaskLogsParams {
+	mmTaskLogs.mutex.RLock()
+
+	argCopy := make([]*TaskLogServiceMockTaskLogsParams, len(mmTaskLogs.callArgs))
+	copy(argCopy, mmTaskLogs.callArgs)
+
+	mmTaskLogs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTaskLogsDone returns true if the count of the TaskLogs invocations corresponds
+// the number of defined expectations
+func (m *TaskLogServiceMock) MinimockTaskLogsDone() bool {
+	if m.TaskLogsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.TaskLogsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.TaskLogsMock.invocationsDone()
+}
+
+// MinimockTaskLogsInspect logs each unmet expectation
+func (m *TaskLogServiceMock) MinimockTaskLogsInspect() {
+	for _, e := range m.TaskLogsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TaskLogServiceMock.TaskLogs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterTaskLogsCounter := mm_atomic.LoadUint64(&m.afterTaskLogsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TaskLogsMock.defaultExpectation != nil && afterTaskLogsCounter < 1 {
+		if m.TaskLogsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TaskLogServiceMock.TaskLogs at\n%s", m.TaskLogsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TaskLogServiceMock.TaskLogs at\n%s with params: %#v", m.TaskLogsMock.defaultExpectation.expectationOrigins.origin, *m.TaskLogsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTaskLogs != nil && afterTaskLogsCounter < 1 {
+		m.t.Errorf("Expected call to TaskLogServiceMock.TaskLogs at\n%s", m.funcTaskLogsOrigin)
+	}
+
+	if !m.TaskLogsMock.invocationsDone() && afterTaskLogsCounter > 0 {
+		m.t.Errorf("Expected %d calls to TaskLogServiceMock.TaskLogs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.TaskLogsMock.expectedInvocations), m.TaskLogsMock.expectedInvocationsOrigin, afterTaskLogsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *TaskLogServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockFollowTaskLogsInspect()
+
+			m.MinimockTaskLogsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *TaskLogServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *TaskLogServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockFollowTaskLogsDone() &&
+		m.MinimockTaskLogsDone()
+}
@@ -19,20 +19,69 @@ type TasksServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcDeleteTask          func(ctx context.Context, taskId string) (err error)
+	funcDeleteTask          func(ctx context.Context, taskId string, version *int64) (err error)
 	funcDeleteTaskOrigin    string
-	inspectFuncDeleteTask   func(ctx context.Context, taskId string)
+	inspectFuncDeleteTask   func(ctx context.Context, taskId string, version *int64)
 	afterDeleteTaskCounter  uint64
 	beforeDeleteTaskCounter uint64
 	DeleteTaskMock          mTasksServiceMockDeleteTask
 
-	funcRegisterTask          func(ctx context.Context, title string) (s1 string, err error)
+	funcDeleteTasks          func(ctx context.Context, taskIds []string) (ba1 []model.BatchResult)
+	funcDeleteTasksOrigin    string
+	inspectFuncDeleteTasks   func(ctx context.Context, taskIds []string)
+	afterDeleteTasksCounter  uint64
+	beforeDeleteTasksCounter uint64
+	DeleteTasksMock          mTasksServiceMockDeleteTasks
+
+	funcListTasks          func(ctx context.Context, selector model.LabelSelector) (ta1 []model.Task, err error)
+	funcListTasksOrigin    string
+	inspectFuncListTasks   func(ctx context.Context, selector model.LabelSelector)
+	afterListTasksCounter  uint64
+	beforeListTasksCounter uint64
+	ListTasksMock          mTasksServiceMockListTasks
+
+	funcPatchTask          func(ctx context.Context, taskId string, patch model.TaskPatch) (tp1 *model.Task, err error)
+	funcPatchTaskOrigin    string
+	inspectFuncPatchTask   func(ctx context.Context, taskId string, patch model.TaskPatch)
+	afterPatchTaskCounter  uint64
+	beforePatchTaskCounter uint64
+	PatchTaskMock          mTasksServiceMockPatchTask
+
+	funcPurgeTask          func(ctx context.Context, taskId string) (err error)
+	funcPurgeTaskOrigin    string
+	inspectFuncPurgeTask   func(ctx context.Context, taskId string)
+	afterPurgeTaskCounter  uint64
+	beforePurgeTaskCounter uint64
+	PurgeTaskMock          mTasksServiceMockPurgeTask
+
+	funcRegisterTask          func(ctx context.Context, spec model.TaskSpec) (s1 string, err error)
 	funcRegisterTaskOrigin    string
-	inspectFuncRegisterTask   func(ctx context.Context, title string)
+	inspectFuncRegisterTask   func(ctx context.Context, spec model.TaskSpec)
 	afterRegisterTaskCounter  uint64
 	beforeRegisterTaskCounter uint64
 	RegisterTaskMock          mTasksServiceMockRegisterTask
 
+	funcRegisterTasks          func(ctx context.Context, specs []model.TaskSpec) (ba1 []model.BatchResult)
+	funcRegisterTasksOrigin    string
+	inspectFuncRegisterTasks   func(ctx context.Context, specs []model.TaskSpec)
+	afterRegisterTasksCounter  uint64
+	beforeRegisterTasksCounter uint64
+	RegisterTasksMock          mTasksServiceMockRegisterTasks
+
+	funcRestoreTask          func(ctx context.Context, taskId string) (tp1 *model.Task, err error)
+	funcRestoreTaskOrigin    string
+	inspectFuncRestoreTask   func(ctx context.Context, taskId string)
+	afterRestoreTaskCounter  uint64
+	beforeRestoreTaskCounter uint64
+	RestoreTaskMock          mTasksServiceMockRestoreTask
+
+	funcSearchTasks          func(ctx context.Context, query string, limit int) (ta1 []model.Task, err error)
+	funcSearchTasksOrigin    string
+	inspectFuncSearchTasks   func(ctx context.Context, query string, limit int)
+	afterSearchTasksCounter  uint64
+	beforeSearchTasksCounter uint64
+	SearchTasksMock          mTasksServiceMockSearchTasks
+
 	funcTaskInfo          func(ctx context.Context, taskId string) (tp1 *model.Task, err error)
 	funcTaskInfoOrigin    string
 	inspectFuncTaskInfo   func(ctx context.Context, taskId string)
@@ -52,9 +101,30 @@ func NewTasksServiceMock(t minimock.Tester) *TasksServiceMock {
 	m.DeleteTaskMock = mTasksServiceMockDeleteTask{mock: m}
 	m.DeleteTaskMock.callArgs = []*TasksServiceMockDeleteTaskParams{}
 
+	m.DeleteTasksMock = mTasksServiceMockDeleteTasks{mock: m}
+	m.DeleteTasksMock.callArgs = []*TasksServiceMockDeleteTasksParams{}
+
+	m.ListTasksMock = mTasksServiceMockListTasks{mock: m}
+	m.ListTasksMock.callArgs = []*TasksServiceMockListTasksParams{}
+
+	m.PatchTaskMock = mTasksServiceMockPatchTask{mock: m}
+	m.PatchTaskMock.callArgs = []*TasksServiceMockPatchTaskParams{}
+
+	m.PurgeTaskMock = mTasksServiceMockPurgeTask{mock: m}
+	m.PurgeTaskMock.callArgs = []*TasksServiceMockPurgeTaskParams{}
+
 	m.RegisterTaskMock = mTasksServiceMockRegisterTask{mock: m}
 	m.RegisterTaskMock.callArgs = []*TasksServiceMockRegisterTaskParams{}
 
+	m.RegisterTasksMock = mTasksServiceMockRegisterTasks{mock: m}
+	m.RegisterTasksMock.callArgs = []*TasksServiceMockRegisterTasksParams{}
+
+	m.RestoreTaskMock = mTasksServiceMockRestoreTask{mock: m}
+	m.RestoreTaskMock.callArgs = []*TasksServiceMockRestoreTaskParams{}
+
+	m.SearchTasksMock = mTasksServiceMockSearchTasks{mock: m}
+	m.SearchTasksMock.callArgs = []*TasksServiceMockSearchTasksParams{}
+
 	m.TaskInfoMock = mTasksServiceMockTaskInfo{mock: m}
 	m.TaskInfoMock.callArgs = []*TasksServiceMockTaskInfoParams{}
 
@@ -89,14 +159,16 @@ type TasksServiceMockDeleteTaskExpectation struct {
 
 // TasksServiceMockDeleteTaskParams contains parameters of the TasksService.DeleteTask
 type TasksServiceMockDeleteTaskParams struct {
-	ctx    context.Context
-	taskId string
+	ctx     context.Context
+	taskId  string
+	version *int64
 }
 
 // TasksServiceMockDeleteTaskParamPtrs contains pointers to parameters of the TasksService.DeleteTask
 type TasksServiceMockDeleteTaskParamPtrs struct {
-	ctx    *context.Context
-	taskId *string
+	ctx     *context.Context
+	taskId  *string
+	version **int64
 }
 
 // TasksServiceMockDeleteTaskResults contains results of the TasksService.DeleteTask
@@ -106,9 +178,10 @@ type TasksServiceMockDeleteTaskResults struct {
 
 // TasksServiceMockDeleteTaskOrigins contains origins of expectations of the TasksService.DeleteTask
 type TasksServiceMockDeleteTaskExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originTaskId string
+	origin        string
+	originCtx     string
+	originTaskId  string
+	originVersion string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -122,7 +195,7 @@ func (mmDeleteTask *mTasksServiceMockDeleteTask) Optional() *mTasksServiceMockDe
 }
 
 // Expect sets up expected params for TasksService.DeleteTask
-func (mmDeleteTask *mTasksServiceMockDeleteTask) Expect(ctx context.Context, taskId string) *mTasksServiceMockDeleteTask {
+func (mmDeleteTask *mTasksServiceMockDeleteTask) Expect(ctx context.Context, taskId string, version *int64) *mTasksServiceMockDeleteTask {
 	if mmDeleteTask.mock.funcDeleteTask != nil {
 		mmDeleteTask.mock.t.Fatalf("TasksServiceMock.DeleteTask mock is already set by Set")
 	}
@@ -135,7 +208,7 @@ func (mmDeleteTask *mTasksServiceMockDeleteTask) Expect(ctx context.Context, tas
 		mmDeleteTask.mock.t.Fatalf("TasksServiceMock.DeleteTask mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteTask.defaultExpectation.params = &TasksServiceMockDeleteTaskParams{ctx, taskId}
+	mmDeleteTask.defaultExpectation.params = &TasksServiceMockDeleteTaskParams{ctx, taskId, version}
 	mmDeleteTask.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmDeleteTask.expectations {
 		if minimock.Equal(e.params, mmDeleteTask.defaultExpectation.params) {
@@ -192,8 +265,31 @@ func (mmDeleteTask *mTasksServiceMockDeleteTask) ExpectTaskIdParam2(taskId strin
 	return mmDeleteTask
 }
 
+// ExpectVersionParam3 sets up expected param version for TasksService.DeleteTask
+func (mmDeleteTask *mTasksServiceMockDeleteTask) ExpectVersionParam3(version *int64) *mTasksServiceMockDeleteTask {
+	if mmDeleteTask.mock.funcDeleteTask != nil {
+		mmDeleteTask.mock.t.Fatalf("TasksServiceMock.DeleteTask mock is already set by Set")
+	}
+
+	if mmDeleteTask.defaultExpectation == nil {
+		mmDeleteTask.defaultExpectation = &TasksServiceMockDeleteTaskExpectation{}
+	}
+
+	if mmDeleteTask.defaultExpectation.params != nil {
+		mmDeleteTask.mock.t.Fatalf("TasksServiceMock.DeleteTask mock is already set by Expect")
+	}
+
+	if mmDeleteTask.defaultExpectation.paramPtrs == nil {
+		mmDeleteTask.defaultExpectation.paramPtrs = &TasksServiceMockDeleteTaskParamPtrs{}
+	}
+	mmDeleteTask.defaultExpectation.paramPtrs.version = &version
+	mmDeleteTask.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
+
+	return mmDeleteTask
+}
+
 // Inspect accepts an inspector function that has same arguments as the TasksService.DeleteTask
-func (mmDeleteTask *mTasksServiceMockDeleteTask) Inspect(f func(ctx context.Context, taskId string)) *mTasksServiceMockDeleteTask {
+func (mmDeleteTask *mTasksServiceMockDeleteTask) Inspect(f func(ctx context.Context, taskId string, version *int64)) *mTasksServiceMockDeleteTask {
 	if mmDeleteTask.mock.inspectFuncDeleteTask != nil {
 		mmDeleteTask.mock.t.Fatalf("Inspect function is already set for TasksServiceMock.DeleteTask")
 	}
@@ -217,241 +313,2678 @@ func (mmDeleteTask *mTasksServiceMockDeleteTask) Return(err error) *TasksService
 	return mmDeleteTask.mock
 }
 
-// Set uses given function f to mock the TasksService.DeleteTask method
-func (mmDeleteTask *mTasksServiceMockDeleteTask) Set(f func(ctx context.Context, taskId string) (err error)) *TasksServiceMock {
-	if mmDeleteTask.defaultExpectation != nil {
-		mmDeleteTask.mock.t.Fatalf("Default expectation is already set for the TasksService.DeleteTask method")
+// Set uses given function f to mock the TasksService.DeleteTask method
+func (mmDeleteTask *mTasksServiceMockDeleteTask) Set(f func(ctx context.Context, taskId string, version *int64) (err error)) *TasksServiceMock {
+	if mmDeleteTask.defaultExpectation != nil {
+		mmDeleteTask.mock.t.Fatalf("Default expectation is already set for the TasksService.DeleteTask method")
+	}
+
+	if len(mmDeleteTask.expectations) > 0 {
+		mmDeleteTask.mock.t.Fatalf("Some expectations are already set for the TasksService.DeleteTask method")
+	}
+
+	mmDeleteTask.mock.funcDeleteTask = f
+	mmDeleteTask.mock.funcDeleteTaskOrigin = minimock.CallerInfo(1)
+	return mmDeleteTask.mock
+}
+
+// When sets expectation for the TasksService.DeleteTask which will trigger the result defined by the following
+// Then helper
+func (mmDeleteTask *mTasksServiceMockDeleteTask) When(ctx context.Context, taskId string, version *int64) *TasksServiceMockDeleteTaskExpectation {
+	if mmDeleteTask.mock.funcDeleteTask != nil {
+		mmDeleteTask.mock.t.Fatalf("TasksServiceMock.DeleteTask mock is already set by Set")
+	}
+
+	expectation := &TasksServiceMockDeleteTaskExpectation{
+		mock:               mmDeleteTask.mock,
+		params:             &TasksServiceMockDeleteTaskParams{ctx, taskId, version},
+		expectationOrigins: TasksServiceMockDeleteTaskExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteTask.expectations = append(mmDeleteTask.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TasksService.DeleteTask return parameters for the expectation previously defined by the When method
+func (e *TasksServiceMockDeleteTaskExpectation) Then(err error) *TasksServiceMock {
+	e.results = &TasksServiceMockDeleteTaskResults{err}
+	return e.mock
+}
+
+// Times sets number of times TasksService.DeleteTask should be invoked
+func (mmDeleteTask *mTasksServiceMockDeleteTask) Times(n uint64) *mTasksServiceMockDeleteTask {
+	if n == 0 {
+		mmDeleteTask.mock.t.Fatalf("Times of TasksServiceMock.DeleteTask mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteTask.expectedInvocations, n)
+	mmDeleteTask.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteTask
+}
+
+func (mmDeleteTask *mTasksServiceMockDeleteTask) invocationsDone() bool {
+	if len(mmDeleteTask.expectations) == 0 && mmDeleteTask.defaultExpectation == nil && mmDeleteTask.mock.funcDeleteTask == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteTask.mock.afterDeleteTaskCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteTask.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteTask implements mm_handlers.TasksService
+func (mmDeleteTask *TasksServiceMock) DeleteTask(ctx context.Context, taskId string, version *int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteTask.beforeDeleteTaskCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteTask.afterDeleteTaskCounter, 1)
+
+	mmDeleteTask.t.Helper()
+
+	if mmDeleteTask.inspectFuncDeleteTask != nil {
+		mmDeleteTask.inspectFuncDeleteTask(ctx, taskId, version)
+	}
+
+	mm_params := TasksServiceMockDeleteTaskParams{ctx, taskId, version}
+
+	// Record call args
+	mmDeleteTask.DeleteTaskMock.mutex.Lock()
+	mmDeleteTask.DeleteTaskMock.callArgs = append(mmDeleteTask.DeleteTaskMock.callArgs, &mm_params)
+	mmDeleteTask.DeleteTaskMock.mutex.Unlock()
+
+	for _, e := range mmDeleteTask.DeleteTaskMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteTask.DeleteTaskMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteTask.DeleteTaskMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteTask.DeleteTaskMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteTask.DeleteTaskMock.defaultExpectation.paramPtrs
+
+		mm_got := TasksServiceMockDeleteTaskParams{ctx, taskId, version}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteTask.t.Errorf("TasksServiceMock.DeleteTask got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTask.DeleteTaskMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.taskId != nil && !minimock.Equal(*mm_want_ptrs.taskId, mm_got.taskId) {
+				mmDeleteTask.t.Errorf("TasksServiceMock.DeleteTask got unexpected parameter taskId, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTask.DeleteTaskMock.defaultExpectation.expectationOrigins.originTaskId, *mm_want_ptrs.taskId, mm_got.taskId, minimock.Diff(*mm_want_ptrs.taskId, mm_got.taskId))
+			}
+
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmDeleteTask.t.Errorf("TasksServiceMock.DeleteTask got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTask.DeleteTaskMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteTask.t.Errorf("TasksServiceMock.DeleteTask got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteTask.DeleteTaskMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteTask.DeleteTaskMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteTask.t.Fatal("No results are set for the TasksServiceMock.DeleteTask")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteTask.funcDeleteTask != nil {
+		return mmDeleteTask.funcDeleteTask(ctx, taskId, version)
+	}
+	mmDeleteTask.t.Fatalf("Unexpected call to TasksServiceMock.DeleteTask. %v %v %v", ctx, taskId, version)
+	return
+}
+
+// DeleteTaskAfterCounter returns a count of finished TasksServiceMock.DeleteTask invocations
+func (mmDeleteTask *TasksServiceMock) DeleteTaskAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTask.afterDeleteTaskCounter)
+}
+
+// DeleteTaskBeforeCounter returns a count of TasksServiceMock.DeleteTask invocations
+func (mmDeleteTask *TasksServiceMock) DeleteTaskBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTask.beforeDeleteTaskCounter)
+}
+
+// Calls returns a list of arguments used in each call to TasksServiceMock.DeleteTask.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteTask *mTasksServiceMockDeleteTask) Calls() []*TasksServiceMockDeleteTaskParams {
+	mmDeleteTask.mutex.RLock()
+
+	argCopy := make([]*TasksServiceMockDeleteTaskParams, len(mmDeleteTask.callArgs))
+	copy(argCopy, mmDeleteTask.callArgs)
+
+	mmDeleteTask.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteTaskDone returns true if the count of the DeleteTask invocations corresponds
+// the number of defined expectations
+func (m *TasksServiceMock) MinimockDeleteTaskDone() bool {
+	if m.DeleteTaskMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteTaskMock.invocationsDone()
+}
+
+// MinimockDeleteTaskInspect logs each unmet expectation
+func (m *TasksServiceMock) MinimockDeleteTaskInspect() {
+	for _, e := range m.DeleteTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TasksServiceMock.DeleteTask at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteTaskCounter := mm_atomic.LoadUint64(&m.afterDeleteTaskCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteTaskMock.defaultExpectation != nil && afterDeleteTaskCounter < 1 {
+		if m.DeleteTaskMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksServiceMock.DeleteTask at\n%s", m.DeleteTaskMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TasksServiceMock.DeleteTask at\n%s with params: %#v", m.DeleteTaskMock.defaultExpectation.expectationOrigins.origin, *m.DeleteTaskMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteTask != nil && afterDeleteTaskCounter < 1 {
+		m.t.Errorf("Expected call to TasksServiceMock.DeleteTask at\n%s", m.funcDeleteTaskOrigin)
+	}
+
+	if !m.DeleteTaskMock.invocationsDone() && afterDeleteTaskCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksServiceMock.DeleteTask at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteTaskMock.expectedInvocations), m.DeleteTaskMock.expectedInvocationsOrigin, afterDeleteTaskCounter)
+	}
+}
+
+type mTasksServiceMockDeleteTasks struct {
+	optional           bool
+	mock               *TasksServiceMock
+	defaultExpectation *TasksServiceMockDeleteTasksExpectation
+	expectations       []*TasksServiceMockDeleteTasksExpectation
+
+	callArgs []*TasksServiceMockDeleteTasksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TasksServiceMockDeleteTasksExpectation specifies expectation struct of the TasksService.DeleteTasks
+type TasksServiceMockDeleteTasksExpectation struct {
+	mock               *TasksServiceMock
+	params             *TasksServiceMockDeleteTasksParams
+	paramPtrs          *TasksServiceMockDeleteTasksParamPtrs
+	expectationOrigins TasksServiceMockDeleteTasksExpectationOrigins
+	results            *TasksServiceMockDeleteTasksResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TasksServiceMockDeleteTasksParams contains parameters of the TasksService.DeleteTasks
+type TasksServiceMockDeleteTasksParams struct {
+	ctx     context.Context
+	taskIds []string
+}
+
+// TasksServiceMockDeleteTasksParamPtrs contains pointers to parameters of the TasksService.DeleteTasks
+type TasksServiceMockDeleteTasksParamPtrs struct {
+	ctx     *context.Context
+	taskIds *[]string
+}
+
+// TasksServiceMockDeleteTasksResults contains results of the TasksService.DeleteTasks
+type TasksServiceMockDeleteTasksResults struct {
+	ba1 []model.BatchResult
+}
+
+// TasksServiceMockDeleteTasksOrigins contains origins of expectations of the TasksService.DeleteTasks
+type TasksServiceMockDeleteTasksExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originTaskIds string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteTasks *mTasksServiceMockDeleteTasks) Optional() *mTasksServiceMockDeleteTasks {
+	mmDeleteTasks.optional = true
+	return mmDeleteTasks
+}
+
+// Expect sets up expected params for TasksService.DeleteTasks
+func (mmDeleteTasks *mTasksServiceMockDeleteTasks) Expect(ctx context.Context, taskIds []string) *mTasksServiceMockDeleteTasks {
+	if mmDeleteTasks.mock.funcDeleteTasks != nil {
+		mmDeleteTasks.mock.t.Fatalf("TasksServiceMock.DeleteTasks mock is already set by Set")
+	}
+
+	if mmDeleteTasks.defaultExpectation == nil {
+		mmDeleteTasks.defaultExpectation = &TasksServiceMockDeleteTasksExpectation{}
+	}
+
+	if mmDeleteTasks.defaultExpectation.paramPtrs != nil {
+		mmDeleteTasks.mock.t.Fatalf("TasksServiceMock.DeleteTasks mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteTasks.defaultExpectation.params = &TasksServiceMockDeleteTasksParams{ctx, taskIds}
+	mmDeleteTasks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteTasks.expectations {
+		if minimock.Equal(e.params, mmDeleteTasks.defaultExpectation.params) {
+			mmDeleteTasks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteTasks.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteTasks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TasksService.DeleteTasks
+func (mmDeleteTasks *mTasksServiceMockDeleteTasks) ExpectCtxParam1(ctx context.Context) *mTasksServiceMockDeleteTasks {
+	if mmDeleteTasks.mock.funcDeleteTasks != nil {
+		mmDeleteTasks.mock.t.Fatalf("TasksServiceMock.DeleteTasks mock is already set by Set")
+	}
+
+	if mmDeleteTasks.defaultExpectation == nil {
+		mmDeleteTasks.defaultExpectation = &TasksServiceMockDeleteTasksExpectation{}
+	}
+
+	if mmDeleteTasks.defaultExpectation.params != nil {
+		mmDeleteTasks.mock.t.Fatalf("TasksServiceMock.DeleteTasks mock is already set by Expect")
+	}
+
+	if mmDeleteTasks.defaultExpectation.paramPtrs == nil {
+		mmDeleteTasks.defaultExpectation.paramPtrs = &TasksServiceMockDeleteTasksParamPtrs{}
+	}
+	mmDeleteTasks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteTasks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteTasks
+}
+
+// ExpectTaskIdsParam2 sets up expected param taskIds for TasksService.DeleteTasks
+func (mmDeleteTasks *mTasksServiceMockDeleteTasks) ExpectTaskIdsParam2(taskIds []string) *mTasksServiceMockDeleteTasks {
+	if mmDeleteTasks.mock.funcDeleteTasks != nil {
+		mmDeleteTasks.mock.t.Fatalf("TasksServiceMock.DeleteTasks mock is already set by Set")
+	}
+
+	if mmDeleteTasks.defaultExpectation == nil {
+		mmDeleteTasks.defaultExpectation = &TasksServiceMockDeleteTasksExpectation{}
+	}
+
+	if mmDeleteTasks.defaultExpectation.params != nil {
+		mmDeleteTasks.mock.t.Fatalf("TasksServiceMock.DeleteTasks mock is already set by Expect")
+	}
+
+	if mmDeleteTasks.defaultExpectation.paramPtrs == nil {
+		mmDeleteTasks.defaultExpectation.paramPtrs = &TasksServiceMockDeleteTasksParamPtrs{}
+	}
+	mmDeleteTasks.defaultExpectation.paramPtrs.taskIds = &taskIds
+	mmDeleteTasks.defaultExpectation.expectationOrigins.originTaskIds = minimock.CallerInfo(1)
+
+	return mmDeleteTasks
+}
+
+// Inspect accepts an inspector function that has same arguments as the TasksService.DeleteTasks
+func (mmDeleteTasks *mTasksServiceMockDeleteTasks) Inspect(f func(ctx context.Context, taskIds []string)) *mTasksServiceMockDeleteTasks {
+	if mmDeleteTasks.mock.inspectFuncDeleteTasks != nil {
+		mmDeleteTasks.mock.t.Fatalf("Inspect function is already set for TasksServiceMock.DeleteTasks")
+	}
+
+	mmDeleteTasks.mock.inspectFuncDeleteTasks = f
+
+	return mmDeleteTasks
+}
+
+// Return sets up results that will be returned by TasksService.DeleteTasks
+func (mmDeleteTasks *mTasksServiceMockDeleteTasks) Return(ba1 []model.BatchResult) *TasksServiceMock {
+	if mmDeleteTasks.mock.funcDeleteTasks != nil {
+		mmDeleteTasks.mock.t.Fatalf("TasksServiceMock.DeleteTasks mock is already set by Set")
+	}
+
+	if mmDeleteTasks.defaultExpectation == nil {
+		mmDeleteTasks.defaultExpectation = &TasksServiceMockDeleteTasksExpectation{mock: mmDeleteTasks.mock}
+	}
+	mmDeleteTasks.defaultExpectation.results = &TasksServiceMockDeleteTasksResults{ba1}
+	mmDeleteTasks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteTasks.mock
+}
+
+// Set uses given function f to mock the TasksService.DeleteTasks method
+func (mmDeleteTasks *mTasksServiceMockDeleteTasks) Set(f func(ctx context.Context, taskIds []string) (ba1 []model.BatchResult)) *TasksServiceMock {
+	if mmDeleteTasks.defaultExpectation != nil {
+		mmDeleteTasks.mock.t.Fatalf("Default expectation is already set for the TasksService.DeleteTasks method")
+	}
+
+	if len(mmDeleteTasks.expectations) > 0 {
+		mmDeleteTasks.mock.t.Fatalf("Some expectations are already set for the TasksService.DeleteTasks method")
+	}
+
+	mmDeleteTasks.mock.funcDeleteTasks = f
+	mmDeleteTasks.mock.funcDeleteTasksOrigin = minimock.CallerInfo(1)
+	return mmDeleteTasks.mock
+}
+
+// When sets expectation for the TasksService.DeleteTasks which will trigger the result defined by the following
+// Then helper
+func (mmDeleteTasks *mTasksServiceMockDeleteTasks) When(ctx context.Context, taskIds []string) *TasksServiceMockDeleteTasksExpectation {
+	if mmDeleteTasks.mock.funcDeleteTasks != nil {
+		mmDeleteTasks.mock.t.Fatalf("TasksServiceMock.DeleteTasks mock is already set by Set")
+	}
+
+	expectation := &TasksServiceMockDeleteTasksExpectation{
+		mock:               mmDeleteTasks.mock,
+		params:             &TasksServiceMockDeleteTasksParams{ctx, taskIds},
+		expectationOrigins: TasksServiceMockDeleteTasksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteTasks.expectations = append(mmDeleteTasks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TasksService.DeleteTasks return parameters for the expectation previously defined by the When method
+func (e *TasksServiceMockDeleteTasksExpectation) Then(ba1 []model.BatchResult) *TasksServiceMock {
+	e.results = &TasksServiceMockDeleteTasksResults{ba1}
+	return e.mock
+}
+
+// Times sets number of times TasksService.DeleteTasks should be invoked
+func (mmDeleteTasks *mTasksServiceMockDeleteTasks) Times(n uint64) *mTasksServiceMockDeleteTasks {
+	if n == 0 {
+		mmDeleteTasks.mock.t.Fatalf("Times of TasksServiceMock.DeleteTasks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteTasks.expectedInvocations, n)
+	mmDeleteTasks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteTasks
+}
+
+func (mmDeleteTasks *mTasksServiceMockDeleteTasks) invocationsDone() bool {
+	if len(mmDeleteTasks.expectations) == 0 && mmDeleteTasks.defaultExpectation == nil && mmDeleteTasks.mock.funcDeleteTasks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteTasks.mock.afterDeleteTasksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteTasks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteTasks implements mm_handlers.TasksService
+func (mmDeleteTasks *TasksServiceMock) DeleteTasks(ctx context.Context, taskIds []string) (ba1 []model.BatchResult) {
+	mm_atomic.AddUint64(&mmDeleteTasks.beforeDeleteTasksCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteTasks.afterDeleteTasksCounter, 1)
+
+	mmDeleteTasks.t.Helper()
+
+	if mmDeleteTasks.inspectFuncDeleteTasks != nil {
+		mmDeleteTasks.inspectFuncDeleteTasks(ctx, taskIds)
+	}
+
+	mm_params := TasksServiceMockDeleteTasksParams{ctx, taskIds}
+
+	// Record call args
+	mmDeleteTasks.DeleteTasksMock.mutex.Lock()
+	mmDeleteTasks.DeleteTasksMock.callArgs = append(mmDeleteTasks.DeleteTasksMock.callArgs, &mm_params)
+	mmDeleteTasks.DeleteTasksMock.mutex.Unlock()
+
+	for _, e := range mmDeleteTasks.DeleteTasksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ba1
+		}
+	}
+
+	if mmDeleteTasks.DeleteTasksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteTasks.DeleteTasksMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteTasks.DeleteTasksMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteTasks.DeleteTasksMock.defaultExpectation.paramPtrs
+
+		mm_got := TasksServiceMockDeleteTasksParams{ctx, taskIds}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteTasks.t.Errorf("TasksServiceMock.DeleteTasks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTasks.DeleteTasksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.taskIds != nil && !minimock.Equal(*mm_want_ptrs.taskIds, mm_got.taskIds) {
+				mmDeleteTasks.t.Errorf("TasksServiceMock.DeleteTasks got unexpected parameter taskIds, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTasks.DeleteTasksMock.defaultExpectation.expectationOrigins.originTaskIds, *mm_want_ptrs.taskIds, mm_got.taskIds, minimock.Diff(*mm_want_ptrs.taskIds, mm_got.taskIds))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteTasks.t.Errorf("TasksServiceMock.DeleteTasks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteTasks.DeleteTasksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteTasks.DeleteTasksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteTasks.t.Fatal("No results are set for the TasksServiceMock.DeleteTasks")
+		}
+		return (*mm_results).ba1
+	}
+	if mmDeleteTasks.funcDeleteTasks != nil {
+		return mmDeleteTasks.funcDeleteTasks(ctx, taskIds)
+	}
+	mmDeleteTasks.t.Fatalf("Unexpected call to TasksServiceMock.DeleteTasks. %v %v", ctx, taskIds)
+	return
+}
+
+// DeleteTasksAfterCounter returns a count of finished TasksServiceMock.DeleteTasks invocations
+func (mmDeleteTasks *TasksServiceMock) DeleteTasksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTasks.afterDeleteTasksCounter)
+}
+
+// DeleteTasksBeforeCounter returns a count of TasksServiceMock.DeleteTasks invocations
+func (mmDeleteTasks *TasksServiceMock) DeleteTasksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTasks.beforeDeleteTasksCounter)
+}
+
+// Calls returns a list of arguments used in each call to TasksServiceMock.DeleteTasks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteTasks *mTasksServiceMockDeleteTasks) Calls() []*TasksServiceMockDeleteTasksParams {
+	mmDeleteTasks.mutex.RLock()
+
+	argCopy := make([]*TasksServiceMockDeleteTasksParams, len(mmDeleteTasks.callArgs))
+	copy(argCopy, mmDeleteTasks.callArgs)
+
+	mmDeleteTasks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteTasksDone returns true if the count of the DeleteTasks invocations corresponds
+// the number of defined expectations
+func (m *TasksServiceMock) MinimockDeleteTasksDone() bool {
+	if m.DeleteTasksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteTasksMock.invocationsDone()
+}
+
+// MinimockDeleteTasksInspect logs each unmet expectation
+func (m *TasksServiceMock) MinimockDeleteTasksInspect() {
+	for _, e := range m.DeleteTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TasksServiceMock.DeleteTasks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteTasksCounter := mm_atomic.LoadUint64(&m.afterDeleteTasksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteTasksMock.defaultExpectation != nil && afterDeleteTasksCounter < 1 {
+		if m.DeleteTasksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksServiceMock.DeleteTasks at\n%s", m.DeleteTasksMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TasksServiceMock.DeleteTasks at\n%s with params: %#v", m.DeleteTasksMock.defaultExpectation.expectationOrigins.origin, *m.DeleteTasksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteTasks != nil && afterDeleteTasksCounter < 1 {
+		m.t.Errorf("Expected call to TasksServiceMock.DeleteTasks at\n%s", m.funcDeleteTasksOrigin)
+	}
+
+	if !m.DeleteTasksMock.invocationsDone() && afterDeleteTasksCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksServiceMock.DeleteTasks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteTasksMock.expectedInvocations), m.DeleteTasksMock.expectedInvocationsOrigin, afterDeleteTasksCounter)
+	}
+}
+
+type mTasksServiceMockListTasks struct {
+	optional           bool
+	mock               *TasksServiceMock
+	defaultExpectation *TasksServiceMockListTasksExpectation
+	expectations       []*TasksServiceMockListTasksExpectation
+
+	callArgs []*TasksServiceMockListTasksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TasksServiceMockListTasksExpectation specifies expectation struct of the TasksService.ListTasks
+type TasksServiceMockListTasksExpectation struct {
+	mock               *TasksServiceMock
+	params             *TasksServiceMockListTasksParams
+	paramPtrs          *TasksServiceMockListTasksParamPtrs
+	expectationOrigins TasksServiceMockListTasksExpectationOrigins
+	results            *TasksServiceMockListTasksResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TasksServiceMockListTasksParams contains parameters of the TasksService.ListTasks
+type TasksServiceMockListTasksParams struct {
+	ctx      context.Context
+	selector model.LabelSelector
+}
+
+// TasksServiceMockListTasksParamPtrs contains pointers to parameters of the TasksService.ListTasks
+type TasksServiceMockListTasksParamPtrs struct {
+	ctx      *context.Context
+	selector *model.LabelSelector
+}
+
+// TasksServiceMockListTasksResults contains results of the TasksService.ListTasks
+type TasksServiceMockListTasksResults struct {
+	ta1 []model.Task
+	err error
+}
+
+// TasksServiceMockListTasksOrigins contains origins of expectations of the TasksService.ListTasks
+type TasksServiceMockListTasksExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originSelector string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListTasks *mTasksServiceMockListTasks) Optional() *mTasksServiceMockListTasks {
+	mmListTasks.optional = true
+	return mmListTasks
+}
+
+// Expect sets up expected params for TasksService.ListTasks
+func (mmListTasks *mTasksServiceMockListTasks) Expect(ctx context.Context, selector model.LabelSelector) *mTasksServiceMockListTasks {
+	if mmListTasks.mock.funcListTasks != nil {
+		mmListTasks.mock.t.Fatalf("TasksServiceMock.ListTasks mock is already set by Set")
+	}
+
+	if mmListTasks.defaultExpectation == nil {
+		mmListTasks.defaultExpectation = &TasksServiceMockListTasksExpectation{}
+	}
+
+	if mmListTasks.defaultExpectation.paramPtrs != nil {
+		mmListTasks.mock.t.Fatalf("TasksServiceMock.ListTasks mock is already set by ExpectParams functions")
+	}
+
+	mmListTasks.defaultExpectation.params = &TasksServiceMockListTasksParams{ctx, selector}
+	mmListTasks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListTasks.expectations {
+		if minimock.Equal(e.params, mmListTasks.defaultExpectation.params) {
+			mmListTasks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListTasks.defaultExpectation.params)
+		}
+	}
+
+	return mmListTasks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TasksService.ListTasks
+func (mmListTasks *mTasksServiceMockListTasks) ExpectCtxParam1(ctx context.Context) *mTasksServiceMockListTasks {
+	if mmListTasks.mock.funcListTasks != nil {
+		mmListTasks.mock.t.Fatalf("TasksServiceMock.ListTasks mock is already set by Set")
+	}
+
+	if mmListTasks.defaultExpectation == nil {
+		mmListTasks.defaultExpectation = &TasksServiceMockListTasksExpectation{}
+	}
+
+	if mmListTasks.defaultExpectation.params != nil {
+		mmListTasks.mock.t.Fatalf("TasksServiceMock.ListTasks mock is already set by Expect")
+	}
+
+	if mmListTasks.defaultExpectation.paramPtrs == nil {
+		mmListTasks.defaultExpectation.paramPtrs = &TasksServiceMockListTasksParamPtrs{}
+	}
+	mmListTasks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListTasks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListTasks
+}
+
+// ExpectSelectorParam2 sets up expected param selector for TasksService.ListTasks
+func (mmListTasks *mTasksServiceMockListTasks) ExpectSelectorParam2(selector model.LabelSelector) *mTasksServiceMockListTasks {
+	if mmListTasks.mock.funcListTasks != nil {
+		mmListTasks.mock.t.Fatalf("TasksServiceMock.ListTasks mock is already set by Set")
+	}
+
+	if mmListTasks.defaultExpectation == nil {
+		mmListTasks.defaultExpectation = &TasksServiceMockListTasksExpectation{}
+	}
+
+	if mmListTasks.defaultExpectation.params != nil {
+		mmListTasks.mock.t.Fatalf("TasksServiceMock.ListTasks mock is already set by Expect")
+	}
+
+	if mmListTasks.defaultExpectation.paramPtrs == nil {
+		mmListTasks.defaultExpectation.paramPtrs = &TasksServiceMockListTasksParamPtrs{}
+	}
+	mmListTasks.defaultExpectation.paramPtrs.selector = &selector
+	mmListTasks.defaultExpectation.expectationOrigins.originSelector = minimock.CallerInfo(1)
+
+	return mmListTasks
+}
+
+// Inspect accepts an inspector function that has same arguments as the TasksService.ListTasks
+func (mmListTasks *mTasksServiceMockListTasks) Inspect(f func(ctx context.Context, selector model.LabelSelector)) *mTasksServiceMockListTasks {
+	if mmListTasks.mock.inspectFuncListTasks != nil {
+		mmListTasks.mock.t.Fatalf("Inspect function is already set for TasksServiceMock.ListTasks")
+	}
+
+	mmListTasks.mock.inspectFuncListTasks = f
+
+	return mmListTasks
+}
+
+// Return sets up results that will be returned by TasksService.ListTasks
+func (mmListTasks *mTasksServiceMockListTasks) Return(ta1 []model.Task, err error) *TasksServiceMock {
+	if mmListTasks.mock.funcListTasks != nil {
+		mmListTasks.mock.t.Fatalf("TasksServiceMock.ListTasks mock is already set by Set")
+	}
+
+	if mmListTasks.defaultExpectation == nil {
+		mmListTasks.defaultExpectation = &TasksServiceMockListTasksExpectation{mock: mmListTasks.mock}
+	}
+	mmListTasks.defaultExpectation.results = &TasksServiceMockListTasksResults{ta1, err}
+	mmListTasks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListTasks.mock
+}
+
+// Set uses given function f to mock the TasksService.ListTasks method
+func (mmListTasks *mTasksServiceMockListTasks) Set(f func(ctx context.Context, selector model.LabelSelector) (ta1 []model.Task, err error)) *TasksServiceMock {
+	if mmListTasks.defaultExpectation != nil {
+		mmListTasks.mock.t.Fatalf("Default expectation is already set for the TasksService.ListTasks method")
+	}
+
+	if len(mmListTasks.expectations) > 0 {
+		mmListTasks.mock.t.Fatalf("Some expectations are already set for the TasksService.ListTasks method")
+	}
+
+	mmListTasks.mock.funcListTasks = f
+	mmListTasks.mock.funcListTasksOrigin = minimock.CallerInfo(1)
+	return mmListTasks.mock
+}
+
+// When sets expectation for the TasksService.ListTasks which will trigger the result defined by the following
+// Then helper
+func (mmListTasks *mTasksServiceMockListTasks) When(ctx context.Context, selector model.LabelSelector) *TasksServiceMockListTasksExpectation {
+	if mmListTasks.mock.funcListTasks != nil {
+		mmListTasks.mock.t.Fatalf("TasksServiceMock.ListTasks mock is already set by Set")
+	}
+
+	expectation := &TasksServiceMockListTasksExpectation{
+		mock:               mmListTasks.mock,
+		params:             &TasksServiceMockListTasksParams{ctx, selector},
+		expectationOrigins: TasksServiceMockListTasksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListTasks.expectations = append(mmListTasks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TasksService.ListTasks return parameters for the expectation previously defined by the When method
+func (e *TasksServiceMockListTasksExpectation) Then(ta1 []model.Task, err error) *TasksServiceMock {
+	e.results = &TasksServiceMockListTasksResults{ta1, err}
+	return e.mock
+}
+
+// Times sets number of times TasksService.ListTasks should be invoked
+func (mmListTasks *mTasksServiceMockListTasks) Times(n uint64) *mTasksServiceMockListTasks {
+	if n == 0 {
+		mmListTasks.mock.t.Fatalf("Times of TasksServiceMock.ListTasks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListTasks.expectedInvocations, n)
+	mmListTasks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListTasks
+}
+
+func (mmListTasks *mTasksServiceMockListTasks) invocationsDone() bool {
+	if len(mmListTasks.expectations) == 0 && mmListTasks.defaultExpectation == nil && mmListTasks.mock.funcListTasks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListTasks.mock.afterListTasksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListTasks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListTasks implements mm_handlers.TasksService
+func (mmListTasks *TasksServiceMock) ListTasks(ctx context.Context, selector model.LabelSelector) (ta1 []model.Task, err error) {
+	mm_atomic.AddUint64(&mmListTasks.beforeListTasksCounter, 1)
+	defer mm_atomic.AddUint64(&mmListTasks.afterListTasksCounter, 1)
+
+	mmListTasks.t.Helper()
+
+	if mmListTasks.inspectFuncListTasks != nil {
+		mmListTasks.inspectFuncListTasks(ctx, selector)
+	}
+
+	mm_params := TasksServiceMockListTasksParams{ctx, selector}
+
+	// Record call args
+	mmListTasks.ListTasksMock.mutex.Lock()
+	mmListTasks.ListTasksMock.callArgs = append(mmListTasks.ListTasksMock.callArgs, &mm_params)
+	mmListTasks.ListTasksMock.mutex.Unlock()
+
+	for _, e := range mmListTasks.ListTasksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ta1, e.results.err
+		}
+	}
+
+	if mmListTasks.ListTasksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListTasks.ListTasksMock.defaultExpectation.Counter, 1)
+		mm_want := mmListTasks.ListTasksMock.defaultExpectation.params
+		mm_want_ptrs := mmListTasks.ListTasksMock.defaultExpectation.paramPtrs
+
+		mm_got := TasksServiceMockListTasksParams{ctx, selector}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListTasks.t.Errorf("TasksServiceMock.ListTasks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListTasks.ListTasksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.selector != nil && !minimock.Equal(*mm_want_ptrs.selector, mm_got.selector) {
+				mmListTasks.t.Errorf("TasksServiceMock.ListTasks got unexpected parameter selector, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListTasks.ListTasksMock.defaultExpectation.expectationOrigins.originSelector, *mm_want_ptrs.selector, mm_got.selector, minimock.Diff(*mm_want_ptrs.selector, mm_got.selector))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListTasks.t.Errorf("TasksServiceMock.ListTasks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListTasks.ListTasksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListTasks.ListTasksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListTasks.t.Fatal("No results are set for the TasksServiceMock.ListTasks")
+		}
+		return (*mm_results).ta1, (*mm_results).err
+	}
+	if mmListTasks.funcListTasks != nil {
+		return mmListTasks.funcListTasks(ctx, selector)
+	}
+	mmListTasks.t.Fatalf("Unexpected call to TasksServiceMock.ListTasks. %v %v", ctx, selector)
+	return
+}
+
+// ListTasksAfterCounter returns a count of finished TasksServiceMock.ListTasks invocations
+func (mmListTasks *TasksServiceMock) ListTasksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListTasks.afterListTasksCounter)
+}
+
+// ListTasksBeforeCounter returns a count of TasksServiceMock.ListTasks invocations
+func (mmListTasks *TasksServiceMock) ListTasksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListTasks.beforeListTasksCounter)
+}
+
+// Calls returns a list of arguments used in each call to TasksServiceMock.ListTasks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListTasks *mTasksServiceMockListTasks) Calls() []*TasksServiceMockListTasksParams {
+	mmListTasks.mutex.RLock()
+
+	argCopy := make([]*TasksServiceMockListTasksParams, len(mmListTasks.callArgs))
+	copy(argCopy, mmListTasks.callArgs)
+
+	mmListTasks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListTasksDone returns true if the count of the ListTasks invocations corresponds
+// the number of defined expectations
+func (m *TasksServiceMock) MinimockListTasksDone() bool {
+	if m.ListTasksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListTasksMock.invocationsDone()
+}
+
+// MinimockListTasksInspect logs each unmet expectation
+func (m *TasksServiceMock) MinimockListTasksInspect() {
+	for _, e := range m.ListTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TasksServiceMock.ListTasks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListTasksCounter := mm_atomic.LoadUint64(&m.afterListTasksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListTasksMock.defaultExpectation != nil && afterListTasksCounter < 1 {
+		if m.ListTasksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksServiceMock.ListTasks at\n%s", m.ListTasksMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TasksServiceMock.ListTasks at\n%s with params: %#v", m.ListTasksMock.defaultExpectation.expectationOrigins.origin, *m.ListTasksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListTasks != nil && afterListTasksCounter < 1 {
+		m.t.Errorf("Expected call to TasksServiceMock.ListTasks at\n%s", m.funcListTasksOrigin)
+	}
+
+	if !m.ListTasksMock.invocationsDone() && afterListTasksCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksServiceMock.ListTasks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListTasksMock.expectedInvocations), m.ListTasksMock.expectedInvocationsOrigin, afterListTasksCounter)
+	}
+}
+
+type mTasksServiceMockPatchTask struct {
+	optional           bool
+	mock               *TasksServiceMock
+	defaultExpectation *TasksServiceMockPatchTaskExpectation
+	expectations       []*TasksServiceMockPatchTaskExpectation
+
+	callArgs []*TasksServiceMockPatchTaskParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TasksServiceMockPatchTaskExpectation specifies expectation struct of the TasksService.PatchTask
+type TasksServiceMockPatchTaskExpectation struct {
+	mock               *TasksServiceMock
+	params             *TasksServiceMockPatchTaskParams
+	paramPtrs          *TasksServiceMockPatchTaskParamPtrs
+	expectationOrigins TasksServiceMockPatchTaskExpectationOrigins
+	results            *TasksServiceMockPatchTaskResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TasksServiceMockPatchTaskParams contains parameters of the TasksService.PatchTask
+type TasksServiceMockPatchTaskParams struct {
+	ctx    context.Context
+	taskId string
+	patch  model.TaskPatch
+}
+
+// TasksServiceMockPatchTaskParamPtrs contains pointers to parameters of the TasksService.PatchTask
+type TasksServiceMockPatchTaskParamPtrs struct {
+	ctx    *context.Context
+	taskId *string
+	patch  *model.TaskPatch
+}
+
+// TasksServiceMockPatchTaskResults contains results of the TasksService.PatchTask
+type TasksServiceMockPatchTaskResults struct {
+	tp1 *model.Task
+	err error
+}
+
+// TasksServiceMockPatchTaskOrigins contains origins of expectations of the TasksService.PatchTask
+type TasksServiceMockPatchTaskExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originTaskId string
+	originPatch  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPatchTask *mTasksServiceMockPatchTask) Optional() *mTasksServiceMockPatchTask {
+	mmPatchTask.optional = true
+	return mmPatchTask
+}
+
+// Expect sets up expected params for TasksService.PatchTask
+func (mmPatchTask *mTasksServiceMockPatchTask) Expect(ctx context.Context, taskId string, patch model.TaskPatch) *mTasksServiceMockPatchTask {
+	if mmPatchTask.mock.funcPatchTask != nil {
+		mmPatchTask.mock.t.Fatalf("TasksServiceMock.PatchTask mock is already set by Set")
+	}
+
+	if mmPatchTask.defaultExpectation == nil {
+		mmPatchTask.defaultExpectation = &TasksServiceMockPatchTaskExpectation{}
+	}
+
+	if mmPatchTask.defaultExpectation.paramPtrs != nil {
+		mmPatchTask.mock.t.Fatalf("TasksServiceMock.PatchTask mock is already set by ExpectParams functions")
+	}
+
+	mmPatchTask.defaultExpectation.params = &TasksServiceMockPatchTaskParams{ctx, taskId, patch}
+	mmPatchTask.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPatchTask.expectations {
+		if minimock.Equal(e.params, mmPatchTask.defaultExpectation.params) {
+			mmPatchTask.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPatchTask.defaultExpectation.params)
+		}
+	}
+
+	return mmPatchTask
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TasksService.PatchTask
+func (mmPatchTask *mTasksServiceMockPatchTask) ExpectCtxParam1(ctx context.Context) *mTasksServiceMockPatchTask {
+	if mmPatchTask.mock.funcPatchTask != nil {
+		mmPatchTask.mock.t.Fatalf("TasksServiceMock.PatchTask mock is already set by Set")
+	}
+
+	if mmPatchTask.defaultExpectation == nil {
+		mmPatchTask.defaultExpectation = &TasksServiceMockPatchTaskExpectation{}
+	}
+
+	if mmPatchTask.defaultExpectation.params != nil {
+		mmPatchTask.mock.t.Fatalf("TasksServiceMock.PatchTask mock is already set by Expect")
+	}
+
+	if mmPatchTask.defaultExpectation.paramPtrs == nil {
+		mmPatchTask.defaultExpectation.paramPtrs = &TasksServiceMockPatchTaskParamPtrs{}
+	}
+	mmPatchTask.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPatchTask.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPatchTask
+}
+
+// ExpectTaskIdParam2 sets up expected param taskId for TasksService.PatchTask
+func (mmPatchTask *mTasksServiceMockPatchTask) ExpectTaskIdParam2(taskId string) *mTasksServiceMockPatchTask {
+	if mmPatchTask.mock.funcPatchTask != nil {
+		mmPatchTask.mock.t.Fatalf("TasksServiceMock.PatchTask mock is already set by Set")
+	}
+
+	if mmPatchTask.defaultExpectation == nil {
+		mmPatchTask.defaultExpectation = &TasksServiceMockPatchTaskExpectation{}
+	}
+
+	if mmPatchTask.defaultExpectation.params != nil {
+		mmPatchTask.mock.t.Fatalf("TasksServiceMock.PatchTask mock is already set by Expect")
+	}
+
+	if mmPatchTask.defaultExpectation.paramPtrs == nil {
+		mmPatchTask.defaultExpectation.paramPtrs = &TasksServiceMockPatchTaskParamPtrs{}
+	}
+	mmPatchTask.defaultExpectation.paramPtrs.taskId = &taskId
+	mmPatchTask.defaultExpectation.expectationOrigins.originTaskId = minimock.CallerInfo(1)
+
+	return mmPatchTask
+}
+
+// ExpectPatchParam3 sets up expected param patch for TasksService.PatchTask
+func (mmPatchTask *mTasksServiceMockPatchTask) ExpectPatchParam3(patch model.TaskPatch) *mTasksServiceMockPatchTask {
+	if mmPatchTask.mock.funcPatchTask != nil {
+		mmPatchTask.mock.t.Fatalf("TasksServiceMock.PatchTask mock is already set by Set")
+	}
+
+	if mmPatchTask.defaultExpectation == nil {
+		mmPatchTask.defaultExpectation = &TasksServiceMockPatchTaskExpectation{}
+	}
+
+	if mmPatchTask.defaultExpectation.params != nil {
+		mmPatchTask.mock.t.Fatalf("TasksServiceMock.PatchTask mock is already set by Expect")
+	}
+
+	if mmPatchTask.defaultExpectation.paramPtrs == nil {
+		mmPatchTask.defaultExpectation.paramPtrs = &TasksServiceMockPatchTaskParamPtrs{}
+	}
+	mmPatchTask.defaultExpectation.paramPtrs.patch = &patch
+	mmPatchTask.defaultExpectation.expectationOrigins.originPatch = minimock.CallerInfo(1)
+
+	return mmPatchTask
+}
+
+// Inspect accepts an inspector function that has same arguments as the TasksService.PatchTask
+func (mmPatchTask *mTasksServiceMockPatchTask) Inspect(f func(ctx context.Context, taskId string, patch model.TaskPatch)) *mTasksServiceMockPatchTask {
+	if mmPatchTask.mock.inspectFuncPatchTask != nil {
+		mmPatchTask.mock.t.Fatalf("Inspect function is already set for TasksServiceMock.PatchTask")
+	}
+
+	mmPatchTask.mock.inspectFuncPatchTask = f
+
+	return mmPatchTask
+}
+
+// Return sets up results that will be returned by TasksService.PatchTask
+func (mmPatchTask *mTasksServiceMockPatchTask) Return(tp1 *model.Task, err error) *TasksServiceMock {
+	if mmPatchTask.mock.funcPatchTask != nil {
+		mmPatchTask.mock.t.Fatalf("TasksServiceMock.PatchTask mock is already set by Set")
+	}
+
+	if mmPatchTask.defaultExpectation == nil {
+		mmPatchTask.defaultExpectation = &TasksServiceMockPatchTaskExpectation{mock: mmPatchTask.mock}
+	}
+	mmPatchTask.defaultExpectation.results = &TasksServiceMockPatchTaskResults{tp1, err}
+	mmPatchTask.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPatchTask.mock
+}
+
+// Set uses given function f to mock the TasksService.PatchTask method
+func (mmPatchTask *mTasksServiceMockPatchTask) Set(f func(ctx context.Context, taskId string, patch model.TaskPatch) (tp1 *model.Task, err error)) *TasksServiceMock {
+	if mmPatchTask.defaultExpectation != nil {
+		mmPatchTask.mock.t.Fatalf("Default expectation is already set for the TasksService.PatchTask method")
+	}
+
+	if len(mmPatchTask.expectations) > 0 {
+		mmPatchTask.mock.t.Fatalf("Some expectations are already set for the TasksService.PatchTask method")
+	}
+
+	mmPatchTask.mock.funcPatchTask = f
+	mmPatchTask.mock.funcPatchTaskOrigin = minimock.CallerInfo(1)
+	return mmPatchTask.mock
+}
+
+// When sets expectation for the TasksService.PatchTask which will trigger the result defined by the following
+// Then helper
+func (mmPatchTask *mTasksServiceMockPatchTask) When(ctx context.Context, taskId string, patch model.TaskPatch) *TasksServiceMockPatchTaskExpectation {
+	if mmPatchTask.mock.funcPatchTask != nil {
+		mmPatchTask.mock.t.Fatalf("TasksServiceMock.PatchTask mock is already set by Set")
+	}
+
+	expectation := &TasksServiceMockPatchTaskExpectation{
+		mock:               mmPatchTask.mock,
+		params:             &TasksServiceMockPatchTaskParams{ctx, taskId, patch},
+		expectationOrigins: TasksServiceMockPatchTaskExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPatchTask.expectations = append(mmPatchTask.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TasksService.PatchTask return parameters for the expectation previously defined by the When method
+func (e *TasksServiceMockPatchTaskExpectation) Then(tp1 *model.Task, err error) *TasksServiceMock {
+	e.results = &TasksServiceMockPatchTaskResults{tp1, err}
+	return e.mock
+}
+
+// Times sets number of times TasksService.PatchTask should be invoked
+func (mmPatchTask *mTasksServiceMockPatchTask) Times(n uint64) *mTasksServiceMockPatchTask {
+	if n == 0 {
+		mmPatchTask.mock.t.Fatalf("Times of TasksServiceMock.PatchTask mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPatchTask.expectedInvocations, n)
+	mmPatchTask.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPatchTask
+}
+
+func (mmPatchTask *mTasksServiceMockPatchTask) invocationsDone() bool {
+	if len(mmPatchTask.expectations) == 0 && mmPatchTask.defaultExpectation == nil && mmPatchTask.mock.funcPatchTask == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPatchTask.mock.afterPatchTaskCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPatchTask.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PatchTask implements mm_handlers.TasksService
+func (mmPatchTask *TasksServiceMock) PatchTask(ctx context.Context, taskId string, patch model.TaskPatch) (tp1 *model.Task, err error) {
+	mm_atomic.AddUint64(&mmPatchTask.beforePatchTaskCounter, 1)
+	defer mm_atomic.AddUint64(&mmPatchTask.afterPatchTaskCounter, 1)
+
+	mmPatchTask.t.Helper()
+
+	if mmPatchTask.inspectFuncPatchTask != nil {
+		mmPatchTask.inspectFuncPatchTask(ctx, taskId, patch)
+	}
+
+	mm_params := TasksServiceMockPatchTaskParams{ctx, taskId, patch}
+
+	// Record call args
+	mmPatchTask.PatchTaskMock.mutex.Lock()
+	mmPatchTask.PatchTaskMock.callArgs = append(mmPatchTask.PatchTaskMock.callArgs, &mm_params)
+	mmPatchTask.PatchTaskMock.mutex.Unlock()
+
+	for _, e := range mmPatchTask.PatchTaskMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tp1, e.results.err
+		}
+	}
+
+	if mmPatchTask.PatchTaskMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPatchTask.PatchTaskMock.defaultExpectation.Counter, 1)
+		mm_want := mmPatchTask.PatchTaskMock.defaultExpectation.params
+		mm_want_ptrs := mmPatchTask.PatchTaskMock.defaultExpectation.paramPtrs
+
+		mm_got := TasksServiceMockPatchTaskParams{ctx, taskId, patch}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPatchTask.t.Errorf("TasksServiceMock.PatchTask got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchTask.PatchTaskMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.taskId != nil && !minimock.Equal(*mm_want_ptrs.taskId, mm_got.taskId) {
+				mmPatchTask.t.Errorf("TasksServiceMock.PatchTask got unexpected parameter taskId, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchTask.PatchTaskMock.defaultExpectation.expectationOrigins.originTaskId, *mm_want_ptrs.taskId, mm_got.taskId, minimock.Diff(*mm_want_ptrs.taskId, mm_got.taskId))
+			}
+
+			if mm_want_ptrs.patch != nil && !minimock.Equal(*mm_want_ptrs.patch, mm_got.patch) {
+				mmPatchTask.t.Errorf("TasksServiceMock.PatchTask got unexpected parameter patch, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchTask.PatchTaskMock.defaultExpectation.expectationOrigins.originPatch, *mm_want_ptrs.patch, mm_got.patch, minimock.Diff(*mm_want_ptrs.patch, mm_got.patch))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPatchTask.t.Errorf("TasksServiceMock.PatchTask got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPatchTask.PatchTaskMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPatchTask.PatchTaskMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPatchTask.t.Fatal("No results are set for the TasksServiceMock.PatchTask")
+		}
+		return (*mm_results).tp1, (*mm_results).err
+	}
+	if mmPatchTask.funcPatchTask != nil {
+		return mmPatchTask.funcPatchTask(ctx, taskId, patch)
+	}
+	mmPatchTask.t.Fatalf("Unexpected call to TasksServiceMock.PatchTask. %v %v %v", ctx, taskId, patch)
+	return
+}
+
+// PatchTaskAfterCounter returns a count of finished TasksServiceMock.PatchTask invocations
+func (mmPatchTask *TasksServiceMock) PatchTaskAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPatchTask.afterPatchTaskCounter)
+}
+
+// PatchTaskBeforeCounter returns a count of TasksServiceMock.PatchTask invocations
+func (mmPatchTask *TasksServiceMock) PatchTaskBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPatchTask.beforePatchTaskCounter)
+}
+
+// Calls returns a list of arguments used in each call to TasksServiceMock.PatchTask.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPatchTask *mTasksServiceMockPatchTask) Calls() []*TasksServiceMockPatchTaskParams {
+	mmPatchTask.mutex.RLock()
+
+	argCopy := make([]*TasksServiceMockPatchTaskParams, len(mmPatchTask.callArgs))
+	copy(argCopy, mmPatchTask.callArgs)
+
+	mmPatchTask.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPatchTaskDone returns true if the count of the PatchTask invocations corresponds
+// the number of defined expectations
+func (m *TasksServiceMock) MinimockPatchTaskDone() bool {
+	if m.PatchTaskMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PatchTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PatchTaskMock.invocationsDone()
+}
+
+// MinimockPatchTaskInspect logs each unmet expectation
+func (m *TasksServiceMock) MinimockPatchTaskInspect() {
+	for _, e := range m.PatchTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TasksServiceMock.PatchTask at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPatchTaskCounter := mm_atomic.LoadUint64(&m.afterPatchTaskCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PatchTaskMock.defaultExpectation != nil && afterPatchTaskCounter < 1 {
+		if m.PatchTaskMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksServiceMock.PatchTask at\n%s", m.PatchTaskMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TasksServiceMock.PatchTask at\n%s with params: %#v", m.PatchTaskMock.defaultExpectation.expectationOrigins.origin, *m.PatchTaskMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPatchTask != nil && afterPatchTaskCounter < 1 {
+		m.t.Errorf("Expected call to TasksServiceMock.PatchTask at\n%s", m.funcPatchTaskOrigin)
+	}
+
+	if !m.PatchTaskMock.invocationsDone() && afterPatchTaskCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksServiceMock.PatchTask at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PatchTaskMock.expectedInvocations), m.PatchTaskMock.expectedInvocationsOrigin, afterPatchTaskCounter)
+	}
+}
+
+type mTasksServiceMockPurgeTask struct {
+	optional           bool
+	mock               *TasksServiceMock
+	defaultExpectation *TasksServiceMockPurgeTaskExpectation
+	expectations       []*TasksServiceMockPurgeTaskExpectation
+
+	callArgs []*TasksServiceMockPurgeTaskParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TasksServiceMockPurgeTaskExpectation specifies expectation struct of the TasksService.PurgeTask
+type TasksServiceMockPurgeTaskExpectation struct {
+	mock               *TasksServiceMock
+	params             *TasksServiceMockPurgeTaskParams
+	paramPtrs          *TasksServiceMockPurgeTaskParamPtrs
+	expectationOrigins TasksServiceMockPurgeTaskExpectationOrigins
+	results            *TasksServiceMockPurgeTaskResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TasksServiceMockPurgeTaskParams contains parameters of the TasksService.PurgeTask
+type TasksServiceMockPurgeTaskParams struct {
+	ctx    context.Context
+	taskId string
+}
+
+// TasksServiceMockPurgeTaskParamPtrs contains pointers to parameters of the TasksService.PurgeTask
+type TasksServiceMockPurgeTaskParamPtrs struct {
+	ctx    *context.Context
+	taskId *string
+}
+
+// TasksServiceMockPurgeTaskResults contains results of the TasksService.PurgeTask
+type TasksServiceMockPurgeTaskResults struct {
+	err error
+}
+
+// TasksServiceMockPurgeTaskOrigins contains origins of expectations of the TasksService.PurgeTask
+type TasksServiceMockPurgeTaskExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originTaskId string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeTask *mTasksServiceMockPurgeTask) Optional() *mTasksServiceMockPurgeTask {
+	mmPurgeTask.optional = true
+	return mmPurgeTask
+}
+
+// Expect sets up expected params for TasksService.PurgeTask
+func (mmPurgeTask *mTasksServiceMockPurgeTask) Expect(ctx context.Context, taskId string) *mTasksServiceMockPurgeTask {
+	if mmPurgeTask.mock.funcPurgeTask != nil {
+		mmPurgeTask.mock.t.Fatalf("TasksServiceMock.PurgeTask mock is already set by Set")
+	}
+
+	if mmPurgeTask.defaultExpectation == nil {
+		mmPurgeTask.defaultExpectation = &TasksServiceMockPurgeTaskExpectation{}
+	}
+
+	if mmPurgeTask.defaultExpectation.paramPtrs != nil {
+		mmPurgeTask.mock.t.Fatalf("TasksServiceMock.PurgeTask mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeTask.defaultExpectation.params = &TasksServiceMockPurgeTaskParams{ctx, taskId}
+	mmPurgeTask.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeTask.expectations {
+		if minimock.Equal(e.params, mmPurgeTask.defaultExpectation.params) {
+			mmPurgeTask.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeTask.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeTask
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TasksService.PurgeTask
+func (mmPurgeTask *mTasksServiceMockPurgeTask) ExpectCtxParam1(ctx context.Context) *mTasksServiceMockPurgeTask {
+	if mmPurgeTask.mock.funcPurgeTask != nil {
+		mmPurgeTask.mock.t.Fatalf("TasksServiceMock.PurgeTask mock is already set by Set")
+	}
+
+	if mmPurgeTask.defaultExpectation == nil {
+		mmPurgeTask.defaultExpectation = &TasksServiceMockPurgeTaskExpectation{}
+	}
+
+	if mmPurgeTask.defaultExpectation.params != nil {
+		mmPurgeTask.mock.t.Fatalf("TasksServiceMock.PurgeTask mock is already set by Expect")
+	}
+
+	if mmPurgeTask.defaultExpectation.paramPtrs == nil {
+		mmPurgeTask.defaultExpectation.paramPtrs = &TasksServiceMockPurgeTaskParamPtrs{}
+	}
+	mmPurgeTask.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeTask.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeTask
+}
+
+// ExpectTaskIdParam2 sets up expected param taskId for TasksService.PurgeTask
+func (mmPurgeTask *mTasksServiceMockPurgeTask) ExpectTaskIdParam2(taskId string) *mTasksServiceMockPurgeTask {
+	if mmPurgeTask.mock.funcPurgeTask != nil {
+		mmPurgeTask.mock.t.Fatalf("TasksServiceMock.PurgeTask mock is already set by Set")
+	}
+
+	if mmPurgeTask.defaultExpectation == nil {
+		mmPurgeTask.defaultExpectation = &TasksServiceMockPurgeTaskExpectation{}
+	}
+
+	if mmPurgeTask.defaultExpectation.params != nil {
+		mmPurgeTask.mock.t.Fatalf("TasksServiceMock.PurgeTask mock is already set by Expect")
+	}
+
+	if mmPurgeTask.defaultExpectation.paramPtrs == nil {
+		mmPurgeTask.defaultExpectation.paramPtrs = &TasksServiceMockPurgeTaskParamPtrs{}
+	}
+	mmPurgeTask.defaultExpectation.paramPtrs.taskId = &taskId
+	mmPurgeTask.defaultExpectation.expectationOrigins.originTaskId = minimock.CallerInfo(1)
+
+	return mmPurgeTask
+}
+
+// Inspect accepts an inspector function that has same arguments as the TasksService.PurgeTask
+func (mmPurgeTask *mTasksServiceMockPurgeTask) Inspect(f func(ctx context.Context, taskId string)) *mTasksServiceMockPurgeTask {
+	if mmPurgeTask.mock.inspectFuncPurgeTask != nil {
+		mmPurgeTask.mock.t.Fatalf("Inspect function is already set for TasksServiceMock.PurgeTask")
+	}
+
+	mmPurgeTask.mock.inspectFuncPurgeTask = f
+
+	return mmPurgeTask
+}
+
+// Return sets up results that will be returned by TasksService.PurgeTask
+func (mmPurgeTask *mTasksServiceMockPurgeTask) Return(err error) *TasksServiceMock {
+	if mmPurgeTask.mock.funcPurgeTask != nil {
+		mmPurgeTask.mock.t.Fatalf("TasksServiceMock.PurgeTask mock is already set by Set")
+	}
+
+	if mmPurgeTask.defaultExpectation == nil {
+		mmPurgeTask.defaultExpectation = &TasksServiceMockPurgeTaskExpectation{mock: mmPurgeTask.mock}
+	}
+	mmPurgeTask.defaultExpectation.results = &TasksServiceMockPurgeTaskResults{err}
+	mmPurgeTask.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeTask.mock
+}
+
+// Set uses given function f to mock the TasksService.PurgeTask method
+func (mmPurgeTask *mTasksServiceMockPurgeTask) Set(f func(ctx context.Context, taskId string) (err error)) *TasksServiceMock {
+	if mmPurgeTask.defaultExpectation != nil {
+		mmPurgeTask.mock.t.Fatalf("Default expectation is already set for the TasksService.PurgeTask method")
+	}
+
+	if len(mmPurgeTask.expectations) > 0 {
+		mmPurgeTask.mock.t.Fatalf("Some expectations are already set for the TasksService.PurgeTask method")
+	}
+
+	mmPurgeTask.mock.funcPurgeTask = f
+	mmPurgeTask.mock.funcPurgeTaskOrigin = minimock.CallerInfo(1)
+	return mmPurgeTask.mock
+}
+
+// When sets expectation for the TasksService.PurgeTask which will trigger the result defined by the following
+// Then helper
+func (mmPurgeTask *mTasksServiceMockPurgeTask) When(ctx context.Context, taskId string) *TasksServiceMockPurgeTaskExpectation {
+	if mmPurgeTask.mock.funcPurgeTask != nil {
+		mmPurgeTask.mock.t.Fatalf("TasksServiceMock.PurgeTask mock is already set by Set")
+	}
+
+	expectation := &TasksServiceMockPurgeTaskExpectation{
+		mock:               mmPurgeTask.mock,
+		params:             &TasksServiceMockPurgeTaskParams{ctx, taskId},
+		expectationOrigins: TasksServiceMockPurgeTaskExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeTask.expectations = append(mmPurgeTask.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TasksService.PurgeTask return parameters for the expectation previously defined by the When method
+func (e *TasksServiceMockPurgeTaskExpectation) Then(err error) *TasksServiceMock {
+	e.results = &TasksServiceMockPurgeTaskResults{err}
+	return e.mock
+}
+
+// Times sets number of times TasksService.PurgeTask should be invoked
+func (mmPurgeTask *mTasksServiceMockPurgeTask) Times(n uint64) *mTasksServiceMockPurgeTask {
+	if n == 0 {
+		mmPurgeTask.mock.t.Fatalf("Times of TasksServiceMock.PurgeTask mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeTask.expectedInvocations, n)
+	mmPurgeTask.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeTask
+}
+
+func (mmPurgeTask *mTasksServiceMockPurgeTask) invocationsDone() bool {
+	if len(mmPurgeTask.expectations) == 0 && mmPurgeTask.defaultExpectation == nil && mmPurgeTask.mock.funcPurgeTask == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeTask.mock.afterPurgeTaskCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeTask.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeTask implements mm_handlers.TasksService
+func (mmPurgeTask *TasksServiceMock) PurgeTask(ctx context.Context, taskId string) (err error) {
+	mm_atomic.AddUint64(&mmPurgeTask.beforePurgeTaskCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeTask.afterPurgeTaskCounter, 1)
+
+	mmPurgeTask.t.Helper()
+
+	if mmPurgeTask.inspectFuncPurgeTask != nil {
+		mmPurgeTask.inspectFuncPurgeTask(ctx, taskId)
+	}
+
+	mm_params := TasksServiceMockPurgeTaskParams{ctx, taskId}
+
+	// Record call args
+	mmPurgeTask.PurgeTaskMock.mutex.Lock()
+	mmPurgeTask.PurgeTaskMock.callArgs = append(mmPurgeTask.PurgeTaskMock.callArgs, &mm_params)
+	mmPurgeTask.PurgeTaskMock.mutex.Unlock()
+
+	for _, e := range mmPurgeTask.PurgeTaskMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPurgeTask.PurgeTaskMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeTask.PurgeTaskMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeTask.PurgeTaskMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeTask.PurgeTaskMock.defaultExpectation.paramPtrs
+
+		mm_got := TasksServiceMockPurgeTaskParams{ctx, taskId}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeTask.t.Errorf("TasksServiceMock.PurgeTask got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeTask.PurgeTaskMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.taskId != nil && !minimock.Equal(*mm_want_ptrs.taskId, mm_got.taskId) {
+				mmPurgeTask.t.Errorf("TasksServiceMock.PurgeTask got unexpected parameter taskId, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeTask.PurgeTaskMock.defaultExpectation.expectationOrigins.originTaskId, *mm_want_ptrs.taskId, mm_got.taskId, minimock.Diff(*mm_want_ptrs.taskId, mm_got.taskId))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeTask.t.Errorf("TasksServiceMock.PurgeTask got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeTask.PurgeTaskMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeTask.PurgeTaskMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeTask.t.Fatal("No results are set for the TasksServiceMock.PurgeTask")
+		}
+		return (*mm_results).err
+	}
+	if mmPurgeTask.funcPurgeTask != nil {
+		return mmPurgeTask.funcPurgeTask(ctx, taskId)
+	}
+	mmPurgeTask.t.Fatalf("Unexpected call to TasksServiceMock.PurgeTask. %v %v", ctx, taskId)
+	return
+}
+
+// PurgeTaskAfterCounter returns a count of finished TasksServiceMock.PurgeTask invocations
+func (mmPurgeTask *TasksServiceMock) PurgeTaskAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeTask.afterPurgeTaskCounter)
+}
+
+// PurgeTaskBeforeCounter returns a count of TasksServiceMock.PurgeTask invocations
+func (mmPurgeTask *TasksServiceMock) PurgeTaskBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeTask.beforePurgeTaskCounter)
+}
+
+// Calls returns a list of arguments used in each call to TasksServiceMock.PurgeTask.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeTask *mTasksServiceMockPurgeTask) Calls() []*TasksServiceMockPurgeTaskParams {
+	mmPurgeTask.mutex.RLock()
+
+	argCopy := make([]*TasksServiceMockPurgeTaskParams, len(mmPurgeTask.callArgs))
+	copy(argCopy, mmPurgeTask.callArgs)
+
+	mmPurgeTask.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeTaskDone returns true if the count of the PurgeTask invocations corresponds
+// the number of defined expectations
+func (m *TasksServiceMock) MinimockPurgeTaskDone() bool {
+	if m.PurgeTaskMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeTaskMock.invocationsDone()
+}
+
+// MinimockPurgeTaskInspect logs each unmet expectation
+func (m *TasksServiceMock) MinimockPurgeTaskInspect() {
+	for _, e := range m.PurgeTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TasksServiceMock.PurgeTask at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeTaskCounter := mm_atomic.LoadUint64(&m.afterPurgeTaskCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeTaskMock.defaultExpectation != nil && afterPurgeTaskCounter < 1 {
+		if m.PurgeTaskMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksServiceMock.PurgeTask at\n%s", m.PurgeTaskMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TasksServiceMock.PurgeTask at\n%s with params: %#v", m.PurgeTaskMock.defaultExpectation.expectationOrigins.origin, *m.PurgeTaskMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeTask != nil && afterPurgeTaskCounter < 1 {
+		m.t.Errorf("Expected call to TasksServiceMock.PurgeTask at\n%s", m.funcPurgeTaskOrigin)
+	}
+
+	if !m.PurgeTaskMock.invocationsDone() && afterPurgeTaskCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksServiceMock.PurgeTask at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeTaskMock.expectedInvocations), m.PurgeTaskMock.expectedInvocationsOrigin, afterPurgeTaskCounter)
+	}
+}
+
+type mTasksServiceMockRegisterTask struct {
+	optional           bool
+	mock               *TasksServiceMock
+	defaultExpectation *TasksServiceMockRegisterTaskExpectation
+	expectations       []*TasksServiceMockRegisterTaskExpectation
+
+	callArgs []*TasksServiceMockRegisterTaskParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TasksServiceMockRegisterTaskExpectation specifies expectation struct of the TasksService.RegisterTask
+type TasksServiceMockRegisterTaskExpectation struct {
+	mock               *TasksServiceMock
+	params             *TasksServiceMockRegisterTaskParams
+	paramPtrs          *TasksServiceMockRegisterTaskParamPtrs
+	expectationOrigins TasksServiceMockRegisterTaskExpectationOrigins
+	results            *TasksServiceMockRegisterTaskResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TasksServiceMockRegisterTaskParams contains parameters of the TasksService.RegisterTask
+type TasksServiceMockRegisterTaskParams struct {
+	ctx  context.Context
+	spec model.TaskSpec
+}
+
+// TasksServiceMockRegisterTaskParamPtrs contains pointers to parameters of the TasksService.RegisterTask
+type TasksServiceMockRegisterTaskParamPtrs struct {
+	ctx  *context.Context
+	spec *model.TaskSpec
+}
+
+// TasksServiceMockRegisterTaskResults contains results of the TasksService.RegisterTask
+type TasksServiceMockRegisterTaskResults struct {
+	s1  string
+	err error
+}
+
+// TasksServiceMockRegisterTaskOrigins contains origins of expectations of the TasksService.RegisterTask
+type TasksServiceMockRegisterTaskExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originSpec string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRegisterTask *mTasksServiceMockRegisterTask) Optional() *mTasksServiceMockRegisterTask {
+	mmRegisterTask.optional = true
+	return mmRegisterTask
+}
+
+// Expect sets up expected params for TasksService.RegisterTask
+func (mmRegisterTask *mTasksServiceMockRegisterTask) Expect(ctx context.Context, spec model.TaskSpec) *mTasksServiceMockRegisterTask {
+	if mmRegisterTask.mock.funcRegisterTask != nil {
+		mmRegisterTask.mock.t.Fatalf("TasksServiceMock.RegisterTask mock is already set by Set")
+	}
+
+	if mmRegisterTask.defaultExpectation == nil {
+		mmRegisterTask.defaultExpectation = &TasksServiceMockRegisterTaskExpectation{}
+	}
+
+	if mmRegisterTask.defaultExpectation.paramPtrs != nil {
+		mmRegisterTask.mock.t.Fatalf("TasksServiceMock.RegisterTask mock is already set by ExpectParams functions")
+	}
+
+	mmRegisterTask.defaultExpectation.params = &TasksServiceMockRegisterTaskParams{ctx, spec}
+	mmRegisterTask.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRegisterTask.expectations {
+		if minimock.Equal(e.params, mmRegisterTask.defaultExpectation.params) {
+			mmRegisterTask.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRegisterTask.defaultExpectation.params)
+		}
+	}
+
+	return mmRegisterTask
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TasksService.RegisterTask
+func (mmRegisterTask *mTasksServiceMockRegisterTask) ExpectCtxParam1(ctx context.Context) *mTasksServiceMockRegisterTask {
+	if mmRegisterTask.mock.funcRegisterTask != nil {
+		mmRegisterTask.mock.t.Fatalf("TasksServiceMock.RegisterTask mock is already set by Set")
+	}
+
+	if mmRegisterTask.defaultExpectation == nil {
+		mmRegisterTask.defaultExpectation = &TasksServiceMockRegisterTaskExpectation{}
+	}
+
+	if mmRegisterTask.defaultExpectation.params != nil {
+		mmRegisterTask.mock.t.Fatalf("TasksServiceMock.RegisterTask mock is already set by Expect")
+	}
+
+	if mmRegisterTask.defaultExpectation.paramPtrs == nil {
+		mmRegisterTask.defaultExpectation.paramPtrs = &TasksServiceMockRegisterTaskParamPtrs{}
+	}
+	mmRegisterTask.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRegisterTask.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRegisterTask
+}
+
+// ExpectSpecParam2 sets up expected param spec for TasksService.RegisterTask
+func (mmRegisterTask *mTasksServiceMockRegisterTask) ExpectSpecParam2(spec model.TaskSpec) *mTasksServiceMockRegisterTask {
+	if mmRegisterTask.mock.funcRegisterTask != nil {
+		mmRegisterTask.mock.t.Fatalf("TasksServiceMock.RegisterTask mock is already set by Set")
+	}
+
+	if mmRegisterTask.defaultExpectation == nil {
+		mmRegisterTask.defaultExpectation = &TasksServiceMockRegisterTaskExpectation{}
+	}
+
+	if mmRegisterTask.defaultExpectation.params != nil {
+		mmRegisterTask.mock.t.Fatalf("TasksServiceMock.RegisterTask mock is already set by Expect")
+	}
+
+	if mmRegisterTask.defaultExpectation.paramPtrs == nil {
+		mmRegisterTask.defaultExpectation.paramPtrs = &TasksServiceMockRegisterTaskParamPtrs{}
+	}
+	mmRegisterTask.defaultExpectation.paramPtrs.spec = &spec
+	mmRegisterTask.defaultExpectation.expectationOrigins.originSpec = minimock.CallerInfo(1)
+
+	return mmRegisterTask
+}
+
+// Inspect accepts an inspector function that has same arguments as the TasksService.RegisterTask
+func (mmRegisterTask *mTasksServiceMockRegisterTask) Inspect(f func(ctx context.Context, spec model.TaskSpec)) *mTasksServiceMockRegisterTask {
+	if mmRegisterTask.mock.inspectFuncRegisterTask != nil {
+		mmRegisterTask.mock.t.Fatalf("Inspect function is already set for TasksServiceMock.RegisterTask")
+	}
+
+	mmRegisterTask.mock.inspectFuncRegisterTask = f
+
+	return mmRegisterTask
+}
+
+// Return sets up results that will be returned by TasksService.RegisterTask
+func (mmRegisterTask *mTasksServiceMockRegisterTask) Return(s1 string, err error) *TasksServiceMock {
+	if mmRegisterTask.mock.funcRegisterTask != nil {
+		mmRegisterTask.mock.t.Fatalf("TasksServiceMock.RegisterTask mock is already set by Set")
+	}
+
+	if mmRegisterTask.defaultExpectation == nil {
+		mmRegisterTask.defaultExpectation = &TasksServiceMockRegisterTaskExpectation{mock: mmRegisterTask.mock}
+	}
+	mmRegisterTask.defaultExpectation.results = &TasksServiceMockRegisterTaskResults{s1, err}
+	mmRegisterTask.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRegisterTask.mock
+}
+
+// Set uses given function f to mock the TasksService.RegisterTask method
+func (mmRegisterTask *mTasksServiceMockRegisterTask) Set(f func(ctx context.Context, spec model.TaskSpec) (s1 string, err error)) *TasksServiceMock {
+	if mmRegisterTask.defaultExpectation != nil {
+		mmRegisterTask.mock.t.Fatalf("Default expectation is already set for the TasksService.RegisterTask method")
+	}
+
+	if len(mmRegisterTask.expectations) > 0 {
+		mmRegisterTask.mock.t.Fatalf("Some expectations are already set for the TasksService.RegisterTask method")
+	}
+
+	mmRegisterTask.mock.funcRegisterTask = f
+	mmRegisterTask.mock.funcRegisterTaskOrigin = minimock.CallerInfo(1)
+	return mmRegisterTask.mock
+}
+
+// When sets expectation for the TasksService.RegisterTask which will trigger the result defined by the following
+// Then helper
+func (mmRegisterTask *mTasksServiceMockRegisterTask) When(ctx context.Context, spec model.TaskSpec) *TasksServiceMockRegisterTaskExpectation {
+	if mmRegisterTask.mock.funcRegisterTask != nil {
+		mmRegisterTask.mock.t.Fatalf("TasksServiceMock.RegisterTask mock is already set by Set")
+	}
+
+	expectation := &TasksServiceMockRegisterTaskExpectation{
+		mock:               mmRegisterTask.mock,
+		params:             &TasksServiceMockRegisterTaskParams{ctx, spec},
+		expectationOrigins: TasksServiceMockRegisterTaskExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRegisterTask.expectations = append(mmRegisterTask.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TasksService.RegisterTask return parameters for the expectation previously defined by the When method
+func (e *TasksServiceMockRegisterTaskExpectation) Then(s1 string, err error) *TasksServiceMock {
+	e.results = &TasksServiceMockRegisterTaskResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times TasksService.RegisterTask should be invoked
+func (mmRegisterTask *mTasksServiceMockRegisterTask) Times(n uint64) *mTasksServiceMockRegisterTask {
+	if n == 0 {
+		mmRegisterTask.mock.t.Fatalf("Times of TasksServiceMock.RegisterTask mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRegisterTask.expectedInvocations, n)
+	mmRegisterTask.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRegisterTask
+}
+
+func (mmRegisterTask *mTasksServiceMockRegisterTask) invocationsDone() bool {
+	if len(mmRegisterTask.expectations) == 0 && mmRegisterTask.defaultExpectation == nil && mmRegisterTask.mock.funcRegisterTask == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRegisterTask.mock.afterRegisterTaskCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRegisterTask.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RegisterTask implements mm_handlers.TasksService
+func (mmRegisterTask *TasksServiceMock) RegisterTask(ctx context.Context, spec model.TaskSpec) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmRegisterTask.beforeRegisterTaskCounter, 1)
+	defer mm_atomic.AddUint64(&mmRegisterTask.afterRegisterTaskCounter, 1)
+
+	mmRegisterTask.t.Helper()
+
+	if mmRegisterTask.inspectFuncRegisterTask != nil {
+		mmRegisterTask.inspectFuncRegisterTask(ctx, spec)
+	}
+
+	mm_params := TasksServiceMockRegisterTaskParams{ctx, spec}
+
+	// Record call args
+	mmRegisterTask.RegisterTaskMock.mutex.Lock()
+	mmRegisterTask.RegisterTaskMock.callArgs = append(mmRegisterTask.RegisterTaskMock.callArgs, &mm_params)
+	mmRegisterTask.RegisterTaskMock.mutex.Unlock()
+
+	for _, e := range mmRegisterTask.RegisterTaskMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmRegisterTask.RegisterTaskMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRegisterTask.RegisterTaskMock.defaultExpectation.Counter, 1)
+		mm_want := mmRegisterTask.RegisterTaskMock.defaultExpectation.params
+		mm_want_ptrs := mmRegisterTask.RegisterTaskMock.defaultExpectation.paramPtrs
+
+		mm_got := TasksServiceMockRegisterTaskParams{ctx, spec}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRegisterTask.t.Errorf("TasksServiceMock.RegisterTask got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRegisterTask.RegisterTaskMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.spec != nil && !minimock.Equal(*mm_want_ptrs.spec, mm_got.spec) {
+				mmRegisterTask.t.Errorf("TasksServiceMock.RegisterTask got unexpected parameter spec, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRegisterTask.RegisterTaskMock.defaultExpectation.expectationOrigins.originSpec, *mm_want_ptrs.spec, mm_got.spec, minimock.Diff(*mm_want_ptrs.spec, mm_got.spec))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRegisterTask.t.Errorf("TasksServiceMock.RegisterTask got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRegisterTask.RegisterTaskMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRegisterTask.RegisterTaskMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRegisterTask.t.Fatal("No results are set for the TasksServiceMock.RegisterTask")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmRegisterTask.funcRegisterTask != nil {
+		return mmRegisterTask.funcRegisterTask(ctx, spec)
+	}
+	mmRegisterTask.t.Fatalf("Unexpected call to TasksServiceMock.RegisterTask. %v %v", ctx, spec)
+	return
+}
+
+// RegisterTaskAfterCounter returns a count of finished TasksServiceMock.RegisterTask invocations
+func (mmRegisterTask *TasksServiceMock) RegisterTaskAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRegisterTask.afterRegisterTaskCounter)
+}
+
+// RegisterTaskBeforeCounter returns a count of TasksServiceMock.RegisterTask invocations
+func (mmRegisterTask *TasksServiceMock) RegisterTaskBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRegisterTask.beforeRegisterTaskCounter)
+}
+
+// Calls returns a list of arguments used in each call to TasksServiceMock.RegisterTask.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRegisterTask *mTasksServiceMockRegisterTask) Calls() []*TasksServiceMockRegisterTaskParams {
+	mmRegisterTask.mutex.RLock()
+
+	argCopy := make([]*TasksServiceMockRegisterTaskParams, len(mmRegisterTask.callArgs))
+	copy(argCopy, mmRegisterTask.callArgs)
+
+	mmRegisterTask.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRegisterTaskDone returns true if the count of the RegisterTask invocations corresponds
+// the number of defined expectations
+func (m *TasksServiceMock) MinimockRegisterTaskDone() bool {
+	if m.RegisterTaskMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RegisterTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RegisterTaskMock.invocationsDone()
+}
+
+// MinimockRegisterTaskInspect logs each unmet expectation
+func (m *TasksServiceMock) MinimockRegisterTaskInspect() {
+	for _, e := range m.RegisterTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TasksServiceMock.RegisterTask at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRegisterTaskCounter := mm_atomic.LoadUint64(&m.afterRegisterTaskCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RegisterTaskMock.defaultExpectation != nil && afterRegisterTaskCounter < 1 {
+		if m.RegisterTaskMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksServiceMock.RegisterTask at\n%s", m.RegisterTaskMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TasksServiceMock.RegisterTask at\n%s with params: %#v", m.RegisterTaskMock.defaultExpectation.expectationOrigins.origin, *m.RegisterTaskMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRegisterTask != nil && afterRegisterTaskCounter < 1 {
+		m.t.Errorf("Expected call to TasksServiceMock.RegisterTask at\n%s", m.funcRegisterTaskOrigin)
+	}
+
+	if !m.RegisterTaskMock.invocationsDone() && afterRegisterTaskCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksServiceMock.RegisterTask at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RegisterTaskMock.expectedInvocations), m.RegisterTaskMock.expectedInvocationsOrigin, afterRegisterTaskCounter)
+	}
+}
+
+type mTasksServiceMockRegisterTasks struct {
+	optional           bool
+	mock               *TasksServiceMock
+	defaultExpectation *TasksServiceMockRegisterTasksExpectation
+	expectations       []*TasksServiceMockRegisterTasksExpectation
+
+	callArgs []*TasksServiceMockRegisterTasksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TasksServiceMockRegisterTasksExpectation specifies expectation struct of the TasksService.RegisterTasks
+type TasksServiceMockRegisterTasksExpectation struct {
+	mock               *TasksServiceMock
+	params             *TasksServiceMockRegisterTasksParams
+	paramPtrs          *TasksServiceMockRegisterTasksParamPtrs
+	expectationOrigins TasksServiceMockRegisterTasksExpectationOrigins
+	results            *TasksServiceMockRegisterTasksResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TasksServiceMockRegisterTasksParams contains parameters of the TasksService.RegisterTasks
+type TasksServiceMockRegisterTasksParams struct {
+	ctx   context.Context
+	specs []model.TaskSpec
+}
+
+// TasksServiceMockRegisterTasksParamPtrs contains pointers to parameters of the TasksService.RegisterTasks
+type TasksServiceMockRegisterTasksParamPtrs struct {
+	ctx   *context.Context
+	specs *[]model.TaskSpec
+}
+
+// TasksServiceMockRegisterTasksResults contains results of the TasksService.RegisterTasks
+type TasksServiceMockRegisterTasksResults struct {
+	ba1 []model.BatchResult
+}
+
+// TasksServiceMockRegisterTasksOrigins contains origins of expectations of the TasksService.RegisterTasks
+type TasksServiceMockRegisterTasksExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originSpecs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRegisterTasks *mTasksServiceMockRegisterTasks) Optional() *mTasksServiceMockRegisterTasks {
+	mmRegisterTasks.optional = true
+	return mmRegisterTasks
+}
+
+// Expect sets up expected params for TasksService.RegisterTasks
+func (mmRegisterTasks *mTasksServiceMockRegisterTasks) Expect(ctx context.Context, specs []model.TaskSpec) *mTasksServiceMockRegisterTasks {
+	if mmRegisterTasks.mock.funcRegisterTasks != nil {
+		mmRegisterTasks.mock.t.Fatalf("TasksServiceMock.RegisterTasks mock is already set by Set")
+	}
+
+	if mmRegisterTasks.defaultExpectation == nil {
+		mmRegisterTasks.defaultExpectation = &TasksServiceMockRegisterTasksExpectation{}
+	}
+
+	if mmRegisterTasks.defaultExpectation.paramPtrs != nil {
+		mmRegisterTasks.mock.t.Fatalf("TasksServiceMock.RegisterTasks mock is already set by ExpectParams functions")
+	}
+
+	mmRegisterTasks.defaultExpectation.params = &TasksServiceMockRegisterTasksParams{ctx, specs}
+	mmRegisterTasks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRegisterTasks.expectations {
+		if minimock.Equal(e.params, mmRegisterTasks.defaultExpectation.params) {
+			mmRegisterTasks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRegisterTasks.defaultExpectation.params)
+		}
+	}
+
+	return mmRegisterTasks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TasksService.RegisterTasks
+func (mmRegisterTasks *mTasksServiceMockRegisterTasks) ExpectCtxParam1(ctx context.Context) *mTasksServiceMockRegisterTasks {
+	if mmRegisterTasks.mock.funcRegisterTasks != nil {
+		mmRegisterTasks.mock.t.Fatalf("TasksServiceMock.RegisterTasks mock is already set by Set")
+	}
+
+	if mmRegisterTasks.defaultExpectation == nil {
+		mmRegisterTasks.defaultExpectation = &TasksServiceMockRegisterTasksExpectation{}
+	}
+
+	if mmRegisterTasks.defaultExpectation.params != nil {
+		mmRegisterTasks.mock.t.Fatalf("TasksServiceMock.RegisterTasks mock is already set by Expect")
+	}
+
+	if mmRegisterTasks.defaultExpectation.paramPtrs == nil {
+		mmRegisterTasks.defaultExpectation.paramPtrs = &TasksServiceMockRegisterTasksParamPtrs{}
+	}
+	mmRegisterTasks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRegisterTasks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRegisterTasks
+}
+
+// ExpectSpecsParam2 sets up expected param specs for TasksService.RegisterTasks
+func (mmRegisterTasks *mTasksServiceMockRegisterTasks) ExpectSpecsParam2(specs []model.TaskSpec) *mTasksServiceMockRegisterTasks {
+	if mmRegisterTasks.mock.funcRegisterTasks != nil {
+		mmRegisterTasks.mock.t.Fatalf("TasksServiceMock.RegisterTasks mock is already set by Set")
+	}
+
+	if mmRegisterTasks.defaultExpectation == nil {
+		mmRegisterTasks.defaultExpectation = &TasksServiceMockRegisterTasksExpectation{}
+	}
+
+	if mmRegisterTasks.defaultExpectation.params != nil {
+		mmRegisterTasks.mock.t.Fatalf("TasksServiceMock.RegisterTasks mock is already set by Expect")
+	}
+
+	if mmRegisterTasks.defaultExpectation.paramPtrs == nil {
+		mmRegisterTasks.defaultExpectation.paramPtrs = &TasksServiceMockRegisterTasksParamPtrs{}
+	}
+	mmRegisterTasks.defaultExpectation.paramPtrs.specs = &specs
+	mmRegisterTasks.defaultExpectation.expectationOrigins.originSpecs = minimock.CallerInfo(1)
+
+	return mmRegisterTasks
+}
+
+// Inspect accepts an inspector function that has same arguments as the TasksService.RegisterTasks
+func (mmRegisterTasks *mTasksServiceMockRegisterTasks) Inspect(f func(ctx context.Context, specs []model.TaskSpec)) *mTasksServiceMockRegisterTasks {
+	if mmRegisterTasks.mock.inspectFuncRegisterTasks != nil {
+		mmRegisterTasks.mock.t.Fatalf("Inspect function is already set for TasksServiceMock.RegisterTasks")
+	}
+
+	mmRegisterTasks.mock.inspectFuncRegisterTasks = f
+
+	return mmRegisterTasks
+}
+
+// Return sets up results that will be returned by TasksService.RegisterTasks
+func (mmRegisterTasks *mTasksServiceMockRegisterTasks) Return(ba1 []model.BatchResult) *TasksServiceMock {
+	if mmRegisterTasks.mock.funcRegisterTasks != nil {
+		mmRegisterTasks.mock.t.Fatalf("TasksServiceMock.RegisterTasks mock is already set by Set")
+	}
+
+	if mmRegisterTasks.defaultExpectation == nil {
+		mmRegisterTasks.defaultExpectation = &TasksServiceMockRegisterTasksExpectation{mock: mmRegisterTasks.mock}
+	}
+	mmRegisterTasks.defaultExpectation.results = &TasksServiceMockRegisterTasksResults{ba1}
+	mmRegisterTasks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRegisterTasks.mock
+}
+
+// Set uses given function f to mock the TasksService.RegisterTasks method
+func (mmRegisterTasks *mTasksServiceMockRegisterTasks) Set(f func(ctx context.Context, specs []model.TaskSpec) (ba1 []model.BatchResult)) *TasksServiceMock {
+	if mmRegisterTasks.defaultExpectation != nil {
+		mmRegisterTasks.mock.t.Fatalf("Default expectation is already set for the TasksService.RegisterTasks method")
+	}
+
+	if len(mmRegisterTasks.expectations) > 0 {
+		mmRegisterTasks.mock.t.Fatalf("Some expectations are already set for the TasksService.RegisterTasks method")
+	}
+
+	mmRegisterTasks.mock.funcRegisterTasks = f
+	mmRegisterTasks.mock.funcRegisterTasksOrigin = minimock.CallerInfo(1)
+	return mmRegisterTasks.mock
+}
+
+// When sets expectation for the TasksService.RegisterTasks which will trigger the result defined by the following
+// Then helper
+func (mmRegisterTasks *mTasksServiceMockRegisterTasks) When(ctx context.Context, specs []model.TaskSpec) *TasksServiceMockRegisterTasksExpectation {
+	if mmRegisterTasks.mock.funcRegisterTasks != nil {
+		mmRegisterTasks.mock.t.Fatalf("TasksServiceMock.RegisterTasks mock is already set by Set")
+	}
+
+	expectation := &TasksServiceMockRegisterTasksExpectation{
+		mock:               mmRegisterTasks.mock,
+		params:             &TasksServiceMockRegisterTasksParams{ctx, specs},
+		expectationOrigins: TasksServiceMockRegisterTasksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRegisterTasks.expectations = append(mmRegisterTasks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TasksService.RegisterTasks return parameters for the expectation previously defined by the When method
+func (e *TasksServiceMockRegisterTasksExpectation) Then(ba1 []model.BatchResult) *TasksServiceMock {
+	e.results = &TasksServiceMockRegisterTasksResults{ba1}
+	return e.mock
+}
+
+// Times sets number of times TasksService.RegisterTasks should be invoked
+func (mmRegisterTasks *mTasksServiceMockRegisterTasks) Times(n uint64) *mTasksServiceMockRegisterTasks {
+	if n == 0 {
+		mmRegisterTasks.mock.t.Fatalf("Times of TasksServiceMock.RegisterTasks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRegisterTasks.expectedInvocations, n)
+	mmRegisterTasks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRegisterTasks
+}
+
+func (mmRegisterTasks *mTasksServiceMockRegisterTasks) invocationsDone() bool {
+	if len(mmRegisterTasks.expectations) == 0 && mmRegisterTasks.defaultExpectation == nil && mmRegisterTasks.mock.funcRegisterTasks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRegisterTasks.mock.afterRegisterTasksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRegisterTasks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RegisterTasks implements mm_handlers.TasksService
+func (mmRegisterTasks *TasksServiceMock) RegisterTasks(ctx context.Context, specs []model.TaskSpec) (ba1 []model.BatchResult) {
+	mm_atomic.AddUint64(&mmRegisterTasks.beforeRegisterTasksCounter, 1)
+	defer mm_atomic.AddUint64(&mmRegisterTasks.afterRegisterTasksCounter, 1)
+
+	mmRegisterTasks.t.Helper()
+
+	if mmRegisterTasks.inspectFuncRegisterTasks != nil {
+		mmRegisterTasks.inspectFuncRegisterTasks(ctx, specs)
+	}
+
+	mm_params := TasksServiceMockRegisterTasksParams{ctx, specs}
+
+	// Record call args
+	mmRegisterTasks.RegisterTasksMock.mutex.Lock()
+	mmRegisterTasks.RegisterTasksMock.callArgs = append(mmRegisterTasks.RegisterTasksMock.callArgs, &mm_params)
+	mmRegisterTasks.RegisterTasksMock.mutex.Unlock()
+
+	for _, e := range mmRegisterTasks.RegisterTasksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ba1
+		}
+	}
+
+	if mmRegisterTasks.RegisterTasksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRegisterTasks.RegisterTasksMock.defaultExpectation.Counter, 1)
+		mm_want := mmRegisterTasks.RegisterTasksMock.defaultExpectation.params
+		mm_want_ptrs := mmRegisterTasks.RegisterTasksMock.defaultExpectation.paramPtrs
+
+		mm_got := TasksServiceMockRegisterTasksParams{ctx, specs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRegisterTasks.t.Errorf("TasksServiceMock.RegisterTasks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRegisterTasks.RegisterTasksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.specs != nil && !minimock.Equal(*mm_want_ptrs.specs, mm_got.specs) {
+				mmRegisterTasks.t.Errorf("TasksServiceMock.RegisterTasks got unexpected parameter specs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRegisterTasks.RegisterTasksMock.defaultExpectation.expectationOrigins.originSpecs, *mm_want_ptrs.specs, mm_got.specs, minimock.Diff(*mm_want_ptrs.specs, mm_got.specs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRegisterTasks.t.Errorf("TasksServiceMock.RegisterTasks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRegisterTasks.RegisterTasksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRegisterTasks.RegisterTasksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRegisterTasks.t.Fatal("No results are set for the TasksServiceMock.RegisterTasks")
+		}
+		return (*mm_results).ba1
+	}
+	if mmRegisterTasks.funcRegisterTasks != nil {
+		return mmRegisterTasks.funcRegisterTasks(ctx, specs)
+	}
+	mmRegisterTasks.t.Fatalf("Unexpected call to TasksServiceMock.RegisterTasks. %v %v", ctx, specs)
+	return
+}
+
+// RegisterTasksAfterCounter returns a count of finished TasksServiceMock.RegisterTasks invocations
+func (mmRegisterTasks *TasksServiceMock) RegisterTasksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRegisterTasks.afterRegisterTasksCounter)
+}
+
+// RegisterTasksBeforeCounter returns a count of TasksServiceMock.RegisterTasks invocations
+func (mmRegisterTasks *TasksServiceMock) RegisterTasksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRegisterTasks.beforeRegisterTasksCounter)
+}
+
+// Calls returns a list of arguments used in each call to TasksServiceMock.RegisterTasks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRegisterTasks *mTasksServiceMockRegisterTasks) Calls() []*TasksServiceMockRegisterTasksParams {
+	mmRegisterTasks.mutex.RLock()
+
+	argCopy := make([]*TasksServiceMockRegisterTasksParams, len(mmRegisterTasks.callArgs))
+	copy(argCopy, mmRegisterTasks.callArgs)
+
+	mmRegisterTasks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRegisterTasksDone returns true if the count of the RegisterTasks invocations corresponds
+// the number of defined expectations
+func (m *TasksServiceMock) MinimockRegisterTasksDone() bool {
+	if m.RegisterTasksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RegisterTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RegisterTasksMock.invocationsDone()
+}
+
+// MinimockRegisterTasksInspect logs each unmet expectation
+func (m *TasksServiceMock) MinimockRegisterTasksInspect() {
+	for _, e := range m.RegisterTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TasksServiceMock.RegisterTasks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRegisterTasksCounter := mm_atomic.LoadUint64(&m.afterRegisterTasksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RegisterTasksMock.defaultExpectation != nil && afterRegisterTasksCounter < 1 {
+		if m.RegisterTasksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksServiceMock.RegisterTasks at\n%s", m.RegisterTasksMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TasksServiceMock.RegisterTasks at\n%s with params: %#v", m.RegisterTasksMock.defaultExpectation.expectationOrigins.origin, *m.RegisterTasksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRegisterTasks != nil && afterRegisterTasksCounter < 1 {
+		m.t.Errorf("Expected call to TasksServiceMock.RegisterTasks at\n%s", m.funcRegisterTasksOrigin)
+	}
+
+	if !m.RegisterTasksMock.invocationsDone() && afterRegisterTasksCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksServiceMock.RegisterTasks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RegisterTasksMock.expectedInvocations), m.RegisterTasksMock.expectedInvocationsOrigin, afterRegisterTasksCounter)
+	}
+}
+
+type mTasksServiceMockRestoreTask struct {
+	optional           bool
+	mock               *TasksServiceMock
+	defaultExpectation *TasksServiceMockRestoreTaskExpectation
+	expectations       []*TasksServiceMockRestoreTaskExpectation
+
+	callArgs []*TasksServiceMockRestoreTaskParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TasksServiceMockRestoreTaskExpectation specifies expectation struct of the TasksService.RestoreTask
+type TasksServiceMockRestoreTaskExpectation struct {
+	mock               *TasksServiceMock
+	params             *TasksServiceMockRestoreTaskParams
+	paramPtrs          *TasksServiceMockRestoreTaskParamPtrs
+	expectationOrigins TasksServiceMockRestoreTaskExpectationOrigins
+	results            *TasksServiceMockRestoreTaskResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TasksServiceMockRestoreTaskParams contains parameters of the TasksService.RestoreTask
+type TasksServiceMockRestoreTaskParams struct {
+	ctx    context.Context
+	taskId string
+}
+
+// TasksServiceMockRestoreTaskParamPtrs contains pointers to parameters of the TasksService.RestoreTask
+type TasksServiceMockRestoreTaskParamPtrs struct {
+	ctx    *context.Context
+	taskId *string
+}
+
+// TasksServiceMockRestoreTaskResults contains results of the TasksService.RestoreTask
+type TasksServiceMockRestoreTaskResults struct {
+	tp1 *model.Task
+	err error
+}
+
+// TasksServiceMockRestoreTaskOrigins contains origins of expectations of the TasksService.RestoreTask
+type TasksServiceMockRestoreTaskExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originTaskId string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRestoreTask *mTasksServiceMockRestoreTask) Optional() *mTasksServiceMockRestoreTask {
+	mmRestoreTask.optional = true
+	return mmRestoreTask
+}
+
+// Expect sets up expected params for TasksService.RestoreTask
+func (mmRestoreTask *mTasksServiceMockRestoreTask) Expect(ctx context.Context, taskId string) *mTasksServiceMockRestoreTask {
+	if mmRestoreTask.mock.funcRestoreTask != nil {
+		mmRestoreTask.mock.t.Fatalf("TasksServiceMock.RestoreTask mock is already set by Set")
+	}
+
+	if mmRestoreTask.defaultExpectation == nil {
+		mmRestoreTask.defaultExpectation = &TasksServiceMockRestoreTaskExpectation{}
+	}
+
+	if mmRestoreTask.defaultExpectation.paramPtrs != nil {
+		mmRestoreTask.mock.t.Fatalf("TasksServiceMock.RestoreTask mock is already set by ExpectParams functions")
+	}
+
+	mmRestoreTask.defaultExpectation.params = &TasksServiceMockRestoreTaskParams{ctx, taskId}
+	mmRestoreTask.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRestoreTask.expectations {
+		if minimock.Equal(e.params, mmRestoreTask.defaultExpectation.params) {
+			mmRestoreTask.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRestoreTask.defaultExpectation.params)
+		}
+	}
+
+	return mmRestoreTask
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TasksService.RestoreTask
+func (mmRestoreTask *mTasksServiceMockRestoreTask) ExpectCtxParam1(ctx context.Context) *mTasksServiceMockRestoreTask {
+	if mmRestoreTask.mock.funcRestoreTask != nil {
+		mmRestoreTask.mock.t.Fatalf("TasksServiceMock.RestoreTask mock is already set by Set")
+	}
+
+	if mmRestoreTask.defaultExpectation == nil {
+		mmRestoreTask.defaultExpectation = &TasksServiceMockRestoreTaskExpectation{}
+	}
+
+	if mmRestoreTask.defaultExpectation.params != nil {
+		mmRestoreTask.mock.t.Fatalf("TasksServiceMock.RestoreTask mock is already set by Expect")
+	}
+
+	if mmRestoreTask.defaultExpectation.paramPtrs == nil {
+		mmRestoreTask.defaultExpectation.paramPtrs = &TasksServiceMockRestoreTaskParamPtrs{}
+	}
+	mmRestoreTask.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRestoreTask.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRestoreTask
+}
+
+// ExpectTaskIdParam2 sets up expected param taskId for TasksService.RestoreTask
+func (mmRestoreTask *mTasksServiceMockRestoreTask) ExpectTaskIdParam2(taskId string) *mTasksServiceMockRestoreTask {
+	if mmRestoreTask.mock.funcRestoreTask != nil {
+		mmRestoreTask.mock.t.Fatalf("TasksServiceMock.RestoreTask mock is already set by Set")
+	}
+
+	if mmRestoreTask.defaultExpectation == nil {
+		mmRestoreTask.defaultExpectation = &TasksServiceMockRestoreTaskExpectation{}
+	}
+
+	if mmRestoreTask.defaultExpectation.params != nil {
+		mmRestoreTask.mock.t.Fatalf("TasksServiceMock.RestoreTask mock is already set by Expect")
+	}
+
+	if mmRestoreTask.defaultExpectation.paramPtrs == nil {
+		mmRestoreTask.defaultExpectation.paramPtrs = &TasksServiceMockRestoreTaskParamPtrs{}
+	}
+	mmRestoreTask.defaultExpectation.paramPtrs.taskId = &taskId
+	mmRestoreTask.defaultExpectation.expectationOrigins.originTaskId = minimock.CallerInfo(1)
+
+	return mmRestoreTask
+}
+
+// Inspect accepts an inspector function that has same arguments as the TasksService.RestoreTask
+func (mmRestoreTask *mTasksServiceMockRestoreTask) Inspect(f func(ctx context.Context, taskId string)) *mTasksServiceMockRestoreTask {
+	if mmRestoreTask.mock.inspectFuncRestoreTask != nil {
+		mmRestoreTask.mock.t.Fatalf("Inspect function is already set for TasksServiceMock.RestoreTask")
+	}
+
+	mmRestoreTask.mock.inspectFuncRestoreTask = f
+
+	return mmRestoreTask
+}
+
+// Return sets up results that will be returned by TasksService.RestoreTask
+func (mmRestoreTask *mTasksServiceMockRestoreTask) Return(tp1 *model.Task, err error) *TasksServiceMock {
+	if mmRestoreTask.mock.funcRestoreTask != nil {
+		mmRestoreTask.mock.t.Fatalf("TasksServiceMock.RestoreTask mock is already set by Set")
+	}
+
+	if mmRestoreTask.defaultExpectation == nil {
+		mmRestoreTask.defaultExpectation = &TasksServiceMockRestoreTaskExpectation{mock: mmRestoreTask.mock}
+	}
+	mmRestoreTask.defaultExpectation.results = &TasksServiceMockRestoreTaskResults{tp1, err}
+	mmRestoreTask.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRestoreTask.mock
+}
+
+// Set uses given function f to mock the TasksService.RestoreTask method
+func (mmRestoreTask *mTasksServiceMockRestoreTask) Set(f func(ctx context.Context, taskId string) (tp1 *model.Task, err error)) *TasksServiceMock {
+	if mmRestoreTask.defaultExpectation != nil {
+		mmRestoreTask.mock.t.Fatalf("Default expectation is already set for the TasksService.RestoreTask method")
 	}
 
-	if len(mmDeleteTask.expectations) > 0 {
-		mmDeleteTask.mock.t.Fatalf("Some expectations are already set for the TasksService.DeleteTask method")
+	if len(mmRestoreTask.expectations) > 0 {
+		mmRestoreTask.mock.t.Fatalf("Some expectations are already set for the TasksService.RestoreTask method")
 	}
 
-	mmDeleteTask.mock.funcDeleteTask = f
-	mmDeleteTask.mock.funcDeleteTaskOrigin = minimock.CallerInfo(1)
-	return mmDeleteTask.mock
+	mmRestoreTask.mock.funcRestoreTask = f
+	mmRestoreTask.mock.funcRestoreTaskOrigin = minimock.CallerInfo(1)
+	return mmRestoreTask.mock
 }
 
-// When sets expectation for the TasksService.DeleteTask which will trigger the result defined by the following
+// When sets expectation for the TasksService.RestoreTask which will trigger the result defined by the following
 // Then helper
-func (mmDeleteTask *mTasksServiceMockDeleteTask) When(ctx context.Context, taskId string) *TasksServiceMockDeleteTaskExpectation {
-	if mmDeleteTask.mock.funcDeleteTask != nil {
-		mmDeleteTask.mock.t.Fatalf("TasksServiceMock.DeleteTask mock is already set by Set")
+func (mmRestoreTask *mTasksServiceMockRestoreTask) When(ctx context.Context, taskId string) *TasksServiceMockRestoreTaskExpectation {
+	if mmRestoreTask.mock.funcRestoreTask != nil {
+		mmRestoreTask.mock.t.Fatalf("TasksServiceMock.RestoreTask mock is already set by Set")
 	}
 
-	expectation := &TasksServiceMockDeleteTaskExpectation{
-		mock:               mmDeleteTask.mock,
-		params:             &TasksServiceMockDeleteTaskParams{ctx, taskId},
-		expectationOrigins: TasksServiceMockDeleteTaskExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &TasksServiceMockRestoreTaskExpectation{
+		mock:               mmRestoreTask.mock,
+		params:             &TasksServiceMockRestoreTaskParams{ctx, taskId},
+		expectationOrigins: TasksServiceMockRestoreTaskExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteTask.expectations = append(mmDeleteTask.expectations, expectation)
+	mmRestoreTask.expectations = append(mmRestoreTask.expectations, expectation)
 	return expectation
 }
 
-// Then sets up TasksService.DeleteTask return parameters for the expectation previously defined by the When method
-func (e *TasksServiceMockDeleteTaskExpectation) Then(err error) *TasksServiceMock {
-	e.results = &TasksServiceMockDeleteTaskResults{err}
+// Then sets up TasksService.RestoreTask return parameters for the expectation previously defined by the When method
+func (e *TasksServiceMockRestoreTaskExpectation) Then(tp1 *model.Task, err error) *TasksServiceMock {
+	e.results = &TasksServiceMockRestoreTaskResults{tp1, err}
 	return e.mock
 }
 
-// Times sets number of times TasksService.DeleteTask should be invoked
-func (mmDeleteTask *mTasksServiceMockDeleteTask) Times(n uint64) *mTasksServiceMockDeleteTask {
+// Times sets number of times TasksService.RestoreTask should be invoked
+func (mmRestoreTask *mTasksServiceMockRestoreTask) Times(n uint64) *mTasksServiceMockRestoreTask {
 	if n == 0 {
-		mmDeleteTask.mock.t.Fatalf("Times of TasksServiceMock.DeleteTask mock can not be zero")
+		mmRestoreTask.mock.t.Fatalf("Times of TasksServiceMock.RestoreTask mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteTask.expectedInvocations, n)
-	mmDeleteTask.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteTask
+	mm_atomic.StoreUint64(&mmRestoreTask.expectedInvocations, n)
+	mmRestoreTask.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRestoreTask
 }
 
-func (mmDeleteTask *mTasksServiceMockDeleteTask) invocationsDone() bool {
-	if len(mmDeleteTask.expectations) == 0 && mmDeleteTask.defaultExpectation == nil && mmDeleteTask.mock.funcDeleteTask == nil {
+func (mmRestoreTask *mTasksServiceMockRestoreTask) invocationsDone() bool {
+	if len(mmRestoreTask.expectations) == 0 && mmRestoreTask.defaultExpectation == nil && mmRestoreTask.mock.funcRestoreTask == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteTask.mock.afterDeleteTaskCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteTask.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmRestoreTask.mock.afterRestoreTaskCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRestoreTask.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteTask implements mm_handlers.TasksService
-func (mmDeleteTask *TasksServiceMock) DeleteTask(ctx context.Context, taskId string) (err error) {
-	mm_atomic.AddUint64(&mmDeleteTask.beforeDeleteTaskCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteTask.afterDeleteTaskCounter, 1)
+// RestoreTask implements mm_handlers.TasksService
+func (mmRestoreTask *TasksServiceMock) RestoreTask(ctx context.Context, taskId string) (tp1 *model.Task, err error) {
+	mm_atomic.AddUint64(&mmRestoreTask.beforeRestoreTaskCounter, 1)
+	defer mm_atomic.AddUint64(&mmRestoreTask.afterRestoreTaskCounter, 1)
 
-	mmDeleteTask.t.Helper()
+	mmRestoreTask.t.Helper()
 
-	if mmDeleteTask.inspectFuncDeleteTask != nil {
-		mmDeleteTask.inspectFuncDeleteTask(ctx, taskId)
+	if mmRestoreTask.inspectFuncRestoreTask != nil {
+		mmRestoreTask.inspectFuncRestoreTask(ctx, taskId)
 	}
 
-	mm_params := TasksServiceMockDeleteTaskParams{ctx, taskId}
+	mm_params := TasksServiceMockRestoreTaskParams{ctx, taskId}
 
 	// Record call args
-	mmDeleteTask.DeleteTaskMock.mutex.Lock()
-	mmDeleteTask.DeleteTaskMock.callArgs = append(mmDeleteTask.DeleteTaskMock.callArgs, &mm_params)
-	mmDeleteTask.DeleteTaskMock.mutex.Unlock()
+	mmRestoreTask.RestoreTaskMock.mutex.Lock()
+	mmRestoreTask.RestoreTaskMock.callArgs = append(mmRestoreTask.RestoreTaskMock.callArgs, &mm_params)
+	mmRestoreTask.RestoreTaskMock.mutex.Unlock()
 
-	for _, e := range mmDeleteTask.DeleteTaskMock.expectations {
+	for _, e := range mmRestoreTask.RestoreTaskMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.tp1, e.results.err
 		}
 	}
 
-	if mmDeleteTask.DeleteTaskMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteTask.DeleteTaskMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteTask.DeleteTaskMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteTask.DeleteTaskMock.defaultExpectation.paramPtrs
+	if mmRestoreTask.RestoreTaskMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRestoreTask.RestoreTaskMock.defaultExpectation.Counter, 1)
+		mm_want := mmRestoreTask.RestoreTaskMock.defaultExpectation.params
+		mm_want_ptrs := mmRestoreTask.RestoreTaskMock.defaultExpectation.paramPtrs
 
-		mm_got := TasksServiceMockDeleteTaskParams{ctx, taskId}
+		mm_got := TasksServiceMockRestoreTaskParams{ctx, taskId}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteTask.t.Errorf("TasksServiceMock.DeleteTask got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteTask.DeleteTaskMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmRestoreTask.t.Errorf("TasksServiceMock.RestoreTask got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreTask.RestoreTaskMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.taskId != nil && !minimock.Equal(*mm_want_ptrs.taskId, mm_got.taskId) {
-				mmDeleteTask.t.Errorf("TasksServiceMock.DeleteTask got unexpected parameter taskId, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteTask.DeleteTaskMock.defaultExpectation.expectationOrigins.originTaskId, *mm_want_ptrs.taskId, mm_got.taskId, minimock.Diff(*mm_want_ptrs.taskId, mm_got.taskId))
+				mmRestoreTask.t.Errorf("TasksServiceMock.RestoreTask got unexpected parameter taskId, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreTask.RestoreTaskMock.defaultExpectation.expectationOrigins.originTaskId, *mm_want_ptrs.taskId, mm_got.taskId, minimock.Diff(*mm_want_ptrs.taskId, mm_got.taskId))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteTask.t.Errorf("TasksServiceMock.DeleteTask got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteTask.DeleteTaskMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmRestoreTask.t.Errorf("TasksServiceMock.RestoreTask got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRestoreTask.RestoreTaskMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteTask.DeleteTaskMock.defaultExpectation.results
+		mm_results := mmRestoreTask.RestoreTaskMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteTask.t.Fatal("No results are set for the TasksServiceMock.DeleteTask")
+			mmRestoreTask.t.Fatal("No results are set for the TasksServiceMock.RestoreTask")
 		}
-		return (*mm_results).err
+		return (*mm_results).tp1, (*mm_results).err
 	}
-	if mmDeleteTask.funcDeleteTask != nil {
-		return mmDeleteTask.funcDeleteTask(ctx, taskId)
+	if mmRestoreTask.funcRestoreTask != nil {
+		return mmRestoreTask.funcRestoreTask(ctx, taskId)
 	}
-	mmDeleteTask.t.Fatalf("Unexpected call to TasksServiceMock.DeleteTask. %v %v", ctx, taskId)
+	mmRestoreTask.t.Fatalf("Unexpected call to TasksServiceMock.RestoreTask. %v %v", ctx, taskId)
 	return
 }
 
-// DeleteTaskAfterCounter returns a count of finished TasksServiceMock.DeleteTask invocations
-func (mmDeleteTask *TasksServiceMock) DeleteTaskAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteTask.afterDeleteTaskCounter)
+// RestoreTaskAfterCounter returns a count of finished TasksServiceMock.RestoreTask invocations
+func (mmRestoreTask *TasksServiceMock) RestoreTaskAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestoreTask.afterRestoreTaskCounter)
 }
 
-// DeleteTaskBeforeCounter returns a count of TasksServiceMock.DeleteTask invocations
-func (mmDeleteTask *TasksServiceMock) DeleteTaskBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteTask.beforeDeleteTaskCounter)
+// RestoreTaskBeforeCounter returns a count of TasksServiceMock.RestoreTask invocations
+func (mmRestoreTask *TasksServiceMock) RestoreTaskBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestoreTask.beforeRestoreTaskCounter)
 }
 
-// Calls returns a list of arguments used in each call to TasksServiceMock.DeleteTask.
+// Calls returns a list of arguments used in each call to TasksServiceMock.RestoreTask.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteTask *mTasksServiceMockDeleteTask) Calls() []*TasksServiceMockDeleteTaskParams {
-	mmDeleteTask.mutex.RLock()
+func (mmRestoreTask *mTasksServiceMockRestoreTask) Calls() []*TasksServiceMockRestoreTaskParams {
+	mmRestoreTask.mutex.RLock()
 
-	argCopy := make([]*TasksServiceMockDeleteTaskParams, len(mmDeleteTask.callArgs))
-	copy(argCopy, mmDeleteTask.callArgs)
+	argCopy := make([]*TasksServiceMockRestoreTaskParams, len(mmRestoreTask.callArgs))
+	copy(argCopy, mmRestoreTask.callArgs)
 
-	mmDeleteTask.mutex.RUnlock()
+	mmRestoreTask.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteTaskDone returns true if the count of the DeleteTask invocations corresponds
+// MinimockRestoreTaskDone returns true if the count of the RestoreTask invocations corresponds
 // the number of defined expectations
-func (m *TasksServiceMock) MinimockDeleteTaskDone() bool {
-	if m.DeleteTaskMock.optional {
+func (m *TasksServiceMock) MinimockRestoreTaskDone() bool {
+	if m.RestoreTaskMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteTaskMock.expectations {
+	for _, e := range m.RestoreTaskMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteTaskMock.invocationsDone()
+	return m.RestoreTaskMock.invocationsDone()
 }
 
-// MinimockDeleteTaskInspect logs each unmet expectation
-func (m *TasksServiceMock) MinimockDeleteTaskInspect() {
-	for _, e := range m.DeleteTaskMock.expectations {
+// MinimockRestoreTaskInspect logs each unmet expectation
+func (m *TasksServiceMock) MinimockRestoreTaskInspect() {
+	for _, e := range m.RestoreTaskMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to TasksServiceMock.DeleteTask at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to TasksServiceMock.RestoreTask at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteTaskCounter := mm_atomic.LoadUint64(&m.afterDeleteTaskCounter)
+	afterRestoreTaskCounter := mm_atomic.LoadUint64(&m.afterRestoreTaskCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteTaskMock.defaultExpectation != nil && afterDeleteTaskCounter < 1 {
-		if m.DeleteTaskMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to TasksServiceMock.DeleteTask at\n%s", m.DeleteTaskMock.defaultExpectation.returnOrigin)
+	if m.RestoreTaskMock.defaultExpectation != nil && afterRestoreTaskCounter < 1 {
+		if m.RestoreTaskMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksServiceMock.RestoreTask at\n%s", m.RestoreTaskMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to TasksServiceMock.DeleteTask at\n%s with params: %#v", m.DeleteTaskMock.defaultExpectation.expectationOrigins.origin, *m.DeleteTaskMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to TasksServiceMock.RestoreTask at\n%s with params: %#v", m.RestoreTaskMock.defaultExpectation.expectationOrigins.origin, *m.RestoreTaskMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteTask != nil && afterDeleteTaskCounter < 1 {
-		m.t.Errorf("Expected call to TasksServiceMock.DeleteTask at\n%s", m.funcDeleteTaskOrigin)
+	if m.funcRestoreTask != nil && afterRestoreTaskCounter < 1 {
+		m.t.Errorf("Expected call to TasksServiceMock.RestoreTask at\n%s", m.funcRestoreTaskOrigin)
 	}
 
-	if !m.DeleteTaskMock.invocationsDone() && afterDeleteTaskCounter > 0 {
-		m.t.Errorf("Expected %d calls to TasksServiceMock.DeleteTask at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteTaskMock.expectedInvocations), m.DeleteTaskMock.expectedInvocationsOrigin, afterDeleteTaskCounter)
+	if !m.RestoreTaskMock.invocationsDone() && afterRestoreTaskCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksServiceMock.RestoreTask at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RestoreTaskMock.expectedInvocations), m.RestoreTaskMock.expectedInvocationsOrigin, afterRestoreTaskCounter)
 	}
 }
 
-type mTasksServiceMockRegisterTask struct {
+type mTasksServiceMockSearchTasks struct {
 	optional           bool
 	mock               *TasksServiceMock
-	defaultExpectation *TasksServiceMockRegisterTaskExpectation
-	expectations       []*TasksServiceMockRegisterTaskExpectation
+	defaultExpectation *TasksServiceMockSearchTasksExpectation
+	expectations       []*TasksServiceMockSearchTasksExpectation
 
-	callArgs []*TasksServiceMockRegisterTaskParams
+	callArgs []*TasksServiceMockSearchTasksParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// TasksServiceMockRegisterTaskExpectation specifies expectation struct of the TasksService.RegisterTask
-type TasksServiceMockRegisterTaskExpectation struct {
+// TasksServiceMockSearchTasksExpectation specifies expectation struct of the TasksService.SearchTasks
+type TasksServiceMockSearchTasksExpectation struct {
 	mock               *TasksServiceMock
-	params             *TasksServiceMockRegisterTaskParams
-	paramPtrs          *TasksServiceMockRegisterTaskParamPtrs
-	expectationOrigins TasksServiceMockRegisterTaskExpectationOrigins
-	results            *TasksServiceMockRegisterTaskResults
+	params             *TasksServiceMockSearchTasksParams
+	paramPtrs          *TasksServiceMockSearchTasksParamPtrs
+	expectationOrigins TasksServiceMockSearchTasksExpectationOrigins
+	results            *TasksServiceMockSearchTasksResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// TasksServiceMockRegisterTaskParams contains parameters of the TasksService.RegisterTask
-type TasksServiceMockRegisterTaskParams struct {
+// TasksServiceMockSearchTasksParams contains parameters of the TasksService.SearchTasks
+type TasksServiceMockSearchTasksParams struct {
 	ctx   context.Context
-	title string
+	query string
+	limit int
 }
 
-// TasksServiceMockRegisterTaskParamPtrs contains pointers to parameters of the TasksService.RegisterTask
-type TasksServiceMockRegisterTaskParamPtrs struct {
+// TasksServiceMockSearchTasksParamPtrs contains pointers to parameters of the TasksService.SearchTasks
+type TasksServiceMockSearchTasksParamPtrs struct {
 	ctx   *context.Context
-	title *string
+	query *string
+	limit *int
 }
 
-// TasksServiceMockRegisterTaskResults contains results of the TasksService.RegisterTask
-type TasksServiceMockRegisterTaskResults struct {
-	s1  string
+// TasksServiceMockSearchTasksResults contains results of the TasksService.SearchTasks
+type TasksServiceMockSearchTasksResults struct {
+	ta1 []model.Task
 	err error
 }
 
-// TasksServiceMockRegisterTaskOrigins contains origins of expectations of the TasksService.RegisterTask
-type TasksServiceMockRegisterTaskExpectationOrigins struct {
+// TasksServiceMockSearchTasksOrigins contains origins of expectations of the TasksService.SearchTasks
+type TasksServiceMockSearchTasksExpectationOrigins struct {
 	origin      string
 	originCtx   string
-	originTitle string
+	originQuery string
+	originLimit string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -459,292 +2992,320 @@ type TasksServiceMockRegisterTaskExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmRegisterTask *mTasksServiceMockRegisterTask) Optional() *mTasksServiceMockRegisterTask {
-	mmRegisterTask.optional = true
-	return mmRegisterTask
+func (mmSearchTasks *mTasksServiceMockSearchTasks) Optional() *mTasksServiceMockSearchTasks {
+	mmSearchTasks.optional = true
+	return mmSearchTasks
 }
 
-// Expect sets up expected params for TasksService.RegisterTask
-func (mmRegisterTask *mTasksServiceMockRegisterTask) Expect(ctx context.Context, title string) *mTasksServiceMockRegisterTask {
-	if mmRegisterTask.mock.funcRegisterTask != nil {
-		mmRegisterTask.mock.t.Fatalf("TasksServiceMock.RegisterTask mock is already set by Set")
+// Expect sets up expected params for TasksService.SearchTasks
+func (mmSearchTasks *mTasksServiceMockSearchTasks) Expect(ctx context.Context, query string, limit int) *mTasksServiceMockSearchTasks {
+	if mmSearchTasks.mock.funcSearchTasks != nil {
+		mmSearchTasks.mock.t.Fatalf("TasksServiceMock.SearchTasks mock is already set by Set")
 	}
 
-	if mmRegisterTask.defaultExpectation == nil {
-		mmRegisterTask.defaultExpectation = &TasksServiceMockRegisterTaskExpectation{}
+	if mmSearchTasks.defaultExpectation == nil {
+		mmSearchTasks.defaultExpectation = &TasksServiceMockSearchTasksExpectation{}
 	}
 
-	if mmRegisterTask.defaultExpectation.paramPtrs != nil {
-		mmRegisterTask.mock.t.Fatalf("TasksServiceMock.RegisterTask mock is already set by ExpectParams functions")
+	if mmSearchTasks.defaultExpectation.paramPtrs != nil {
+		mmSearchTasks.mock.t.Fatalf("TasksServiceMock.SearchTasks mock is already set by ExpectParams functions")
 	}
 
-	mmRegisterTask.defaultExpectation.params = &TasksServiceMockRegisterTaskParams{ctx, title}
-	mmRegisterTask.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmRegisterTask.expectations {
-		if minimock.Equal(e.params, mmRegisterTask.defaultExpectation.params) {
-			mmRegisterTask.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRegisterTask.defaultExpectation.params)
+	mmSearchTasks.defaultExpectation.params = &TasksServiceMockSearchTasksParams{ctx, query, limit}
+	mmSearchTasks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSearchTasks.expectations {
+		if minimock.Equal(e.params, mmSearchTasks.defaultExpectation.params) {
+			mmSearchTasks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearchTasks.defaultExpectation.params)
 		}
 	}
 
-	return mmRegisterTask
+	return mmSearchTasks
 }
 
-// ExpectCtxParam1 sets up expected param ctx for TasksService.RegisterTask
-func (mmRegisterTask *mTasksServiceMockRegisterTask) ExpectCtxParam1(ctx context.Context) *mTasksServiceMockRegisterTask {
-	if mmRegisterTask.mock.funcRegisterTask != nil {
-		mmRegisterTask.mock.t.Fatalf("TasksServiceMock.RegisterTask mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for TasksService.SearchTasks
+func (mmSearchTasks *mTasksServiceMockSearchTasks) ExpectCtxParam1(ctx context.Context) *mTasksServiceMockSearchTasks {
+	if mmSearchTasks.mock.funcSearchTasks != nil {
+		mmSearchTasks.mock.t.Fatalf("TasksServiceMock.SearchTasks mock is already set by Set")
 	}
 
-	if mmRegisterTask.defaultExpectation == nil {
-		mmRegisterTask.defaultExpectation = &TasksServiceMockRegisterTaskExpectation{}
+	if mmSearchTasks.defaultExpectation == nil {
+		mmSearchTasks.defaultExpectation = &TasksServiceMockSearchTasksExpectation{}
 	}
 
-	if mmRegisterTask.defaultExpectation.params != nil {
-		mmRegisterTask.mock.t.Fatalf("TasksServiceMock.RegisterTask mock is already set by Expect")
+	if mmSearchTasks.defaultExpectation.params != nil {
+		mmSearchTasks.mock.t.Fatalf("TasksServiceMock.SearchTasks mock is already set by Expect")
 	}
 
-	if mmRegisterTask.defaultExpectation.paramPtrs == nil {
-		mmRegisterTask.defaultExpectation.paramPtrs = &TasksServiceMockRegisterTaskParamPtrs{}
+	if mmSearchTasks.defaultExpectation.paramPtrs == nil {
+		mmSearchTasks.defaultExpectation.paramPtrs = &TasksServiceMockSearchTasksParamPtrs{}
 	}
-	mmRegisterTask.defaultExpectation.paramPtrs.ctx = &ctx
-	mmRegisterTask.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmSearchTasks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSearchTasks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmRegisterTask
+	return mmSearchTasks
 }
 
-// ExpectTitleParam2 sets up expected param title for TasksService.RegisterTask
-func (mmRegisterTask *mTasksServiceMockRegisterTask) ExpectTitleParam2(title string) *mTasksServiceMockRegisterTask {
-	if mmRegisterTask.mock.funcRegisterTask != nil {
-		mmRegisterTask.mock.t.Fatalf("TasksServiceMock.RegisterTask mock is already set by Set")
+// ExpectQueryParam2 sets up expected param query for TasksService.SearchTasks
+func (mmSearchTasks *mTasksServiceMockSearchTasks) ExpectQueryParam2(query string) *mTasksServiceMockSearchTasks {
+	if mmSearchTasks.mock.funcSearchTasks != nil {
+		mmSearchTasks.mock.t.Fatalf("TasksServiceMock.SearchTasks mock is already set by Set")
 	}
 
-	if mmRegisterTask.defaultExpectation == nil {
-		mmRegisterTask.defaultExpectation = &TasksServiceMockRegisterTaskExpectation{}
+	if mmSearchTasks.defaultExpectation == nil {
+		mmSearchTasks.defaultExpectation = &TasksServiceMockSearchTasksExpectation{}
 	}
 
-	if mmRegisterTask.defaultExpectation.params != nil {
-		mmRegisterTask.mock.t.Fatalf("TasksServiceMock.RegisterTask mock is already set by Expect")
+	if mmSearchTasks.defaultExpectation.params != nil {
+		mmSearchTasks.mock.t.Fatalf("TasksServiceMock.SearchTasks mock is already set by Expect")
 	}
 
-	if mmRegisterTask.defaultExpectation.paramPtrs == nil {
-		mmRegisterTask.defaultExpectation.paramPtrs = &TasksServiceMockRegisterTaskParamPtrs{}
+	if mmSearchTasks.defaultExpectation.paramPtrs == nil {
+		mmSearchTasks.defaultExpectation.paramPtrs = &TasksServiceMockSearchTasksParamPtrs{}
 	}
-	mmRegisterTask.defaultExpectation.paramPtrs.title = &title
-	mmRegisterTask.defaultExpectation.expectationOrigins.originTitle = minimock.CallerInfo(1)
+	mmSearchTasks.defaultExpectation.paramPtrs.query = &query
+	mmSearchTasks.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
 
-	return mmRegisterTask
+	return mmSearchTasks
 }
 
-// Inspect accepts an inspector function that has same arguments as the TasksService.RegisterTask
-func (mmRegisterTask *mTasksServiceMockRegisterTask) Inspect(f func(ctx context.Context, title string)) *mTasksServiceMockRegisterTask {
-	if mmRegisterTask.mock.inspectFuncRegisterTask != nil {
-		mmRegisterTask.mock.t.Fatalf("Inspect function is already set for TasksServiceMock.RegisterTask")
+// ExpectLimitParam3 sets up expected param limit for TasksService.SearchTasks
+func (mmSearchTasks *mTasksServiceMockSearchTasks) ExpectLimitParam3(limit int) *mTasksServiceMockSearchTasks {
+	if mmSearchTasks.mock.funcSearchTasks != nil {
+		mmSearchTasks.mock.t.Fatalf("TasksServiceMock.SearchTasks mock is already set by Set")
 	}
 
-	mmRegisterTask.mock.inspectFuncRegisterTask = f
+	if mmSearchTasks.defaultExpectation == nil {
+		mmSearchTasks.defaultExpectation = &TasksServiceMockSearchTasksExpectation{}
+	}
 
-	return mmRegisterTask
+	if mmSearchTasks.defaultExpectation.params != nil {
+		mmSearchTasks.mock.t.Fatalf("TasksServiceMock.SearchTasks mock is already set by Expect")
+	}
+
+	if mmSearchTasks.defaultExpectation.paramPtrs == nil {
+		mmSearchTasks.defaultExpectation.paramPtrs = &TasksServiceMockSearchTasksParamPtrs{}
+	}
+	mmSearchTasks.defaultExpectation.paramPtrs.limit = &limit
+	mmSearchTasks.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmSearchTasks
 }
 
-// Return sets up results that will be returned by TasksService.RegisterTask
-func (mmRegisterTask *mTasksServiceMockRegisterTask) Return(s1 string, err error) *TasksServiceMock {
-	if mmRegisterTask.mock.funcRegisterTask != nil {
-		mmRegisterTask.mock.t.Fatalf("TasksServiceMock.RegisterTask mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the TasksService.SearchTasks
+func (mmSearchTasks *mTasksServiceMockSearchTasks) Inspect(f func(ctx context.Context, query string, limit int)) *mTasksServiceMockSearchTasks {
+	if mmSearchTasks.mock.inspectFuncSearchTasks != nil {
+		mmSearchTasks.mock.t.Fatalf("Inspect function is already set for TasksServiceMock.SearchTasks")
 	}
 
-	if mmRegisterTask.defaultExpectation == nil {
-		mmRegisterTask.defaultExpectation = &TasksServiceMockRegisterTaskExpectation{mock: mmRegisterTask.mock}
+	mmSearchTasks.mock.inspectFuncSearchTasks = f
+
+	return mmSearchTasks
+}
+
+// Return sets up results that will be returned by TasksService.SearchTasks
+func (mmSearchTasks *mTasksServiceMockSearchTasks) Return(ta1 []model.Task, err error) *TasksServiceMock {
+	if mmSearchTasks.mock.funcSearchTasks != nil {
+		mmSearchTasks.mock.t.Fatalf("TasksServiceMock.SearchTasks mock is already set by Set")
 	}
-	mmRegisterTask.defaultExpectation.results = &TasksServiceMockRegisterTaskResults{s1, err}
-	mmRegisterTask.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmRegisterTask.mock
+
+	if mmSearchTasks.defaultExpectation == nil {
+		mmSearchTasks.defaultExpectation = &TasksServiceMockSearchTasksExpectation{mock: mmSearchTasks.mock}
+	}
+	mmSearchTasks.defaultExpectation.results = &TasksServiceMockSearchTasksResults{ta1, err}
+	mmSearchTasks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSearchTasks.mock
 }
 
-// Set uses given function f to mock the TasksService.RegisterTask method
-func (mmRegisterTask *mTasksServiceMockRegisterTask) Set(f func(ctx context.Context, title string) (s1 string, err error)) *TasksServiceMock {
-	if mmRegisterTask.defaultExpectation != nil {
-		mmRegisterTask.mock.t.Fatalf("Default expectation is already set for the TasksService.RegisterTask method")
+// Set uses given function f to mock the TasksService.SearchTasks method
+func (mmSearchTasks *mTasksServiceMockSearchTasks) Set(f func(ctx context.Context, query string, limit int) (ta1 []model.Task, err error)) *TasksServiceMock {
+	if mmSearchTasks.defaultExpectation != nil {
+		mmSearchTasks.mock.t.Fatalf("Default expectation is already set for the TasksService.SearchTasks method")
 	}
 
-	if len(mmRegisterTask.expectations) > 0 {
-		mmRegisterTask.mock.t.Fatalf("Some expectations are already set for the TasksService.RegisterTask method")
+	if len(mmSearchTasks.expectations) > 0 {
+		mmSearchTasks.mock.t.Fatalf("Some expectations are already set for the TasksService.SearchTasks method")
 	}
 
-	mmRegisterTask.mock.funcRegisterTask = f
-	mmRegisterTask.mock.funcRegisterTaskOrigin = minimock.CallerInfo(1)
-	return mmRegisterTask.mock
+	mmSearchTasks.mock.funcSearchTasks = f
+	mmSearchTasks.mock.funcSearchTasksOrigin = minimock.CallerInfo(1)
+	return mmSearchTasks.mock
 }
 
-// When sets expectation for the TasksService.RegisterTask which will trigger the result defined by the following
+// When sets expectation for the TasksService.SearchTasks which will trigger the result defined by the following
 // Then helper
-func (mmRegisterTask *mTasksServiceMockRegisterTask) When(ctx context.Context, title string) *TasksServiceMockRegisterTaskExpectation {
-	if mmRegisterTask.mock.funcRegisterTask != nil {
-		mmRegisterTask.mock.t.Fatalf("TasksServiceMock.RegisterTask mock is already set by Set")
+func (mmSearchTasks *mTasksServiceMockSearchTasks) When(ctx context.Context, query string, limit int) *TasksServiceMockSearchTasksExpectation {
+	if mmSearchTasks.mock.funcSearchTasks != nil {
+		mmSearchTasks.mock.t.Fatalf("TasksServiceMock.SearchTasks mock is already set by Set")
 	}
 
-	expectation := &TasksServiceMockRegisterTaskExpectation{
-		mock:               mmRegisterTask.mock,
-		params:             &TasksServiceMockRegisterTaskParams{ctx, title},
-		expectationOrigins: TasksServiceMockRegisterTaskExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &TasksServiceMockSearchTasksExpectation{
+		mock:               mmSearchTasks.mock,
+		params:             &TasksServiceMockSearchTasksParams{ctx, query, limit},
+		expectationOrigins: TasksServiceMockSearchTasksExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmRegisterTask.expectations = append(mmRegisterTask.expectations, expectation)
+	mmSearchTasks.expectations = append(mmSearchTasks.expectations, expectation)
 	return expectation
 }
 
-// Then sets up TasksService.RegisterTask return parameters for the expectation previously defined by the When method
-func (e *TasksServiceMockRegisterTaskExpectation) Then(s1 string, err error) *TasksServiceMock {
-	e.results = &TasksServiceMockRegisterTaskResults{s1, err}
+// Then sets up TasksService.SearchTasks return parameters for the expectation previously defined by the When method
+func (e *TasksServiceMockSearchTasksExpectation) Then(ta1 []model.Task, err error) *TasksServiceMock {
+	e.results = &TasksServiceMockSearchTasksResults{ta1, err}
 	return e.mock
 }
 
-// Times sets number of times TasksService.RegisterTask should be invoked
-func (mmRegisterTask *mTasksServiceMockRegisterTask) Times(n uint64) *mTasksServiceMockRegisterTask {
+// Times sets number of times TasksService.SearchTasks should be invoked
+func (mmSearchTasks *mTasksServiceMockSearchTasks) Times(n uint64) *mTasksServiceMockSearchTasks {
 	if n == 0 {
-		mmRegisterTask.mock.t.Fatalf("Times of TasksServiceMock.RegisterTask mock can not be zero")
+		mmSearchTasks.mock.t.Fatalf("Times of TasksServiceMock.SearchTasks mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmRegisterTask.expectedInvocations, n)
-	mmRegisterTask.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmRegisterTask
+	mm_atomic.StoreUint64(&mmSearchTasks.expectedInvocations, n)
+	mmSearchTasks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSearchTasks
 }
 
-func (mmRegisterTask *mTasksServiceMockRegisterTask) invocationsDone() bool {
-	if len(mmRegisterTask.expectations) == 0 && mmRegisterTask.defaultExpectation == nil && mmRegisterTask.mock.funcRegisterTask == nil {
+func (mmSearchTasks *mTasksServiceMockSearchTasks) invocationsDone() bool {
+	if len(mmSearchTasks.expectations) == 0 && mmSearchTasks.defaultExpectation == nil && mmSearchTasks.mock.funcSearchTasks == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmRegisterTask.mock.afterRegisterTaskCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmRegisterTask.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSearchTasks.mock.afterSearchTasksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSearchTasks.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// RegisterTask implements mm_handlers.TasksService
-func (mmRegisterTask *TasksServiceMock) RegisterTask(ctx context.Context, title string) (s1 string, err error) {
-	mm_atomic.AddUint64(&mmRegisterTask.beforeRegisterTaskCounter, 1)
-	defer mm_atomic.AddUint64(&mmRegisterTask.afterRegisterTaskCounter, 1)
+// SearchTasks implements mm_handlers.TasksService
+func (mmSearchTasks *TasksServiceMock) SearchTasks(ctx context.Context, query string, limit int) (ta1 []model.Task, err error) {
+	mm_atomic.AddUint64(&mmSearchTasks.beforeSearchTasksCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearchTasks.afterSearchTasksCounter, 1)
 
-	mmRegisterTask.t.Helper()
+	mmSearchTasks.t.Helper()
 
-	if mmRegisterTask.inspectFuncRegisterTask != nil {
-		mmRegisterTask.inspectFuncRegisterTask(ctx, title)
+	if mmSearchTasks.inspectFuncSearchTasks != nil {
+		mmSearchTasks.inspectFuncSearchTasks(ctx, query, limit)
 	}
 
-	mm_params := TasksServiceMockRegisterTaskParams{ctx, title}
+	mm_params := TasksServiceMockSearchTasksParams{ctx, query, limit}
 
 	// Record call args
-	mmRegisterTask.RegisterTaskMock.mutex.Lock()
-	mmRegisterTask.RegisterTaskMock.callArgs = append(mmRegisterTask.RegisterTaskMock.callArgs, &mm_params)
-	mmRegisterTask.RegisterTaskMock.mutex.Unlock()
+	mmSearchTasks.SearchTasksMock.mutex.Lock()
+	mmSearchTasks.SearchTasksMock.callArgs = append(mmSearchTasks.SearchTasksMock.callArgs, &mm_params)
+	mmSearchTasks.SearchTasksMock.mutex.Unlock()
 
-	for _, e := range mmRegisterTask.RegisterTaskMock.expectations {
+	for _, e := range mmSearchTasks.SearchTasksMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.s1, e.results.err
+			return e.results.ta1, e.results.err
 		}
 	}
 
-	if mmRegisterTask.RegisterTaskMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmRegisterTask.RegisterTaskMock.defaultExpectation.Counter, 1)
-		mm_want := mmRegisterTask.RegisterTaskMock.defaultExpectation.params
-		mm_want_ptrs := mmRegisterTask.RegisterTaskMock.defaultExpectation.paramPtrs
+	if mmSearchTasks.SearchTasksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearchTasks.SearchTasksMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearchTasks.SearchTasksMock.defaultExpectation.params
+		mm_want_ptrs := mmSearchTasks.SearchTasksMock.defaultExpectation.paramPtrs
 
-		mm_got := TasksServiceMockRegisterTaskParams{ctx, title}
+		mm_got := TasksServiceMockSearchTasksParams{ctx, query, limit}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmRegisterTask.t.Errorf("TasksServiceMock.RegisterTask got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmRegisterTask.RegisterTaskMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSearchTasks.t.Errorf("TasksServiceMock.SearchTasks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchTasks.SearchTasksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmSearchTasks.t.Errorf("TasksServiceMock.SearchTasks got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchTasks.SearchTasksMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
 			}
 
-			if mm_want_ptrs.title != nil && !minimock.Equal(*mm_want_ptrs.title, mm_got.title) {
-				mmRegisterTask.t.Errorf("TasksServiceMock.RegisterTask got unexpected parameter title, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmRegisterTask.RegisterTaskMock.defaultExpectation.expectationOrigins.originTitle, *mm_want_ptrs.title, mm_got.title, minimock.Diff(*mm_want_ptrs.title, mm_got.title))
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmSearchTasks.t.Errorf("TasksServiceMock.SearchTasks got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchTasks.SearchTasksMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmRegisterTask.t.Errorf("TasksServiceMock.RegisterTask got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmRegisterTask.RegisterTaskMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSearchTasks.t.Errorf("TasksServiceMock.SearchTasks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSearchTasks.SearchTasksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmRegisterTask.RegisterTaskMock.defaultExpectation.results
+		mm_results := mmSearchTasks.SearchTasksMock.defaultExpectation.results
 		if mm_results == nil {
-			mmRegisterTask.t.Fatal("No results are set for the TasksServiceMock.RegisterTask")
+			mmSearchTasks.t.Fatal("No results are set for the TasksServiceMock.SearchTasks")
 		}
-		return (*mm_results).s1, (*mm_results).err
+		return (*mm_results).ta1, (*mm_results).err
 	}
-	if mmRegisterTask.funcRegisterTask != nil {
-		return mmRegisterTask.funcRegisterTask(ctx, title)
+	if mmSearchTasks.funcSearchTasks != nil {
+		return mmSearchTasks.funcSearchTasks(ctx, query, limit)
 	}
-	mmRegisterTask.t.Fatalf("Unexpected call to TasksServiceMock.RegisterTask. %v %v", ctx, title)
+	mmSearchTasks.t.Fatalf("Unexpected call to TasksServiceMock.SearchTasks. %v %v %v", ctx, query, limit)
 	return
 }
 
-// RegisterTaskAfterCounter returns a count of finished TasksServiceMock.RegisterTask invocations
-func (mmRegisterTask *TasksServiceMock) RegisterTaskAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmRegisterTask.afterRegisterTaskCounter)
+// SearchTasksAfterCounter returns a count of finished TasksServiceMock.SearchTasks invocations
+func (mmSearchTasks *TasksServiceMock) SearchTasksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchTasks.afterSearchTasksCounter)
 }
 
-// RegisterTaskBeforeCounter returns a count of TasksServiceMock.RegisterTask invocations
-func (mmRegisterTask *TasksServiceMock) RegisterTaskBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmRegisterTask.beforeRegisterTaskCounter)
+// SearchTasksBeforeCounter returns a count of TasksServiceMock.SearchTasks invocations
+func (mmSearchTasks *TasksServiceMock) SearchTasksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchTasks.beforeSearchTasksCounter)
 }
 
-// Calls returns a list of arguments used in each call to TasksServiceMock.RegisterTask.
+// Calls returns a list of arguments used in each call to TasksServiceMock.SearchTasks.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmRegisterTask *mTasksServiceMockRegisterTask) Calls() []*TasksServiceMockRegisterTaskParams {
-	mmRegisterTask.mutex.RLock()
+func (mmSearchTasks *mTasksServiceMockSearchTasks) Calls() []*TasksServiceMockSearchTasksParams {
+	mmSearchTasks.mutex.RLock()
 
-	argCopy := make([]*TasksServiceMockRegisterTaskParams, len(mmRegisterTask.callArgs))
-	copy(argCopy, mmRegisterTask.callArgs)
+	argCopy := make([]*TasksServiceMockSearchTasksParams, len(mmSearchTasks.callArgs))
+	copy(argCopy, mmSearchTasks.callArgs)
 
-	mmRegisterTask.mutex.RUnlock()
+	mmSearchTasks.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockRegisterTaskDone returns true if the count of the RegisterTask invocations corresponds
+// MinimockSearchTasksDone returns true if the count of the SearchTasks invocations corresponds
 // the number of defined expectations
-func (m *TasksServiceMock) MinimockRegisterTaskDone() bool {
-	if m.RegisterTaskMock.optional {
+func (m *TasksServiceMock) MinimockSearchTasksDone() bool {
+	if m.SearchTasksMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.RegisterTaskMock.expectations {
+	for _, e := range m.SearchTasksMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.RegisterTaskMock.invocationsDone()
+	return m.SearchTasksMock.invocationsDone()
 }
 
-// MinimockRegisterTaskInspect logs each unmet expectation
-func (m *TasksServiceMock) MinimockRegisterTaskInspect() {
-	for _, e := range m.RegisterTaskMock.expectations {
+// MinimockSearchTasksInspect logs each unmet expectation
+func (m *TasksServiceMock) MinimockSearchTasksInspect() {
+	for _, e := range m.SearchTasksMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to TasksServiceMock.RegisterTask at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to TasksServiceMock.SearchTasks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterRegisterTaskCounter := mm_atomic.LoadUint64(&m.afterRegisterTaskCounter)
+	afterSearchTasksCounter := mm_atomic.LoadUint64(&m.afterSearchTasksCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.RegisterTaskMock.defaultExpectation != nil && afterRegisterTaskCounter < 1 {
-		if m.RegisterTaskMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to TasksServiceMock.RegisterTask at\n%s", m.RegisterTaskMock.defaultExpectation.returnOrigin)
+	if m.SearchTasksMock.defaultExpectation != nil && afterSearchTasksCounter < 1 {
+		if m.SearchTasksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksServiceMock.SearchTasks at\n%s", m.SearchTasksMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to TasksServiceMock.RegisterTask at\n%s with params: %#v", m.RegisterTaskMock.defaultExpectation.expectationOrigins.origin, *m.RegisterTaskMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to TasksServiceMock.SearchTasks at\n%s with params: %#v", m.SearchTasksMock.defaultExpectation.expectationOrigins.origin, *m.SearchTasksMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcRegisterTask != nil && afterRegisterTaskCounter < 1 {
-		m.t.Errorf("Expected call to TasksServiceMock.RegisterTask at\n%s", m.funcRegisterTaskOrigin)
+	if m.funcSearchTasks != nil && afterSearchTasksCounter < 1 {
+		m.t.Errorf("Expected call to TasksServiceMock.SearchTasks at\n%s", m.funcSearchTasksOrigin)
 	}
 
-	if !m.RegisterTaskMock.invocationsDone() && afterRegisterTaskCounter > 0 {
-		m.t.Errorf("Expected %d calls to TasksServiceMock.RegisterTask at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.RegisterTaskMock.expectedInvocations), m.RegisterTaskMock.expectedInvocationsOrigin, afterRegisterTaskCounter)
+	if !m.SearchTasksMock.invocationsDone() && afterSearchTasksCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksServiceMock.SearchTasks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SearchTasksMock.expectedInvocations), m.SearchTasksMock.expectedInvocationsOrigin, afterSearchTasksCounter)
 	}
 }
 
@@ -1097,8 +3658,22 @@ func (m *TasksServiceMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockDeleteTaskInspect()
 
+			m.MinimockDeleteTasksInspect()
+
+			m.MinimockListTasksInspect()
+
+			m.MinimockPatchTaskInspect()
+
+			m.MinimockPurgeTaskInspect()
+
 			m.MinimockRegisterTaskInspect()
 
+			m.MinimockRegisterTasksInspect()
+
+			m.MinimockRestoreTaskInspect()
+
+			m.MinimockSearchTasksInspect()
+
 			m.MinimockTaskInfoInspect()
 		}
 	})
@@ -1124,6 +3699,13 @@ func (m *TasksServiceMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockDeleteTaskDone() &&
+		m.MinimockDeleteTasksDone() &&
+		m.MinimockListTasksDone() &&
+		m.MinimockPatchTaskDone() &&
+		m.MinimockPurgeTaskDone() &&
 		m.MinimockRegisterTaskDone() &&
+		m.MinimockRegisterTasksDone() &&
+		m.MinimockRestoreTaskDone() &&
+		m.MinimockSearchTasksDone() &&
 		m.MinimockTaskInfoDone()
 }
@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"test-server/internal/domain/model"
+)
+
+// readOnlyTaskFields are task fields that are present in responses but can't
+// be patched. They are the JSON fields of taskInfoResponse, so fields added
+// to responses are covered too. Patchable fields are matched before them.
+var readOnlyTaskFields = jsonFields(reflect.TypeFor[taskInfoResponse]())
+
+// jsonFields returns the JSON names of the fields of the struct type.
+func jsonFields(t reflect.Type) map[string]struct{} {
+	fields := make(map[string]struct{}, t.NumField())
+	for i := range t.NumField() {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			fields[name] = struct{}{}
+		}
+	}
+	return fields
+}
+
+// PatchTask applies a JSON Merge Patch (RFC 7396) document to the task.
+// Expected task version may be passed either in If-Match header or as
+// "version" member of the patch document.
+func (h *Handler) PatchTask(c *fiber.Ctx) error {
+	taskId := c.Params("id")
+	if !validateTaskId(taskId) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"ok":    false,
+			"error": "error: task id is empty or has incorrect format",
+		})
+	}
+
+	ifMatch, err := ifMatchVersion(c, func() (*model.Task, error) {
+		return h.tasksService.TaskInfo(c.UserContext(), taskId)
+	})
+	if err != nil {
+		return ifMatchError(c, err)
+	}
+
+	patch, err := parseTaskMergePatch(c.Body())
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"ok":    false,
+			"error": err.Error(),
+		})
+	}
+	if ifMatch != nil {
+		if patch.Version != nil && *patch.Version != *ifMatch {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"ok":    false,
+				"error": "version in request's body doesn't match If-Match header",
+			})
+		}
+		patch.Version = ifMatch
+	}
+
+	taskInfo, err := h.tasksService.PatchTask(c.UserContext(), taskId, patch)
+	if err != nil {
+		if errors.Is(err, model.ErrTaskNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"ok":    false,
+				"error": fmt.Errorf("task with provided id wasn't found: %w", err).Error(),
+			})
+		}
+		if errors.Is(err, model.ErrVersionMismatch) && ifMatch != nil {
+			return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{
+				"ok":    false,
+				"error": fmt.Errorf("task doesn't match If-Match header: %w", err).Error(),
+			})
+		}
+		if errors.Is(err, model.ErrVersionMismatch) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"ok":    false,
+				"error": fmt.Errorf("task was modified concurrently: %w", err).Error(),
+			})
+		}
+		if errors.Is(err, model.ErrFieldNotEditable) {
+			return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+				"ok":    false,
+				"error": err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"ok":    false,
+			"error": fmt.Errorf("failed to patch task with provided id: %w", err).Error(),
+		})
+	}
+
+	c.Set(fiber.HeaderETag, taskETag(taskInfo.Version))
+	return c.Status(fiber.StatusOK).JSON(getTaskInfoResponse{
+		OK:          true,
+		Error:       "",
+		TaskDetails: mapTaskToDTO(taskInfo),
+	})
+}
+
+// parseTaskMergePatch decodes a merge patch document into model.TaskPatch.
+// The optional "version" member is treated as the expected task version,
+// null means no expected version.
+func parseTaskMergePatch(body []byte) (model.TaskPatch, error) {
+	var patch model.TaskPatch
+
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(body, &doc); err != nil || doc == nil {
+		return patch, errors.New("request's body must be a JSON object")
+	}
+
+	for field, raw := range doc {
+		switch field {
+		case "title":
+			var title *string
+			if err := json.Unmarshal(raw, &title); err != nil {
+				return patch, errors.New("title must be a string")
+			}
+			if title == nil || *title == "" {
+				return patch, errors.New("title can't be removed or empty")
+			}
+			patch.Title = title
+		case "version":
+			// null, like an absent member, makes the patch unconditional
+			var version *int64
+			if err := json.Unmarshal(raw, &version); err != nil {
+				return patch, errors.New("version must be an integer")
+			}
+			patch.Version = version
+		default:
+			if _, ok := readOnlyTaskFields[field]; ok {
+				return patch, fmt.Errorf("field %q is read-only", field)
+			}
+			return patch, fmt.Errorf("unknown field %q", field)
+		}
+	}
+
+	return patch, nil
+}
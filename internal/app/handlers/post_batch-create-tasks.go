@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"test-server/internal/domain/model"
+)
+
+type postBatchCreateTasks struct {
+	Tasks []postRegisterTask `json:"tasks"`
+}
+
+// PostBatchCreateTasks registers several tasks at once and reports the
+// outcome of every item in the order of the request. Items beyond the tenant
+// quota fail and Retry-After is set; when no item is admitted by the quota
+// the response is 429. Like a single create, a batch sent while the service
+// is draining is rejected as a whole with 503.
+func (h *Handler) PostBatchCreateTasks(c *fiber.Ctx) error {
+	var request postBatchCreateTasks
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"ok":    false,
+			"error": "Cannot parse JSON",
+		})
+	}
+	if err := validateBatchSize(len(request.Tasks)); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"ok":    false,
+			"error": err.Error(),
+		})
+	}
+
+	items := make([]batchItemResponse, len(request.Tasks))
+	specs := make([]model.TaskSpec, 0, len(request.Tasks))
+	validIdx := make([]int, 0, len(request.Tasks))
+	for i, task := range request.Tasks {
+		spec, err := task.toSpec()
+		if err != nil {
+			items[i].Error = err.Error()
+			continue
+		}
+		specs = append(specs, spec)
+		validIdx = append(validIdx, i)
+	}
+
+	response := batchResponse{OK: true, Error: "", Results: items}
+	status := fiber.StatusOK
+	if len(specs) > 0 {
+		results := h.tasksService.RegisterTasks(c.UserContext(), specs)
+		response.Results = mergeBatchResults(items, validIdx, results)
+
+		rejected := 0
+		for _, result := range results {
+			if setRetryAfter(c, result.Err) {
+				rejected++
+			}
+		}
+		// the service rejects every item of a batch sent while draining
+		if errors.Is(results[0].Err, model.ErrServiceDraining) {
+			status = fiber.StatusServiceUnavailable
+			response.OK, response.Error = false, model.ErrServiceDraining.Error()
+		} else if rejected == len(results) {
+			status = fiber.StatusTooManyRequests
+			response.OK, response.Error = false, model.ErrQuotaExceeded.Error()
+		}
+	}
+
+	return c.Status(status).JSON(response)
+}
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type postBatchDeleteTasks struct {
+	TaskIds []string `json:"task_ids"`
+}
+
+// PostBatchDeleteTasks removes several tasks at once and reports the
+// outcome of every item in the order of the request.
+func (h *Handler) PostBatchDeleteTasks(c *fiber.Ctx) error {
+	var request postBatchDeleteTasks
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"ok":    false,
+			"error": "Cannot parse JSON",
+		})
+	}
+	if err := validateBatchSize(len(request.TaskIds)); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"ok":    false,
+			"error": err.Error(),
+		})
+	}
+
+	items := make([]batchItemResponse, len(request.TaskIds))
+	taskIds := make([]string, 0, len(request.TaskIds))
+	validIdx := make([]int, 0, len(request.TaskIds))
+	for i, taskId := range request.TaskIds {
+		if !validateTaskId(taskId) {
+			items[i].ID = taskId
+			items[i].Error = "error: task id is empty or has incorrect format"
+			continue
+		}
+		taskIds = append(taskIds, taskId)
+		validIdx = append(validIdx, i)
+	}
+
+	if len(taskIds) > 0 {
+		results := h.tasksService.DeleteTasks(c.UserContext(), taskIds)
+		items = mergeBatchResults(items, validIdx, results)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(batchResponse{
+		OK:      true,
+		Error:   "",
+		Results: items,
+	})
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"test-server/internal/auth"
+	"test-server/internal/tenant"
+)
+
+type postCreateKey struct {
+	ID     string   `json:"id"`
+	Tenant string   `json:"tenant"`
+	Scopes []string `json:"scopes"`
+}
+
+// CreateKey generates an API key. Its secret is only returned in this response.
+func (h *KeysHandler) CreateKey(c *fiber.Ctx) error {
+	var postCreateKey postCreateKey
+
+	if err := c.BodyParser(&postCreateKey); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"ok":    false,
+			"error": "Cannot parse JSON",
+		})
+	}
+
+	key, secret, err := h.keysService.CreateKey(c.UserContext(), postCreateKey.ID, postCreateKey.Tenant, postCreateKey.Scopes)
+	if err != nil {
+		status := fiber.StatusInternalServerError
+		switch {
+		case errors.Is(err, auth.ErrInvalidScope), errors.Is(err, auth.ErrInvalidKeyID),
+			errors.Is(err, tenant.ErrInvalidTenant):
+			status = fiber.StatusBadRequest
+		case errors.Is(err, auth.ErrKeyExists):
+			status = fiber.StatusConflict
+		}
+		return c.Status(status).JSON(fiber.Map{
+			"ok":    false,
+			"error": err.Error(),
+		})
+	}
+
+	response := newKeyResponse(*key)
+	response.Secret = secret
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"ok":   true,
+		"data": response,
+	})
+}
@@ -1,11 +1,33 @@
 package handlers
 
 import (
+	"errors"
+
 	"github.com/gofiber/fiber/v2"
+
+	"test-server/internal/domain/model"
 )
 
 type postRegisterTask struct {
-	Title string `json:"title"`
+	Title    string            `json:"title"`
+	Labels   map[string]string `json:"labels"`
+	Metadata map[string]any    `json:"metadata"`
+}
+
+// toSpec validates the request and converts it to model.TaskSpec.
+func (r postRegisterTask) toSpec() (model.TaskSpec, error) {
+	if r.Title == "" {
+		return model.TaskSpec{}, errors.New("request's body doesnt match schema")
+	}
+	if err := model.ValidateLabels(r.Labels); err != nil {
+		return model.TaskSpec{}, err
+	}
+
+	return model.TaskSpec{
+		Title:    r.Title,
+		Labels:   r.Labels,
+		Metadata: r.Metadata,
+	}, nil
 }
 
 func (h *Handler) PostRegisterTask(c *fiber.Ctx) error {
@@ -17,14 +39,27 @@ func (h *Handler) PostRegisterTask(c *fiber.Ctx) error {
 			"error": "Cannot parse JSON",
 		})
 	}
-	if postRegisterTask.Title == "" {
+	spec, err := postRegisterTask.toSpec()
+	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"ok":    false,
-			"error": "request's body doesnt match schema",
+			"error": err.Error(),
 		})
 	}
 
-	newID, err := h.tasksService.RegisterTask(c.UserContext(), postRegisterTask.Title)
+	newID, err := h.tasksService.RegisterTask(c.UserContext(), spec)
+	if setRetryAfter(c, err) {
+		return c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+			"ok":    false,
+			"error": err.Error(),
+		})
+	}
+	if errors.Is(err, model.ErrServiceDraining) {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"ok":    false,
+			"error": err.Error(),
+		})
+	}
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"ok":    false,
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+
+	"test-server/internal/domain/model"
+)
+
+// RestoreTask undoes soft deletion of the task.
+func (h *Handler) RestoreTask(c *fiber.Ctx) error {
+	taskId := c.Params("id")
+	if !validateTaskId(taskId) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"ok":    false,
+			"error": "error: task id is empty or has incorrect format",
+		})
+	}
+
+	taskInfo, err := h.tasksService.RestoreTask(c.UserContext(), taskId)
+	if err != nil {
+		if errors.Is(err, model.ErrTaskNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"ok":    false,
+				"error": fmt.Errorf("task with provided id wasn't found: %w", err).Error(),
+			})
+		}
+		if errors.Is(err, model.ErrTaskNotDeleted) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"ok":    false,
+				"error": fmt.Errorf("task with provided id can't be restored: %w", err).Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"ok":    false,
+			"error": fmt.Errorf("failed to restore task with provided id: %w", err).Error(),
+		})
+	}
+
+	c.Set(fiber.HeaderETag, taskETag(taskInfo.Version))
+	return c.Status(fiber.StatusOK).JSON(getTaskInfoResponse{
+		OK:          true,
+		Error:       "",
+		TaskDetails: mapTaskToDTO(taskInfo),
+	})
+}
@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"errors"
+	"math"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"test-server/internal/domain/model"
+)
+
+// setRetryAfter sets the Retry-After header, in whole seconds rounded up,
+// if err is a model.QuotaExceededError, and reports whether it is.
+func setRetryAfter(c *fiber.Ctx, err error) bool {
+	var exceeded *model.QuotaExceededError
+	if !errors.As(err, &exceeded) {
+		return false
+	}
+
+	seconds := max(1, int(math.Ceil(exceeded.RetryAfter.Seconds())))
+	c.Set(fiber.HeaderRetryAfter, strconv.Itoa(seconds))
+	return true
+}
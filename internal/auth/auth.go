@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Scopes granted to principals. ScopeAdmin grants every other scope.
+const (
+	ScopeRead  = "tasks:read"
+	ScopeWrite = "tasks:write"
+	ScopeAdmin = "tasks:admin"
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrInvalidScope       = errors.New("invalid scope")
+)
+
+// Prefixes of principal ids by credential source. Ids of different sources
+// never collide, so e.g. a JWT subject can't own the tasks of an API key
+// with the same name.
+const (
+	keyPrincipalPrefix  = "key:"
+	jwtPrincipalPrefix  = "jwt:"
+	certPrincipalPrefix = "cert:"
+)
+
+// Anonymous is the principal of every request when authentication is disabled.
+var Anonymous = Principal{ID: "anonymous", Scopes: []string{ScopeAdmin}}
+
+// Authenticator resolves the principal presenting token. It returns
+// ErrInvalidCredentials if the token isn't valid.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (Principal, error)
+}
+
+// Principal is the authenticated client of a request.
+type Principal struct {
+	// ID is prefixed by the credential source, e.g. "key:ci",
+	// "jwt:<issuer>/<subject>" or "cert:<common name>".
+	ID     string   `json:"id"`
+	Scopes []string `json:"scopes"`
+	// Tenant the principal is bound to. Principals without one may act on
+	// behalf of any tenant.
+	Tenant string `json:"tenant,omitempty"`
+}
+
+// HasScope reports whether the principal was granted scope, directly or by ScopeAdmin.
+func (p Principal) HasScope(scope string) bool {
+	return slices.Contains(p.Scopes, scope) || slices.Contains(p.Scopes, ScopeAdmin)
+}
+
+// ValidateScopes checks that scopes are non-empty and known.
+func ValidateScopes(scopes []string) error {
+	if len(scopes) == 0 {
+		return fmt.Errorf("%w: at least one scope is required", ErrInvalidScope)
+	}
+	for _, scope := range scopes {
+		if scope != ScopeRead && scope != ScopeWrite && scope != ScopeAdmin {
+			return fmt.Errorf("%w: %q", ErrInvalidScope, scope)
+		}
+	}
+	return nil
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying the principal.
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFrom returns the principal carried by ctx.
+func PrincipalFrom(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
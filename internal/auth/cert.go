@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"strings"
+
+	"test-server/internal/tenant"
+)
+
+var ErrInvalidCertEntry = errors.New("invalid client certificate entry")
+
+// CertAuthenticator maps verified client certificates of mutual TLS to
+// principals by the common name of their subject.
+type CertAuthenticator struct {
+	principals map[string]Principal // by common name
+}
+
+// ParseCertPrincipal parses a "common_name[@tenant]:scopes" entry, e.g.
+// "worker.example.com@acme:tasks:read tasks:write". The principal id is the
+// common name prefixed by "cert:".
+func ParseCertPrincipal(entry string) (Principal, error) {
+	name, scopes, ok := strings.Cut(entry, ":")
+	if !ok || name == "" {
+		return Principal{}, fmt.Errorf("%w: expected common_name[@tenant]:scopes", ErrInvalidCertEntry)
+	}
+
+	principal := Principal{Scopes: strings.Fields(scopes)}
+	commonName, tenantID, ok := strings.Cut(name, "@")
+	if ok {
+		principal.Tenant = tenantID
+		if err := tenant.Validate(tenantID); err != nil {
+			return Principal{}, fmt.Errorf("%w: %q: %w", ErrInvalidCertEntry, commonName, err)
+		}
+	}
+	if err := ValidateScopes(principal.Scopes); err != nil {
+		return Principal{}, fmt.Errorf("%w: %q: %w", ErrInvalidCertEntry, commonName, err)
+	}
+	principal.ID = certPrincipalPrefix + commonName
+	return principal, nil
+}
+
+// NewCertAuthenticator creates an authenticator of the entries accepted by
+// ParseCertPrincipal.
+func NewCertAuthenticator(entries []string) (*CertAuthenticator, error) {
+	a := &CertAuthenticator{principals: make(map[string]Principal, len(entries))}
+	for _, entry := range entries {
+		principal, err := ParseCertPrincipal(entry)
+		if err != nil {
+			return nil, fmt.Errorf("auth.NewCertAuthenticator: %w", err)
+		}
+		commonName := strings.TrimPrefix(principal.ID, certPrincipalPrefix)
+		if _, ok := a.principals[commonName]; ok {
+			return nil, fmt.Errorf("auth.NewCertAuthenticator: %w: duplicate common name %q", ErrInvalidCertEntry, commonName)
+		}
+		a.principals[commonName] = principal
+	}
+	return a, nil
+}
+
+// AuthenticateCert returns the principal of a client certificate the TLS
+// handshake verified. It returns ErrInvalidCredentials for unknown subjects.
+func (a *CertAuthenticator) AuthenticateCert(cert *x509.Certificate) (Principal, error) {
+	principal, ok := a.principals[cert.Subject.CommonName]
+	if !ok {
+		return Principal{}, fmt.Errorf("%w: unknown client certificate subject %q", ErrInvalidCredentials, cert.Subject.String())
+	}
+	return principal, nil
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCertPrincipal(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name    string
+		entry   string
+		want    Principal
+		wantErr error
+	}{
+		{
+			name:  "valid",
+			entry: "worker.example.com:tasks:read tasks:write",
+			want:  Principal{ID: "cert:worker.example.com", Scopes: []string{ScopeRead, ScopeWrite}},
+		},
+		{
+			name:  "bound to tenant",
+			entry: "worker@acme:tasks:read",
+			want:  Principal{ID: "cert:worker", Scopes: []string{ScopeRead}, Tenant: "acme"},
+		},
+		{name: "missing scopes", entry: "worker", wantErr: ErrInvalidCertEntry},
+		{name: "empty common name", entry: ":tasks:read", wantErr: ErrInvalidCertEntry},
+		{name: "invalid tenant", entry: "worker@a b:tasks:read", wantErr: ErrInvalidCertEntry},
+		{name: "unknown scope", entry: "worker:tasks:all", wantErr: ErrInvalidScope},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseCertPrincipal(tt.entry)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCertAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewCertAuthenticator([]string{"worker:tasks:read", "worker@acme:tasks:write"})
+	assert.ErrorIs(t, err, ErrInvalidCertEntry)
+
+	authenticator, err := NewCertAuthenticator([]string{"worker@acme:tasks:read"})
+	require.NoError(t, err)
+
+	principal, err := authenticator.AuthenticateCert(&x509.Certificate{Subject: pkix.Name{CommonName: "worker", Organization: []string{"Acme"}}})
+	require.NoError(t, err)
+	assert.Equal(t, Principal{ID: "cert:worker", Scopes: []string{ScopeRead}, Tenant: "acme"}, principal)
+
+	_, err = authenticator.AuthenticateCert(&x509.Certificate{Subject: pkix.Name{CommonName: "other"}})
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+}
@@ -0,0 +1,440 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"test-server/internal/tenant"
+)
+
+// JWTOptions configure validation of JWT bearer tokens.
+type JWTOptions struct {
+	// JWKSFile is a JSON Web Key Set with RSA, EC or symmetric (oct) keys.
+	JWKSFile string
+	// KeyFiles are PEM encoded public keys or certificates. The key id of
+	// each is the file name without extension.
+	KeyFiles []string
+	// Audience must be one of the token "aud" values.
+	Audience string
+	// Issuer, when set, must match the token "iss".
+	Issuer string
+	// Leeway tolerates clock skew in "exp" and "nbf" checks.
+	Leeway time.Duration
+	// TenantClaim, when set, names the claim binding the principal to a tenant.
+	TenantClaim string
+}
+
+// JWTAuthenticator validates JWT bearer tokens signed by local keys and
+// maps their claims to a principal: "sub" to the id and the space separated
+// "scope" or the "scp" list to the scopes and the tenant claim, if any, to
+// the tenant.
+type JWTAuthenticator struct {
+	keys        []jwk
+	audience    string
+	issuer      string
+	leeway      time.Duration
+	tenantClaim string
+	now         func() time.Time
+}
+
+var _ Authenticator = (*JWTAuthenticator)(nil)
+
+type jwk struct {
+	id  string
+	alg string // empty allows every algorithm of the key type
+	key any    // *rsa.PublicKey, *ecdsa.PublicKey or []byte
+}
+
+func NewJWTAuthenticator(opts JWTOptions) (*JWTAuthenticator, error) {
+	a := &JWTAuthenticator{
+		audience:    opts.Audience,
+		issuer:      opts.Issuer,
+		leeway:      opts.Leeway,
+		tenantClaim: opts.TenantClaim,
+		now:         time.Now,
+	}
+
+	if opts.JWKSFile != "" {
+		keys, err := loadJWKS(opts.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("auth.NewJWTAuthenticator: %w", err)
+		}
+		a.keys = append(a.keys, keys...)
+	}
+	for _, file := range opts.KeyFiles {
+		key, err := loadPEMKey(file)
+		if err != nil {
+			return nil, fmt.Errorf("auth.NewJWTAuthenticator: %w", err)
+		}
+		a.keys = append(a.keys, key)
+	}
+	if len(a.keys) == 0 {
+		return nil, errors.New("auth.NewJWTAuthenticator: no keys configured")
+	}
+	return a, nil
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type jwtClaims struct {
+	Subject   string   `json:"sub"`
+	Issuer    string   `json:"iss"`
+	Audience  audience `json:"aud"`
+	ExpiresAt *int64   `json:"exp"`
+	NotBefore *int64   `json:"nbf"`
+	Scope     string   `json:"scope"`
+	Scp       []string `json:"scp"`
+}
+
+// audience accepts both forms of the "aud" claim, a string or a list.
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*a = list
+	return nil
+}
+
+// Authenticate verifies the token signature and claims. Every failure
+// wraps ErrInvalidCredentials.
+func (a *JWTAuthenticator) Authenticate(ctx context.Context, token string) (Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return Principal{}, fmt.Errorf("%w: not a JWT", ErrInvalidCredentials)
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return Principal{}, fmt.Errorf("%w: malformed header: %w", ErrInvalidCredentials, err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return Principal{}, fmt.Errorf("%w: malformed signature: %w", ErrInvalidCredentials, err)
+	}
+	if err := a.verify(header, []byte(parts[0]+"."+parts[1]), signature); err != nil {
+		return Principal{}, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+
+	var claims jwtClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return Principal{}, fmt.Errorf("%w: malformed claims: %w", ErrInvalidCredentials, err)
+	}
+	if err := a.validate(claims); err != nil {
+		return Principal{}, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+
+	scopes := claims.Scp
+	if claims.Scope != "" {
+		scopes = strings.Fields(claims.Scope)
+	}
+	tenantID, err := a.tenant(parts[1])
+	if err != nil {
+		return Principal{}, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+	// subjects are unique within their issuer only
+	return Principal{ID: jwtPrincipalPrefix + claims.Issuer + "/" + claims.Subject, Scopes: scopes, Tenant: tenantID}, nil
+}
+
+// tenant returns the value of the tenant claim of the already verified
+// claims segment. A missing claim leaves the principal unbound.
+func (a *JWTAuthenticator) tenant(segment string) (string, error) {
+	if a.tenantClaim == "" {
+		return "", nil
+	}
+
+	var claims map[string]json.RawMessage
+	if err := decodeSegment(segment, &claims); err != nil {
+		return "", err
+	}
+	raw, ok := claims[a.tenantClaim]
+	if !ok {
+		return "", nil
+	}
+	var tenantID string
+	if err := json.Unmarshal(raw, &tenantID); err != nil {
+		return "", fmt.Errorf("claim %q must be a string", a.tenantClaim)
+	}
+	if err := tenant.Validate(tenantID); err != nil {
+		return "", fmt.Errorf("claim %q: %w", a.tenantClaim, err)
+	}
+	return tenantID, nil
+}
+
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// verify checks the signature with the key named by the header, or with
+// every key of the algorithm if the header names none.
+func (a *JWTAuthenticator) verify(header jwtHeader, signed, signature []byte) error {
+	for _, key := range a.keys {
+		if header.Kid != "" && key.id != header.Kid {
+			continue
+		}
+		if key.alg != "" && key.alg != header.Alg {
+			continue
+		}
+		ok, err := verifySignature(header.Alg, key.key, signed, signature)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+	}
+	return errors.New("signature doesn't match any key")
+}
+
+func verifySignature(alg string, key any, signed, signature []byte) (bool, error) {
+	if len(alg) != 5 {
+		return false, fmt.Errorf("unsupported algorithm %q", alg)
+	}
+
+	var hashFunc crypto.Hash
+	switch alg[2:] {
+	case "256":
+		hashFunc = crypto.SHA256
+	case "384":
+		hashFunc = crypto.SHA384
+	case "512":
+		hashFunc = crypto.SHA512
+	default:
+		return false, fmt.Errorf("unsupported algorithm %q", alg)
+	}
+
+	switch alg[:2] {
+	case "RS":
+		pub, ok := key.(*rsa.PublicKey)
+		return ok && rsa.VerifyPKCS1v15(pub, hashFunc, digest(hashFunc, signed), signature) == nil, nil
+	case "ES":
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return false, nil
+		}
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return false, nil
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		return ecdsa.Verify(pub, digest(hashFunc, signed), r, s), nil
+	case "HS":
+		secret, ok := key.([]byte)
+		if !ok {
+			return false, nil
+		}
+		var newHash func() hash.Hash
+		switch hashFunc {
+		case crypto.SHA256:
+			newHash = sha256.New
+		case crypto.SHA384:
+			newHash = sha512.New384
+		default:
+			newHash = sha512.New
+		}
+		mac := hmac.New(newHash, secret)
+		mac.Write(signed)
+		return hmac.Equal(mac.Sum(nil), signature), nil
+	}
+	return false, fmt.Errorf("unsupported algorithm %q", alg)
+}
+
+func digest(hashFunc crypto.Hash, data []byte) []byte {
+	h := hashFunc.New()
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+func (a *JWTAuthenticator) validate(claims jwtClaims) error {
+	now := a.now()
+	if claims.ExpiresAt == nil {
+		return errors.New("token has no expiration")
+	}
+	if now.After(time.Unix(*claims.ExpiresAt, 0).Add(a.leeway)) {
+		return errors.New("token is expired")
+	}
+	if claims.NotBefore != nil && now.Add(a.leeway).Before(time.Unix(*claims.NotBefore, 0)) {
+		return errors.New("token isn't valid yet")
+	}
+	if a.audience != "" && !slices.Contains(claims.Audience, a.audience) {
+		return fmt.Errorf("token audience doesn't include %q", a.audience)
+	}
+	if a.issuer != "" && claims.Issuer != a.issuer {
+		return fmt.Errorf("token issuer isn't %q", a.issuer)
+	}
+	if claims.Subject == "" {
+		return errors.New("token has no subject")
+	}
+	return nil
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	// oct
+	K string `json:"k"`
+}
+
+func loadJWKS(file string) ([]jwk, error) {
+	data, err := os.ReadFile(filepath.Clean(file))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS file: %w", err)
+	}
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("failed to decode JWKS file: %w", err)
+	}
+
+	keys := make([]jwk, 0, len(set.Keys))
+	for i, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := parseJWK(k)
+		if err != nil {
+			return nil, fmt.Errorf("JWKS key %d (%q): %w", i, k.Kid, err)
+		}
+		keys = append(keys, jwk{id: k.Kid, alg: k.Alg, key: key})
+	}
+	return keys, nil
+}
+
+func parseJWK(k jsonWebKey) (any, error) {
+	decode := base64.RawURLEncoding.DecodeString
+
+	switch k.Kty {
+	case "RSA":
+		n, err := decode(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := decode(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent: %w", err)
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() < 3 {
+			return nil, errors.New("invalid exponent")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		var ecdhCurve ecdh.Curve
+		switch k.Crv {
+		case "P-256":
+			curve, ecdhCurve = elliptic.P256(), ecdh.P256()
+		case "P-384":
+			curve, ecdhCurve = elliptic.P384(), ecdh.P384()
+		case "P-521":
+			curve, ecdhCurve = elliptic.P521(), ecdh.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decode(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid x: %w", err)
+		}
+		y, err := decode(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid y: %w", err)
+		}
+		size := (curve.Params().BitSize + 7) / 8
+		if len(x) != size || len(y) != size {
+			return nil, errors.New("invalid point size")
+		}
+		// ecdh validates that the point is on the curve
+		if _, err := ecdhCurve.NewPublicKey(slices.Concat([]byte{4}, x, y)); err != nil {
+			return nil, fmt.Errorf("invalid point: %w", err)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	case "oct":
+		secret, err := decode(k.K)
+		if err != nil || len(secret) == 0 {
+			return nil, errors.New("invalid symmetric key")
+		}
+		return secret, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+}
+
+func loadPEMKey(file string) (jwk, error) {
+	data, err := os.ReadFile(filepath.Clean(file))
+	if err != nil {
+		return jwk{}, fmt.Errorf("failed to read key file: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return jwk{}, fmt.Errorf("key file %q isn't PEM encoded", file)
+	}
+
+	var key any
+	switch block.Type {
+	case "PUBLIC KEY":
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "CERTIFICATE":
+		var cert *x509.Certificate
+		cert, err = x509.ParseCertificate(block.Bytes)
+		if err == nil {
+			key = cert.PublicKey
+		}
+	default:
+		err = fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return jwk{}, fmt.Errorf("key file %q: %w", file, err)
+	}
+
+	switch key.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey:
+	default:
+		return jwk{}, fmt.Errorf("key file %q: unsupported key type %T", file, key)
+	}
+	id := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+	return jwk{id: id, key: key}, nil
+}
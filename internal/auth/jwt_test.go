@@ -0,0 +1,226 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func b64(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// signJWT returns a token with the header and claims signed by key.
+func signJWT(t *testing.T, header, claims map[string]any, key any) string {
+	t.Helper()
+
+	headerJSON, err := json.Marshal(header)
+	require.NoError(t, err)
+	claimsJSON, err := json.Marshal(claims)
+	require.NoError(t, err)
+	signed := b64(headerJSON) + "." + b64(claimsJSON)
+	sum := sha256.Sum256([]byte(signed))
+
+	var signature []byte
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum[:])
+		require.NoError(t, err)
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, key, sum[:])
+		require.NoError(t, err)
+		signature = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	case []byte:
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(signed))
+		signature = mac.Sum(nil)
+	}
+	return signed + "." + b64(signature)
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pemKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	secret := []byte("0123456789abcdef0123456789abcdef")
+
+	dir := t.TempDir()
+	jwks := map[string]any{"keys": []map[string]any{
+		{"kty": "RSA", "kid": "rsa", "alg": "RS256", "n": b64(rsaKey.N.Bytes()), "e": b64(big.NewInt(int64(rsaKey.E)).Bytes())},
+		{"kty": "EC", "kid": "ec", "crv": "P-256", "x": b64(ecKey.X.FillBytes(make([]byte, 32))), "y": b64(ecKey.Y.FillBytes(make([]byte, 32)))},
+		{"kty": "oct", "kid": "hmac", "alg": "HS256", "k": b64(secret)},
+	}}
+	jwksJSON, err := json.Marshal(jwks)
+	require.NoError(t, err)
+	jwksFile := filepath.Join(dir, "jwks.json")
+	require.NoError(t, os.WriteFile(jwksFile, jwksJSON, 0o600))
+
+	der, err := x509.MarshalPKIXPublicKey(&pemKey.PublicKey)
+	require.NoError(t, err)
+	pemFile := filepath.Join(dir, "gateway.pem")
+	require.NoError(t, os.WriteFile(pemFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+
+	now := time.Unix(1_700_000_000, 0)
+	authenticator, err := NewJWTAuthenticator(JWTOptions{
+		JWKSFile:    jwksFile,
+		KeyFiles:    []string{pemFile},
+		Audience:    "tasks-api",
+		Leeway:      time.Minute,
+		TenantClaim: "org",
+	})
+	require.NoError(t, err)
+	authenticator.now = func() time.Time { return now }
+
+	claims := func(overrides map[string]any) map[string]any {
+		c := map[string]any{
+			"sub":   "alice",
+			"iss":   "https://idp.example.com",
+			"aud":   []string{"other", "tasks-api"},
+			"exp":   now.Add(time.Hour).Unix(),
+			"scope": "tasks:read tasks:write",
+		}
+		for k, v := range overrides {
+			if v == nil {
+				delete(c, k)
+			} else {
+				c[k] = v
+			}
+		}
+		return c
+	}
+
+	testTable := []struct {
+		name      string
+		token     string
+		want      Principal
+		wantError string
+	}{
+		{
+			name:  "rsa",
+			token: signJWT(t, map[string]any{"alg": "RS256", "kid": "rsa"}, claims(nil), rsaKey),
+			want:  Principal{ID: "jwt:https://idp.example.com/alice", Scopes: []string{ScopeRead, ScopeWrite}},
+		},
+		{
+			name:  "ec without kid",
+			token: signJWT(t, map[string]any{"alg": "ES256"}, claims(map[string]any{"aud": "tasks-api"}), ecKey),
+			want:  Principal{ID: "jwt:https://idp.example.com/alice", Scopes: []string{ScopeRead, ScopeWrite}},
+		},
+		{
+			name:  "hmac with scp claim",
+			token: signJWT(t, map[string]any{"alg": "HS256", "kid": "hmac"}, claims(map[string]any{"scope": nil, "scp": []string{ScopeAdmin}}), secret),
+			want:  Principal{ID: "jwt:https://idp.example.com/alice", Scopes: []string{ScopeAdmin}},
+		},
+		{
+			name:  "pem key file",
+			token: signJWT(t, map[string]any{"alg": "ES256", "kid": "gateway"}, claims(nil), pemKey),
+			want:  Principal{ID: "jwt:https://idp.example.com/alice", Scopes: []string{ScopeRead, ScopeWrite}},
+		},
+		{
+			name:  "bound to tenant",
+			token: signJWT(t, map[string]any{"alg": "RS256"}, claims(map[string]any{"org": "acme"}), rsaKey),
+			want:  Principal{ID: "jwt:https://idp.example.com/alice", Scopes: []string{ScopeRead, ScopeWrite}, Tenant: "acme"},
+		},
+		{
+			name:      "invalid tenant",
+			token:     signJWT(t, map[string]any{"alg": "RS256"}, claims(map[string]any{"org": "a/b"}), rsaKey),
+			wantError: `claim "org"`,
+		},
+		{
+			name:  "expired within leeway",
+			token: signJWT(t, map[string]any{"alg": "RS256"}, claims(map[string]any{"exp": now.Add(-30 * time.Second).Unix()}), rsaKey),
+			want:  Principal{ID: "jwt:https://idp.example.com/alice", Scopes: []string{ScopeRead, ScopeWrite}},
+		},
+		{
+			name:      "expired",
+			token:     signJWT(t, map[string]any{"alg": "RS256"}, claims(map[string]any{"exp": now.Add(-time.Hour).Unix()}), rsaKey),
+			wantError: "token is expired",
+		},
+		{
+			name:      "without expiration",
+			token:     signJWT(t, map[string]any{"alg": "RS256"}, claims(map[string]any{"exp": nil}), rsaKey),
+			wantError: "token has no expiration",
+		},
+		{
+			name:      "not valid yet",
+			token:     signJWT(t, map[string]any{"alg": "RS256"}, claims(map[string]any{"nbf": now.Add(time.Hour).Unix()}), rsaKey),
+			wantError: "token isn't valid yet",
+		},
+		{
+			name:      "wrong audience",
+			token:     signJWT(t, map[string]any{"alg": "RS256"}, claims(map[string]any{"aud": "other"}), rsaKey),
+			wantError: "token audience doesn't include",
+		},
+		{
+			name:      "key of other kid",
+			token:     signJWT(t, map[string]any{"alg": "ES256", "kid": "ec"}, claims(nil), pemKey),
+			wantError: "signature doesn't match any key",
+		},
+		{
+			name:      "hmac signed with rsa public key",
+			token:     signJWT(t, map[string]any{"alg": "HS256", "kid": "rsa"}, claims(nil), rsaKey.N.Bytes()),
+			wantError: "signature doesn't match any key",
+		},
+		{
+			name:      "alg none",
+			token:     b64([]byte(`{"alg":"none"}`)) + "." + b64([]byte(`{"sub":"alice"}`)) + ".",
+			wantError: `unsupported algorithm "none"`,
+		},
+		{
+			name:      "api key",
+			token:     "tsk_0123",
+			wantError: "not a JWT",
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			principal, err := authenticator.Authenticate(context.Background(), tt.token)
+			if tt.wantError != "" {
+				assert.ErrorIs(t, err, ErrInvalidCredentials)
+				assert.ErrorContains(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, principal)
+		})
+	}
+}
+
+func TestNewJWTAuthenticator_Errors(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	jwksFile := filepath.Join(dir, "jwks.json")
+	require.NoError(t, os.WriteFile(jwksFile, []byte(`{"keys":[{"kty":"EC","crv":"P-256","x":"AA","y":"AA"}]}`), 0o600))
+
+	_, err := NewJWTAuthenticator(JWTOptions{})
+	assert.ErrorContains(t, err, "no keys configured")
+	_, err = NewJWTAuthenticator(JWTOptions{JWKSFile: jwksFile})
+	assert.ErrorContains(t, err, "invalid point size")
+	_, err = NewJWTAuthenticator(JWTOptions{KeyFiles: []string{filepath.Join(dir, "missing.pem")}})
+	assert.ErrorContains(t, err, "failed to read key file")
+}
@@ -0,0 +1,265 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"test-server/internal/tenant"
+)
+
+// keyPrefix starts every generated API key, so leaked keys are easy to find.
+const keyPrefix = "tsk_"
+
+var (
+	ErrKeyNotFound     = errors.New("api key not found")
+	ErrKeyExists       = errors.New("api key with this id already exists")
+	ErrKeyReadOnly     = errors.New("api key is defined in config and can't be changed")
+	ErrInvalidKeyID    = errors.New("api key id must be 1-64 letters, digits, '.', '_' or '-'")
+	ErrInvalidKeyEntry = errors.New("invalid api key entry")
+)
+
+var _ Authenticator = (*KeyStore)(nil)
+
+var keyIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// Key is an API key stored by the hash of its secret.
+type Key struct {
+	ID        string    `json:"id"`
+	Hash      string    `json:"hash"` // hex encoded SHA-256 of the secret
+	Scopes    []string  `json:"scopes"`
+	Tenant    string    `json:"tenant,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	// ReadOnly keys are defined in config and can't be deleted by the admin endpoints.
+	ReadOnly bool `json:"-"`
+}
+
+// HashKey returns the hash a key with secret is stored by.
+func HashKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// ParseKey parses a config key entry "id:hash:scope scope", e.g.
+// "ci:5e88...:tasks:read tasks:write". The hash is returned by HashKey.
+func ParseKey(entry string) (Key, error) {
+	parts := strings.SplitN(entry, ":", 3)
+	if len(parts) != 3 {
+		return Key{}, fmt.Errorf("%w: expected id[@tenant]:hash:scopes", ErrInvalidKeyEntry)
+	}
+
+	key := Key{ID: parts[0], Hash: strings.ToLower(parts[1]), Scopes: strings.Fields(parts[2]), ReadOnly: true}
+	if id, tenantID, ok := strings.Cut(key.ID, "@"); ok {
+		key.ID, key.Tenant = id, tenantID
+		if err := tenant.Validate(tenantID); err != nil {
+			return Key{}, fmt.Errorf("%w: key %q: %w", ErrInvalidKeyEntry, key.ID, err)
+		}
+	}
+	if !keyIDPattern.MatchString(key.ID) {
+		return Key{}, fmt.Errorf("%w: %w", ErrInvalidKeyEntry, ErrInvalidKeyID)
+	}
+	if hash, err := hex.DecodeString(key.Hash); err != nil || len(hash) != sha256.Size {
+		return Key{}, fmt.Errorf("%w: key %q: hash must be a hex encoded SHA-256", ErrInvalidKeyEntry, key.ID)
+	}
+	if err := ValidateScopes(key.Scopes); err != nil {
+		return Key{}, fmt.Errorf("%w: key %q: %w", ErrInvalidKeyEntry, key.ID, err)
+	}
+	return key, nil
+}
+
+// KeyStore authenticates API keys defined in config and the ones created at
+// runtime, which are saved to the key file. Only hashes of the keys are kept.
+type KeyStore struct {
+	file string // empty keeps created keys in memory only
+
+	mu     sync.RWMutex
+	keys   map[string]Key    // by id
+	byHash map[string]string // hash to id
+}
+
+// NewKeyStore creates a store with the config key entries accepted by
+// ParseKey and the keys saved to file. A missing file isn't an error.
+func NewKeyStore(file string, entries []string) (*KeyStore, error) {
+	s := &KeyStore{file: file, keys: make(map[string]Key), byHash: make(map[string]string)}
+
+	for _, entry := range entries {
+		key, err := ParseKey(entry)
+		if err != nil {
+			return nil, fmt.Errorf("auth.NewKeyStore: %w", err)
+		}
+		if err := s.add(key); err != nil {
+			return nil, fmt.Errorf("auth.NewKeyStore: %w", err)
+		}
+	}
+
+	if file == "" {
+		return s, nil
+	}
+	data, err := os.ReadFile(filepath.Clean(file))
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("auth.NewKeyStore: failed to read key file: %w", err)
+	}
+	var keys []Key
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, fmt.Errorf("auth.NewKeyStore: failed to decode key file: %w", err)
+	}
+	for _, key := range keys {
+		if err := s.add(key); err != nil {
+			return nil, fmt.Errorf("auth.NewKeyStore: %w", err)
+		}
+	}
+	return s, nil
+}
+
+func (s *KeyStore) add(key Key) error {
+	if _, ok := s.keys[key.ID]; ok {
+		return fmt.Errorf("%w: %q", ErrKeyExists, key.ID)
+	}
+	s.keys[key.ID] = key
+	s.byHash[key.Hash] = key.ID
+	return nil
+}
+
+// Authenticate returns the principal of the key with the secret token.
+func (s *KeyStore) Authenticate(ctx context.Context, token string) (Principal, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	id, ok := s.byHash[HashKey(token)]
+	if !ok {
+		return Principal{}, ErrInvalidCredentials
+	}
+	key := s.keys[id]
+	return Principal{ID: keyPrincipalPrefix + id, Scopes: key.Scopes, Tenant: key.Tenant}, nil
+}
+
+// ListKeys returns all keys ordered by id.
+func (s *KeyStore) ListKeys(ctx context.Context) []Key {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	keys := make([]Key, 0, len(s.keys))
+	for _, key := range s.keys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].ID < keys[j].ID })
+	return keys
+}
+
+// CreateKey generates a key with the scopes, bound to the tenant unless it's
+// empty, and returns it with its secret, which isn't stored and can't be
+// retrieved later. An empty id is generated.
+func (s *KeyStore) CreateKey(ctx context.Context, id, tenantID string, scopes []string) (*Key, string, error) {
+	if err := ValidateScopes(scopes); err != nil {
+		return nil, "", err
+	}
+	if tenantID != "" {
+		if err := tenant.Validate(tenantID); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if id == "" {
+		// ids are public, so they share no bytes with the secret
+		random := make([]byte, 4)
+		if _, err := rand.Read(random); err != nil {
+			return nil, "", fmt.Errorf("auth.CreateKey: %w", err)
+		}
+		id = hex.EncodeToString(random)
+	}
+	if !keyIDPattern.MatchString(id) {
+		return nil, "", ErrInvalidKeyID
+	}
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return nil, "", fmt.Errorf("auth.CreateKey: %w", err)
+	}
+	secret := keyPrefix + hex.EncodeToString(random)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := Key{ID: id, Hash: HashKey(secret), Scopes: scopes, Tenant: tenantID, CreatedAt: time.Now()}
+	if err := s.add(key); err != nil {
+		return nil, "", err
+	}
+	if err := s.save(); err != nil {
+		s.remove(id)
+		return nil, "", err
+	}
+	return &key, secret, nil
+}
+
+// DeleteKey revokes the key with id.
+func (s *KeyStore) DeleteKey(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.keys[id]
+	if !ok {
+		return ErrKeyNotFound
+	}
+	if key.ReadOnly {
+		return ErrKeyReadOnly
+	}
+
+	s.remove(id)
+	if err := s.save(); err != nil {
+		_ = s.add(key)
+		return err
+	}
+	return nil
+}
+
+func (s *KeyStore) remove(id string) {
+	delete(s.byHash, s.keys[id].Hash)
+	delete(s.keys, id)
+}
+
+// save replaces the key file with the keys created at runtime. It must be
+// called with the lock held.
+func (s *KeyStore) save() error {
+	if s.file == "" {
+		return nil
+	}
+
+	keys := make([]Key, 0, len(s.keys))
+	for _, key := range s.keys {
+		if !key.ReadOnly {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].ID < keys[j].ID })
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.file), filepath.Base(s.file)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("auth.save: failed to create file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := json.NewEncoder(tmp).Encode(keys); err != nil {
+		tmp.Close()
+		return fmt.Errorf("auth.save: failed to write keys: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("auth.save: failed to close file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.file); err != nil {
+		return fmt.Errorf("auth.save: failed to replace key file: %w", err)
+	}
+	return nil
+}
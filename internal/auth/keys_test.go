@@ -0,0 +1,126 @@
+package auth
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"test-server/internal/tenant"
+)
+
+func TestParseKey(t *testing.T) {
+	t.Parallel()
+
+	hash := HashKey("secret")
+
+	testTable := []struct {
+		name    string
+		entry   string
+		want    Key
+		wantErr error
+	}{
+		{
+			name:  "valid",
+			entry: "ci:" + hash + ":tasks:read tasks:write",
+			want:  Key{ID: "ci", Hash: hash, Scopes: []string{ScopeRead, ScopeWrite}, ReadOnly: true},
+		},
+		{
+			name:  "bound to tenant",
+			entry: "ci@acme:" + hash + ":tasks:read",
+			want:  Key{ID: "ci", Hash: hash, Scopes: []string{ScopeRead}, Tenant: "acme", ReadOnly: true},
+		},
+		{name: "invalid tenant", entry: "ci@a b:" + hash + ":tasks:read", wantErr: ErrInvalidKeyEntry},
+		{name: "missing scopes", entry: "ci:" + hash, wantErr: ErrInvalidKeyEntry},
+		{name: "empty scopes", entry: "ci:" + hash + ":", wantErr: ErrInvalidScope},
+		{name: "unknown scope", entry: "ci:" + hash + ":tasks:all", wantErr: ErrInvalidScope},
+		{name: "invalid hash", entry: "ci:secret:tasks:read", wantErr: ErrInvalidKeyEntry},
+		{name: "invalid id", entry: "c i:" + hash + ":tasks:read", wantErr: ErrInvalidKeyID},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			key, err := ParseKey(tt.entry)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, key)
+		})
+	}
+}
+
+func TestKeyStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	file := filepath.Join(t.TempDir(), "keys.json")
+
+	store, err := NewKeyStore(file, []string{"config:" + HashKey("config-secret") + ":tasks:read"})
+	require.NoError(t, err)
+
+	principal, err := store.Authenticate(ctx, "config-secret")
+	require.NoError(t, err)
+	assert.Equal(t, Principal{ID: "key:config", Scopes: []string{ScopeRead}}, principal)
+	assert.True(t, principal.HasScope(ScopeRead))
+	assert.False(t, principal.HasScope(ScopeWrite))
+
+	_, err = store.Authenticate(ctx, "wrong")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	key, secret, err := store.CreateKey(ctx, "ops", "", []string{ScopeAdmin})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(secret, keyPrefix))
+	assert.Equal(t, HashKey(secret), key.Hash)
+
+	_, _, err = store.CreateKey(ctx, "ops", "", []string{ScopeRead})
+	assert.ErrorIs(t, err, ErrKeyExists)
+	generated, generatedSecret, err := store.CreateKey(ctx, "", "", []string{ScopeRead})
+	require.NoError(t, err)
+	assert.Regexp(t, `^[0-9a-f]{8}$`, generated.ID)
+	assert.NotContains(t, generatedSecret, generated.ID, "id reveals no part of the secret")
+	require.NoError(t, store.DeleteKey(ctx, generated.ID))
+	_, _, err = store.CreateKey(ctx, "", "", []string{"tasks:all"})
+	assert.ErrorIs(t, err, ErrInvalidScope)
+	_, _, err = store.CreateKey(ctx, "", "a/b", []string{ScopeRead})
+	assert.ErrorIs(t, err, tenant.ErrInvalidTenant)
+
+	_, tenantSecret, err := store.CreateKey(ctx, "acme-ci", "acme", []string{ScopeRead})
+	require.NoError(t, err)
+	principal, err = store.Authenticate(ctx, tenantSecret)
+	require.NoError(t, err)
+	assert.Equal(t, Principal{ID: "key:acme-ci", Scopes: []string{ScopeRead}, Tenant: "acme"}, principal)
+	require.NoError(t, store.DeleteKey(ctx, "acme-ci"))
+
+	principal, err = store.Authenticate(ctx, secret)
+	require.NoError(t, err)
+	assert.True(t, principal.HasScope(ScopeWrite), "admin scope grants every scope")
+
+	// keys created at runtime survive a restart, config keys aren't saved
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), secret)
+	assert.NotContains(t, string(data), `"config"`)
+
+	reloaded, err := NewKeyStore(file, nil)
+	require.NoError(t, err)
+	_, err = reloaded.Authenticate(ctx, secret)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, store.DeleteKey(ctx, "config"), ErrKeyReadOnly)
+	assert.ErrorIs(t, store.DeleteKey(ctx, "missing"), ErrKeyNotFound)
+	require.NoError(t, store.DeleteKey(ctx, "ops"))
+	_, err = store.Authenticate(ctx, secret)
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	keys := store.ListKeys(ctx)
+	require.Len(t, keys, 1)
+	assert.Equal(t, "config", keys[0].ID)
+}
@@ -0,0 +1,177 @@
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultWatchInterval is how often the certificate files are checked for changes.
+const DefaultWatchInterval = 30 * time.Second
+
+// Client authentication modes of mutual TLS.
+const (
+	ClientAuthRequire  = "require"
+	ClientAuthOptional = "optional"
+)
+
+// TLS versions accepted as minimum version.
+var versions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// ParseVersion returns the TLS version named "1.2" or "1.3".
+func ParseVersion(name string) (uint16, error) {
+	version, ok := versions[name]
+	if !ok {
+		return 0, fmt.Errorf("unsupported TLS version %q, expected 1.2 or 1.3", name)
+	}
+	return version, nil
+}
+
+// Options configure the TLS listener.
+type Options struct {
+	CertFile string
+	KeyFile  string
+	// ClientCAFile enables mutual TLS, client certificates must be signed
+	// by one of its PEM encoded CAs.
+	ClientCAFile string
+	// ClientAuth is ClientAuthRequire or ClientAuthOptional, which lets
+	// clients without a certificate connect.
+	ClientAuth string
+	// MinVersion is "1.2" or "1.3".
+	MinVersion string
+}
+
+// Store serves the certificate and client CAs of the files in Options and
+// swaps them when the files change, so renewed certificates are used
+// without a restart. Connections established before keep their certificate.
+type Store struct {
+	opts       Options
+	minVersion uint16
+	clientAuth tls.ClientAuthType
+
+	cert      atomic.Pointer[tls.Certificate]
+	clientCAs atomic.Pointer[x509.CertPool]
+}
+
+// NewStore loads the files of opts.
+func NewStore(opts Options) (*Store, error) {
+	s := &Store{opts: opts, clientAuth: tls.NoClientCert}
+
+	var err error
+	if s.minVersion, err = ParseVersion(opts.MinVersion); err != nil {
+		return nil, fmt.Errorf("certs.NewStore: %w", err)
+	}
+	if opts.ClientCAFile != "" {
+		switch opts.ClientAuth {
+		case ClientAuthRequire:
+			s.clientAuth = tls.RequireAndVerifyClientCert
+		case ClientAuthOptional:
+			s.clientAuth = tls.VerifyClientCertIfGiven
+		default:
+			return nil, fmt.Errorf("certs.NewStore: unknown client auth %q", opts.ClientAuth)
+		}
+	}
+
+	if err := s.Reload(); err != nil {
+		return nil, fmt.Errorf("certs.NewStore: %w", err)
+	}
+	return s, nil
+}
+
+// Reload reads the files again. On error the current certificate and client
+// CAs are kept.
+func (s *Store) Reload() error {
+	cert, err := tls.LoadX509KeyPair(s.opts.CertFile, s.opts.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load key pair: %w", err)
+	}
+
+	var clientCAs *x509.CertPool
+	if s.opts.ClientCAFile != "" {
+		pem, err := os.ReadFile(filepath.Clean(s.opts.ClientCAFile))
+		if err != nil {
+			return fmt.Errorf("failed to read client CA file: %w", err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return errors.New("no certificates in client CA file")
+		}
+	}
+
+	s.cert.Store(&cert)
+	s.clientCAs.Store(clientCAs)
+	return nil
+}
+
+// TLSConfig returns the server config. Every handshake uses the certificate
+// and client CAs loaded last.
+func (s *Store) TLSConfig() *tls.Config {
+	base := &tls.Config{
+		MinVersion: s.minVersion,
+		ClientAuth: s.clientAuth,
+	}
+	return &tls.Config{
+		MinVersion: s.minVersion,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			config := base.Clone()
+			config.Certificates = []tls.Certificate{*s.cert.Load()}
+			config.ClientCAs = s.clientCAs.Load()
+			return config, nil
+		},
+	}
+}
+
+// Watch reloads the files when their modification time changes, checked
+// every interval, until ctx is done.
+func (s *Store) Watch(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	modTimes := s.modTimes()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		current := s.modTimes()
+		if current == modTimes {
+			continue
+		}
+		// a certificate and its key are rarely replaced at once, a failed
+		// reload is retried when the other file changes
+		modTimes = current
+		if err := s.Reload(); err != nil {
+			slog.Error("Certificate reload error, keeping current certificate", "error", err)
+			continue
+		}
+		slog.Info("Certificate reloaded", "file", s.opts.CertFile)
+	}
+}
+
+// modTimes returns the modification times of the files in nanoseconds,
+// zero for missing ones.
+func (s *Store) modTimes() [3]int64 {
+	var times [3]int64
+	for i, file := range []string{s.opts.CertFile, s.opts.KeyFile, s.opts.ClientCAFile} {
+		if info, err := os.Stat(file); err == nil {
+			times[i] = info.ModTime().UnixNano()
+		}
+	}
+	return times
+}
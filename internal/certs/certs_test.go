@@ -0,0 +1,130 @@
+package certs
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCert writes a self-signed certificate of commonName and its key.
+func writeCert(t *testing.T, certFile, keyFile, commonName string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+}
+
+func servedCommonName(t *testing.T, s *Store) string {
+	t.Helper()
+
+	config, err := s.TLSConfig().GetConfigForClient(&tls.ClientHelloInfo{})
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(config.Certificates[0].Certificate[0])
+	require.NoError(t, err)
+	return leaf.Subject.CommonName
+}
+
+func TestStore_Watch(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	writeCert(t, certFile, keyFile, "first")
+
+	store, err := NewStore(Options{CertFile: certFile, KeyFile: keyFile, ClientCAFile: certFile, ClientAuth: ClientAuthOptional, MinVersion: "1.3"})
+	require.NoError(t, err)
+	assert.Equal(t, "first", servedCommonName(t, store))
+
+	config, err := store.TLSConfig().GetConfigForClient(&tls.ClientHelloInfo{})
+	require.NoError(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS13), config.MinVersion)
+	assert.Equal(t, tls.VerifyClientCertIfGiven, config.ClientAuth)
+	assert.NotNil(t, config.ClientCAs)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go store.Watch(ctx, 10*time.Millisecond)
+
+	// a broken key pair keeps the current certificate
+	require.NoError(t, os.WriteFile(keyFile, []byte("broken"), 0o600))
+	require.NoError(t, os.Chtimes(keyFile, time.Now(), time.Now().Add(time.Minute)))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, "first", servedCommonName(t, store))
+
+	writeCert(t, certFile, keyFile, "second")
+	require.NoError(t, os.Chtimes(certFile, time.Now(), time.Now().Add(2*time.Minute)))
+	require.NoError(t, os.Chtimes(keyFile, time.Now(), time.Now().Add(2*time.Minute)))
+	require.Eventually(t, func() bool {
+		return servedCommonName(t, store) == "second"
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestNewStore_Errors(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	writeCert(t, certFile, keyFile, "server")
+
+	testTable := []struct {
+		name    string
+		opts    Options
+		wantErr string
+	}{
+		{
+			name:    "unsupported version",
+			opts:    Options{CertFile: certFile, KeyFile: keyFile, MinVersion: "1.1"},
+			wantErr: `unsupported TLS version "1.1"`,
+		},
+		{
+			name:    "unknown client auth",
+			opts:    Options{CertFile: certFile, KeyFile: keyFile, ClientCAFile: certFile, ClientAuth: "maybe", MinVersion: "1.2"},
+			wantErr: `unknown client auth "maybe"`,
+		},
+		{
+			name:    "missing key",
+			opts:    Options{CertFile: certFile, KeyFile: filepath.Join(dir, "missing.key"), MinVersion: "1.2"},
+			wantErr: "failed to load key pair",
+		},
+		{
+			name:    "client CA without certificates",
+			opts:    Options{CertFile: certFile, KeyFile: keyFile, ClientCAFile: keyFile, ClientAuth: ClientAuthRequire, MinVersion: "1.2"},
+			wantErr: "no certificates in client CA file",
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewStore(tt.opts)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
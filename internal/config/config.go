@@ -4,19 +4,189 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"gopkg.in/yaml.v3"
+
+	"test-server/internal/certs"
 )
 
+// Config values tagged with secret:"true" are redacted by Redacted.
 type Config struct {
+	// Path of the YAML file the config was loaded from.
+	Path string `yaml:"-"`
+
 	Service struct {
 		Host     string `yaml:"host"`
 		Port     int    `yaml:"port"`
 		File     string `yaml:"file"`
 		Interval int    `yaml:"interval"`
+		// DrainTimeout is how long shutdown waits for running tasks before interrupting them.
+		DrainTimeout time.Duration `yaml:"drain_timeout"`
 	} `yaml:"service"`
+	// TLS of the HTTP listener, plain HTTP is served when CertFile is empty.
+	// The files are reloaded when they change.
+	TLS struct {
+		CertFile string `yaml:"cert_file"`
+		KeyFile  string `yaml:"key_file"`
+		// ClientCAFile enables mutual TLS, client certificates must be signed by one of its CAs.
+		ClientCAFile string `yaml:"client_ca_file"`
+		// ClientAuth is "require" or "optional", which lets clients without a certificate connect.
+		ClientAuth string `yaml:"client_auth"`
+		// MinVersion is "1.2" or "1.3".
+		MinVersion string `yaml:"min_version"`
+		// ClientPrincipals map client certificate common names to principals as
+		// "common_name[@tenant]:scope scope" entries, used when auth is enabled.
+		ClientPrincipals []string `yaml:"client_principals"`
+	} `yaml:"tls"`
+	// Retention of finished and soft deleted tasks. Zero values disable the corresponding limit.
+	Retention struct {
+		Completed    time.Duration `yaml:"completed"`
+		Failed       time.Duration `yaml:"failed"`
+		MaxTasks     int           `yaml:"max_tasks"`
+		DeletedGrace time.Duration `yaml:"deleted_grace"`
+		Interval     time.Duration `yaml:"interval"`
+	} `yaml:"retention"`
+	// Quota of tasks of every tenant. Zero values disable the corresponding limit.
+	Quota struct {
+		MaxRunning   int `yaml:"max_running"`
+		MaxStored    int `yaml:"max_stored"`
+		MaxCreations int `yaml:"max_creations"`
+		// Window is the period max_creations is counted in.
+		Window time.Duration `yaml:"window"`
+		// Tenants override the limits of single tenants as
+		// "tenant:max_running:max_stored:max_creations" entries.
+		Tenants []string `yaml:"tenants"`
+	} `yaml:"quota"`
+	// Archive of finished tasks. Archiving is disabled when Dir is empty.
+	Archive struct {
+		Dir      string        `yaml:"dir"`
+		After    time.Duration `yaml:"after"`
+		Interval time.Duration `yaml:"interval"`
+	} `yaml:"archive"`
+	// Audit log of task mutations. Auditing is disabled when Dir is empty.
+	Audit struct {
+		Dir string `yaml:"dir"`
+		// MaxSizeMB is the size of the current file that triggers its rotation.
+		MaxSizeMB int `yaml:"max_size_mb"`
+		// MaxFiles is the number of rotated files kept, zero keeps all of them.
+		MaxFiles int `yaml:"max_files"`
+	} `yaml:"audit"`
+	// TaskLog keeps the logs written by tasks. Task logs are disabled when Dir is empty.
+	TaskLog struct {
+		Dir string `yaml:"dir"`
+		// BufferKB is the size of the lines of a running task buffered in memory
+		// before they are written to its file.
+		BufferKB int `yaml:"buffer_kb"`
+		// MaxSizeMB is the size a task log stops growing at.
+		MaxSizeMB int `yaml:"max_size_mb"`
+	} `yaml:"task_log"`
+	// Health checks served at /livez and /readyz. Zero MaxInFlight disables the in-flight check.
+	Health struct {
+		MaxInFlight   int           `yaml:"max_in_flight"`
+		Timeout       time.Duration `yaml:"timeout"`
+		ShutdownDelay time.Duration `yaml:"shutdown_delay"`
+	} `yaml:"health"`
+	// Log level is one of "debug", "info", "warn" or "error", format is "text" or "json".
+	Log struct {
+		Level  string `yaml:"level"`
+		Format string `yaml:"format"`
+	} `yaml:"log"`
+	// Tracing exporter. Exporter is one of "none", "stdout", "file" or "otlp".
+	Tracing struct {
+		Exporter    string  `yaml:"exporter"`
+		File        string  `yaml:"file"`
+		Endpoint    string  `yaml:"endpoint"`
+		Insecure    bool    `yaml:"insecure"`
+		SampleRatio float64 `yaml:"sample_ratio"`
+		// Headers are comma separated key=value pairs sent to the OTLP collector, e.g. for authorization.
+		Headers string `yaml:"headers" secret:"true"`
+	} `yaml:"tracing"`
+	// Auth of API requests. When disabled, every request has all scopes.
+	Auth struct {
+		Enabled bool `yaml:"enabled"`
+		// KeyFile stores the API keys created by the admin endpoints. When
+		// empty, created keys are lost on restart.
+		KeyFile string `yaml:"key_file"`
+		// Keys are "id[@tenant]:sha256-hex:scope scope" entries, e.g.
+		// "ci@acme:5e88...:tasks:read tasks:write". Keys with a tenant act on it only.
+		Keys []string `yaml:"keys" secret:"true"`
+	} `yaml:"auth"`
+	// JWT bearer tokens are accepted when auth is enabled and JWKSFile or KeyFiles is set.
+	JWT struct {
+		JWKSFile string `yaml:"jwks_file"`
+		// KeyFiles are PEM public keys or certificates, the file name without extension is the key id.
+		KeyFiles []string      `yaml:"key_files"`
+		Audience string        `yaml:"audience"`
+		Issuer   string        `yaml:"issuer"`
+		Leeway   time.Duration `yaml:"leeway"`
+		// TenantClaim names the claim binding the principal to a tenant.
+		TenantClaim string `yaml:"tenant_claim"`
+	} `yaml:"jwt"`
+	// RateLimit of requests of every client, the authenticated principal or
+	// the IP of unauthenticated requests. Requests not matching Routes
+	// aren't limited when Rate is zero.
+	RateLimit struct {
+		// Rate is the number of requests per second, Burst the number of requests allowed at once.
+		Rate  float64 `yaml:"rate"`
+		Burst int     `yaml:"burst"`
+		// Routes override the limit of single routes as "METHOD PATH RATE BURST"
+		// entries, e.g. "POST /api/tasks 0.5 10".
+		Routes []string `yaml:"routes"`
+		// IdleTimeout is how long the state of a client is kept after its limit refilled.
+		IdleTimeout time.Duration `yaml:"idle_timeout"`
+	} `yaml:"rate_limit"`
+	// CORS policy of cross-origin requests.
+	Cors struct {
+		// AllowOrigins are origins like "https://example.com". "https://*.example.com"
+		// allows any subdomain and "*" any origin.
+		AllowOrigins     []string `yaml:"allow_origins"`
+		AllowCredentials bool     `yaml:"allow_credentials"`
+		// AllowMethods of preflight requests, the methods of the API routes when empty.
+		AllowMethods []string `yaml:"allow_methods"`
+		AllowHeaders []string `yaml:"allow_headers"`
+		// MaxAge is how long browsers may cache preflight responses.
+		MaxAge time.Duration `yaml:"max_age"`
+	} `yaml:"cors"`
+}
+
+// Default returns the configuration used for values set by no other layer.
+func Default() *Config {
+	c := &Config{}
+	c.Service.Host = "localhost"
+	c.Service.Port = 8080
+	c.Service.File = "/output/task-db.json"
+	c.Service.Interval = 3
+	c.Service.DrainTimeout = 30 * time.Second
+	c.TLS.ClientAuth = certs.ClientAuthRequire
+	c.TLS.MinVersion = "1.2"
+	c.Retention.Interval = time.Minute
+	c.Quota.Window = time.Minute
+	c.Archive.After = 12 * time.Hour
+	c.Archive.Interval = 10 * time.Minute
+	c.Audit.MaxSizeMB = 100
+	c.TaskLog.BufferKB = 64
+	c.TaskLog.MaxSizeMB = 10
+	c.Health.Timeout = 2 * time.Second
+	c.Log.Level = "info"
+	c.Log.Format = "text"
+	c.Tracing.Exporter = "none"
+	c.Tracing.SampleRatio = 1
+	c.JWT.Leeway = time.Minute
+	c.JWT.TenantClaim = "tenant"
+	c.RateLimit.Burst = 1
+	c.RateLimit.IdleTimeout = 10 * time.Minute
+	c.Cors.AllowOrigins = []string{"*"}
+	c.Cors.AllowHeaders = []string{
+		"Origin", "Content-Type", "Accept", "Authorization", "If-Match",
+		"X-API-Key", "X-Tenant-ID", "traceparent", "tracestate", "X-Request-ID",
+	}
+	c.Cors.MaxAge = 5 * time.Minute
+	return c
 }
 
+// LoadConfig reads the YAML file on top of the defaults. Values missing
+// from the file keep their defaults.
 func LoadConfig(filename string) (*Config, error) {
 	f, err := os.Open(filepath.Clean(filename))
 	if err != nil {
@@ -24,7 +194,8 @@ func LoadConfig(filename string) (*Config, error) {
 	}
 	defer f.Close()
 
-	config := &Config{}
+	config := Default()
+	config.Path = filename
 	if err := yaml.NewDecoder(f).Decode(config); err != nil {
 		return nil, fmt.Errorf("config.LoadConfig error occured while decoding config: %w", err)
 	}
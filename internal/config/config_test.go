@@ -1,10 +1,13 @@
 package config
 
 import (
+	"flag"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -19,6 +22,24 @@ func TestLoadConfig_Success(t *testing.T) {
   port: 8080
   file: "/output/task-db.json"
   interval: 3
+  drain_timeout: 20s
+retention:
+  completed: 24h
+  failed: 168h
+  max_tasks: 100
+archive:
+  dir: "/output/archive"
+  after: 12h
+health:
+  max_in_flight: 50
+  shutdown_delay: 5s
+log:
+  level: debug
+  format: json
+tracing:
+  exporter: otlp
+  endpoint: "collector:4318"
+  sample_ratio: 0.5
 `
 
 	err := os.WriteFile(configFile, []byte(configContent), 0644)
@@ -32,4 +53,258 @@ func TestLoadConfig_Success(t *testing.T) {
 	assert.Equal(t, 8080, cfg.Service.Port)
 	assert.Equal(t, "/output/task-db.json", cfg.Service.File)
 	assert.Equal(t, 3, cfg.Service.Interval)
+	assert.Equal(t, 20*time.Second, cfg.Service.DrainTimeout)
+	assert.Equal(t, 24*time.Hour, cfg.Retention.Completed)
+	assert.Equal(t, 7*24*time.Hour, cfg.Retention.Failed)
+	assert.Equal(t, 100, cfg.Retention.MaxTasks)
+	assert.Equal(t, time.Minute, cfg.Retention.Interval, "default is used for missing value")
+	assert.Equal(t, "/output/archive", cfg.Archive.Dir)
+	assert.Equal(t, 12*time.Hour, cfg.Archive.After)
+	assert.Equal(t, 50, cfg.Health.MaxInFlight)
+	assert.Equal(t, 5*time.Second, cfg.Health.ShutdownDelay)
+	assert.Equal(t, "debug", cfg.Log.Level)
+	assert.Equal(t, "json", cfg.Log.Format)
+	assert.Equal(t, "otlp", cfg.Tracing.Exporter)
+	assert.Equal(t, "collector:4318", cfg.Tracing.Endpoint)
+	assert.Equal(t, 0.5, cfg.Tracing.SampleRatio)
+}
+
+func TestLoadConfig_Defaults(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte("service:\n  port: 9090\n"), 0644))
+
+	cfg, err := LoadConfig(configFile)
+	require.NoError(t, err)
+
+	want := Default()
+	want.Path = configFile
+	want.Service.Port = 9090
+	assert.Equal(t, want, cfg)
+	assert.Empty(t, cfg.TaskLog.Dir, "task logs are disabled by default")
+}
+
+func TestLoad_Layers(t *testing.T) {
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "config.yaml")
+	configContent := `service:
+  host: yaml-host
+  port: 8081
+  interval: 5
+  file: "` + filepath.Join(dir, "task-db.json") + `"
+log:
+  level: warn
+`
+	require.NoError(t, os.WriteFile(configFile, []byte(configContent), 0644))
+
+	environ := []string{
+		"CONFIG_FILE=" + configFile,
+		"APP_SERVICE_PORT=8082",
+		"APP_SERVICE_INTERVAL=7",
+		"APP_TRACING_INSECURE=true",
+		"UNRELATED=1",
+	}
+	args := []string{"-service.port=8083", "-retention.max_tasks", "10"}
+
+	cfg, err := Load(args, environ)
+	require.NoError(t, err)
+
+	assert.Equal(t, "yaml-host", cfg.Service.Host, "file overrides defaults")
+	assert.Equal(t, 7, cfg.Service.Interval, "env overrides file")
+	assert.Equal(t, 8083, cfg.Service.Port, "flags override env")
+	assert.Equal(t, 10, cfg.Retention.MaxTasks)
+	assert.True(t, cfg.Tracing.Insecure)
+	assert.Equal(t, "warn", cfg.Log.Level)
+	assert.Equal(t, time.Minute, cfg.Retention.Interval, "default is kept")
+}
+
+func TestLoad_ConfigFlag(t *testing.T) {
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "config.yaml")
+	configContent := "service:\n  file: \"" + filepath.Join(dir, "task-db.json") + "\"\n  port: 9000\n"
+	require.NoError(t, os.WriteFile(configFile, []byte(configContent), 0644))
+
+	cfg, err := Load([]string{"-config", configFile}, []string{"CONFIG_FILE=" + filepath.Join(dir, "missing.yaml")})
+	require.NoError(t, err)
+	assert.Equal(t, 9000, cfg.Service.Port)
+}
+
+func TestLoad_OptionalFile(t *testing.T) {
+	dir := t.TempDir()
+	dataFile := filepath.Join(dir, "task-db.json")
+
+	// the default config file doesn't exist in the test directory
+	cfg, err := Load([]string{"-service.file=" + dataFile}, []string{"APP_SERVICE_PORT=9000"})
+	require.NoError(t, err)
+	assert.Equal(t, DefaultConfigFile, cfg.Path)
+	assert.Equal(t, 9000, cfg.Service.Port)
+	assert.Equal(t, dataFile, cfg.Service.File)
+	assert.Equal(t, Default().Retention.Interval, cfg.Retention.Interval)
+}
+
+func TestLoad_Help(t *testing.T) {
+	_, err := Load([]string{"-h"}, nil)
+	assert.ErrorIs(t, err, flag.ErrHelp)
+}
+
+func TestLoad_Errors(t *testing.T) {
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "config.yaml")
+	configContent := "service:\n  file: \"" + filepath.Join(dir, "task-db.json") + "\"\n"
+	require.NoError(t, os.WriteFile(configFile, []byte(configContent), 0644))
+	environ := []string{"CONFIG_FILE=" + configFile}
+
+	testTable := []struct {
+		name    string
+		args    []string
+		environ []string
+		wantErr []string
+	}{
+		{
+			name:    "unknown flag",
+			args:    []string{"-service.unknown=1"},
+			wantErr: []string{"flag provided but not defined"},
+		},
+		{
+			name:    "malformed flag value",
+			args:    []string{"-service.port=abc"},
+			wantErr: []string{"service.port"},
+		},
+		{
+			name:    "malformed env values",
+			environ: []string{"APP_SERVICE_PORT=abc", "APP_RETENTION_INTERVAL=soon"},
+			wantErr: []string{"APP_SERVICE_PORT", "APP_RETENTION_INTERVAL"},
+		},
+		{
+			name:    "malformed env values are aggregated with invalid values",
+			args:    []string{"-log.format=xml"},
+			environ: []string{"APP_SERVICE_PORT=abc"},
+			wantErr: []string{"APP_SERVICE_PORT", `log.format: must be text or json, got "xml"`},
+		},
+		{
+			name:    "missing explicit config file",
+			args:    []string{"-config", filepath.Join(dir, "missing.yaml")},
+			wantErr: []string{"missing.yaml"},
+		},
+		{
+			name: "invalid values are aggregated",
+			args: []string{"-service.port=70000", "-service.interval=0", "-log.format=xml"},
+			wantErr: []string{
+				ErrInvalidConfig.Error(),
+				"service.port: must be between 1 and 65535, got 70000",
+				"service.interval: must be positive, got 0",
+				`log.format: must be text or json, got "xml"`,
+			},
+		},
+		{
+			name:    "file directory isn't writable",
+			args:    []string{"-service.file=" + filepath.Join(dir, "missing", "task-db.json")},
+			wantErr: []string{"service.file: directory isn't writable"},
+		},
+		{
+			name:    "auth without keys",
+			args:    []string{"-auth.enabled=true"},
+			wantErr: []string{"auth: key_file, keys or jwt keys must be set when enabled"},
+		},
+		{
+			name:    "malformed auth key",
+			args:    []string{"-auth.keys=ci:not-a-hash:tasks:read"},
+			wantErr: []string{"auth.keys[0]: invalid api key entry"},
+		},
+		{
+			name: "malformed quota override",
+			args: []string{"-quota.tenants=acme:1:2,acme:1:2:3,acme:0:0:0"},
+			wantErr: []string{
+				"quota.tenants[0]: expected tenant:max_running:max_stored:max_creations",
+				`quota.tenants[2]: tenant "acme" is already overridden`,
+			},
+		},
+		{
+			name: "malformed rate limit route",
+			args: []string{"-rate_limit.rate=-1", "-rate_limit.routes=POST /api/tasks 1"},
+			wantErr: []string{
+				"rate_limit.rate: must not be negative, got -1",
+				`rate_limit.routes[0]: expected "METHOD PATH RATE BURST"`,
+			},
+		},
+		{
+			name:    "invalid audit limits",
+			args:    []string{"-audit.max_size_mb=0", "-audit.max_files=-1"},
+			wantErr: []string{"audit.max_size_mb: must be positive, got 0", "audit.max_files: must not be negative, got -1"},
+		},
+		{
+			name:    "invalid task log sizes",
+			args:    []string{"-task_log.buffer_kb=0", "-task_log.max_size_mb=-1"},
+			wantErr: []string{"task_log.buffer_kb: must be positive, got 0", "task_log.max_size_mb: must be positive, got -1"},
+		},
+		{
+			name: "invalid tls",
+			args: []string{
+				"-tls.key_file=tls.key", "-tls.client_auth=maybe", "-tls.min_version=1.1",
+				"-tls.client_principals=worker:tasks:all",
+			},
+			wantErr: []string{
+				"tls.key_file: must be set together with tls.cert_file",
+				`tls.client_auth: must be "require" or "optional", got "maybe"`,
+				`tls.min_version: unsupported TLS version "1.1", expected 1.2 or 1.3`,
+				"tls.client_principals: requires tls.client_ca_file",
+				`invalid scope: "tasks:all"`,
+			},
+		},
+		{
+			name: "invalid cors",
+			args: []string{
+				"-cors.allow_origins=*,https://example.com/app,https://a.*.example.com",
+				"-cors.allow_credentials=true", "-cors.allow_methods=GET,FETCH", "-cors.max_age=-1s",
+			},
+			wantErr: []string{
+				`cors.allow_origins[1]: origin "https://example.com/app" must be scheme://host[:port]`,
+				`cors.allow_origins[2]: origin "https://a.*.example.com" may only have a leading "*." wildcard`,
+				`cors.allow_credentials: can't be used with "*" origin`,
+				`cors.allow_methods[1]: unknown method "FETCH"`,
+				"cors.max_age: must not be negative, got -1s",
+			},
+		},
+		{
+			name:    "jwt without audience",
+			args:    []string{"-jwt.jwks_file=" + filepath.Join(dir, "jwks.json")},
+			wantErr: []string{"jwt.audience: must be set when jwt keys are set"},
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(tt.args, append(environ, tt.environ...))
+			require.Error(t, err)
+			for _, want := range tt.wantErr {
+				assert.Contains(t, err.Error(), want)
+			}
+		})
+	}
+}
+
+func TestParseRouteRateLimit(t *testing.T) {
+	t.Parallel()
+
+	limit, err := ParseRouteRateLimit("post api/tasks 0.5 10")
+	require.NoError(t, err)
+	assert.Equal(t, RouteRateLimit{Method: fiber.MethodPost, Path: "/api/tasks", Rate: 0.5, Burst: 10}, limit)
+
+	for _, entry := range []string{"POST /api/tasks 1", "POST /api/tasks 0 10", "POST /api/tasks 1 0", "POST /api/tasks fast 10"} {
+		_, err := ParseRouteRateLimit(entry)
+		assert.Error(t, err, entry)
+	}
+}
+
+func TestValidateOrigin(t *testing.T) {
+	t.Parallel()
+
+	valid := []string{"*", "https://example.com", "http://localhost:3000", "https://*.example.com"}
+	for _, origin := range valid {
+		assert.NoError(t, ValidateOrigin(origin), origin)
+	}
+
+	invalid := []string{"", "example.com", "ftp://example.com", "https://example.com/", "https://*", "https://a.*.example.com", "https://user@example.com"}
+	for _, origin := range invalid {
+		assert.Error(t, ValidateOrigin(origin), origin)
+	}
 }
@@ -0,0 +1,196 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// EnvPrefix prefixes environment variables overriding config values,
+	// e.g. APP_SERVICE_PORT overrides service.port.
+	EnvPrefix = "APP_"
+
+	// ConfigFileEnv names the environment variable with the config file path.
+	ConfigFileEnv = "CONFIG_FILE"
+
+	DefaultConfigFile = "configs/config.yaml"
+)
+
+// Load builds the configuration from layers, each overriding the previous
+// one: built-in defaults, the YAML file, APP_ prefixed environment
+// variables and command-line flags. The file is set by the -config flag or
+// the CONFIG_FILE environment variable and is optional unless set
+// explicitly. Every value has a flag named by its YAML path, e.g.
+// -service.port. The result is validated and invalid environment variables
+// are reported along with invalid values. flag.ErrHelp is returned after
+// the usage is printed for -h.
+func Load(args []string, environ []string) (*Config, error) {
+	env := make(map[string]string, len(environ))
+	for _, kv := range environ {
+		if key, value, ok := strings.Cut(kv, "="); ok {
+			env[key] = value
+		}
+	}
+
+	configFile := DefaultConfigFile
+	file, fileSet := env[ConfigFileEnv]
+	if fileSet {
+		configFile = file
+	}
+
+	fields := Default().fields()
+
+	// flags are collected first and applied last, since the file they
+	// override is set by a flag too
+	flags := flag.NewFlagSet("test-server", flag.ContinueOnError)
+	flags.StringVar(&configFile, "config", configFile, "path to the YAML config file")
+	flagValues := make(map[string]string)
+	for _, f := range fields {
+		flags.Func(f.key, fmt.Sprintf("overrides %s (%s)", f.key, f.value.Type()), func(value string) error {
+			if err := setValue(f.value, value); err != nil {
+				return err
+			}
+			flagValues[f.key] = value
+			return nil
+		})
+	}
+	if err := flags.Parse(args); err != nil {
+		return nil, fmt.Errorf("config.Load: failed to parse flags: %w", err)
+	}
+
+	flags.Visit(func(f *flag.Flag) {
+		fileSet = fileSet || f.Name == "config"
+	})
+
+	config, err := LoadConfig(configFile)
+	if err != nil {
+		if fileSet || !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+		config = Default()
+		config.Path = configFile // watched for reloads once created
+	}
+
+	var envErrs []error
+	for _, f := range config.fields() {
+		if value, ok := env[f.envName()]; ok {
+			if err := setValue(f.value, value); err != nil {
+				envErrs = append(envErrs, fmt.Errorf("%s: %w", f.envName(), err))
+			}
+		}
+	}
+	for _, f := range config.fields() {
+		if value, ok := flagValues[f.key]; ok {
+			_ = setValue(f.value, value) // already validated while parsing
+		}
+	}
+
+	var errs []error
+	if len(envErrs) > 0 {
+		errs = append(errs, fmt.Errorf("config.Load: invalid environment variables: %w", errors.Join(envErrs...)))
+	}
+	if err := config.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return config, nil
+}
+
+// field is a settable config value addressed by its YAML path.
+type field struct {
+	key    string // e.g. "service.port"
+	value  reflect.Value
+	secret bool
+}
+
+func (f field) envName() string {
+	return EnvPrefix + strings.ToUpper(strings.ReplaceAll(f.key, ".", "_"))
+}
+
+// fields lists the values of every config section by YAML path.
+func (c *Config) fields() []field {
+	var fields []field
+
+	root := reflect.ValueOf(c).Elem()
+	for i := range root.NumField() {
+		section := root.Field(i)
+		if section.Kind() != reflect.Struct {
+			continue
+		}
+		sectionKey := yamlName(root.Type().Field(i))
+		for j := range section.NumField() {
+			fields = append(fields, field{
+				key:    sectionKey + "." + yamlName(section.Type().Field(j)),
+				value:  section.Field(j),
+				secret: section.Type().Field(j).Tag.Get("secret") == "true",
+			})
+		}
+	}
+
+	return fields
+}
+
+func yamlName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("yaml"), ",")
+	return name
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// setValue parses s according to the type of v and stores it. Lists are
+// comma separated.
+func setValue(v reflect.Value, s string) error {
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Int:
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(n))
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported type %s", v.Type())
+		}
+		var items []string
+		for _, item := range strings.Split(s, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		v.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+// DefaultWatchInterval is how often the config file is checked for changes.
+const DefaultWatchInterval = 5 * time.Second
+
+const redacted = "REDACTED"
+
+// reloadableKeys lists the config values applied without a restart. Changes
+// of other values are rejected until the next start.
+var reloadableKeys = map[string]bool{
+	"service.interval":        true,
+	"service.drain_timeout":   true,
+	"retention.completed":     true,
+	"retention.failed":        true,
+	"retention.max_tasks":     true,
+	"retention.deleted_grace": true,
+	"retention.interval":      true,
+	"quota.max_running":       true,
+	"quota.max_stored":        true,
+	"quota.max_creations":     true,
+	"quota.window":            true,
+	"quota.tenants":           true,
+	"archive.after":           true,
+	"archive.interval":        true,
+	"health.max_in_flight":    true,
+	"health.shutdown_delay":   true,
+	"log.level":               true,
+	"cors.allow_origins":      true,
+}
+
+// Reloader holds the current configuration and reloads it on SIGHUP or when
+// the config file changes. Subscribers are notified of applied changes.
+type Reloader struct {
+	load    func() (*Config, error)
+	current atomic.Pointer[Config]
+
+	mu          sync.Mutex // serializes reloads
+	subscribers []func(c *Config)
+}
+
+// NewReloader creates a reloader starting from initial, which rebuilds the
+// configuration with load.
+func NewReloader(initial *Config, load func() (*Config, error)) *Reloader {
+	r := &Reloader{load: load}
+	r.current.Store(initial)
+	return r
+}
+
+// Current returns the configuration with all applied reloads. It must not be modified.
+func (r *Reloader) Current() *Config {
+	return r.current.Load()
+}
+
+// Subscribe registers fn to be called with the new configuration after
+// every reload that changed reloadable values.
+func (r *Reloader) Subscribe(fn func(c *Config)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.subscribers = append(r.subscribers, fn)
+}
+
+// Reload rebuilds the configuration and applies changes of reloadable
+// values. Changes of other values are logged and ignored. Invalid
+// configuration is rejected as a whole.
+func (r *Reloader) Reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	next, err := r.load()
+	if err != nil {
+		return fmt.Errorf("config.Reload: %w", err)
+	}
+
+	merged, changed, rejected := merge(r.Current(), next)
+	for _, key := range rejected {
+		slog.Warn("Config change requires restart and is ignored", "key", key)
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	r.current.Store(merged)
+	for _, fn := range r.subscribers {
+		fn(merged)
+	}
+	slog.Info("Config reloaded", "changed", changed)
+	return nil
+}
+
+// Watch reloads the configuration on SIGHUP and when the modification time
+// of the config file changes, checked every interval, until ctx is done.
+func (r *Reloader) Watch(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	modTime := r.fileModTime()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			slog.Info("SIGHUP received, reloading config")
+		case <-ticker.C:
+			current := r.fileModTime()
+			if current.Equal(modTime) {
+				continue
+			}
+			modTime = current
+			slog.Info("Config file changed, reloading config", "file", r.Current().Path)
+		}
+
+		if err := r.Reload(); err != nil {
+			slog.Error("Config reload error, keeping current config", "error", err)
+		}
+	}
+}
+
+func (r *Reloader) fileModTime() time.Time {
+	info, err := os.Stat(r.Current().Path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
+
+// RedactedConfig returns the current configuration with secrets redacted.
+func (r *Reloader) RedactedConfig() map[string]map[string]any {
+	return r.Current().Redacted()
+}
+
+// merge returns a copy of current with the reloadable values of next and
+// the keys of applied and rejected changes.
+func merge(current, next *Config) (merged *Config, changed, rejected []string) {
+	merged = new(Config)
+	*merged = *current
+
+	nextFields := next.fields()
+	for i, f := range merged.fields() {
+		value := nextFields[i].value
+		if reflect.DeepEqual(f.value.Interface(), value.Interface()) {
+			continue
+		}
+		if !reloadableKeys[f.key] {
+			rejected = append(rejected, f.key)
+			continue
+		}
+		f.value.Set(value)
+		changed = append(changed, f.key)
+	}
+
+	return merged, changed, rejected
+}
+
+// Redacted returns config values by section and key, with durations
+// formatted as strings and non-empty secrets replaced.
+func (c *Config) Redacted() map[string]map[string]any {
+	result := make(map[string]map[string]any)
+	for _, f := range c.fields() {
+		section, key, _ := strings.Cut(f.key, ".")
+		if result[section] == nil {
+			result[section] = make(map[string]any)
+		}
+
+		switch {
+		case f.secret && !f.value.IsZero():
+			result[section][key] = redacted
+		case f.value.Type() == durationType:
+			result[section][key] = time.Duration(f.value.Int()).String()
+		default:
+			result[section][key] = f.value.Interface()
+		}
+	}
+	return result
+}
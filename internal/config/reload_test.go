@@ -0,0 +1,89 @@
+package config
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloader_Reload(t *testing.T) {
+	t.Parallel()
+
+	initial := Default()
+	next := Default()
+	var loadErr error
+	reloader := NewReloader(initial, func() (*Config, error) {
+		c := *next
+		return &c, loadErr
+	})
+
+	var notified []*Config
+	reloader.Subscribe(func(c *Config) { notified = append(notified, c) })
+
+	// unchanged config doesn't notify subscribers
+	require.NoError(t, reloader.Reload())
+	assert.Empty(t, notified)
+
+	// reloadable values are applied, others are kept
+	next.Service.Interval = 10
+	next.Log.Level = "debug"
+	next.Cors.AllowOrigins = []string{"https://example.com"}
+	next.Service.Host = "0.0.0.0"
+	next.Service.Port = 9090
+	require.NoError(t, reloader.Reload())
+
+	current := reloader.Current()
+	assert.Equal(t, 10, current.Service.Interval)
+	assert.Equal(t, "debug", current.Log.Level)
+	assert.Equal(t, []string{"https://example.com"}, current.Cors.AllowOrigins)
+	assert.Equal(t, "localhost", current.Service.Host)
+	assert.Equal(t, 8080, current.Service.Port)
+	require.Len(t, notified, 1)
+	assert.Same(t, current, notified[0])
+	assert.Equal(t, 3, initial.Service.Interval, "initial config must not be modified")
+
+	// failed load keeps the current config
+	loadErr = errors.New("invalid config")
+	next.Service.Interval = 20
+	require.Error(t, reloader.Reload())
+	assert.Equal(t, 10, reloader.Current().Service.Interval)
+	assert.Len(t, notified, 1)
+}
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	current, next := Default(), Default()
+	next.Retention.MaxTasks = 5
+	next.Retention.Interval = time.Hour
+	next.Service.File = "/tmp/other.json"
+	next.Tracing.Exporter = "stdout"
+
+	merged, changed, rejected := merge(current, next)
+
+	assert.ElementsMatch(t, []string{"retention.max_tasks", "retention.interval"}, changed)
+	assert.ElementsMatch(t, []string{"service.file", "tracing.exporter"}, rejected)
+	assert.Equal(t, 5, merged.Retention.MaxTasks)
+	assert.Equal(t, "/output/task-db.json", merged.Service.File)
+	assert.Equal(t, "none", merged.Tracing.Exporter)
+}
+
+func TestConfig_Redacted(t *testing.T) {
+	t.Parallel()
+
+	c := Default()
+	redactedConfig := c.Redacted()
+	assert.Equal(t, "", redactedConfig["tracing"]["headers"], "empty secret is shown as empty")
+
+	c.Tracing.Headers = "authorization=Bearer secret"
+	redactedConfig = c.Redacted()
+
+	assert.Equal(t, "REDACTED", redactedConfig["tracing"]["headers"])
+	assert.Equal(t, 8080, redactedConfig["service"]["port"])
+	assert.Equal(t, "30s", redactedConfig["service"]["drain_timeout"])
+	assert.Equal(t, []string{"*"}, redactedConfig["cors"]["allow_origins"])
+	assert.NotContains(t, redactedConfig, "", "path isn't a section")
+}
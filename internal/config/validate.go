@@ -0,0 +1,224 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"test-server/internal/auth"
+	"test-server/internal/certs"
+	"test-server/internal/domain/model"
+	"test-server/internal/tenant"
+)
+
+var ErrInvalidConfig = errors.New("invalid config")
+
+// Validate checks the configuration values and reports all invalid ones at
+// once. The returned error wraps ErrInvalidConfig.
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, key, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: %s", key, fmt.Sprintf(format, args...)))
+		}
+	}
+
+	check(c.Service.Port >= 1 && c.Service.Port <= 65535, "service.port", "must be between 1 and 65535, got %d", c.Service.Port)
+	check(c.Service.Interval > 0, "service.interval", "must be positive, got %d", c.Service.Interval)
+	check(c.Service.File != "", "service.file", "must be set")
+	if c.Service.File != "" {
+		if err := dirWritable(filepath.Dir(c.Service.File)); err != nil {
+			check(false, "service.file", "directory isn't writable: %v", err)
+		}
+	}
+	check((c.TLS.CertFile == "") == (c.TLS.KeyFile == ""), "tls.key_file", "must be set together with tls.cert_file")
+	check(c.TLS.ClientCAFile == "" || c.TLS.CertFile != "", "tls.client_ca_file", "requires tls.cert_file")
+	check(c.TLS.ClientAuth == certs.ClientAuthRequire || c.TLS.ClientAuth == certs.ClientAuthOptional,
+		"tls.client_auth", "must be %q or %q, got %q", certs.ClientAuthRequire, certs.ClientAuthOptional, c.TLS.ClientAuth)
+	if _, err := certs.ParseVersion(c.TLS.MinVersion); err != nil {
+		check(false, "tls.min_version", "%v", err)
+	}
+	check(len(c.TLS.ClientPrincipals) == 0 || c.TLS.ClientCAFile != "", "tls.client_principals", "requires tls.client_ca_file")
+	if _, err := auth.NewCertAuthenticator(c.TLS.ClientPrincipals); err != nil {
+		check(false, "tls.client_principals", "%v", err)
+	}
+	check(c.Service.DrainTimeout >= 0, "service.drain_timeout", "must not be negative, got %s", c.Service.DrainTimeout)
+
+	check(c.Retention.Completed >= 0, "retention.completed", "must not be negative, got %s", c.Retention.Completed)
+	check(c.Retention.Failed >= 0, "retention.failed", "must not be negative, got %s", c.Retention.Failed)
+	check(c.Retention.MaxTasks >= 0, "retention.max_tasks", "must not be negative, got %d", c.Retention.MaxTasks)
+	check(c.Retention.DeletedGrace >= 0, "retention.deleted_grace", "must not be negative, got %s", c.Retention.DeletedGrace)
+	check(c.Retention.Interval > 0, "retention.interval", "must be positive, got %s", c.Retention.Interval)
+
+	check(c.Quota.MaxRunning >= 0, "quota.max_running", "must not be negative, got %d", c.Quota.MaxRunning)
+	check(c.Quota.MaxStored >= 0, "quota.max_stored", "must not be negative, got %d", c.Quota.MaxStored)
+	check(c.Quota.MaxCreations >= 0, "quota.max_creations", "must not be negative, got %d", c.Quota.MaxCreations)
+	check(c.Quota.Window > 0, "quota.window", "must be positive, got %s", c.Quota.Window)
+	quotaTenants := make(map[string]bool, len(c.Quota.Tenants))
+	for i, entry := range c.Quota.Tenants {
+		key := fmt.Sprintf("quota.tenants[%d]", i)
+		tenantID, _, err := model.ParseQuotaOverride(entry)
+		if err != nil {
+			check(false, key, "%v", err)
+			continue
+		}
+		if err := tenant.Validate(tenantID); err != nil {
+			check(false, key, "%v", err)
+		}
+		check(!quotaTenants[tenantID], key, "tenant %q is already overridden", tenantID)
+		quotaTenants[tenantID] = true
+	}
+
+	check(c.Archive.After >= 0, "archive.after", "must not be negative, got %s", c.Archive.After)
+	check(c.Archive.Interval > 0, "archive.interval", "must be positive, got %s", c.Archive.Interval)
+
+	check(c.Health.MaxInFlight >= 0, "health.max_in_flight", "must not be negative, got %d", c.Health.MaxInFlight)
+	check(c.Health.Timeout > 0, "health.timeout", "must be positive, got %s", c.Health.Timeout)
+	check(c.Health.ShutdownDelay >= 0, "health.shutdown_delay", "must not be negative, got %s", c.Health.ShutdownDelay)
+
+	check(slices.Contains([]string{"debug", "info", "warn", "error"}, c.Log.Level), "log.level", "must be one of debug, info, warn, error, got %q", c.Log.Level)
+	check(slices.Contains([]string{"text", "json"}, c.Log.Format), "log.format", "must be text or json, got %q", c.Log.Format)
+
+	check(slices.Contains([]string{"none", "stdout", "file", "otlp"}, c.Tracing.Exporter), "tracing.exporter", "must be one of none, stdout, file, otlp, got %q", c.Tracing.Exporter)
+	check(c.Tracing.Exporter != "file" || c.Tracing.File != "", "tracing.file", "must be set for the file exporter")
+	check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, "tracing.sample_ratio", "must be between 0 and 1, got %v", c.Tracing.SampleRatio)
+
+	jwtEnabled := c.JWT.JWKSFile != "" || len(c.JWT.KeyFiles) > 0
+	check(!c.Auth.Enabled || c.Auth.KeyFile != "" || len(c.Auth.Keys) > 0 || jwtEnabled, "auth", "key_file, keys or jwt keys must be set when enabled")
+	if c.Auth.KeyFile != "" {
+		if err := dirWritable(filepath.Dir(c.Auth.KeyFile)); err != nil {
+			check(false, "auth.key_file", "directory isn't writable: %v", err)
+		}
+	}
+	for i, entry := range c.Auth.Keys {
+		if _, err := auth.ParseKey(entry); err != nil {
+			check(false, fmt.Sprintf("auth.keys[%d]", i), "%v", err)
+		}
+	}
+
+	check(!jwtEnabled || c.JWT.Audience != "", "jwt.audience", "must be set when jwt keys are set")
+	check(c.JWT.Leeway >= 0, "jwt.leeway", "must not be negative, got %s", c.JWT.Leeway)
+
+	check(c.Audit.MaxSizeMB > 0, "audit.max_size_mb", "must be positive, got %d", c.Audit.MaxSizeMB)
+	check(c.Audit.MaxFiles >= 0, "audit.max_files", "must not be negative, got %d", c.Audit.MaxFiles)
+	check(c.TaskLog.BufferKB > 0, "task_log.buffer_kb", "must be positive, got %d", c.TaskLog.BufferKB)
+	check(c.TaskLog.MaxSizeMB > 0, "task_log.max_size_mb", "must be positive, got %d", c.TaskLog.MaxSizeMB)
+	check(c.RateLimit.Rate >= 0, "rate_limit.rate", "must not be negative, got %v", c.RateLimit.Rate)
+	check(c.RateLimit.Rate == 0 || c.RateLimit.Burst >= 1, "rate_limit.burst", "must be positive, got %d", c.RateLimit.Burst)
+	check(c.RateLimit.IdleTimeout > 0, "rate_limit.idle_timeout", "must be positive, got %s", c.RateLimit.IdleTimeout)
+	for i, entry := range c.RateLimit.Routes {
+		if _, err := ParseRouteRateLimit(entry); err != nil {
+			check(false, fmt.Sprintf("rate_limit.routes[%d]", i), "%v", err)
+		}
+	}
+
+	for i, origin := range c.Cors.AllowOrigins {
+		if err := ValidateOrigin(origin); err != nil {
+			check(false, fmt.Sprintf("cors.allow_origins[%d]", i), "%v", err)
+		}
+	}
+	check(!c.Cors.AllowCredentials || !slices.Contains(c.Cors.AllowOrigins, "*"), "cors.allow_credentials",
+		`can't be used with "*" origin`)
+	for i, method := range c.Cors.AllowMethods {
+		check(slices.Contains(fiber.DefaultMethods, method), fmt.Sprintf("cors.allow_methods[%d]", i), "unknown method %q", method)
+	}
+	for i, header := range c.Cors.AllowHeaders {
+		check(validHeaderName(header), fmt.Sprintf("cors.allow_headers[%d]", i), "invalid header name %q", header)
+	}
+	check(c.Cors.MaxAge >= 0, "cors.max_age", "must not be negative, got %s", c.Cors.MaxAge)
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w:\n%w", ErrInvalidConfig, errors.Join(errs...))
+	}
+	return nil
+}
+
+// dirWritable checks that a file can be created in dir.
+func dirWritable(dir string) error {
+	f, err := os.CreateTemp(dir, ".config-check-*")
+	if err != nil {
+		return err
+	}
+	f.Close()
+	return os.Remove(f.Name())
+}
+
+// validHeaderName accepts the token characters of RFC 9110.
+func validHeaderName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		ch := name[i]
+		if ch <= 0x20 || ch >= 0x7f || strings.IndexByte(`"(),/:;<=>?@[\]{}`, ch) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// RouteRateLimit overrides the rate limit of requests matching Method and Path.
+type RouteRateLimit struct {
+	// Method is an HTTP method or "*" for any.
+	Method string
+	// Path is a route pattern, ":param" segments match any value.
+	Path string
+	// Rate is the number of requests per second, Burst the number of
+	// requests allowed at once.
+	Rate  float64
+	Burst int
+}
+
+// ParseRouteRateLimit parses a "METHOD PATH RATE BURST" entry, e.g.
+// "POST /api/tasks 0.5 10" allows bursts of 10 task creations refilled at
+// one every two seconds.
+func ParseRouteRateLimit(entry string) (RouteRateLimit, error) {
+	fields := strings.Fields(entry)
+	if len(fields) != 4 {
+		return RouteRateLimit{}, fmt.Errorf("expected \"METHOD PATH RATE BURST\", got %q", entry)
+	}
+
+	limit := RouteRateLimit{Method: strings.ToUpper(fields[0]), Path: "/" + strings.TrimLeft(fields[1], "/")}
+	rate, err := strconv.ParseFloat(fields[2], 64)
+	if err != nil || rate <= 0 || math.IsInf(rate, 0) {
+		return RouteRateLimit{}, fmt.Errorf("rate must be a positive number, got %q", fields[2])
+	}
+	burst, err := strconv.Atoi(fields[3])
+	if err != nil || burst < 1 {
+		return RouteRateLimit{}, fmt.Errorf("burst must be a positive integer, got %q", fields[3])
+	}
+	limit.Rate, limit.Burst = rate, burst
+	return limit, nil
+}
+
+// ValidateOrigin checks an origin pattern. A pattern is "*" allowing any
+// origin, an origin like "https://example.com:8443" or an origin whose host
+// starts with "*." like "https://*.example.com", which allows any subdomain.
+func ValidateOrigin(pattern string) error {
+	if pattern == "*" {
+		return nil
+	}
+
+	u, err := url.Parse(pattern)
+	if err != nil {
+		return fmt.Errorf("invalid origin %q", pattern)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("origin %q must use http or https scheme", pattern)
+	}
+	if u.Hostname() == "" || u.User != nil || u.Path != "" || u.RawQuery != "" || u.Fragment != "" || u.Opaque != "" {
+		return fmt.Errorf("origin %q must be scheme://host[:port]", pattern)
+	}
+	if strings.Contains(strings.TrimPrefix(u.Host, "*."), "*") {
+		return fmt.Errorf("origin %q may only have a leading \"*.\" wildcard", pattern)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+type AuditAction string
+
+// Actions recorded in the audit log.
+const (
+	AuditCreate  AuditAction = "create"
+	AuditUpdate  AuditAction = "update"
+	AuditStart   AuditAction = "start"
+	AuditFinish  AuditAction = "finish"
+	AuditDelete  AuditAction = "delete"
+	AuditRestore AuditAction = "restore"
+	AuditPurge   AuditAction = "purge"
+)
+
+// AuditActions lists the valid actions.
+var AuditActions = []AuditAction{AuditCreate, AuditUpdate, AuditStart, AuditFinish, AuditDelete, AuditRestore, AuditPurge}
+
+// SystemActor is the actor of mutations without a principal and of task
+// start and completion.
+const SystemActor = "system"
+
+// AuditEntry records a single mutation of a task.
+type AuditEntry struct {
+	Time      time.Time     `json:"time"`
+	Actor     string        `json:"actor"`
+	Tenant    string        `json:"tenant"`
+	Action    AuditAction   `json:"action"`
+	TaskID    string        `json:"task_id"`
+	RequestID string        `json:"request_id,omitempty"`
+	Changes   []AuditChange `json:"changes,omitempty"`
+}
+
+// AuditChange is a task field changed by a mutation. Before is nil for
+// fields set by it, After for fields it removed.
+type AuditChange struct {
+	Field  string `json:"field"`
+	Before any    `json:"before,omitempty"`
+	After  any    `json:"after,omitempty"`
+}
+
+// AuditFilter selects audit entries. Zero fields match any entry.
+type AuditFilter struct {
+	Tenant    string
+	Actor     string
+	Action    AuditAction
+	TaskID    string
+	RequestID string
+	Since     time.Time
+	Until     time.Time
+	// Limit is the maximum number of entries returned, the most recent ones.
+	Limit int
+}
+
+// Matches reports whether the entry is selected by the filter, Limit aside.
+func (f AuditFilter) Matches(entry AuditEntry) bool {
+	return (f.Tenant == "" || entry.Tenant == f.Tenant) &&
+		(f.Actor == "" || entry.Actor == f.Actor) &&
+		(f.Action == "" || entry.Action == f.Action) &&
+		(f.TaskID == "" || entry.TaskID == f.TaskID) &&
+		(f.RequestID == "" || entry.RequestID == f.RequestID) &&
+		(f.Since.IsZero() || !entry.Time.Before(f.Since)) &&
+		(f.Until.IsZero() || entry.Time.Before(f.Until))
+}
+
+// ParseAuditAction validates the name of an audit action.
+func ParseAuditAction(name string) (AuditAction, error) {
+	for _, action := range AuditActions {
+		if string(action) == name {
+			return action, nil
+		}
+	}
+	return "", fmt.Errorf("%w: unknown audit action %q", ErrInvalidAuditFilter, name)
+}
@@ -9,4 +9,25 @@ var (
 	ErrInvalidTask       = errors.New("invalid input task")
 	ErrTaskAlreadyExists = errors.New("task with this ID already exists")
 	ErrTaskNotFound      = errors.New("task not found")
+	ErrTaskNotDeleted    = errors.New("task isn't deleted")
+	ErrVersionMismatch   = errors.New("task version mismatch")
+)
+
+// Tasks Service possible errors
+var (
+	ErrFieldNotEditable = errors.New("field can't be edited in current task status")
+	ErrInvalidLabel     = errors.New("invalid task label")
+	ErrInvalidSelector  = errors.New("invalid label selector")
+	ErrServiceDraining  = errors.New("service is shutting down and doesn't accept new tasks")
+	ErrQuotaExceeded    = errors.New("tenant quota exceeded")
+)
+
+// Audit log possible errors
+var (
+	ErrInvalidAuditFilter = errors.New("invalid audit filter")
+)
+
+// Task log possible errors
+var (
+	ErrInvalidLogQuery = errors.New("invalid task log query")
 )
@@ -0,0 +1,201 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// labelNameRe and labelPrefixRe follow Kubernetes label syntax: an optional
+// DNS prefix separated by "/" and a name of up to 63 alphanumeric characters,
+// '-', '_' or '.', starting and ending with an alphanumeric character.
+var (
+	labelNameRe   = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]{0,61}[A-Za-z0-9])?$`)
+	labelPrefixRe = regexp.MustCompile(`^[a-z0-9]([-a-z0-9.]{0,251}[a-z0-9])?$`)
+)
+
+// ValidateLabels checks that all label keys and values are well-formed.
+func ValidateLabels(labels map[string]string) error {
+	for key, value := range labels {
+		if err := validateLabelKey(key); err != nil {
+			return err
+		}
+		if err := validateLabelValue(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateLabelKey(key string) error {
+	name := key
+	if prefix, rest, found := strings.Cut(key, "/"); found {
+		if !labelPrefixRe.MatchString(prefix) {
+			return fmt.Errorf("label key %q has invalid prefix: %w", key, ErrInvalidLabel)
+		}
+		name = rest
+	}
+	if !labelNameRe.MatchString(name) {
+		return fmt.Errorf("label key %q is invalid: %w", key, ErrInvalidLabel)
+	}
+	return nil
+}
+
+func validateLabelValue(value string) error {
+	if value != "" && !labelNameRe.MatchString(value) {
+		return fmt.Errorf("label value %q is invalid: %w", value, ErrInvalidLabel)
+	}
+	return nil
+}
+
+type SelectorOperator string
+
+const (
+	OpEquals       SelectorOperator = "="
+	OpNotEquals    SelectorOperator = "!="
+	OpIn           SelectorOperator = "in"
+	OpNotIn        SelectorOperator = "notin"
+	OpExists       SelectorOperator = "exists"
+	OpDoesNotExist SelectorOperator = "!"
+)
+
+// Requirement is a single condition of a label selector, e.g. "env!=prod".
+type Requirement struct {
+	Key      string
+	Operator SelectorOperator
+	Values   []string
+}
+
+// Matches reports whether labels satisfy the requirement.
+func (r Requirement) Matches(labels map[string]string) bool {
+	value, exists := labels[r.Key]
+	switch r.Operator {
+	case OpEquals, OpIn:
+		return exists && slices.Contains(r.Values, value)
+	case OpNotEquals, OpNotIn:
+		return !exists || !slices.Contains(r.Values, value)
+	case OpExists:
+		return exists
+	case OpDoesNotExist:
+		return !exists
+	}
+	return false
+}
+
+// LabelSelector is a conjunction of requirements. Empty selector matches everything.
+type LabelSelector []Requirement
+
+// Matches reports whether labels satisfy every requirement of the selector.
+func (s LabelSelector) Matches(labels map[string]string) bool {
+	for _, r := range s {
+		if !r.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseSelector parses a Kubernetes-style label selector such as
+// "team=io,env!=prod,tier in (a,b),!legacy".
+func ParseSelector(selector string) (LabelSelector, error) {
+	var result LabelSelector
+
+	for _, part := range splitSelector(selector) {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			return nil, fmt.Errorf("selector %q has empty requirement: %w", selector, ErrInvalidSelector)
+		}
+
+		r, err := parseRequirement(part)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+
+	return result, nil
+}
+
+// splitSelector splits selector by commas that aren't inside parentheses.
+func splitSelector(selector string) []string {
+	if strings.TrimSpace(selector) == "" {
+		return nil
+	}
+
+	var parts []string
+	depth, start := 0, 0
+	for i, ch := range selector {
+		switch ch {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, selector[start:])
+}
+
+func parseRequirement(part string) (Requirement, error) {
+	var r Requirement
+
+	switch {
+	case strings.HasPrefix(part, "!"):
+		r = Requirement{Key: strings.TrimSpace(part[1:]), Operator: OpDoesNotExist}
+	case strings.Contains(part, "!="):
+		key, value, _ := strings.Cut(part, "!=")
+		r = Requirement{Key: strings.TrimSpace(key), Operator: OpNotEquals, Values: []string{strings.TrimSpace(value)}}
+	case strings.Contains(part, "=="):
+		key, value, _ := strings.Cut(part, "==")
+		r = Requirement{Key: strings.TrimSpace(key), Operator: OpEquals, Values: []string{strings.TrimSpace(value)}}
+	case strings.Contains(part, "="):
+		key, value, _ := strings.Cut(part, "=")
+		r = Requirement{Key: strings.TrimSpace(key), Operator: OpEquals, Values: []string{strings.TrimSpace(value)}}
+	case strings.Contains(part, "("):
+		fields := strings.Fields(part[:strings.Index(part, "(")])
+		if len(fields) != 2 || (fields[1] != string(OpIn) && fields[1] != string(OpNotIn)) {
+			return r, fmt.Errorf("requirement %q has unknown operator: %w", part, ErrInvalidSelector)
+		}
+		values, err := parseSetValues(part[strings.Index(part, "("):])
+		if err != nil {
+			return r, fmt.Errorf("requirement %q: %w", part, err)
+		}
+		r = Requirement{Key: fields[0], Operator: SelectorOperator(fields[1]), Values: values}
+	default:
+		r = Requirement{Key: strings.TrimSpace(part), Operator: OpExists}
+	}
+
+	if err := validateLabelKey(r.Key); err != nil {
+		return r, fmt.Errorf("requirement %q: %w", part, ErrInvalidSelector)
+	}
+	for _, value := range r.Values {
+		if err := validateLabelValue(value); err != nil {
+			return r, fmt.Errorf("requirement %q: %w", part, ErrInvalidSelector)
+		}
+	}
+
+	return r, nil
+}
+
+// parseSetValues parses a parenthesized value list like "(a, b)".
+func parseSetValues(set string) ([]string, error) {
+	set = strings.TrimSpace(set)
+	if !strings.HasSuffix(set, ")") {
+		return nil, fmt.Errorf("value set isn't closed: %w", ErrInvalidSelector)
+	}
+
+	var values []string
+	for _, value := range strings.Split(set[1:len(set)-1], ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return nil, fmt.Errorf("value set has empty value: %w", ErrInvalidSelector)
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
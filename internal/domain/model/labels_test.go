@@ -0,0 +1,109 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSelector(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name     string
+		selector string
+		expected LabelSelector
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name:     "empty",
+			selector: "",
+			expected: nil,
+			wantErr:  require.NoError,
+		},
+		{
+			name:     "equality based",
+			selector: "team=io, env!=prod,tier==backend",
+			expected: LabelSelector{
+				{Key: "team", Operator: OpEquals, Values: []string{"io"}},
+				{Key: "env", Operator: OpNotEquals, Values: []string{"prod"}},
+				{Key: "tier", Operator: OpEquals, Values: []string{"backend"}},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:     "set based",
+			selector: "env in (dev, qa),example.com/source notin (cron),legacy,!deprecated",
+			expected: LabelSelector{
+				{Key: "env", Operator: OpIn, Values: []string{"dev", "qa"}},
+				{Key: "example.com/source", Operator: OpNotIn, Values: []string{"cron"}},
+				{Key: "legacy", Operator: OpExists},
+				{Key: "deprecated", Operator: OpDoesNotExist},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:     "unknown operator",
+			selector: "env within (dev)",
+			wantErr:  require.Error,
+		},
+		{
+			name:     "empty requirement",
+			selector: "team=io,,env=dev",
+			wantErr:  require.Error,
+		},
+		{
+			name:     "invalid key",
+			selector: "te am=io",
+			wantErr:  require.Error,
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			selector, err := ParseSelector(tt.selector)
+			tt.wantErr(t, err)
+
+			if err == nil {
+				assert.Equal(t, tt.expected, selector)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidSelector)
+			}
+		})
+	}
+}
+
+func TestLabelSelector_Matches(t *testing.T) {
+	t.Parallel()
+
+	labels := map[string]string{"team": "io", "env": "dev"}
+
+	testTable := []struct {
+		selector string
+		expected bool
+	}{
+		{selector: "", expected: true},
+		{selector: "team=io", expected: true},
+		{selector: "team=io,env!=prod", expected: true},
+		{selector: "team=io,env=prod", expected: false},
+		{selector: "env in (qa, dev)", expected: true},
+		{selector: "env notin (qa, dev)", expected: false},
+		{selector: "project", expected: false},
+		{selector: "!project", expected: true},
+		{selector: "project!=x", expected: true},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.selector, func(t *testing.T) {
+			t.Parallel()
+
+			selector, err := ParseSelector(tt.selector)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, selector.Matches(labels))
+		})
+	}
+}
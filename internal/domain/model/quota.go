@@ -0,0 +1,89 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Quota limit names reported by QuotaExceededError.
+const (
+	QuotaRunning   = "max_running"
+	QuotaStored    = "max_stored"
+	QuotaCreations = "max_creations"
+)
+
+// QuotaLimits bounds the tasks of a tenant. Zero values mean no limit.
+type QuotaLimits struct {
+	// MaxRunning is the number of tasks running at once.
+	MaxRunning int
+	// MaxStored is the number of stored tasks, soft deleted ones excluded.
+	MaxStored int
+	// MaxCreations is the number of tasks created per QuotaPolicy.Window.
+	MaxCreations int
+}
+
+// QuotaPolicy holds the limits of every tenant and the overrides of single tenants.
+type QuotaPolicy struct {
+	Default QuotaLimits
+	Tenants map[string]QuotaLimits
+	// Window is the period MaxCreations of every tenant is counted in.
+	Window time.Duration
+}
+
+// Limits returns the limits of the tenant.
+func (p QuotaPolicy) Limits(tenant string) QuotaLimits {
+	if limits, ok := p.Tenants[tenant]; ok {
+		return limits
+	}
+	return p.Default
+}
+
+// ParseQuotaOverride parses a "tenant:max_running:max_stored:max_creations"
+// entry overriding the limits of a single tenant.
+func ParseQuotaOverride(entry string) (string, QuotaLimits, error) {
+	parts := strings.Split(entry, ":")
+	if len(parts) != 4 || parts[0] == "" {
+		return "", QuotaLimits{}, fmt.Errorf("expected tenant:max_running:max_stored:max_creations, got %q", entry)
+	}
+
+	values := make([]int, 3)
+	for i, part := range parts[1:] {
+		value, err := strconv.Atoi(part)
+		if err != nil || value < 0 {
+			return "", QuotaLimits{}, fmt.Errorf("limit must be a non-negative integer, got %q", part)
+		}
+		values[i] = value
+	}
+	return parts[0], QuotaLimits{MaxRunning: values[0], MaxStored: values[1], MaxCreations: values[2]}, nil
+}
+
+// QuotaUsage is the current consumption of the tenant quota.
+type QuotaUsage struct {
+	Tenant    string
+	Limits    QuotaLimits
+	Running   int
+	Stored    int
+	Creations int
+	Window    time.Duration
+	// WindowResetsAt is the end of the current creations window, zero
+	// when no window has started.
+	WindowResetsAt time.Time
+}
+
+// QuotaExceededError is returned when a new task would exceed the Limit
+// of the tenant quota. It matches ErrQuotaExceeded.
+type QuotaExceededError struct {
+	Limit string
+	// RetryAfter is when the limit is expected to admit a new task again.
+	RetryAfter time.Duration
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrQuotaExceeded, e.Limit)
+}
+
+func (e *QuotaExceededError) Is(target error) bool {
+	return target == ErrQuotaExceeded
+}
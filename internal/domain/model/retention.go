@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+// RetentionPolicy defines how long finished and soft deleted tasks are kept
+// in storage. Zero durations and zero MaxTasks mean no limit.
+type RetentionPolicy struct {
+	Completed    time.Duration
+	Failed       time.Duration
+	MaxTasks     int
+	DeletedGrace time.Duration
+}
+
+// ExpiresAt returns the moment a task finished with status at finishedAt
+// expires, or nil when tasks with this status are kept forever. Interrupted
+// tasks are kept as long as failed ones.
+func (p RetentionPolicy) ExpiresAt(status Status, finishedAt time.Time) *time.Time {
+	var ttl time.Duration
+	switch status {
+	case Completed:
+		ttl = p.Completed
+	case Failed, Interrupted:
+		ttl = p.Failed
+	}
+	if ttl <= 0 {
+		return nil
+	}
+
+	expiresAt := finishedAt.Add(ttl)
+	return &expiresAt
+}
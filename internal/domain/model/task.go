@@ -12,12 +12,127 @@ const (
 	Pending   Status = "pending"
 	Completed Status = "completed"
 	Failed    Status = "failed"
+	// Interrupted tasks were still running when the service shut down.
+	Interrupted Status = "interrupted"
 )
 
+type EventType string
+
+// Lifecycle events of a task in the order they happen.
+const (
+	EventQueued   EventType = "queued"
+	EventStarted  EventType = "started"
+	EventFinished EventType = "finished"
+)
+
+// TaskEvent is an entry of the task lifecycle history.
+type TaskEvent struct {
+	Type EventType `json:"type"`
+	Time time.Time `json:"time"`
+	// Status is the final status of finished events.
+	Status Status `json:"status,omitempty"`
+}
+
 type Task struct {
-	ID        uuid.UUID     `json:"task_id"`
-	Status    Status        `json:"status"`
-	Title     string        `json:"title"`
-	CreatedAt time.Time     `json:"created_at"`
-	Duration  time.Duration `json:"duration"`
+	ID        uuid.UUID         `json:"task_id"`
+	Status    Status            `json:"status"`
+	Title     string            `json:"title"`
+	CreatedAt time.Time         `json:"created_at"`
+	Duration  time.Duration     `json:"duration"` // between StartedAt and FinishedAt
+	Version   int64             `json:"version"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Metadata  map[string]any    `json:"metadata,omitempty"`
+	ExpiresAt *time.Time        `json:"expires_at,omitempty"`
+	DeletedAt *time.Time        `json:"deleted_at,omitempty"`
+	CreatedBy string            `json:"created_by,omitempty"` // id of the principal that registered the task
+	Tenant    string            `json:"tenant,omitempty"`
+	// StartedAt and FinishedAt are the times of the started and the
+	// finished event, Events the lifecycle history, oldest first.
+	StartedAt  *time.Time  `json:"started_at,omitempty"`
+	FinishedAt *time.Time  `json:"finished_at,omitempty"`
+	Events     []TaskEvent `json:"events,omitempty"`
+}
+
+// IsFinished reports whether the task reached a terminal status.
+func (t Task) IsFinished() bool {
+	return t.Status == Completed || t.Status == Failed || t.Status == Interrupted
+}
+
+// IsDeleted reports whether the task was soft deleted.
+func (t Task) IsDeleted() bool {
+	return t.DeletedAt != nil
+}
+
+// CloneMetadata returns a deep copy of the JSON metadata of a task, sharing
+// no nested objects or arrays with it.
+func CloneMetadata(metadata map[string]any) map[string]any {
+	if metadata == nil {
+		return nil
+	}
+	clone := make(map[string]any, len(metadata))
+	for key, value := range metadata {
+		clone[key] = cloneJSONValue(value)
+	}
+	return clone
+}
+
+func cloneJSONValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		return CloneMetadata(v)
+	case []any:
+		clone := make([]any, len(v))
+		for i, item := range v {
+			clone[i] = cloneJSONValue(item)
+		}
+		return clone
+	default:
+		// other JSON values are immutable
+		return v
+	}
+}
+
+// TaskUpdate describes a lifecycle event of a task and the status it leads to.
+// Started events set the task StartedAt, finished ones FinishedAt.
+type TaskUpdate struct {
+	Event     TaskEvent
+	Status    Status
+	Duration  time.Duration
+	ExpiresAt *time.Time
+}
+
+// TaskChange holds the stored copies of a task before and after a
+// repository mutation. Before is nil for created tasks, After for purged ones.
+type TaskChange struct {
+	Before *Task
+	After  *Task
+}
+
+// TaskSpec holds the user-provided attributes of a new task.
+type TaskSpec struct {
+	Title    string
+	Labels   map[string]string
+	Metadata map[string]any
+}
+
+// TaskPatch holds the mutable fields of a task. Nil fields are left untouched.
+// Version, when set, must match the stored task version for the patch to apply.
+type TaskPatch struct {
+	Title   *string
+	Version *int64
+}
+
+// Fields returns the names of the task fields the patch modifies.
+func (p TaskPatch) Fields() []string {
+	var fields []string
+	if p.Title != nil {
+		fields = append(fields, "title")
+	}
+	return fields
+}
+
+// BatchResult is the outcome of a single item of a batch operation.
+type BatchResult struct {
+	ID  string
+	Err error
 }
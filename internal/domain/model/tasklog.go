@@ -0,0 +1,40 @@
+package model
+
+import "time"
+
+type LogLevel string
+
+// Levels of task log lines.
+const (
+	LogDebug LogLevel = "debug"
+	LogInfo  LogLevel = "info"
+	LogWarn  LogLevel = "warn"
+	LogError LogLevel = "error"
+)
+
+// LogLine is a line written to the log of a task by its execution. Offsets
+// number the lines of a log from zero.
+type LogLine struct {
+	Offset  int64     `json:"offset"`
+	Time    time.Time `json:"time"`
+	Level   LogLevel  `json:"level"`
+	Message string    `json:"message"`
+}
+
+// LogQuery selects lines of a task log. A positive Tail selects the last
+// Tail lines, otherwise the lines from Offset on. A positive Limit caps the
+// number of selected lines.
+type LogQuery struct {
+	Offset int64
+	Tail   int
+	Limit  int
+}
+
+// LogPage is the part of a task log selected by a LogQuery.
+type LogPage struct {
+	Lines []LogLine
+	// NextOffset is the offset to continue reading the log from.
+	NextOffset int64
+	// Finished reports whether the task finished writing the log.
+	Finished bool
+}
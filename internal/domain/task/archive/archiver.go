@@ -0,0 +1,390 @@
+package archive
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"test-server/internal/domain/model"
+	"test-server/internal/tenant"
+)
+
+// DefaultInterval is used when the archiver interval isn't configured.
+const DefaultInterval = 10 * time.Minute
+
+const (
+	fileExt    = ".jsonl.gz"
+	dateLayout = "2006-01-02"
+)
+
+//go:generate minimock -i TasksRepository -o ./mock -s _mock.go
+type TasksRepository interface {
+	FinishedTasksBefore(ctx context.Context, before time.Time) ([]model.Task, error)
+	PurgeTasks(ctx context.Context, ids []string) ([]model.TaskChange, []error)
+}
+
+// Archiver moves finished tasks older than a threshold from the repository
+// into gzip compressed JSON-lines files partitioned by task creation date,
+// e.g. "<dir>/2025-08-23.jsonl.gz". Every run appends a new gzip member to
+// the partition file, which is still a valid gzip stream.
+type Archiver struct {
+	dir      string
+	after    atomic.Int64 // time.Duration
+	interval time.Duration
+	// intervalCh passes interval changes to the running archiver.
+	intervalCh chan time.Duration
+	tasksRepo  TasksRepository
+
+	// archiveMu serializes runs, so appends to a partition never interleave.
+	archiveMu sync.Mutex
+	// mu guards the index and keeps readers from seeing partially written
+	// gzip members.
+	mu sync.RWMutex
+	// index maps archived task tenant and id, see indexKey, to its
+	// partition file name.
+	index map[string]string
+}
+
+// NewArchiver creates the archive directory if needed and indexes
+// already archived tasks.
+func NewArchiver(dir string, after, interval time.Duration, tasksRepo TasksRepository) (*Archiver, error) {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("archive.NewArchiver: failed to create archive dir: %w", err)
+	}
+
+	a := &Archiver{
+		dir:        dir,
+		interval:   interval,
+		intervalCh: make(chan time.Duration, 1),
+		tasksRepo:  tasksRepo,
+		index:      make(map[string]string),
+	}
+	a.after.Store(int64(after))
+	if err := a.buildIndex(); err != nil {
+		return nil, fmt.Errorf("archive.NewArchiver: failed to index archive: %w", err)
+	}
+
+	return a, nil
+}
+
+// SetAfter changes the age of tasks archived by the following runs.
+func (a *Archiver) SetAfter(after time.Duration) {
+	a.after.Store(int64(after))
+}
+
+// SetInterval changes the interval of the running archiver.
+func (a *Archiver) SetInterval(interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	// replace a change the archiver hasn't picked up yet
+	select {
+	case <-a.intervalCh:
+	default:
+	}
+	select {
+	case a.intervalCh <- interval:
+	default:
+	}
+}
+
+// Run archives tasks every interval until ctx is done.
+func (a *Archiver) Run(ctx context.Context) {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case interval := <-a.intervalCh:
+			ticker.Reset(interval)
+		case now := <-ticker.C:
+			if _, err := a.Archive(ctx, now); err != nil {
+				slog.ErrorContext(ctx, "Archiver.Run: error while archiving tasks", "error", err)
+			}
+		}
+	}
+}
+
+// Archive moves finished tasks created before now minus the threshold into
+// the archive and returns the number of archived tasks. Tasks are removed
+// from the repository only after they are durably written.
+func (a *Archiver) Archive(ctx context.Context, now time.Time) (int, error) {
+	tasks, err := a.tasksRepo.FinishedTasksBefore(ctx, now.Add(-time.Duration(a.after.Load())))
+	if err != nil {
+		return 0, fmt.Errorf("TasksRepo.FinishedTasksBefore: failed to get tasks to archive: %w", err)
+	}
+	if len(tasks) == 0 {
+		return 0, nil
+	}
+
+	partitions := make(map[string][]model.Task)
+	for _, task := range tasks {
+		name := task.CreatedAt.UTC().Format(dateLayout) + fileExt
+		partitions[name] = append(partitions[name], task)
+	}
+
+	a.archiveMu.Lock()
+	defer a.archiveMu.Unlock()
+
+	archived := 0
+	byTenant := make(map[string][]string)
+	for name, partition := range partitions {
+		if err := a.appendPartition(name, partition); err != nil {
+			// tasks of other partitions may still be archived
+			slog.ErrorContext(ctx, "Archiver.Archive: error while writing partition", "partition", name, "error", err)
+			continue
+		}
+
+		a.mu.Lock()
+		for _, task := range partition {
+			id, tenantID := task.ID.String(), taskTenant(task)
+			a.index[indexKey(tenantID, id)] = name
+			byTenant[tenantID] = append(byTenant[tenantID], id)
+			archived++
+		}
+		a.mu.Unlock()
+	}
+
+	// the repository purges tasks of the tenant carried by ctx only
+	for tenantID, ids := range byTenant {
+		_, errs := a.tasksRepo.PurgeTasks(tenant.With(ctx, tenantID), ids)
+		for i, err := range errs {
+			if err != nil && !errors.Is(err, model.ErrTaskNotFound) {
+				slog.ErrorContext(ctx, "Archiver.Archive: error while deleting archived task", "task_id", ids[i], "tenant", tenantID, "error", err)
+			}
+		}
+	}
+
+	if archived < len(tasks) {
+		return archived, fmt.Errorf("archive.Archive: archived %d of %d tasks", archived, len(tasks))
+	}
+	return archived, nil
+}
+
+// ArchivedTask returns the archived task of the tenant of ctx by id.
+func (a *Archiver) ArchivedTask(ctx context.Context, id string) (*model.Task, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	tenantID := tenant.From(ctx)
+	name, ok := a.index[indexKey(tenantID, id)]
+	if !ok {
+		return nil, model.ErrTaskNotFound
+	}
+
+	var found *model.Task
+	err := a.scanPartition(name, func(task model.Task) bool {
+		if task.ID.String() == id && taskTenant(task) == tenantID {
+			found = &task
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("archive.ArchivedTask: failed to read %s: %w", name, err)
+	}
+	if found == nil {
+		return nil, model.ErrTaskNotFound
+	}
+
+	return found, nil
+}
+
+// appendPartition writes tasks as a new gzip member at the end of the partition
+// file and syncs it to disk. The member is written under a.mu, so readers
+// never see it partially, and synced without it. A failed append is
+// truncated away, so the file stays a valid gzip stream. Caller must hold
+// a.archiveMu.
+func (a *Archiver) appendPartition(name string, tasks []model.Task) error {
+	f, err := os.OpenFile(filepath.Join(a.dir, name), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o640)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	err = writeMember(f, tasks)
+	a.mu.Unlock()
+	if err == nil {
+		err = f.Sync()
+	}
+	if err != nil {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		if truncErr := f.Truncate(info.Size()); truncErr != nil {
+			return errors.Join(err, fmt.Errorf("failed to truncate partial member: %w", truncErr))
+		}
+		return err
+	}
+	return nil
+}
+
+func writeMember(w io.Writer, tasks []model.Task) error {
+	zw := gzip.NewWriter(w)
+	encoder := json.NewEncoder(zw)
+	for _, task := range tasks {
+		if err := encoder.Encode(task); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+// scanPartition decodes tasks of the partition file and passes them to fn
+// until it returns false.
+func (a *Archiver) scanPartition(name string, fn func(task model.Task) bool) error {
+	f, err := os.Open(filepath.Join(a.dir, name))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	zr, err := gzip.NewReader(bufio.NewReader(f))
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+
+	decoder := json.NewDecoder(zr)
+	for {
+		var task model.Task
+		if err := decoder.Decode(&task); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if !fn(task) {
+			return nil
+		}
+	}
+}
+
+func (a *Archiver) buildIndex() error {
+	entries, err := os.ReadDir(a.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, fileExt) {
+			continue
+		}
+		if err := a.indexPartition(name); err != nil {
+			return fmt.Errorf("failed to read %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// indexPartition indexes the tasks of the partition file member by member.
+// A torn trailing member, left by a crash during an append, is truncated
+// away. Its tasks were still in the repository, since they are purged only
+// after the member is synced, so they are archived again by a later run.
+func (a *Archiver) indexPartition(name string) error {
+	f, err := os.OpenFile(filepath.Join(a.dir, name), os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	counter := &countingReader{r: f}
+	br := bufio.NewReader(counter)
+	zr := new(gzip.Reader)
+	var end int64 // offset following the last complete member
+	for {
+		if _, err := br.Peek(1); errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		var tasks []model.Task
+		err := readMember(zr, br, func(task model.Task) {
+			tasks = append(tasks, task)
+		})
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			slog.Warn("Truncating torn archive member", "partition", name, "offset", end, "error", err)
+			return f.Truncate(end)
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, task := range tasks {
+			a.index[indexKey(taskTenant(task), task.ID.String())] = name
+		}
+		end = counter.n - int64(br.Buffered())
+	}
+}
+
+// readMember decodes the tasks of the next gzip member of br.
+func readMember(zr *gzip.Reader, br *bufio.Reader, fn func(task model.Task)) error {
+	if err := zr.Reset(br); err != nil {
+		if errors.Is(err, io.EOF) {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	zr.Multistream(false)
+
+	decoder := json.NewDecoder(zr)
+	for {
+		var task model.Task
+		if err := decoder.Decode(&task); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		fn(task)
+	}
+}
+
+// countingReader counts the bytes read from r.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func indexKey(tenantID, id string) string {
+	return tenantID + "/" + id
+}
+
+// taskTenant returns the tenant of the archived task. Tasks archived before
+// tenants were introduced belong to the default one.
+func taskTenant(task model.Task) string {
+	if task.Tenant == "" {
+		return tenant.Default
+	}
+	return task.Tenant
+}
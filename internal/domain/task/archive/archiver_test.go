@@ -0,0 +1,155 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"test-server/internal/domain/model"
+	mocks "test-server/internal/domain/task/archive/mock"
+	"test-server/internal/tenant"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiver_Archive(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	now := time.Date(2025, 8, 25, 12, 0, 0, 0, time.UTC)
+	tasks := []model.Task{
+		{ID: uuid.New(), Status: model.Completed, Title: "first", CreatedAt: now.Add(-48 * time.Hour), Version: 2},
+		{ID: uuid.New(), Status: model.Failed, Title: "second", CreatedAt: now.Add(-30 * time.Hour), Version: 2},
+		{ID: uuid.New(), Status: model.Completed, Title: "third", CreatedAt: now.Add(-47 * time.Hour), Version: 2},
+	}
+	ids := []string{tasks[0].ID.String(), tasks[1].ID.String(), tasks[2].ID.String()}
+
+	mc := minimock.NewController(t)
+	repo := mocks.NewTasksRepositoryMock(mc).
+		FinishedTasksBeforeMock.Expect(minimock.AnyContext, now.Add(-24*time.Hour)).Return(tasks, nil).
+		PurgeTasksMock.Set(func(ctx context.Context, deleted []string) ([]model.TaskChange, []error) {
+		assert.ElementsMatch(t, ids, deleted)
+		return make([]model.TaskChange, len(deleted)), make([]error, len(deleted))
+	})
+
+	archiver, err := NewArchiver(dir, 24*time.Hour, time.Minute, repo)
+	require.NoError(t, err)
+
+	archived, err := archiver.Archive(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, 3, archived)
+
+	// tasks are partitioned by creation date
+	assert.FileExists(t, filepath.Join(dir, "2025-08-23.jsonl.gz"))
+	assert.FileExists(t, filepath.Join(dir, "2025-08-24.jsonl.gz"))
+
+	task, err := archiver.ArchivedTask(context.Background(), ids[2])
+	require.NoError(t, err)
+	assert.Equal(t, tasks[2].Title, task.Title)
+	assert.True(t, tasks[2].CreatedAt.Equal(task.CreatedAt))
+
+	_, err = archiver.ArchivedTask(context.Background(), uuid.NewString())
+	assert.ErrorIs(t, err, model.ErrTaskNotFound)
+	// archived tasks stay visible to their tenant only
+	_, err = archiver.ArchivedTask(tenant.With(context.Background(), "acme"), ids[2])
+	assert.ErrorIs(t, err, model.ErrTaskNotFound)
+
+	// a new archiver restores the index from existing files
+	reopened, err := NewArchiver(dir, 24*time.Hour, time.Minute, mocks.NewTasksRepositoryMock(mc))
+	require.NoError(t, err)
+
+	task, err = reopened.ArchivedTask(context.Background(), ids[1])
+	require.NoError(t, err)
+	assert.Equal(t, model.Failed, task.Status)
+}
+
+func TestArchiver_Archive_AppendsToPartition(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	now := time.Date(2025, 8, 25, 12, 0, 0, 0, time.UTC)
+	first := model.Task{ID: uuid.New(), Status: model.Completed, CreatedAt: now.Add(-48 * time.Hour)}
+	second := model.Task{ID: uuid.New(), Status: model.Completed, CreatedAt: now.Add(-47 * time.Hour)}
+
+	mc := minimock.NewController(t)
+	repo := mocks.NewTasksRepositoryMock(mc).
+		FinishedTasksBeforeMock.When(minimock.AnyContext, now.Add(-24*time.Hour)).Then([]model.Task{first}, nil).
+		FinishedTasksBeforeMock.When(minimock.AnyContext, now.Add(-23*time.Hour)).Then([]model.Task{second}, nil).
+		PurgeTasksMock.Return([]model.TaskChange{{}}, []error{nil})
+
+	archiver, err := NewArchiver(dir, 24*time.Hour, time.Minute, repo)
+	require.NoError(t, err)
+
+	_, err = archiver.Archive(context.Background(), now)
+	require.NoError(t, err)
+	_, err = archiver.Archive(context.Background(), now.Add(time.Hour))
+	require.NoError(t, err)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	for _, task := range []model.Task{first, second} {
+		found, err := archiver.ArchivedTask(context.Background(), task.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, task.ID, found.ID)
+	}
+}
+
+func TestArchiver_TornMember(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	now := time.Date(2025, 8, 25, 12, 0, 0, 0, time.UTC)
+	first := model.Task{ID: uuid.New(), Status: model.Completed, CreatedAt: now.Add(-48 * time.Hour)}
+	second := model.Task{ID: uuid.New(), Status: model.Completed, CreatedAt: now.Add(-47 * time.Hour)}
+
+	mc := minimock.NewController(t)
+	repo := mocks.NewTasksRepositoryMock(mc).
+		FinishedTasksBeforeMock.When(minimock.AnyContext, now.Add(-24*time.Hour)).Then([]model.Task{first}, nil).
+		FinishedTasksBeforeMock.When(minimock.AnyContext, now.Add(-23*time.Hour)).Then([]model.Task{second}, nil).
+		PurgeTasksMock.Return([]model.TaskChange{{}}, []error{nil})
+
+	archiver, err := NewArchiver(dir, 24*time.Hour, time.Minute, repo)
+	require.NoError(t, err)
+	_, err = archiver.Archive(context.Background(), now)
+	require.NoError(t, err)
+
+	// simulate a crash in the middle of an append
+	path := filepath.Join(dir, "2025-08-23.jsonl.gz")
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	var member bytes.Buffer
+	require.NoError(t, writeMember(&member, []model.Task{second}))
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.Write(member.Bytes()[:member.Len()/2])
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	// the torn member is dropped on startup
+	reopened, err := NewArchiver(dir, 24*time.Hour, time.Minute, repo)
+	require.NoError(t, err)
+	truncated, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, info.Size(), truncated.Size())
+
+	_, err = reopened.ArchivedTask(context.Background(), first.ID.String())
+	require.NoError(t, err)
+	_, err = reopened.ArchivedTask(context.Background(), second.ID.String())
+	assert.ErrorIs(t, err, model.ErrTaskNotFound)
+
+	// its tasks are archived again by a later run
+	_, err = reopened.Archive(context.Background(), now.Add(time.Hour))
+	require.NoError(t, err)
+	for _, task := range []model.Task{first, second} {
+		found, err := reopened.ArchivedTask(context.Background(), task.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, task.ID, found.ID)
+	}
+}
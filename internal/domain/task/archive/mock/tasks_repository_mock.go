@@ -0,0 +1,775 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mock
+
+//go:generate minimock -i test-server/internal/domain/task/archive.TasksRepository -o tasks_repository_mock.go -n TasksRepositoryMock -p mock
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"test-server/internal/domain/model"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// TasksRepositoryMock implements mm_archive.TasksRepository
+type TasksRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcFinishedTasksBefore          func(ctx context.Context, before time.Time) (ta1 []model.Task, err error)
+	funcFinishedTasksBeforeOrigin    string
+	inspectFuncFinishedTasksBefore   func(ctx context.Context, before time.Time)
+	afterFinishedTasksBeforeCounter  uint64
+	beforeFinishedTasksBeforeCounter uint64
+	FinishedTasksBeforeMock          mTasksRepositoryMockFinishedTasksBefore
+
+	funcPurgeTasks          func(ctx context.Context, ids []string) (ta1 []model.TaskChange, ea1 []error)
+	funcPurgeTasksOrigin    string
+	inspectFuncPurgeTasks   func(ctx context.Context, ids []string)
+	afterPurgeTasksCounter  uint64
+	beforePurgeTasksCounter uint64
+	PurgeTasksMock          mTasksRepositoryMockPurgeTasks
+}
+
+// NewTasksRepositoryMock returns a mock for mm_archive.TasksRepository
+func NewTasksRepositoryMock(t minimock.Tester) *TasksRepositoryMock {
+	m := &TasksRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.FinishedTasksBeforeMock = mTasksRepositoryMockFinishedTasksBefore{mock: m}
+	m.FinishedTasksBeforeMock.callArgs = []*TasksRepositoryMockFinishedTasksBeforeParams{}
+
+	m.PurgeTasksMock = mTasksRepositoryMockPurgeTasks{mock: m}
+	m.PurgeTasksMock.callArgs = []*TasksRepositoryMockPurgeTasksParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mTasksRepositoryMockFinishedTasksBefore struct {
+	optional           bool
+	mock               *TasksRepositoryMock
+	defaultExpectation *TasksRepositoryMockFinishedTasksBeforeExpectation
+	expectations       []*TasksRepositoryMockFinishedTasksBeforeExpectation
+
+	callArgs []*TasksRepositoryMockFinishedTasksBeforeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TasksRepositoryMockFinishedTasksBeforeExpectation specifies expectation struct of the TasksRepository.FinishedTasksBefore
+type TasksRepositoryMockFinishedTasksBeforeExpectation struct {
+	mock               *TasksRepositoryMock
+	params             *TasksRepositoryMockFinishedTasksBeforeParams
+	paramPtrs          *TasksRepositoryMockFinishedTasksBeforeParamPtrs
+	expectationOrigins TasksRepositoryMockFinishedTasksBeforeExpectationOrigins
+	results            *TasksRepositoryMockFinishedTasksBeforeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TasksRepositoryMockFinishedTasksBeforeParams contains parameters of the TasksRepository.FinishedTasksBefore
+type TasksRepositoryMockFinishedTasksBeforeParams struct {
+	ctx    context.Context
+	before time.Time
+}
+
+// TasksRepositoryMockFinishedTasksBeforeParamPtrs contains pointers to parameters of the TasksRepository.FinishedTasksBefore
+type TasksRepositoryMockFinishedTasksBeforeParamPtrs struct {
+	ctx    *context.Context
+	before *time.Time
+}
+
+// TasksRepositoryMockFinishedTasksBeforeResults contains results of the TasksRepository.FinishedTasksBefore
+type TasksRepositoryMockFinishedTasksBeforeResults struct {
+	ta1 []model.Task
+	err error
+}
+
+// TasksRepositoryMockFinishedTasksBeforeOrigins contains origins of expectations of the TasksRepository.FinishedTasksBefore
+type TasksRepositoryMockFinishedTasksBeforeExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originBefore string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFinishedTasksBefore *mTasksRepositoryMockFinishedTasksBefore) Optional() *mTasksRepositoryMockFinishedTasksBefore {
+	mmFinishedTasksBefore.optional = true
+	return mmFinishedTasksBefore
+}
+
+// Expect sets up expected params for TasksRepository.FinishedTasksBefore
+func (mmFinishedTasksBefore *mTasksRepositoryMockFinishedTasksBefore) Expect(ctx context.Context, before time.Time) *mTasksRepositoryMockFinishedTasksBefore {
+	if mmFinishedTasksBefore.mock.funcFinishedTasksBefore != nil {
+		mmFinishedTasksBefore.mock.t.Fatalf("TasksRepositoryMock.FinishedTasksBefore mock is already set by Set")
+	}
+
+	if mmFinishedTasksBefore.defaultExpectation == nil {
+		mmFinishedTasksBefore.defaultExpectation = &TasksRepositoryMockFinishedTasksBeforeExpectation{}
+	}
+
+	if mmFinishedTasksBefore.defaultExpectation.paramPtrs != nil {
+		mmFinishedTasksBefore.mock.t.Fatalf("TasksRepositoryMock.FinishedTasksBefore mock is already set by ExpectParams functions")
+	}
+
+	mmFinishedTasksBefore.defaultExpectation.params = &TasksRepositoryMockFinishedTasksBeforeParams{ctx, before}
+	mmFinishedTasksBefore.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFinishedTasksBefore.expectations {
+		if minimock.Equal(e.params, mmFinishedTasksBefore.defaultExpectation.params) {
+			mmFinishedTasksBefore.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFinishedTasksBefore.defaultExpectation.params)
+		}
+	}
+
+	return mmFinishedTasksBefore
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TasksRepository.FinishedTasksBefore
+func (mmFinishedTasksBefore *mTasksRepositoryMockFinishedTasksBefore) ExpectCtxParam1(ctx context.Context) *mTasksRepositoryMockFinishedTasksBefore {
+	if mmFinishedTasksBefore.mock.funcFinishedTasksBefore != nil {
+		mmFinishedTasksBefore.mock.t.Fatalf("TasksRepositoryMock.FinishedTasksBefore mock is already set by Set")
+	}
+
+	if mmFinishedTasksBefore.defaultExpectation == nil {
+		mmFinishedTasksBefore.defaultExpectation = &TasksRepositoryMockFinishedTasksBeforeExpectation{}
+	}
+
+	if mmFinishedTasksBefore.defaultExpectation.params != nil {
+		mmFinishedTasksBefore.mock.t.Fatalf("TasksRepositoryMock.FinishedTasksBefore mock is already set by Expect")
+	}
+
+	if mmFinishedTasksBefore.defaultExpectation.paramPtrs == nil {
+		mmFinishedTasksBefore.defaultExpectation.paramPtrs = &TasksRepositoryMockFinishedTasksBeforeParamPtrs{}
+	}
+	mmFinishedTasksBefore.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFinishedTasksBefore.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFinishedTasksBefore
+}
+
+// ExpectBeforeParam2 sets up expected param before for TasksRepository.FinishedTasksBefore
+func (mmFinishedTasksBefore *mTasksRepositoryMockFinishedTasksBefore) ExpectBeforeParam2(before time.Time) *mTasksRepositoryMockFinishedTasksBefore {
+	if mmFinishedTasksBefore.mock.funcFinishedTasksBefore != nil {
+		mmFinishedTasksBefore.mock.t.Fatalf("TasksRepositoryMock.FinishedTasksBefore mock is already set by Set")
+	}
+
+	if mmFinishedTasksBefore.defaultExpectation == nil {
+		mmFinishedTasksBefore.defaultExpectation = &TasksRepositoryMockFinishedTasksBeforeExpectation{}
+	}
+
+	if mmFinishedTasksBefore.defaultExpectation.params != nil {
+		mmFinishedTasksBefore.mock.t.Fatalf("TasksRepositoryMock.FinishedTasksBefore mock is already set by Expect")
+	}
+
+	if mmFinishedTasksBefore.defaultExpectation.paramPtrs == nil {
+		mmFinishedTasksBefore.defaultExpectation.paramPtrs = &TasksRepositoryMockFinishedTasksBeforeParamPtrs{}
+	}
+	mmFinishedTasksBefore.defaultExpectation.paramPtrs.before = &before
+	mmFinishedTasksBefore.defaultExpectation.expectationOrigins.originBefore = minimock.CallerInfo(1)
+
+	return mmFinishedTasksBefore
+}
+
+// Inspect accepts an inspector function that has same arguments as the TasksRepository.FinishedTasksBefore
+func (mmFinishedTasksBefore *mTasksRepositoryMockFinishedTasksBefore) Inspect(f func(ctx context.Context, before time.Time)) *mTasksRepositoryMockFinishedTasksBefore {
+	if mmFinishedTasksBefore.mock.inspectFuncFinishedTasksBefore != nil {
+		mmFinishedTasksBefore.mock.t.Fatalf("Inspect function is already set for TasksRepositoryMock.FinishedTasksBefore")
+	}
+
+	mmFinishedTasksBefore.mock.inspectFuncFinishedTasksBefore = f
+
+	return mmFinishedTasksBefore
+}
+
+// Return sets up results that will be returned by TasksRepository.FinishedTasksBefore
+func (mmFinishedTasksBefore *mTasksRepositoryMockFinishedTasksBefore) Return(ta1 []model.Task, err error) *TasksRepositoryMock {
+	if mmFinishedTasksBefore.mock.funcFinishedTasksBefore != nil {
+		mmFinishedTasksBefore.mock.t.Fatalf("TasksRepositoryMock.FinishedTasksBefore mock is already set by Set")
+	}
+
+	if mmFinishedTasksBefore.defaultExpectation == nil {
+		mmFinishedTasksBefore.defaultExpectation = &TasksRepositoryMockFinishedTasksBeforeExpectation{mock: mmFinishedTasksBefore.mock}
+	}
+	mmFinishedTasksBefore.defaultExpectation.results = &TasksRepositoryMockFinishedTasksBeforeResults{ta1, err}
+	mmFinishedTasksBefore.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFinishedTasksBefore.mock
+}
+
+// Set uses given function f to mock the TasksRepository.FinishedTasksBefore method
+func (mmFinishedTasksBefore *mTasksRepositoryMockFinishedTasksBefore) Set(f func(ctx context.Context, before time.Time) (ta1 []model.Task, err error)) *TasksRepositoryMock {
+	if mmFinishedTasksBefore.defaultExpectation != nil {
+		mmFinishedTasksBefore.mock.t.Fatalf("Default expectation is already set for the TasksRepository.FinishedTasksBefore method")
+	}
+
+	if len(mmFinishedTasksBefore.expectations) > 0 {
+		mmFinishedTasksBefore.mock.t.Fatalf("Some expectations are already set for the TasksRepository.FinishedTasksBefore method")
+	}
+
+	mmFinishedTasksBefore.mock.funcFinishedTasksBefore = f
+	mmFinishedTasksBefore.mock.funcFinishedTasksBeforeOrigin = minimock.CallerInfo(1)
+	return mmFinishedTasksBefore.mock
+}
+
+// When sets expectation for the TasksRepository.FinishedTasksBefore which will trigger the result defined by the following
+// Then helper
+func (mmFinishedTasksBefore *mTasksRepositoryMockFinishedTasksBefore) When(ctx context.Context, before time.Time) *TasksRepositoryMockFinishedTasksBeforeExpectation {
+	if mmFinishedTasksBefore.mock.funcFinishedTasksBefore != nil {
+		mmFinishedTasksBefore.mock.t.Fatalf("TasksRepositoryMock.FinishedTasksBefore mock is already set by Set")
+	}
+
+	expectation := &TasksRepositoryMockFinishedTasksBeforeExpectation{
+		mock:               mmFinishedTasksBefore.mock,
+		params:             &TasksRepositoryMockFinishedTasksBeforeParams{ctx, before},
+		expectationOrigins: TasksRepositoryMockFinishedTasksBeforeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFinishedTasksBefore.expectations = append(mmFinishedTasksBefore.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TasksRepository.FinishedTasksBefore return parameters for the expectation previously defined by the When method
+func (e *TasksRepositoryMockFinishedTasksBeforeExpectation) Then(ta1 []model.Task, err error) *TasksRepositoryMock {
+	e.results = &TasksRepositoryMockFinishedTasksBeforeResults{ta1, err}
+	return e.mock
+}
+
+// Times sets number of times TasksRepository.FinishedTasksBefore should be invoked
+func (mmFinishedTasksBefore *mTasksRepositoryMockFinishedTasksBefore) Times(n uint64) *mTasksRepositoryMockFinishedTasksBefore {
+	if n == 0 {
+		mmFinishedTasksBefore.mock.t.Fatalf("Times of TasksRepositoryMock.FinishedTasksBefore mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFinishedTasksBefore.expectedInvocations, n)
+	mmFinishedTasksBefore.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFinishedTasksBefore
+}
+
+func (mmFinishedTasksBefore *mTasksRepositoryMockFinishedTasksBefore) invocationsDone() bool {
+	if len(mmFinishedTasksBefore.expectations) == 0 && mmFinishedTasksBefore.defaultExpectation == nil && mmFinishedTasksBefore.mock.funcFinishedTasksBefore == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFinishedTasksBefore.mock.afterFinishedTasksBeforeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFinishedTasksBefore.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FinishedTasksBefore implements mm_archive.TasksRepository
+func (mmFinishedTasksBefore *TasksRepositoryMock) FinishedTasksBefore(ctx context.Context, before time.Time) (ta1 []model.Task, err error) {
+	mm_atomic.AddUint64(&mmFinishedTasksBefore.beforeFinishedTasksBeforeCounter, 1)
+	defer mm_atomic.AddUint64(&mmFinishedTasksBefore.afterFinishedTasksBeforeCounter, 1)
+
+	mmFinishedTasksBefore.t.Helper()
+
+	if mmFinishedTasksBefore.inspectFuncFinishedTasksBefore != nil {
+		mmFinishedTasksBefore.inspectFuncFinishedTasksBefore(ctx, before)
+	}
+
+	mm_params := TasksRepositoryMockFinishedTasksBeforeParams{ctx, before}
+
+	// Record call args
+	mmFinishedTasksBefore.FinishedTasksBeforeMock.mutex.Lock()
+	mmFinishedTasksBefore.FinishedTasksBeforeMock.callArgs = append(mmFinishedTasksBefore.FinishedTasksBeforeMock.callArgs, &mm_params)
+	mmFinishedTasksBefore.FinishedTasksBeforeMock.mutex.Unlock()
+
+	for _, e := range mmFinishedTasksBefore.FinishedTasksBeforeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ta1, e.results.err
+		}
+	}
+
+	if mmFinishedTasksBefore.FinishedTasksBeforeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFinishedTasksBefore.FinishedTasksBeforeMock.defaultExpectation.Counter, 1)
+		mm_want := mmFinishedTasksBefore.FinishedTasksBeforeMock.defaultExpectation.params
+		mm_want_ptrs := mmFinishedTasksBefore.FinishedTasksBeforeMock.defaultExpectation.paramPtrs
+
+		mm_got := TasksRepositoryMockFinishedTasksBeforeParams{ctx, before}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFinishedTasksBefore.t.Errorf("TasksRepositoryMock.FinishedTasksBefore got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinishedTasksBefore.FinishedTasksBeforeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.before != nil && !minimock.Equal(*mm_want_ptrs.before, mm_got.before) {
+				mmFinishedTasksBefore.t.Errorf("TasksRepositoryMock.FinishedTasksBefore got unexpected parameter before, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinishedTasksBefore.FinishedTasksBeforeMock.defaultExpectation.expectationOrigins.originBefore, *mm_want_ptrs.before, mm_got.before, minimock.Diff(*mm_want_ptrs.before, mm_got.before))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFinishedTasksBefore.t.Errorf("TasksRepositoryMock.FinishedTasksBefore got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFinishedTasksBefore.FinishedTasksBeforeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFinishedTasksBefore.FinishedTasksBeforeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFinishedTasksBefore.t.Fatal("No results are set for the TasksRepositoryMock.FinishedTasksBefore")
+		}
+		return (*mm_results).ta1, (*mm_results).err
+	}
+	if mmFinishedTasksBefore.funcFinishedTasksBefore != nil {
+		return mmFinishedTasksBefore.funcFinishedTasksBefore(ctx, before)
+	}
+	mmFinishedTasksBefore.t.Fatalf("Unexpected call to TasksRepositoryMock.FinishedTasksBefore. %v %v", ctx, before)
+	return
+}
+
+// FinishedTasksBeforeAfterCounter returns a count of finished TasksRepositoryMock.FinishedTasksBefore invocations
+func (mmFinishedTasksBefore *TasksRepositoryMock) FinishedTasksBeforeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFinishedTasksBefore.afterFinishedTasksBeforeCounter)
+}
+
+// FinishedTasksBeforeBeforeCounter returns a count of TasksRepositoryMock.FinishedTasksBefore invocations
+func (mmFinishedTasksBefore *TasksRepositoryMock) FinishedTasksBeforeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFinishedTasksBefore.beforeFinishedTasksBeforeCounter)
+}
+
+// Calls returns a list of arguments used in each call to TasksRepositoryMock.FinishedTasksBefore.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFinishedTasksBefore *mTasksRepositoryMockFinishedTasksBefore) Calls() []*TasksRepositoryMockFinishedTasksBeforeParams {
+	mmFinishedTasksBefore.mutex.RLock()
+
+	argCopy := make([]*TasksRepositoryMockFinishedTasksBeforeParams, len(mmFinishedTasksBefore.callArgs))
+	copy(argCopy, mmFinishedTasksBefore.callArgs)
+
+	mmFinishedTasksBefore.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFinishedTasksBeforeDone returns true if the count of the FinishedTasksBefore invocations corresponds
+// the number of defined expectations
+func (m *TasksRepositoryMock) MinimockFinishedTasksBeforeDone() bool {
+	if m.FinishedTasksBeforeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FinishedTasksBeforeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FinishedTasksBeforeMock.invocationsDone()
+}
+
+// MinimockFinishedTasksBeforeInspect logs each unmet expectation
+func (m *TasksRepositoryMock) MinimockFinishedTasksBeforeInspect() {
+	for _, e := range m.FinishedTasksBeforeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TasksRepositoryMock.FinishedTasksBefore at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFinishedTasksBeforeCounter := mm_atomic.LoadUint64(&m.afterFinishedTasksBeforeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FinishedTasksBeforeMock.defaultExpectation != nil && afterFinishedTasksBeforeCounter < 1 {
+		if m.FinishedTasksBeforeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksRepositoryMock.FinishedTasksBefore at\n%s", m.FinishedTasksBeforeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TasksRepositoryMock.FinishedTasksBefore at\n%s with params: %#v", m.FinishedTasksBeforeMock.defaultExpectation.expectationOrigins.origin, *m.FinishedTasksBeforeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFinishedTasksBefore != nil && afterFinishedTasksBeforeCounter < 1 {
+		m.t.Errorf("Expected call to TasksRepositoryMock.FinishedTasksBefore at\n%s", m.funcFinishedTasksBeforeOrigin)
+	}
+
+	if !m.FinishedTasksBeforeMock.invocationsDone() && afterFinishedTasksBeforeCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksRepositoryMock.FinishedTasksBefore at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FinishedTasksBeforeMock.expectedInvocations), m.FinishedTasksBeforeMock.expectedInvocationsOrigin, afterFinishedTasksBeforeCounter)
+	}
+}
+
+type mTasksRepositoryMockPurgeTasks struct {
+	optional           bool
+	mock               *TasksRepositoryMock
+	defaultExpectation *TasksRepositoryMockPurgeTasksExpectation
+	expectations       []*TasksRepositoryMockPurgeTasksExpectation
+
+	callArgs []*TasksRepositoryMockPurgeTasksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TasksRepositoryMockPurgeTasksExpectation specifies expectation struct of the TasksRepository.PurgeTasks
+type TasksRepositoryMockPurgeTasksExpectation struct {
+	mock               *TasksRepositoryMock
+	params             *TasksRepositoryMockPurgeTasksParams
+	paramPtrs          *TasksRepositoryMockPurgeTasksParamPtrs
+	expectationOrigins TasksRepositoryMockPurgeTasksExpectationOrigins
+	results            *TasksRepositoryMockPurgeTasksResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TasksRepositoryMockPurgeTasksParams contains parameters of the TasksRepository.PurgeTasks
+type TasksRepositoryMockPurgeTasksParams struct {
+	ctx context.Context
+	ids []string
+}
+
+// TasksRepositoryMockPurgeTasksParamPtrs contains pointers to parameters of the TasksRepository.PurgeTasks
+type TasksRepositoryMockPurgeTasksParamPtrs struct {
+	ctx *context.Context
+	ids *[]string
+}
+
+// TasksRepositoryMockPurgeTasksResults contains results of the TasksRepository.PurgeTasks
+type TasksRepositoryMockPurgeTasksResults struct {
+	ta1 []model.TaskChange
+	ea1 []error
+}
+
+// TasksRepositoryMockPurgeTasksOrigins contains origins of expectations of the TasksRepository.PurgeTasks
+type TasksRepositoryMockPurgeTasksExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIds string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeTasks *mTasksRepositoryMockPurgeTasks) Optional() *mTasksRepositoryMockPurgeTasks {
+	mmPurgeTasks.optional = true
+	return mmPurgeTasks
+}
+
+// Expect sets up expected params for TasksRepository.PurgeTasks
+func (mmPurgeTasks *mTasksRepositoryMockPurgeTasks) Expect(ctx context.Context, ids []string) *mTasksRepositoryMockPurgeTasks {
+	if mmPurgeTasks.mock.funcPurgeTasks != nil {
+		mmPurgeTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeTasks mock is already set by Set")
+	}
+
+	if mmPurgeTasks.defaultExpectation == nil {
+		mmPurgeTasks.defaultExpectation = &TasksRepositoryMockPurgeTasksExpectation{}
+	}
+
+	if mmPurgeTasks.defaultExpectation.paramPtrs != nil {
+		mmPurgeTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeTasks mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeTasks.defaultExpectation.params = &TasksRepositoryMockPurgeTasksParams{ctx, ids}
+	mmPurgeTasks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeTasks.expectations {
+		if minimock.Equal(e.params, mmPurgeTasks.defaultExpectation.params) {
+			mmPurgeTasks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeTasks.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeTasks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TasksRepository.PurgeTasks
+func (mmPurgeTasks *mTasksRepositoryMockPurgeTasks) ExpectCtxParam1(ctx context.Context) *mTasksRepositoryMockPurgeTasks {
+	if mmPurgeTasks.mock.funcPurgeTasks != nil {
+		mmPurgeTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeTasks mock is already set by Set")
+	}
+
+	if mmPurgeTasks.defaultExpectation == nil {
+		mmPurgeTasks.defaultExpectation = &TasksRepositoryMockPurgeTasksExpectation{}
+	}
+
+	if mmPurgeTasks.defaultExpectation.params != nil {
+		mmPurgeTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeTasks mock is already set by Expect")
+	}
+
+	if mmPurgeTasks.defaultExpectation.paramPtrs == nil {
+		mmPurgeTasks.defaultExpectation.paramPtrs = &TasksRepositoryMockPurgeTasksParamPtrs{}
+	}
+	mmPurgeTasks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeTasks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeTasks
+}
+
+// ExpectIdsParam2 sets up expected param ids for TasksRepository.PurgeTasks
+func (mmPurgeTasks *mTasksRepositoryMockPurgeTasks) ExpectIdsParam2(ids []string) *mTasksRepositoryMockPurgeTasks {
+	if mmPurgeTasks.mock.funcPurgeTasks != nil {
+		mmPurgeTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeTasks mock is already set by Set")
+	}
+
+	if mmPurgeTasks.defaultExpectation == nil {
+		mmPurgeTasks.defaultExpectation = &TasksRepositoryMockPurgeTasksExpectation{}
+	}
+
+	if mmPurgeTasks.defaultExpectation.params != nil {
+		mmPurgeTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeTasks mock is already set by Expect")
+	}
+
+	if mmPurgeTasks.defaultExpectation.paramPtrs == nil {
+		mmPurgeTasks.defaultExpectation.paramPtrs = &TasksRepositoryMockPurgeTasksParamPtrs{}
+	}
+	mmPurgeTasks.defaultExpectation.paramPtrs.ids = &ids
+	mmPurgeTasks.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
+
+	return mmPurgeTasks
+}
+
+// Inspect accepts an inspector function that has same arguments as the TasksRepository.PurgeTasks
+func (mmPurgeTasks *mTasksRepositoryMockPurgeTasks) Inspect(f func(ctx context.Context, ids []string)) *mTasksRepositoryMockPurgeTasks {
+	if mmPurgeTasks.mock.inspectFuncPurgeTasks != nil {
+		mmPurgeTasks.mock.t.Fatalf("Inspect function is already set for TasksRepositoryMock.PurgeTasks")
+	}
+
+	mmPurgeTasks.mock.inspectFuncPurgeTasks = f
+
+	return mmPurgeTasks
+}
+
+// Return sets up results that will be returned by TasksRepository.PurgeTasks
+func (mmPurgeTasks *mTasksRepositoryMockPurgeTasks) Return(ta1 []model.TaskChange, ea1 []error) *TasksRepositoryMock {
+	if mmPurgeTasks.mock.funcPurgeTasks != nil {
+		mmPurgeTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeTasks mock is already set by Set")
+	}
+
+	if mmPurgeTasks.defaultExpectation == nil {
+		mmPurgeTasks.defaultExpectation = &TasksRepositoryMockPurgeTasksExpectation{mock: mmPurgeTasks.mock}
+	}
+	mmPurgeTasks.defaultExpectation.results = &TasksRepositoryMockPurgeTasksResults{ta1, ea1}
+	mmPurgeTasks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeTasks.mock
+}
+
+// Set uses given function f to mock the TasksRepository.PurgeTasks method
+func (mmPurgeTasks *mTasksRepositoryMockPurgeTasks) Set(f func(ctx context.Context, ids []string) (ta1 []model.TaskChange, ea1 []error)) *TasksRepositoryMock {
+	if mmPurgeTasks.defaultExpectation != nil {
+		mmPurgeTasks.mock.t.Fatalf("Default expectation is already set for the TasksRepository.PurgeTasks method")
+	}
+
+	if len(mmPurgeTasks.expectations) > 0 {
+		mmPurgeTasks.mock.t.Fatalf("Some expectations are already set for the TasksRepository.PurgeTasks method")
+	}
+
+	mmPurgeTasks.mock.funcPurgeTasks = f
+	mmPurgeTasks.mock.funcPurgeTasksOrigin = minimock.CallerInfo(1)
+	return mmPurgeTasks.mock
+}
+
+// When sets expectation for the TasksRepository.PurgeTasks which will trigger the result defined by the following
+// Then helper
+func (mmPurgeTasks *mTasksRepositoryMockPurgeTasks) When(ctx context.Context, ids []string) *TasksRepositoryMockPurgeTasksExpectation {
+	if mmPurgeTasks.mock.funcPurgeTasks != nil {
+		mmPurgeTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeTasks mock is already set by Set")
+	}
+
+	expectation := &TasksRepositoryMockPurgeTasksExpectation{
+		mock:               mmPurgeTasks.mock,
+		params:             &TasksRepositoryMockPurgeTasksParams{ctx, ids},
+		expectationOrigins: TasksRepositoryMockPurgeTasksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeTasks.expectations = append(mmPurgeTasks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TasksRepository.PurgeTasks return parameters for the expectation previously defined by the When method
+func (e *TasksRepositoryMockPurgeTasksExpectation) Then(ta1 []model.TaskChange, ea1 []error) *TasksRepositoryMock {
+	e.results = &TasksRepositoryMockPurgeTasksResults{ta1, ea1}
+	return e.mock
+}
+
+// Times sets number of times TasksRepository.PurgeTasks should be invoked
+func (mmPurgeTasks *mTasksRepositoryMockPurgeTasks) Times(n uint64) *mTasksRepositoryMockPurgeTasks {
+	if n == 0 {
+		mmPurgeTasks.mock.t.Fatalf("Times of TasksRepositoryMock.PurgeTasks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeTasks.expectedInvocations, n)
+	mmPurgeTasks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeTasks
+}
+
+func (mmPurgeTasks *mTasksRepositoryMockPurgeTasks) invocationsDone() bool {
+	if len(mmPurgeTasks.expectations) == 0 && mmPurgeTasks.defaultExpectation == nil && mmPurgeTasks.mock.funcPurgeTasks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeTasks.mock.afterPurgeTasksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeTasks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeTasks implements mm_archive.TasksRepository
+func (mmPurgeTasks *TasksRepositoryMock) PurgeTasks(ctx context.Context, ids []string) (ta1 []model.TaskChange, ea1 []error) {
+	mm_atomic.AddUint64(&mmPurgeTasks.beforePurgeTasksCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeTasks.afterPurgeTasksCounter, 1)
+
+	mmPurgeTasks.t.Helper()
+
+	if mmPurgeTasks.inspectFuncPurgeTasks != nil {
+		mmPurgeTasks.inspectFuncPurgeTasks(ctx, ids)
+	}
+
+	mm_params := TasksRepositoryMockPurgeTasksParams{ctx, ids}
+
+	// Record call args
+	mmPurgeTasks.PurgeTasksMock.mutex.Lock()
+	mmPurgeTasks.PurgeTasksMock.callArgs = append(mmPurgeTasks.PurgeTasksMock.callArgs, &mm_params)
+	mmPurgeTasks.PurgeTasksMock.mutex.Unlock()
+
+	for _, e := range mmPurgeTasks.PurgeTasksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ta1, e.results.ea1
+		}
+	}
+
+	if mmPurgeTasks.PurgeTasksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeTasks.PurgeTasksMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeTasks.PurgeTasksMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeTasks.PurgeTasksMock.defaultExpectation.paramPtrs
+
+		mm_got := TasksRepositoryMockPurgeTasksParams{ctx, ids}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeTasks.t.Errorf("TasksRepositoryMock.PurgeTasks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeTasks.PurgeTasksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmPurgeTasks.t.Errorf("TasksRepositoryMock.PurgeTasks got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeTasks.PurgeTasksMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeTasks.t.Errorf("TasksRepositoryMock.PurgeTasks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeTasks.PurgeTasksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeTasks.PurgeTasksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeTasks.t.Fatal("No results are set for the TasksRepositoryMock.PurgeTasks")
+		}
+		return (*mm_results).ta1, (*mm_results).ea1
+	}
+	if mmPurgeTasks.funcPurgeTasks != nil {
+		return mmPurgeTasks.funcPurgeTasks(ctx, ids)
+	}
+	mmPurgeTasks.t.Fatalf("Unexpected call to TasksRepositoryMock.PurgeTasks. %v %v", ctx, ids)
+	return
+}
+
+// PurgeTasksAfterCounter returns a count of finished TasksRepositoryMock.PurgeTasks invocations
+func (mmPurgeTasks *TasksRepositoryMock) PurgeTasksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeTasks.afterPurgeTasksCounter)
+}
+
+// PurgeTasksBeforeCounter returns a count of TasksRepositoryMock.PurgeTasks invocations
+func (mmPurgeTasks *TasksRepositoryMock) PurgeTasksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeTasks.beforePurgeTasksCounter)
+}
+
+// Calls returns a list of arguments used in each call to TasksRepositoryMock.PurgeTasks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeTasks *mTasksRepositoryMockPurgeTasks) Calls() []*TasksRepositoryMockPurgeTasksParams {
+	mmPurgeTasks.mutex.RLock()
+
+	argCopy := make([]*TasksRepositoryMockPurgeTasksParams, len(mmPurgeTasks.callArgs))
+	copy(argCopy, mmPurgeTasks.callArgs)
+
+	mmPurgeTasks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeTasksDone returns true if the count of the PurgeTasks invocations corresponds
+// the number of defined expectations
+func (m *TasksRepositoryMock) MinimockPurgeTasksDone() bool {
+	if m.PurgeTasksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeTasksMock.invocationsDone()
+}
+
+// MinimockPurgeTasksInspect logs each unmet expectation
+func (m *TasksRepositoryMock) MinimockPurgeTasksInspect() {
+	for _, e := range m.PurgeTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TasksRepositoryMock.PurgeTasks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeTasksCounter := mm_atomic.LoadUint64(&m.afterPurgeTasksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeTasksMock.defaultExpectation != nil && afterPurgeTasksCounter < 1 {
+		if m.PurgeTasksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksRepositoryMock.PurgeTasks at\n%s", m.PurgeTasksMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TasksRepositoryMock.PurgeTasks at\n%s with params: %#v", m.PurgeTasksMock.defaultExpectation.expectationOrigins.origin, *m.PurgeTasksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeTasks != nil && afterPurgeTasksCounter < 1 {
+		m.t.Errorf("Expected call to TasksRepositoryMock.PurgeTasks at\n%s", m.funcPurgeTasksOrigin)
+	}
+
+	if !m.PurgeTasksMock.invocationsDone() && afterPurgeTasksCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksRepositoryMock.PurgeTasks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeTasksMock.expectedInvocations), m.PurgeTasksMock.expectedInvocationsOrigin, afterPurgeTasksCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *TasksRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockFinishedTasksBeforeInspect()
+
+			m.MinimockPurgeTasksInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *TasksRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *TasksRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockFinishedTasksBeforeDone() &&
+		m.MinimockPurgeTasksDone()
+}
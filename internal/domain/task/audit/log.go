@@ -0,0 +1,316 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"test-server/internal/auth"
+	"test-server/internal/domain/model"
+	"test-server/internal/logging"
+	"test-server/internal/tenant"
+)
+
+// DefaultMaxSize is used when the maximum file size isn't configured.
+const DefaultMaxSize = 100 << 20
+
+const (
+	currentFile   = "audit.jsonl"
+	rotatedPrefix = "audit-"
+	fileExt       = ".jsonl"
+	// rotatedLayout sorts rotated files by name in the order they were written.
+	rotatedLayout = "20060102T150405.000000000"
+)
+
+// Log is an append-only audit log of task mutations. Entries are appended
+// to "<dir>/audit.jsonl" as JSON lines. When the file would exceed maxSize
+// it's renamed to "audit-<UTC time>.jsonl" and a new one is started, by the
+// first append after the running queries finished. Only
+// the latest maxFiles rotated files are kept, unless maxFiles is zero.
+// A nil Log records nothing.
+type Log struct {
+	dir      string
+	maxSize  int64
+	maxFiles int
+	now      func() time.Time
+
+	// mu serializes appends and rotation. rotateMu is held by queries while
+	// they scan the files, so they see every file exactly once, and by
+	// rotation, which is deferred while queries run rather than blocking
+	// appends.
+	mu       sync.Mutex
+	rotateMu sync.RWMutex
+	file     *os.File
+	size     int64
+}
+
+// Open creates the directory if needed and opens the current file for
+// appending.
+func Open(dir string, maxSize int64, maxFiles int) (*Log, error) {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxSize
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("audit.Open: failed to create audit dir: %w", err)
+	}
+
+	l := &Log{dir: dir, maxSize: maxSize, maxFiles: maxFiles, now: time.Now}
+	if err := l.open(); err != nil {
+		return nil, fmt.Errorf("audit.Open: %w", err)
+	}
+	return l, nil
+}
+
+func (l *Log) open() error {
+	f, err := os.OpenFile(filepath.Join(l.dir, currentFile), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit file: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("failed to stat audit file: %w", err)
+	}
+	l.file, l.size = f, info.Size()
+	return nil
+}
+
+// Close closes the current file.
+func (l *Log) Close() error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.file.Close()
+}
+
+// Record appends an entry of the mutation of the task with taskID by the
+// principal of ctx, or model.SystemActor without one. Before is nil for
+// created tasks and after for purged ones. Write errors are logged, they
+// don't fail the mutation that already happened.
+func (l *Log) Record(ctx context.Context, action model.AuditAction, taskID string, before, after *model.Task) {
+	if l == nil {
+		return
+	}
+
+	// tasks start and finish on their own, even though ctx carries the
+	// principal that created them
+	actor := model.SystemActor
+	if principal, ok := auth.PrincipalFrom(ctx); ok && action != model.AuditStart && action != model.AuditFinish {
+		actor = principal.ID
+	}
+	entry := model.AuditEntry{
+		Time:      l.now().UTC(),
+		Actor:     actor,
+		Tenant:    tenant.From(ctx),
+		Action:    action,
+		TaskID:    taskID,
+		RequestID: logging.RequestID(ctx),
+		Changes:   Diff(before, after),
+	}
+	if err := l.Append(entry); err != nil {
+		slog.ErrorContext(ctx, "Audit log write error", "action", action, "task_id", taskID, "error", err)
+	}
+}
+
+// Append writes the entry, rotating the file first if it would exceed the
+// maximum size.
+func (l *Log) Append(entry model.AuditEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("audit.Append: failed to encode entry: %w", err)
+	}
+	line = append(line, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.size > 0 && l.size+int64(len(line)) > l.maxSize && l.rotateMu.TryLock() {
+		err := l.rotate()
+		l.rotateMu.Unlock()
+		if err != nil {
+			return fmt.Errorf("audit.Append: %w", err)
+		}
+	}
+	n, err := l.file.Write(line)
+	l.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("audit.Append: failed to write entry: %w", err)
+	}
+	return nil
+}
+
+// rotate renames the current file and starts a new one. Caller must hold
+// l.mu and l.rotateMu.
+func (l *Log) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return fmt.Errorf("failed to close audit file: %w", err)
+	}
+	rotated := rotatedPrefix + l.now().UTC().Format(rotatedLayout) + fileExt
+	if err := os.Rename(filepath.Join(l.dir, currentFile), filepath.Join(l.dir, rotated)); err != nil {
+		// keep appending to the current file rather than losing entries
+		if openErr := l.open(); openErr != nil {
+			return errors.Join(err, openErr)
+		}
+		return fmt.Errorf("failed to rotate audit file: %w", err)
+	}
+	if err := l.open(); err != nil {
+		return err
+	}
+
+	if l.maxFiles > 0 {
+		files, err := l.rotatedFiles()
+		if err != nil {
+			return err
+		}
+		for _, name := range files[:max(0, len(files)-l.maxFiles)] {
+			if err := os.Remove(filepath.Join(l.dir, name)); err != nil {
+				slog.Error("Audit file removal error", "file", name, "error", err)
+			}
+		}
+	}
+	return nil
+}
+
+// rotatedFiles returns the names of the rotated files, oldest first.
+func (l *Log) rotatedFiles() ([]string, error) {
+	entries, err := os.ReadDir(l.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audit dir: %w", err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() && strings.HasPrefix(name, rotatedPrefix) && strings.HasSuffix(name, fileExt) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Query returns the most recent entries of the tenant of ctx matching the
+// filter, newest first. filter.Tenant is ignored, entries of other tenants
+// are never returned.
+func (l *Log) Query(ctx context.Context, filter model.AuditFilter) ([]model.AuditEntry, error) {
+	filter.Tenant = tenant.From(ctx)
+
+	// the files are scanned without l.mu, so appends don't wait for queries.
+	// Entries appended after the snapshot of the current file size are left
+	// out, the read lock keeps the files from being rotated or removed.
+	l.mu.Lock()
+	l.rotateMu.RLock()
+	size := l.size
+	l.mu.Unlock()
+	defer l.rotateMu.RUnlock()
+
+	files, err := l.rotatedFiles()
+	if err != nil {
+		return nil, fmt.Errorf("audit.Query: %w", err)
+	}
+
+	var matches []model.AuditEntry
+	for i, name := range append(files, currentFile) {
+		limit := int64(math.MaxInt64)
+		if i == len(files) {
+			limit = size
+		}
+		if err := readEntries(filepath.Join(l.dir, name), limit, func(entry model.AuditEntry) {
+			if !filter.Matches(entry) {
+				return
+			}
+			matches = append(matches, entry)
+			if filter.Limit > 0 && len(matches) > filter.Limit {
+				matches = matches[1:]
+			}
+		}); err != nil {
+			return nil, fmt.Errorf("audit.Query: %w", err)
+		}
+	}
+
+	slices.Reverse(matches)
+	return matches, nil
+}
+
+// readEntries passes every entry of the first limit bytes of the file to fn.
+func readEntries(path string, limit int64, fn func(model.AuditEntry)) error {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return fmt.Errorf("failed to open audit file: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(io.LimitReader(f, limit))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16<<20)
+	for scanner.Scan() {
+		var entry model.AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			// a torn last line of a crash must not hide the other entries
+			slog.Warn("Skipping malformed audit entry", "file", path, "error", err)
+			continue
+		}
+		fn(entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read audit file %s: %w", filepath.Base(path), err)
+	}
+	return nil
+}
+
+// Diff returns the fields of the task JSON representation that differ
+// between before and after, sorted by name. Nil stands for no task.
+func Diff(before, after *model.Task) []model.AuditChange {
+	beforeFields, afterFields := taskFields(before), taskFields(after)
+
+	fields := make([]string, 0, len(beforeFields)+len(afterFields))
+	for field := range beforeFields {
+		fields = append(fields, field)
+	}
+	for field := range afterFields {
+		if _, ok := beforeFields[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+
+	var changes []model.AuditChange
+	for _, field := range fields {
+		if reflect.DeepEqual(beforeFields[field], afterFields[field]) {
+			continue
+		}
+		changes = append(changes, model.AuditChange{Field: field, Before: beforeFields[field], After: afterFields[field]})
+	}
+	return changes
+}
+
+func taskFields(task *model.Task) map[string]any {
+	if task == nil {
+		return nil
+	}
+	data, err := json.Marshal(task)
+	if err != nil {
+		return nil
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil
+	}
+	return fields
+}
@@ -0,0 +1,160 @@
+package audit
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"test-server/internal/auth"
+	"test-server/internal/domain/model"
+	"test-server/internal/tenant"
+)
+
+func TestLog_Rotation(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	log, err := Open(dir, 200, 2)
+	require.NoError(t, err)
+	defer log.Close()
+
+	now := time.Date(2025, 8, 23, 10, 0, 0, 0, time.UTC)
+	log.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	for i := 0; i < 10; i++ {
+		log.Record(context.Background(), model.AuditCreate, "task-"+string(rune('0'+i)), nil, nil)
+	}
+
+	rotated, err := log.rotatedFiles()
+	require.NoError(t, err)
+	assert.Len(t, rotated, 2, "older rotated files are removed")
+	for _, name := range append(rotated, currentFile) {
+		info, err := os.Stat(filepath.Join(dir, name))
+		require.NoError(t, err)
+		assert.LessOrEqual(t, info.Size(), int64(200))
+	}
+
+	entries, err := log.Query(context.Background(), model.AuditFilter{})
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+	assert.Equal(t, "task-9", entries[0].TaskID, "newest first")
+
+	// entries survive reopening
+	require.NoError(t, log.Close())
+	reopened, err := Open(dir, 200, 2)
+	require.NoError(t, err)
+	defer reopened.Close()
+	again, err := reopened.Query(context.Background(), model.AuditFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, entries, again)
+}
+
+func TestLog_RotationDuringQuery(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	log, err := Open(dir, 200, 0)
+	require.NoError(t, err)
+	defer log.Close()
+
+	// a running query defers rotation without blocking appends
+	log.rotateMu.RLock()
+	for i := 0; i < 5; i++ {
+		log.Record(context.Background(), model.AuditCreate, "task-"+string(rune('0'+i)), nil, nil)
+	}
+	rotated, err := log.rotatedFiles()
+	require.NoError(t, err)
+	assert.Empty(t, rotated)
+	log.rotateMu.RUnlock()
+
+	log.Record(context.Background(), model.AuditCreate, "task-5", nil, nil)
+	rotated, err = log.rotatedFiles()
+	require.NoError(t, err)
+	assert.Len(t, rotated, 1)
+
+	entries, err := log.Query(context.Background(), model.AuditFilter{})
+	require.NoError(t, err)
+	assert.Len(t, entries, 6)
+}
+
+func TestLog_Query(t *testing.T) {
+	t.Parallel()
+
+	log, err := Open(t.TempDir(), 0, 0)
+	require.NoError(t, err)
+	defer log.Close()
+
+	start := time.Date(2025, 8, 23, 10, 0, 0, 0, time.UTC)
+	now := start
+	log.now = func() time.Time {
+		now = now.Add(time.Minute)
+		return now
+	}
+
+	alice := auth.WithPrincipal(context.Background(), auth.Principal{ID: "alice"})
+	bob := auth.WithPrincipal(context.Background(), auth.Principal{ID: "bob"})
+	log.Record(alice, model.AuditCreate, "a", nil, &model.Task{Title: "a"})
+	log.Record(bob, model.AuditCreate, "b", nil, &model.Task{Title: "b"})
+	log.Record(alice, model.AuditDelete, "a", nil, nil)
+	log.Record(alice, model.AuditFinish, "b", nil, nil)
+	log.Record(tenant.With(alice, "acme"), model.AuditCreate, "c", nil, nil)
+
+	testTable := []struct {
+		name      string
+		ctx       context.Context
+		filter    model.AuditFilter
+		wantTasks []string
+	}{
+		{name: "all", ctx: alice, wantTasks: []string{"b", "a", "b", "a"}},
+		{name: "actor", ctx: alice, filter: model.AuditFilter{Actor: "alice"}, wantTasks: []string{"a", "a"}},
+		{name: "finish is done by system", ctx: alice, filter: model.AuditFilter{Actor: model.SystemActor}, wantTasks: []string{"b"}},
+		{name: "action", ctx: alice, filter: model.AuditFilter{Action: model.AuditCreate}, wantTasks: []string{"b", "a"}},
+		{name: "task", ctx: alice, filter: model.AuditFilter{TaskID: "a"}, wantTasks: []string{"a", "a"}},
+		{
+			name:      "time range",
+			ctx:       alice,
+			filter:    model.AuditFilter{Since: start.Add(2 * time.Minute), Until: start.Add(4 * time.Minute)},
+			wantTasks: []string{"a", "b"},
+		},
+		{name: "limit keeps the newest", ctx: alice, filter: model.AuditFilter{Limit: 1}, wantTasks: []string{"b"}},
+		{name: "other tenant", ctx: tenant.With(bob, "acme"), filter: model.AuditFilter{Tenant: tenant.Default}, wantTasks: []string{"c"}},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			entries, err := log.Query(tt.ctx, tt.filter)
+			require.NoError(t, err)
+			tasks := make([]string, 0, len(entries))
+			for _, entry := range entries {
+				tasks = append(tasks, entry.TaskID)
+			}
+			assert.Equal(t, tt.wantTasks, tasks)
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	deletedAt := time.Date(2025, 8, 23, 10, 0, 0, 0, time.UTC)
+	before := &model.Task{Title: "a", Version: 1, Labels: map[string]string{"team": "io"}}
+	after := &model.Task{Title: "a", Version: 2, Labels: map[string]string{"team": "io"}, DeletedAt: &deletedAt}
+
+	assert.Equal(t, []model.AuditChange{
+		{Field: "deleted_at", After: "2025-08-23T10:00:00Z"},
+		{Field: "version", Before: float64(1), After: float64(2)},
+	}, Diff(before, after))
+	assert.Empty(t, Diff(before, before))
+	assert.Contains(t, Diff(nil, after), model.AuditChange{Field: "title", After: "a"})
+	assert.Contains(t, Diff(before, nil), model.AuditChange{Field: "title", Before: "a"})
+}
@@ -0,0 +1,247 @@
+package quota
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"test-server/internal/auth"
+	"test-server/internal/domain/model"
+	"test-server/internal/tenant"
+)
+
+// DefaultWindow is used when the creations window isn't configured.
+const DefaultWindow = time.Minute
+
+// runningRetryAfter is suggested when the running limit is reached, a slot
+// frees up as soon as any running task of the client finishes.
+const runningRetryAfter = time.Second
+
+// storedRetryAfter is suggested when the stored limit is reached. Stored
+// tasks only go away when they are deleted or expire.
+const storedRetryAfter = time.Minute
+
+//go:generate minimock -i TasksRepository -o ./mock -s _mock.go
+type TasksRepository interface {
+	CountActiveTasks(ctx context.Context) int
+}
+
+// Limiter enforces the quota policy of every tenant. The stored limit is
+// shared by the tenant, the running and creations limits apply to every
+// client, the principal of the request, separately, so clients can't
+// escape them by switching tenants. New tasks are admitted with Reserve,
+// which holds a running slot for each of them until Finish. A nil Limiter
+// admits every task.
+type Limiter struct {
+	tasksRepo TasksRepository
+	now       func() time.Time
+
+	mu        sync.Mutex
+	policy    model.QuotaPolicy
+	running   map[string]int     // by client, including reserved tasks
+	pending   map[string]int     // reserved tasks not stored yet by tenant
+	creations map[string]*window // by client
+	// pruned is when ended creations windows were last removed.
+	pruned time.Time
+}
+
+// window counts creations of a client in a fixed window.
+type window struct {
+	start time.Time
+	count int
+}
+
+func NewLimiter(policy model.QuotaPolicy, tasksRepo TasksRepository) *Limiter {
+	if policy.Window <= 0 {
+		policy.Window = DefaultWindow
+	}
+
+	return &Limiter{
+		tasksRepo: tasksRepo,
+		now:       time.Now,
+		policy:    policy,
+		running:   make(map[string]int),
+		pending:   make(map[string]int),
+		creations: make(map[string]*window),
+	}
+}
+
+// SetPolicy replaces the quota policy. Tasks already admitted are kept even
+// if they exceed the new limits.
+func (l *Limiter) SetPolicy(policy model.QuotaPolicy) {
+	if policy.Window <= 0 {
+		policy.Window = DefaultWindow
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.policy = policy
+}
+
+// Reservation holds the running slots and creations of admitted tasks until
+// they are created. Done must be called once the tasks are stored or failed
+// to.
+type Reservation struct {
+	limiter *Limiter
+	tenant  string
+	client  string
+	// window is the creations window the tasks were counted in.
+	window *window
+	// N is the number of admitted tasks.
+	N int
+	// Exceeded is the limit rejecting the tasks beyond N, nil when all
+	// tasks were admitted.
+	Exceeded *model.QuotaExceededError
+}
+
+// Reserve admits up to n new tasks of the client and tenant of ctx. When the
+// quota admits fewer tasks, the first ones are admitted, so batches are
+// accepted partially. It returns *model.QuotaExceededError if no task is
+// admitted.
+func (l *Limiter) Reserve(ctx context.Context, n int) (*Reservation, error) {
+	if l == nil {
+		return &Reservation{N: n}, nil
+	}
+
+	tenantID, clientID := tenant.From(ctx), client(ctx)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// counted under the lock, so tasks stored meanwhile aren't missed by
+	// both the count and pending
+	stored := l.tasksRepo.CountActiveTasks(ctx)
+	limits := l.policy.Limits(tenantID)
+	now := l.now()
+	l.prune(now)
+	w := l.window(clientID, now)
+
+	admitted, exceeded, retryAfter := n, "", time.Duration(0)
+	admit := func(limit, used int, name string, after time.Duration) {
+		if limit <= 0 {
+			return
+		}
+		if free := max(0, limit-used); free < admitted {
+			admitted, exceeded, retryAfter = free, name, after
+		}
+	}
+	admit(limits.MaxRunning, l.running[clientID], model.QuotaRunning, runningRetryAfter)
+	admit(limits.MaxStored, stored+l.pending[tenantID], model.QuotaStored, storedRetryAfter)
+	admit(limits.MaxCreations, w.count, model.QuotaCreations, w.start.Add(l.policy.Window).Sub(now))
+
+	reservation := &Reservation{limiter: l, tenant: tenantID, client: clientID, window: w, N: admitted}
+	if admitted < n {
+		reservation.Exceeded = &model.QuotaExceededError{Limit: exceeded, RetryAfter: retryAfter}
+	}
+	if admitted == 0 {
+		return nil, reservation.Exceeded
+	}
+
+	l.running[clientID] += admitted
+	l.pending[tenantID] += admitted
+	w.count += admitted
+	return reservation, nil
+}
+
+// Done releases the reservation of the tasks. The running slots of the
+// created tasks are kept until Finish, tasks that failed to be created are
+// refunded to the creations window if it hasn't ended yet.
+func (r *Reservation) Done(created int) {
+	l := r.limiter
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	decrement(l.pending, r.tenant, r.N)
+	decrement(l.running, r.client, r.N-created)
+	if l.creations[r.client] == r.window {
+		r.window.count -= r.N - created
+	}
+}
+
+// Finish releases the running slot of a finished task of the client of ctx.
+func (l *Limiter) Finish(ctx context.Context) {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	decrement(l.running, client(ctx), 1)
+}
+
+// Usage returns the quota limits of the tenant of ctx, its stored tasks and
+// the running tasks and creations of the client of ctx.
+func (l *Limiter) Usage(ctx context.Context) model.QuotaUsage {
+	tenantID := tenant.From(ctx)
+	if l == nil {
+		return model.QuotaUsage{Tenant: tenantID}
+	}
+
+	clientID := client(ctx)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	usage := model.QuotaUsage{
+		Tenant:  tenantID,
+		Limits:  l.policy.Limits(tenantID),
+		Running: l.running[clientID],
+		Stored:  l.tasksRepo.CountActiveTasks(ctx),
+		Window:  l.policy.Window,
+	}
+	if w, ok := l.creations[clientID]; ok && l.now().Before(w.start.Add(l.policy.Window)) {
+		usage.Creations = w.count
+		usage.WindowResetsAt = w.start.Add(l.policy.Window)
+	}
+	return usage
+}
+
+// window returns the creations window of the client current at now,
+// starting a new one if the previous has ended. Caller must hold l.mu.
+func (l *Limiter) window(clientID string, now time.Time) *window {
+	w, ok := l.creations[clientID]
+	if !ok || !now.Before(w.start.Add(l.policy.Window)) {
+		w = &window{start: now}
+		l.creations[clientID] = w
+	}
+	return w
+}
+
+// prune removes the creations windows ended by now, at most once a window,
+// so clients that stopped creating tasks don't pile up. Caller must hold
+// l.mu.
+func (l *Limiter) prune(now time.Time) {
+	if now.Before(l.pruned.Add(l.policy.Window)) {
+		return
+	}
+	l.pruned = now
+	for clientID, w := range l.creations {
+		if !now.Before(w.start.Add(l.policy.Window)) {
+			delete(l.creations, clientID)
+		}
+	}
+}
+
+// decrement subtracts delta from the count of key and removes counts that
+// drop to zero. Caller must hold l.mu.
+func decrement(counts map[string]int, key string, delta int) {
+	counts[key] -= delta
+	if counts[key] <= 0 {
+		delete(counts, key)
+	}
+}
+
+// client returns the key the running and creations limits of ctx are
+// counted by: the principal id, or the tenant for calls without a principal.
+func client(ctx context.Context) string {
+	if principal, ok := auth.PrincipalFrom(ctx); ok {
+		return principal.ID
+	}
+	return "tenant:" + tenant.From(ctx)
+}
@@ -0,0 +1,174 @@
+package quota
+
+import (
+	"context"
+	"test-server/internal/auth"
+	"test-server/internal/domain/model"
+	mocks "test-server/internal/domain/task/quota/mock"
+	"test-server/internal/tenant"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter_Reserve(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name           string
+		limits         model.QuotaLimits
+		stored         int
+		n              int
+		wantAdmitted   int
+		wantLimit      string
+		wantRetryAfter time.Duration
+	}{
+		{name: "unlimited", n: 5, wantAdmitted: 5},
+		{name: "within limits", limits: model.QuotaLimits{MaxRunning: 5, MaxStored: 10, MaxCreations: 5}, stored: 5, n: 5, wantAdmitted: 5},
+		{name: "partial batch", limits: model.QuotaLimits{MaxStored: 10}, stored: 8, n: 5, wantAdmitted: 2},
+		{name: "stored", limits: model.QuotaLimits{MaxStored: 10}, stored: 10, n: 1, wantLimit: model.QuotaStored, wantRetryAfter: storedRetryAfter},
+		{name: "running", limits: model.QuotaLimits{MaxRunning: 2}, n: 3, wantAdmitted: 2},
+		{name: "creations", limits: model.QuotaLimits{MaxCreations: 3, MaxStored: 10}, n: 4, wantAdmitted: 3},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			repo := mocks.NewTasksRepositoryMock(mc).CountActiveTasksMock.Return(tt.stored)
+			limiter := NewLimiter(model.QuotaPolicy{Default: tt.limits}, repo)
+
+			reservation, err := limiter.Reserve(context.Background(), tt.n)
+			if tt.wantLimit != "" {
+				var exceeded *model.QuotaExceededError
+				require.ErrorAs(t, err, &exceeded)
+				assert.ErrorIs(t, err, model.ErrQuotaExceeded)
+				assert.Equal(t, tt.wantLimit, exceeded.Limit)
+				assert.Equal(t, tt.wantRetryAfter, exceeded.RetryAfter)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAdmitted, reservation.N)
+			assert.Equal(t, tt.wantAdmitted < tt.n, reservation.Exceeded != nil)
+		})
+	}
+}
+
+func TestLimiter_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	repo := mocks.NewTasksRepositoryMock(mc).CountActiveTasksMock.Return(0)
+	limiter := NewLimiter(model.QuotaPolicy{
+		Default: model.QuotaLimits{MaxRunning: 1, MaxCreations: 2},
+		Tenants: map[string]model.QuotaLimits{"acme": {}},
+		Window:  time.Minute,
+	}, repo)
+	now := time.Date(2025, 8, 25, 12, 0, 0, 0, time.UTC)
+	limiter.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	reservation, err := limiter.Reserve(ctx, 1)
+	require.NoError(t, err)
+	reservation.Done(1)
+
+	// the slot is held until the task finishes
+	var exceeded *model.QuotaExceededError
+	_, err = limiter.Reserve(ctx, 1)
+	require.ErrorAs(t, err, &exceeded)
+	assert.Equal(t, model.QuotaRunning, exceeded.Limit)
+
+	// overridden tenants aren't limited
+	_, err = limiter.Reserve(tenant.With(ctx, "acme"), 10)
+	require.NoError(t, err)
+
+	limiter.Finish(ctx)
+	reservation, err = limiter.Reserve(ctx, 1)
+	require.NoError(t, err)
+	// a task that failed to be stored releases its slot and creation at once
+	reservation.Done(0)
+	reservation, err = limiter.Reserve(ctx, 1)
+	require.NoError(t, err)
+	reservation.Done(1)
+	limiter.Finish(ctx)
+
+	now = now.Add(20 * time.Second)
+	_, err = limiter.Reserve(ctx, 1)
+	require.ErrorAs(t, err, &exceeded)
+	assert.Equal(t, model.QuotaCreations, exceeded.Limit)
+	assert.Equal(t, 40*time.Second, exceeded.RetryAfter)
+
+	usage := limiter.Usage(ctx)
+	assert.Equal(t, model.QuotaUsage{
+		Tenant:         tenant.Default,
+		Limits:         model.QuotaLimits{MaxRunning: 1, MaxCreations: 2},
+		Creations:      2,
+		Window:         time.Minute,
+		WindowResetsAt: now.Add(40 * time.Second),
+	}, usage)
+
+	// a new window starts after the previous one ends
+	now = now.Add(40 * time.Second)
+	_, err = limiter.Reserve(ctx, 1)
+	require.NoError(t, err)
+}
+
+func TestLimiter_Clients(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	repo := mocks.NewTasksRepositoryMock(mc).CountActiveTasksMock.Return(0)
+	limiter := NewLimiter(model.QuotaPolicy{
+		Default: model.QuotaLimits{MaxRunning: 1, MaxCreations: 1},
+		Window:  time.Minute,
+	}, repo)
+	now := time.Date(2025, 8, 25, 12, 0, 0, 0, time.UTC)
+	limiter.now = func() time.Time { return now }
+
+	admin := auth.Principal{ID: "key:ops", Scopes: []string{auth.ScopeAdmin}}
+	ops := auth.WithPrincipal(context.Background(), admin)
+	ci := auth.WithPrincipal(context.Background(), auth.Principal{ID: "key:ci", Scopes: []string{auth.ScopeWrite}})
+
+	reservation, err := limiter.Reserve(ops, 1)
+	require.NoError(t, err)
+	reservation.Done(1)
+
+	// other clients of the tenant have their own limits
+	reservation, err = limiter.Reserve(ci, 1)
+	require.NoError(t, err)
+	reservation.Done(1)
+
+	// switching tenants doesn't reset the limits of a client
+	var exceeded *model.QuotaExceededError
+	_, err = limiter.Reserve(tenant.With(ops, "acme"), 1)
+	require.ErrorAs(t, err, &exceeded)
+	assert.Equal(t, model.QuotaRunning, exceeded.Limit)
+	assert.Equal(t, 1, limiter.Usage(tenant.With(ops, "acme")).Running)
+
+	// counts of idle clients are removed
+	limiter.Finish(ops)
+	limiter.Finish(ci)
+	now = now.Add(time.Minute)
+	reservation, err = limiter.Reserve(ops, 1)
+	require.NoError(t, err)
+	reservation.Done(0)
+	assert.Empty(t, limiter.running)
+	assert.Empty(t, limiter.pending)
+	assert.Len(t, limiter.creations, 1)
+}
+
+func TestLimiter_Nil(t *testing.T) {
+	t.Parallel()
+
+	var limiter *Limiter
+	reservation, err := limiter.Reserve(context.Background(), 3)
+	require.NoError(t, err)
+	assert.Equal(t, 3, reservation.N)
+	reservation.Done(3)
+	limiter.Finish(context.Background())
+	assert.Equal(t, tenant.Default, limiter.Usage(context.Background()).Tenant)
+}
@@ -0,0 +1,385 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mock
+
+//go:generate minimock -i test-server/internal/domain/task/quota.TasksRepository -o tasks_repository_mock.go -n TasksRepositoryMock -p mock
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// TasksRepositoryMock implements mm_quota.TasksRepository
+type TasksRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCountActiveTasks          func(ctx context.Context) (i1 int)
+	funcCountActiveTasksOrigin    string
+	inspectFuncCountActiveTasks   func(ctx context.Context)
+	afterCountActiveTasksCounter  uint64
+	beforeCountActiveTasksCounter uint64
+	CountActiveTasksMock          mTasksRepositoryMockCountActiveTasks
+}
+
+// NewTasksRepositoryMock returns a mock for mm_quota.TasksRepository
+func NewTasksRepositoryMock(t minimock.Tester) *TasksRepositoryMock {
+	m := &TasksRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CountActiveTasksMock = mTasksRepositoryMockCountActiveTasks{mock: m}
+	m.CountActiveTasksMock.callArgs = []*TasksRepositoryMockCountActiveTasksParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mTasksRepositoryMockCountActiveTasks struct {
+	optional           bool
+	mock               *TasksRepositoryMock
+	defaultExpectation *TasksRepositoryMockCountActiveTasksExpectation
+	expectations       []*TasksRepositoryMockCountActiveTasksExpectation
+
+	callArgs []*TasksRepositoryMockCountActiveTasksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TasksRepositoryMockCountActiveTasksExpectation specifies expectation struct of the TasksRepository.CountActiveTasks
+type TasksRepositoryMockCountActiveTasksExpectation struct {
+	mock               *TasksRepositoryMock
+	params             *TasksRepositoryMockCountActiveTasksParams
+	paramPtrs          *TasksRepositoryMockCountActiveTasksParamPtrs
+	expectationOrigins TasksRepositoryMockCountActiveTasksExpectationOrigins
+	results            *TasksRepositoryMockCountActiveTasksResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TasksRepositoryMockCountActiveTasksParams contains parameters of the TasksRepository.CountActiveTasks
+type TasksRepositoryMockCountActiveTasksParams struct {
+	ctx context.Context
+}
+
+// TasksRepositoryMockCountActiveTasksParamPtrs contains pointers to parameters of the TasksRepository.CountActiveTasks
+type TasksRepositoryMockCountActiveTasksParamPtrs struct {
+	ctx *context.Context
+}
+
+// TasksRepositoryMockCountActiveTasksResults contains results of the TasksRepository.CountActiveTasks
+type TasksRepositoryMockCountActiveTasksResults struct {
+	i1 int
+}
+
+// TasksRepositoryMockCountActiveTasksOrigins contains origins of expectations of the TasksRepository.CountActiveTasks
+type TasksRepositoryMockCountActiveTasksExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCountActiveTasks *mTasksRepositoryMockCountActiveTasks) Optional() *mTasksRepositoryMockCountActiveTasks {
+	mmCountActiveTasks.optional = true
+	return mmCountActiveTasks
+}
+
+// Expect sets up expected params for TasksRepository.CountActiveTasks
+func (mmCountActiveTasks *mTasksRepositoryMockCountActiveTasks) Expect(ctx context.Context) *mTasksRepositoryMockCountActiveTasks {
+	if mmCountActiveTasks.mock.funcCountActiveTasks != nil {
+		mmCountActiveTasks.mock.t.Fatalf("TasksRepositoryMock.CountActiveTasks mock is already set by Set")
+	}
+
+	if mmCountActiveTasks.defaultExpectation == nil {
+		mmCountActiveTasks.defaultExpectation = &TasksRepositoryMockCountActiveTasksExpectation{}
+	}
+
+	if mmCountActiveTasks.defaultExpectation.paramPtrs != nil {
+		mmCountActiveTasks.mock.t.Fatalf("TasksRepositoryMock.CountActiveTasks mock is already set by ExpectParams functions")
+	}
+
+	mmCountActiveTasks.defaultExpectation.params = &TasksRepositoryMockCountActiveTasksParams{ctx}
+	mmCountActiveTasks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCountActiveTasks.expectations {
+		if minimock.Equal(e.params, mmCountActiveTasks.defaultExpectation.params) {
+			mmCountActiveTasks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountActiveTasks.defaultExpectation.params)
+		}
+	}
+
+	return mmCountActiveTasks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TasksRepository.CountActiveTasks
+func (mmCountActiveTasks *mTasksRepositoryMockCountActiveTasks) ExpectCtxParam1(ctx context.Context) *mTasksRepositoryMockCountActiveTasks {
+	if mmCountActiveTasks.mock.funcCountActiveTasks != nil {
+		mmCountActiveTasks.mock.t.Fatalf("TasksRepositoryMock.CountActiveTasks mock is already set by Set")
+	}
+
+	if mmCountActiveTasks.defaultExpectation == nil {
+		mmCountActiveTasks.defaultExpectation = &TasksRepositoryMockCountActiveTasksExpectation{}
+	}
+
+	if mmCountActiveTasks.defaultExpectation.params != nil {
+		mmCountActiveTasks.mock.t.Fatalf("TasksRepositoryMock.CountActiveTasks mock is already set by Expect")
+	}
+
+	if mmCountActiveTasks.defaultExpectation.paramPtrs == nil {
+		mmCountActiveTasks.defaultExpectation.paramPtrs = &TasksRepositoryMockCountActiveTasksParamPtrs{}
+	}
+	mmCountActiveTasks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCountActiveTasks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCountActiveTasks
+}
+
+// Inspect accepts an inspector function that has same arguments as the TasksRepository.CountActiveTasks
+func (mmCountActiveTasks *mTasksRepositoryMockCountActiveTasks) Inspect(f func(ctx context.Context)) *mTasksRepositoryMockCountActiveTasks {
+	if mmCountActiveTasks.mock.inspectFuncCountActiveTasks != nil {
+		mmCountActiveTasks.mock.t.Fatalf("Inspect function is already set for TasksRepositoryMock.CountActiveTasks")
+	}
+
+	mmCountActiveTasks.mock.inspectFuncCountActiveTasks = f
+
+	return mmCountActiveTasks
+}
+
+// Return sets up results that will be returned by TasksRepository.CountActiveTasks
+func (mmCountActiveTasks *mTasksRepositoryMockCountActiveTasks) Return(i1 int) *TasksRepositoryMock {
+	if mmCountActiveTasks.mock.funcCountActiveTasks != nil {
+		mmCountActiveTasks.mock.t.Fatalf("TasksRepositoryMock.CountActiveTasks mock is already set by Set")
+	}
+
+	if mmCountActiveTasks.defaultExpectation == nil {
+		mmCountActiveTasks.defaultExpectation = &TasksRepositoryMockCountActiveTasksExpectation{mock: mmCountActiveTasks.mock}
+	}
+	mmCountActiveTasks.defaultExpectation.results = &TasksRepositoryMockCountActiveTasksResults{i1}
+	mmCountActiveTasks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCountActiveTasks.mock
+}
+
+// Set uses given function f to mock the TasksRepository.CountActiveTasks method
+func (mmCountActiveTasks *mTasksRepositoryMockCountActiveTasks) Set(f func(ctx context.Context) (i1 int)) *TasksRepositoryMock {
+	if mmCountActiveTasks.defaultExpectation != nil {
+		mmCountActiveTasks.mock.t.Fatalf("Default expectation is already set for the TasksRepository.CountActiveTasks method")
+	}
+
+	if len(mmCountActiveTasks.expectations) > 0 {
+		mmCountActiveTasks.mock.t.Fatalf("Some expectations are already set for the TasksRepository.CountActiveTasks method")
+	}
+
+	mmCountActiveTasks.mock.funcCountActiveTasks = f
+	mmCountActiveTasks.mock.funcCountActiveTasksOrigin = minimock.CallerInfo(1)
+	return mmCountActiveTasks.mock
+}
+
+// When sets expectation for the TasksRepository.CountActiveTasks which will trigger the result defined by the following
+// Then helper
+func (mmCountActiveTasks *mTasksRepositoryMockCountActiveTasks) When(ctx context.Context) *TasksRepositoryMockCountActiveTasksExpectation {
+	if mmCountActiveTasks.mock.funcCountActiveTasks != nil {
+		mmCountActiveTasks.mock.t.Fatalf("TasksRepositoryMock.CountActiveTasks mock is already set by Set")
+	}
+
+	expectation := &TasksRepositoryMockCountActiveTasksExpectation{
+		mock:               mmCountActiveTasks.mock,
+		params:             &TasksRepositoryMockCountActiveTasksParams{ctx},
+		expectationOrigins: TasksRepositoryMockCountActiveTasksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCountActiveTasks.expectations = append(mmCountActiveTasks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TasksRepository.CountActiveTasks return parameters for the expectation previously defined by the When method
+func (e *TasksRepositoryMockCountActiveTasksExpectation) Then(i1 int) *TasksRepositoryMock {
+	e.results = &TasksRepositoryMockCountActiveTasksResults{i1}
+	return e.mock
+}
+
+// Times sets number of times TasksRepository.CountActiveTasks should be invoked
+func (mmCountActiveTasks *mTasksRepositoryMockCountActiveTasks) Times(n uint64) *mTasksRepositoryMockCountActiveTasks {
+	if n == 0 {
+		mmCountActiveTasks.mock.t.Fatalf("Times of TasksRepositoryMock.CountActiveTasks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountActiveTasks.expectedInvocations, n)
+	mmCountActiveTasks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCountActiveTasks
+}
+
+func (mmCountActiveTasks *mTasksRepositoryMockCountActiveTasks) invocationsDone() bool {
+	if len(mmCountActiveTasks.expectations) == 0 && mmCountActiveTasks.defaultExpectation == nil && mmCountActiveTasks.mock.funcCountActiveTasks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountActiveTasks.mock.afterCountActiveTasksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountActiveTasks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountActiveTasks implements mm_quota.TasksRepository
+func (mmCountActiveTasks *TasksRepositoryMock) CountActiveTasks(ctx context.Context) (i1 int) {
+	mm_atomic.AddUint64(&mmCountActiveTasks.beforeCountActiveTasksCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountActiveTasks.afterCountActiveTasksCounter, 1)
+
+	mmCountActiveTasks.t.Helper()
+
+	if mmCountActiveTasks.inspectFuncCountActiveTasks != nil {
+		mmCountActiveTasks.inspectFuncCountActiveTasks(ctx)
+	}
+
+	mm_params := TasksRepositoryMockCountActiveTasksParams{ctx}
+
+	// Record call args
+	mmCountActiveTasks.CountActiveTasksMock.mutex.Lock()
+	mmCountActiveTasks.CountActiveTasksMock.callArgs = append(mmCountActiveTasks.CountActiveTasksMock.callArgs, &mm_params)
+	mmCountActiveTasks.CountActiveTasksMock.mutex.Unlock()
+
+	for _, e := range mmCountActiveTasks.CountActiveTasksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1
+		}
+	}
+
+	if mmCountActiveTasks.CountActiveTasksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountActiveTasks.CountActiveTasksMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountActiveTasks.CountActiveTasksMock.defaultExpectation.params
+		mm_want_ptrs := mmCountActiveTasks.CountActiveTasksMock.defaultExpectation.paramPtrs
+
+		mm_got := TasksRepositoryMockCountActiveTasksParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountActiveTasks.t.Errorf("TasksRepositoryMock.CountActiveTasks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountActiveTasks.CountActiveTasksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountActiveTasks.t.Errorf("TasksRepositoryMock.CountActiveTasks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCountActiveTasks.CountActiveTasksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountActiveTasks.CountActiveTasksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountActiveTasks.t.Fatal("No results are set for the TasksRepositoryMock.CountActiveTasks")
+		}
+		return (*mm_results).i1
+	}
+	if mmCountActiveTasks.funcCountActiveTasks != nil {
+		return mmCountActiveTasks.funcCountActiveTasks(ctx)
+	}
+	mmCountActiveTasks.t.Fatalf("Unexpected call to TasksRepositoryMock.CountActiveTasks. %v", ctx)
+	return
+}
+
+// CountActiveTasksAfterCounter returns a count of finished TasksRepositoryMock.CountActiveTasks invocations
+func (mmCountActiveTasks *TasksRepositoryMock) CountActiveTasksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountActiveTasks.afterCountActiveTasksCounter)
+}
+
+// CountActiveTasksBeforeCounter returns a count of TasksRepositoryMock.CountActiveTasks invocations
+func (mmCountActiveTasks *TasksRepositoryMock) CountActiveTasksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountActiveTasks.beforeCountActiveTasksCounter)
+}
+
+// Calls returns a list of arguments used in each call to TasksRepositoryMock.CountActiveTasks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountActiveTasks *mTasksRepositoryMockCountActiveTasks) Calls() []*TasksRepositoryMockCountActiveTasksParams {
+	mmCountActiveTasks.mutex.RLock()
+
+	argCopy := make([]*TasksRepositoryMockCountActiveTasksParams, len(mmCountActiveTasks.callArgs))
+	copy(argCopy, mmCountActiveTasks.callArgs)
+
+	mmCountActiveTasks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountActiveTasksDone returns true if the count of the CountActiveTasks invocations corresponds
+// the number of defined expectations
+func (m *TasksRepositoryMock) MinimockCountActiveTasksDone() bool {
+	if m.CountActiveTasksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountActiveTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountActiveTasksMock.invocationsDone()
+}
+
+// MinimockCountActiveTasksInspect logs each unmet expectation
+func (m *TasksRepositoryMock) MinimockCountActiveTasksInspect() {
+	for _, e := range m.CountActiveTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TasksRepositoryMock.CountActiveTasks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountActiveTasksCounter := mm_atomic.LoadUint64(&m.afterCountActiveTasksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountActiveTasksMock.defaultExpectation != nil && afterCountActiveTasksCounter < 1 {
+		if m.CountActiveTasksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksRepositoryMock.CountActiveTasks at\n%s", m.CountActiveTasksMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TasksRepositoryMock.CountActiveTasks at\n%s with params: %#v", m.CountActiveTasksMock.defaultExpectation.expectationOrigins.origin, *m.CountActiveTasksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountActiveTasks != nil && afterCountActiveTasksCounter < 1 {
+		m.t.Errorf("Expected call to TasksRepositoryMock.CountActiveTasks at\n%s", m.funcCountActiveTasksOrigin)
+	}
+
+	if !m.CountActiveTasksMock.invocationsDone() && afterCountActiveTasksCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksRepositoryMock.CountActiveTasks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountActiveTasksMock.expectedInvocations), m.CountActiveTasksMock.expectedInvocationsOrigin, afterCountActiveTasksCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *TasksRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCountActiveTasksInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *TasksRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *TasksRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCountActiveTasksDone()
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"test-server/internal/domain/model"
+)
+
+// labelIndex maps label key to label value to the set of task ids having it.
+// It isn't safe for concurrent use and is guarded by TasksRepository.mu.
+type labelIndex map[string]map[string]map[string]struct{}
+
+func (idx labelIndex) add(id string, labels map[string]string) {
+	for key, value := range labels {
+		values, ok := idx[key]
+		if !ok {
+			values = make(map[string]map[string]struct{})
+			idx[key] = values
+		}
+		ids, ok := values[value]
+		if !ok {
+			ids = make(map[string]struct{})
+			values[value] = ids
+		}
+		ids[id] = struct{}{}
+	}
+}
+
+func (idx labelIndex) remove(id string, labels map[string]string) {
+	for key, value := range labels {
+		ids := idx[key][value]
+		delete(ids, id)
+		if len(ids) == 0 {
+			delete(idx[key], value)
+		}
+		if len(idx[key]) == 0 {
+			delete(idx, key)
+		}
+	}
+}
+
+// candidates returns ids of tasks that may match the requirement, or false
+// when the requirement can't narrow the search (negative operators).
+// The returned set may be shared with the index and must not be modified.
+func (idx labelIndex) candidates(r model.Requirement) (map[string]struct{}, bool) {
+	switch r.Operator {
+	case model.OpEquals, model.OpIn:
+		if len(r.Values) == 1 {
+			return idx[r.Key][r.Values[0]], true
+		}
+		result := make(map[string]struct{})
+		for _, value := range r.Values {
+			for id := range idx[r.Key][value] {
+				result[id] = struct{}{}
+			}
+		}
+		return result, true
+	case model.OpExists:
+		result := make(map[string]struct{})
+		for _, ids := range idx[r.Key] {
+			for id := range ids {
+				result[id] = struct{}{}
+			}
+		}
+		return result, true
+	}
+	return nil, false
+}
+
+// lookup returns the smallest candidate id set among positive requirements
+// of the selector, or false when a full scan is required.
+func (idx labelIndex) lookup(selector model.LabelSelector) (map[string]struct{}, bool) {
+	var best map[string]struct{}
+	found := false
+	for _, r := range selector {
+		ids, ok := idx.candidates(r)
+		if !ok {
+			continue
+		}
+		if !found || len(ids) < len(best) {
+			best, found = ids, true
+		}
+	}
+	return best, found
+}
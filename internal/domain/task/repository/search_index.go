@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// searchIndex is an inverted index over task titles. It isn't safe for
+// concurrent use and is guarded by TasksRepository.mu.
+type searchIndex struct {
+	// postings maps a term to the ids of tasks containing it and the term frequency.
+	postings map[string]map[string]int
+	// terms holds all indexed terms sorted to look up terms by prefix.
+	terms []string
+}
+
+func newSearchIndex() *searchIndex {
+	return &searchIndex{
+		postings: make(map[string]map[string]int),
+	}
+}
+
+// tokenize splits text into lowercase terms on any non-alphanumeric character.
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func (idx *searchIndex) add(id, title string) {
+	for _, term := range tokenize(title) {
+		ids, ok := idx.postings[term]
+		if !ok {
+			ids = make(map[string]int)
+			idx.postings[term] = ids
+
+			pos, _ := slices.BinarySearch(idx.terms, term)
+			idx.terms = slices.Insert(idx.terms, pos, term)
+		}
+		ids[id]++
+	}
+}
+
+func (idx *searchIndex) remove(id, title string) {
+	for _, term := range tokenize(title) {
+		ids, ok := idx.postings[term]
+		if !ok {
+			continue
+		}
+
+		delete(ids, id)
+		if len(ids) == 0 {
+			delete(idx.postings, term)
+			if pos, found := slices.BinarySearch(idx.terms, term); found {
+				idx.terms = slices.Delete(idx.terms, pos, pos+1)
+			}
+		}
+	}
+}
+
+// search returns relevance scores of tasks matching every term of the query.
+// The last query term also matches indexed terms it is a prefix of, so
+// results can be shown while the user is still typing. Exact term matches
+// score higher than prefix ones, and rare terms score higher than common ones.
+func (idx *searchIndex) search(query string, total int) map[string]float64 {
+	queryTerms := tokenize(query)
+	if len(queryTerms) == 0 {
+		return nil
+	}
+
+	var scores map[string]float64
+	for i, queryTerm := range queryTerms {
+		termScores := make(map[string]float64)
+		idx.scoreTerm(termScores, queryTerm, total, 2)
+		if i == len(queryTerms)-1 {
+			start, _ := slices.BinarySearch(idx.terms, queryTerm)
+			for _, term := range idx.terms[start:] {
+				if !strings.HasPrefix(term, queryTerm) {
+					break
+				}
+				if term != queryTerm {
+					idx.scoreTerm(termScores, term, total, 1)
+				}
+			}
+		}
+
+		if scores == nil {
+			scores = termScores
+			continue
+		}
+		for id, score := range scores {
+			termScore, ok := termScores[id]
+			if !ok {
+				delete(scores, id)
+				continue
+			}
+			scores[id] = score + termScore
+		}
+	}
+
+	return scores
+}
+
+// scoreTerm adds tf-idf scores of the indexed term to scores, multiplied by weight.
+func (idx *searchIndex) scoreTerm(scores map[string]float64, term string, total int, weight float64) {
+	ids := idx.postings[term]
+	if len(ids) == 0 {
+		return
+	}
+
+	idf := math.Log(1 + float64(total)/float64(len(ids)))
+	for id, tf := range ids {
+		scores[id] += weight * float64(tf) * idf
+	}
+}
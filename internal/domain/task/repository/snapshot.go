@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"test-server/internal/domain/model"
+	"test-server/internal/tenant"
+)
+
+// SaveSnapshot writes all tasks, including soft deleted ones, to the file at
+// path as a JSON array. The file is replaced atomically, so a crash while
+// saving leaves the previous snapshot intact.
+func (repo *TasksRepository) SaveSnapshot(ctx context.Context, path string) error {
+	defer observe(ctx, "SaveSnapshot")()
+
+	repo.mu.RLock()
+	tasks := make([]model.Task, 0, len(repo.storage))
+	for _, task := range repo.storage {
+		tasks = append(tasks, task)
+	}
+	repo.mu.RUnlock()
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("repository.SaveSnapshot: failed to create file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := json.NewEncoder(tmp).Encode(tasks); err != nil {
+		tmp.Close()
+		return fmt.Errorf("repository.SaveSnapshot: failed to write tasks: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("repository.SaveSnapshot: failed to sync file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("repository.SaveSnapshot: failed to close file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("repository.SaveSnapshot: failed to replace snapshot: %w", err)
+	}
+	return nil
+}
+
+// LoadSnapshot stores tasks from the snapshot saved by SaveSnapshot and
+// returns their number. A missing snapshot file isn't an error.
+func (repo *TasksRepository) LoadSnapshot(ctx context.Context, path string) (int, error) {
+	defer observe(ctx, "LoadSnapshot")()
+
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("repository.LoadSnapshot: failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	var tasks []model.Task
+	if err := json.NewDecoder(f).Decode(&tasks); err != nil {
+		return 0, fmt.Errorf("repository.LoadSnapshot: failed to read tasks: %w", err)
+	}
+
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	for _, task := range tasks {
+		if task.Tenant == "" {
+			// saved before tenants were introduced
+			task.Tenant = tenant.Default
+		}
+		repo.put(task)
+	}
+	return len(tasks), nil
+}
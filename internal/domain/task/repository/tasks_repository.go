@@ -1,73 +1,624 @@
 package repository
 
 import (
+	"cmp"
 	"context"
+	"maps"
+	"slices"
 	"sync"
 	"test-server/internal/domain/model"
+	"test-server/internal/metrics"
+	"test-server/internal/tenant"
+	"test-server/internal/tracing"
+	"time"
 )
 
+// TasksRepository stores tasks of all tenants. Tasks are keyed by tenant
+// and id, and operations addressing tasks by id only see the tasks of the
+// tenant carried by ctx, see tenant.From. Background operations, such as
+// retention, work across tenants.
 type TasksRepository struct {
-	storage map[string]model.Task
+	storage map[string]model.Task // by storage key
+	labels  labelIndex
+	search  *searchIndex
+	counts  map[string]map[model.Status]int // by tenant and status
+	active  map[string]int                  // not deleted tasks by tenant
 	mu      sync.RWMutex
+	// onRemove is called with every removed task, see SetRemoveHook.
+	onRemove func(model.Task)
 }
 
 func NewTasksRepository() *TasksRepository {
 	return &TasksRepository{
 		storage: make(map[string]model.Task),
+		labels:  make(labelIndex),
+		search:  newSearchIndex(),
+		counts:  make(map[string]map[model.Status]int),
+		active:  make(map[string]int),
 		mu:      sync.RWMutex{},
 	}
 }
 
-func (repo *TasksRepository) CreateTask(ctx context.Context, task model.Task) error {
-	id := task.ID.String()
+// SetRemoveHook makes the repository call hook with every task it removes,
+// whether purged, expired or evicted, e.g. to remove data kept along with
+// the task. The hook is called after the repository lock is released, once
+// the removal is done. It must be set before the repository is used.
+func (repo *TasksRepository) SetRemoveHook(hook func(model.Task)) {
+	repo.onRemove = hook
+}
+
+// storageKey scopes the task id by tenant, so lookups never cross tenants.
+// Tenant ids can't contain the separator.
+func storageKey(tenantID, id string) string {
+	return tenantID + "/" + id
+}
+
+func taskKey(task model.Task) string {
+	return storageKey(task.Tenant, task.ID.String())
+}
+
+// key returns the storage key of the task id in the tenant of ctx.
+func key(ctx context.Context, id string) string {
+	return storageKey(tenant.From(ctx), id)
+}
+
+// observe starts a span of the repository operation and returns a function
+// ending it and recording the operation duration, including lock waiting.
+func observe(ctx context.Context, operation string) func() {
+	start := time.Now()
+	_, span := tracing.Start(ctx, "TasksRepository."+operation)
+	return func() {
+		span.End()
+		metrics.ObserveRepositoryOperation(operation, start)
+	}
+}
+
+// put stores the task and updates secondary indexes. Caller must hold repo.mu.
+func (repo *TasksRepository) put(task model.Task) {
+	key := taskKey(task)
+	if old, exists := repo.storage[key]; exists {
+		repo.labels.remove(key, old.Labels)
+		repo.search.remove(key, old.Title)
+		repo.count(old, -1)
+	}
+
+	repo.storage[key] = task
+	repo.labels.add(key, task.Labels)
+	repo.search.add(key, task.Title)
+	repo.count(task, 1)
+}
+
+// remove deletes the task with the storage key and its secondary index
+// entries. Caller must hold repo.mu and pass the removed tasks to
+// repo.removed once it released it.
+func (repo *TasksRepository) remove(key string) {
+	task, exists := repo.storage[key]
+	if !exists {
+		return
+	}
+
+	repo.labels.remove(key, task.Labels)
+	repo.search.remove(key, task.Title)
+	repo.count(task, -1)
+	delete(repo.storage, key)
+}
+
+// removed calls the remove hook with the removed tasks. Caller must not
+// hold repo.mu, so the hook doesn't block the repository.
+func (repo *TasksRepository) removed(tasks []model.Task) {
+	if repo.onRemove == nil {
+		return
+	}
+	for _, task := range tasks {
+		repo.onRemove(task)
+	}
+}
+
+// count adds delta to the number of tasks of the task tenant and status.
+// Caller must hold repo.mu.
+func (repo *TasksRepository) count(task model.Task, delta int) {
+	statuses, ok := repo.counts[task.Tenant]
+	if !ok {
+		statuses = make(map[model.Status]int)
+		repo.counts[task.Tenant] = statuses
+	}
+	statuses[task.Status] += delta
+	// tenants and statuses without tasks are pruned, so they don't pile up
+	if statuses[task.Status] == 0 {
+		delete(statuses, task.Status)
+		if len(statuses) == 0 {
+			delete(repo.counts, task.Tenant)
+		}
+	}
+	if !task.IsDeleted() {
+		repo.active[task.Tenant] += delta
+		if repo.active[task.Tenant] == 0 {
+			delete(repo.active, task.Tenant)
+		}
+	}
+}
+
+// CountActiveTasks returns the number of not deleted tasks of the tenant of ctx.
+func (repo *TasksRepository) CountActiveTasks(ctx context.Context) int {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	return repo.active[tenant.From(ctx)]
+}
+
+// CountTasks returns the number of stored tasks, including soft deleted
+// ones, by tenant and status.
+func (repo *TasksRepository) CountTasks() map[string]map[model.Status]int {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	counts := make(map[string]map[model.Status]int, len(repo.counts))
+	for tenantID, statuses := range repo.counts {
+		counts[tenantID] = maps.Clone(statuses)
+	}
+	return counts
+}
+
+// CreateTask stores a new task in the tenant of ctx and returns the stored
+// copy. Stored task version starts at 1 and is incremented by every
+// subsequent mutation.
+func (repo *TasksRepository) CreateTask(ctx context.Context, task model.Task) (model.TaskChange, error) {
+	defer observe(ctx, "CreateTask")()
+
+	task.Tenant = tenant.From(ctx)
 
 	repo.mu.Lock()
 	defer repo.mu.Unlock()
 
-	_, exists := repo.storage[id]
+	_, exists := repo.storage[taskKey(task)]
 	if exists {
-		return model.ErrTaskAlreadyExists
+		return model.TaskChange{}, model.ErrTaskAlreadyExists
 	}
 
-	repo.storage[id] = task
-	return nil
+	task.Version = 1
+	task.Labels = maps.Clone(task.Labels)
+	task.Metadata = model.CloneMetadata(task.Metadata)
+	repo.put(task)
+	return model.TaskChange{After: &task}, nil
+}
+
+// CreateTasks stores tasks under a single lock. The returned slices hold
+// the stored copy and an error (or nil) for every task in the input order.
+func (repo *TasksRepository) CreateTasks(ctx context.Context, tasks []model.Task) ([]model.TaskChange, []error) {
+	defer observe(ctx, "CreateTasks")()
+
+	changes := make([]model.TaskChange, len(tasks))
+	errs := make([]error, len(tasks))
+
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	tenantID := tenant.From(ctx)
+	for i, task := range tasks {
+		task.Tenant = tenantID
+		if _, exists := repo.storage[taskKey(task)]; exists {
+			errs[i] = model.ErrTaskAlreadyExists
+			continue
+		}
+
+		task.Version = 1
+		task.Labels = maps.Clone(task.Labels)
+		task.Metadata = model.CloneMetadata(task.Metadata)
+		repo.put(task)
+		changes[i].After = &task
+	}
+
+	return changes, errs
 }
 
 func (repo *TasksRepository) GetTask(ctx context.Context, id string) (*model.Task, error) {
+	defer observe(ctx, "GetTask")()
+
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	task, exists := repo.storage[key(ctx, id)]
+	if !exists || task.IsDeleted() {
+		return nil, model.ErrTaskNotFound
+	}
+
+	return &task, nil
+}
+
+// GetDeletedTask returns the soft deleted task. Not deleted tasks result in
+// model.ErrTaskNotDeleted.
+func (repo *TasksRepository) GetDeletedTask(ctx context.Context, id string) (*model.Task, error) {
+	defer observe(ctx, "GetDeletedTask")()
+
 	repo.mu.RLock()
 	defer repo.mu.RUnlock()
 
-	task, exists := repo.storage[id]
+	task, exists := repo.storage[key(ctx, id)]
 	if !exists {
 		return nil, model.ErrTaskNotFound
 	}
+	if !task.IsDeleted() {
+		return nil, model.ErrTaskNotDeleted
+	}
 
 	return &task, nil
 }
 
-func (repo *TasksRepository) UpdateTask(ctx context.Context, id string, status model.Status) error {
+// ListTasks returns not deleted tasks of the tenant matching the selector ordered by creation time.
+// Positive requirements are resolved through the label index, so only
+// selectors made of negative requirements fall back to a full scan.
+func (repo *TasksRepository) ListTasks(ctx context.Context, selector model.LabelSelector) ([]model.Task, error) {
+	defer observe(ctx, "ListTasks")()
+
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	tenantID := tenant.From(ctx)
+	matches := func(task model.Task) bool {
+		return task.Tenant == tenantID && !task.IsDeleted() && selector.Matches(task.Labels)
+	}
+
+	var tasks []model.Task
+	if keys, ok := repo.labels.lookup(selector); ok {
+		tasks = make([]model.Task, 0, len(keys))
+		for key := range keys {
+			if task := repo.storage[key]; matches(task) {
+				tasks = append(tasks, task)
+			}
+		}
+	} else {
+		for _, task := range repo.storage {
+			if matches(task) {
+				tasks = append(tasks, task)
+			}
+		}
+	}
+
+	slices.SortFunc(tasks, func(a, b model.Task) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return tasks, nil
+}
+
+// SearchTasks returns up to limit not deleted tasks of the tenant whose titles match
+// the full-text query, most relevant first.
+func (repo *TasksRepository) SearchTasks(ctx context.Context, query string, limit int) ([]model.Task, error) {
+	defer observe(ctx, "SearchTasks")()
+
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	scores := repo.search.search(query, len(repo.storage))
+
+	tenantID := tenant.From(ctx)
+	tasks := make([]model.Task, 0, len(scores))
+	for key := range scores {
+		if task := repo.storage[key]; task.Tenant == tenantID && !task.IsDeleted() {
+			tasks = append(tasks, task)
+		}
+	}
+
+	slices.SortFunc(tasks, func(a, b model.Task) int {
+		scoreA, scoreB := scores[taskKey(a)], scores[taskKey(b)]
+		if scoreA != scoreB {
+			return cmp.Compare(scoreB, scoreA)
+		}
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+
+	if len(tasks) > limit {
+		tasks = tasks[:limit]
+	}
+	return tasks, nil
+}
+
+// UpdateTask applies a lifecycle update to the task, see model.TaskUpdate,
+// and returns the stored copies before and after it.
+func (repo *TasksRepository) UpdateTask(ctx context.Context, id string, update model.TaskUpdate) (model.TaskChange, error) {
+	defer observe(ctx, "UpdateTask")()
+
 	repo.mu.Lock()
 	defer repo.mu.Unlock()
 
-	task, exists := repo.storage[id]
+	stored, exists := repo.storage[key(ctx, id)]
 	if !exists {
-		return model.ErrTaskNotFound
+		return model.TaskChange{}, model.ErrTaskNotFound
 	}
 
-	task.Status = status
-	repo.storage[id] = task
-	return nil
+	task := stored
+
+	task.Status = update.Status
+	task.Duration = update.Duration
+	task.ExpiresAt = update.ExpiresAt
+	if update.Event.Type != "" {
+		// copies handed out share the backing array of the events
+		task.Events = append(slices.Clip(task.Events), update.Event)
+		at := update.Event.Time
+		switch update.Event.Type {
+		case model.EventStarted:
+			task.StartedAt = &at
+		case model.EventFinished:
+			task.FinishedAt = &at
+		}
+	}
+	task.Version++
+	repo.put(task)
+	return model.TaskChange{Before: &stored, After: &task}, nil
 }
 
-func (repo *TasksRepository) DeleteTask(ctx context.Context, id string) error {
+// PatchTask applies apply to a copy of the not deleted task, stores the
+// result with incremented version and returns the stored copies before and
+// after it. The task is read and written under a single lock, so it can't
+// change in between. An error of apply is returned as is and leaves the task
+// untouched. apply must not use the repository.
+func (repo *TasksRepository) PatchTask(ctx context.Context, id string, apply func(task *model.Task) error) (model.TaskChange, error) {
+	defer observe(ctx, "PatchTask")()
+
 	repo.mu.Lock()
 	defer repo.mu.Unlock()
 
-	_, exists := repo.storage[id]
-	if !exists {
-		return model.ErrTaskNotFound
+	stored, exists := repo.storage[key(ctx, id)]
+	if !exists || stored.IsDeleted() {
+		return model.TaskChange{}, model.ErrTaskNotFound
+	}
+
+	// apply must not modify the stored task through shared maps and slices
+	task := stored
+	task.Labels = maps.Clone(stored.Labels)
+	task.Metadata = model.CloneMetadata(stored.Metadata)
+	task.Events = slices.Clip(stored.Events)
+	if err := apply(&task); err != nil {
+		return model.TaskChange{}, err
+	}
+
+	task.ID, task.Tenant = stored.ID, stored.Tenant
+	task.Version = stored.Version + 1
+	task.Labels = maps.Clone(task.Labels)
+	task.Metadata = model.CloneMetadata(task.Metadata)
+	repo.put(task)
+	return model.TaskChange{Before: &stored, After: &task}, nil
+}
+
+// DeleteTask soft deletes the task: it is kept in storage with DeletedAt set
+// and hidden from reads until restored or purged. When version is not nil
+// the task is deleted only if its stored version matches. When owner isn't
+// empty, tasks created by other principals are reported as not found. It
+// returns the stored copies before and after the deletion.
+func (repo *TasksRepository) DeleteTask(ctx context.Context, id string, version *int64, owner string) (model.TaskChange, error) {
+	defer observe(ctx, "DeleteTask")()
+
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	task, exists := repo.storage[key(ctx, id)]
+	if !exists || task.IsDeleted() || !ownedBy(task, owner) {
+		return model.TaskChange{}, model.ErrTaskNotFound
+	}
+	if version != nil && task.Version != *version {
+		return model.TaskChange{}, model.ErrVersionMismatch
+	}
+
+	return repo.markDeleted(task, time.Now()), nil
+}
+
+// DeleteTasks soft deletes tasks under a single lock. The returned slices
+// hold the stored copies before and after the deletion and an error (or nil)
+// for every id in the input order. When owner isn't empty, tasks created by
+// other principals are reported as not found.
+func (repo *TasksRepository) DeleteTasks(ctx context.Context, ids []string, owner string) ([]model.TaskChange, []error) {
+	defer observe(ctx, "DeleteTasks")()
+
+	changes := make([]model.TaskChange, len(ids))
+	errs := make([]error, len(ids))
+	now := time.Now()
+
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	for i, id := range ids {
+		task, exists := repo.storage[key(ctx, id)]
+		if !exists || task.IsDeleted() || !ownedBy(task, owner) {
+			errs[i] = model.ErrTaskNotFound
+			continue
+		}
+
+		changes[i] = repo.markDeleted(task, now)
+	}
+
+	return changes, errs
+}
+
+// ownedBy reports whether the task was created by owner. Empty owner
+// matches every task.
+func ownedBy(task model.Task, owner string) bool {
+	return owner == "" || task.CreatedBy == owner
+}
+
+// markDeleted stores task as soft deleted at the given moment and returns
+// the stored copies before and after. Caller must hold repo.mu.
+func (repo *TasksRepository) markDeleted(stored model.Task, deletedAt time.Time) model.TaskChange {
+	task := stored
+	task.DeletedAt = &deletedAt
+	task.Version++
+	repo.put(task)
+	return model.TaskChange{Before: &stored, After: &task}
+}
+
+// RestoreTask undoes soft deletion of the task and returns the stored copies
+// before and after it. When owner isn't empty, tasks created by other
+// principals are reported as not found.
+func (repo *TasksRepository) RestoreTask(ctx context.Context, id string, owner string) (model.TaskChange, error) {
+	defer observe(ctx, "RestoreTask")()
+
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	stored, exists := repo.storage[key(ctx, id)]
+	if !exists || !ownedBy(stored, owner) {
+		return model.TaskChange{}, model.ErrTaskNotFound
+	}
+	if !stored.IsDeleted() {
+		return model.TaskChange{}, model.ErrTaskNotDeleted
+	}
+
+	task := stored
+	task.DeletedAt = nil
+	task.Version++
+	repo.put(task)
+	return model.TaskChange{Before: &stored, After: &task}, nil
+}
+
+// PurgeTasks irreversibly removes tasks of the tenant, deleted or not, under a single lock.
+// The returned slices hold the removed copy and an error (or nil) for every id in the input order.
+func (repo *TasksRepository) PurgeTasks(ctx context.Context, ids []string) ([]model.TaskChange, []error) {
+	defer observe(ctx, "PurgeTasks")()
+
+	changes := make([]model.TaskChange, len(ids))
+	errs := make([]error, len(ids))
+	var purged []model.Task
+
+	repo.mu.Lock()
+	for i, id := range ids {
+		key := key(ctx, id)
+		task, exists := repo.storage[key]
+		if !exists {
+			errs[i] = model.ErrTaskNotFound
+			continue
+		}
+
+		repo.remove(key)
+		purged = append(purged, task)
+		changes[i].Before = &task
+	}
+	repo.mu.Unlock()
+
+	repo.removed(purged)
+	return changes, errs
+}
+
+// PurgeDeletedTasks irreversibly removes tasks soft deleted before the given
+// moment and returns them.
+func (repo *TasksRepository) PurgeDeletedTasks(ctx context.Context, before time.Time) ([]model.Task, error) {
+	defer observe(ctx, "PurgeDeletedTasks")()
+
+	var purged []model.Task
+
+	repo.mu.Lock()
+	for key, task := range repo.storage {
+		if task.IsDeleted() && task.DeletedAt.Before(before) {
+			repo.remove(key)
+			purged = append(purged, task)
+		}
+	}
+	repo.mu.Unlock()
+
+	repo.removed(purged)
+	return purged, nil
+}
+
+// DeleteExpiredTasks removes tasks which expired by now and returns them.
+// Candidates are collected under the read lock, so concurrent readers are
+// blocked only while the expired tasks are actually removed.
+func (repo *TasksRepository) DeleteExpiredTasks(ctx context.Context, now time.Time) ([]model.Task, error) {
+	defer observe(ctx, "DeleteExpiredTasks")()
+
+	var expired []string
+
+	repo.mu.RLock()
+	for key, task := range repo.storage {
+		if task.ExpiresAt != nil && !task.ExpiresAt.After(now) {
+			expired = append(expired, key)
+		}
+	}
+	repo.mu.RUnlock()
+
+	if len(expired) == 0 {
+		return nil, nil
+	}
+
+	deleted := make([]model.Task, 0, len(expired))
+
+	repo.mu.Lock()
+	for _, key := range expired {
+		// task could have been changed or removed between the locks
+		task, exists := repo.storage[key]
+		if !exists || task.ExpiresAt == nil || task.ExpiresAt.After(now) {
+			continue
+		}
+
+		repo.remove(key)
+		deleted = append(deleted, task)
+	}
+	repo.mu.Unlock()
+
+	repo.removed(deleted)
+	return deleted, nil
+}
+
+// UnfinishedTasks returns unfinished tasks of all tenants, including soft
+// deleted ones.
+func (repo *TasksRepository) UnfinishedTasks(ctx context.Context) ([]model.Task, error) {
+	defer observe(ctx, "UnfinishedTasks")()
+
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	var tasks []model.Task
+	for _, task := range repo.storage {
+		if !task.IsFinished() {
+			tasks = append(tasks, task)
+		}
+	}
+
+	return tasks, nil
+}
+
+// FinishedTasksBefore returns not deleted finished tasks created before the given moment.
+func (repo *TasksRepository) FinishedTasksBefore(ctx context.Context, before time.Time) ([]model.Task, error) {
+	defer observe(ctx, "FinishedTasksBefore")()
+
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	var tasks []model.Task
+	for _, task := range repo.storage {
+		if task.IsFinished() && !task.IsDeleted() && task.CreatedAt.Before(before) {
+			tasks = append(tasks, task)
+		}
+	}
+
+	return tasks, nil
+}
+
+// EvictOldestTasks removes the oldest finished tasks until at most maxTasks
+// remain and returns them. Unfinished tasks are never evicted, so storage may
+// still exceed maxTasks when most tasks are pending.
+func (repo *TasksRepository) EvictOldestTasks(ctx context.Context, maxTasks int) ([]model.Task, error) {
+	defer observe(ctx, "EvictOldestTasks")()
+
+	repo.mu.Lock()
+	overflow := len(repo.storage) - maxTasks
+	if overflow <= 0 {
+		repo.mu.Unlock()
+		return nil, nil
+	}
+
+	finished := make([]model.Task, 0, len(repo.storage))
+	for _, task := range repo.storage {
+		if task.IsFinished() {
+			finished = append(finished, task)
+		}
+	}
+	slices.SortFunc(finished, func(a, b model.Task) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	evicted := finished[:min(overflow, len(finished))]
+	for _, task := range evicted {
+		repo.remove(taskKey(task))
 	}
+	repo.mu.Unlock()
 
-	delete(repo.storage, id)
-	return nil
+	repo.removed(evicted)
+	return evicted, nil
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"strconv"
 	"test-server/internal/domain/model"
 	"testing"
 	"time"
@@ -23,11 +24,11 @@ func BenchmarkGetTask(b *testing.B) {
 	ctx := context.Background()
 
 	repo := NewTasksRepository()
-	err := repo.CreateTask(ctx, testTaskInfo)
+	_, err := repo.CreateTask(ctx, testTaskInfo)
 	assert.NoError(b, err)
-	
+
 	taskID := testTaskInfo.ID.String()
-	
+
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
@@ -38,7 +39,7 @@ func BenchmarkGetTask(b *testing.B) {
 }
 
 func BenchmarkGetTask_Parallel(b *testing.B) {
-		str := "2025-08-23T18:56:28.34065+02:00"
+	str := "2025-08-23T18:56:28.34065+02:00"
 	timestamp, _ := time.Parse(time.RFC3339, str)
 	testTaskInfo := model.Task{
 		ID:        uuid.New(),
@@ -50,11 +51,11 @@ func BenchmarkGetTask_Parallel(b *testing.B) {
 	ctx := context.Background()
 
 	repo := NewTasksRepository()
-	err := repo.CreateTask(ctx, testTaskInfo)
+	_, err := repo.CreateTask(ctx, testTaskInfo)
 	assert.NoError(b, err)
-	
+
 	taskID := testTaskInfo.ID.String()
-	
+
 	b.ResetTimer()
 
 	b.RunParallel(func(pb *testing.PB) {
@@ -63,4 +64,58 @@ func BenchmarkGetTask_Parallel(b *testing.B) {
 			assert.NoError(b, err)
 		}
 	})
-}
\ No newline at end of file
+}
+
+func BenchmarkCreateTasks(b *testing.B) {
+	const batchSize = 100
+	ctx := context.Background()
+
+	repo := NewTasksRepository()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		tasks := make([]model.Task, batchSize)
+		for j := range tasks {
+			tasks[j] = model.Task{
+				ID:        uuid.New(),
+				Status:    model.Pending,
+				Title:     "dummy-title",
+				CreatedAt: time.Now(),
+			}
+		}
+
+		_, errs := repo.CreateTasks(ctx, tasks)
+		for _, err := range errs {
+			assert.NoError(b, err)
+		}
+	}
+}
+
+func BenchmarkListTasks_Selector(b *testing.B) {
+	ctx := context.Background()
+	teams := []string{"io", "core", "web", "data"}
+
+	repo := NewTasksRepository()
+	for i := 0; i < 10000; i++ {
+		_, err := repo.CreateTask(ctx, model.Task{
+			ID:        uuid.New(),
+			Status:    model.Pending,
+			Title:     "dummy-title",
+			CreatedAt: time.Now(),
+			Labels:    map[string]string{"team": teams[i%len(teams)], "shard": strconv.Itoa(i % 100)},
+		})
+		assert.NoError(b, err)
+	}
+
+	selector, err := model.ParseSelector("shard=42,team=web")
+	assert.NoError(b, err)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		tasks, err := repo.ListTasks(ctx, selector)
+		assert.NoError(b, err)
+		assert.Len(b, tasks, 100)
+	}
+}
@@ -0,0 +1,690 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"slices"
+	"sync"
+	"test-server/internal/domain/model"
+	"test-server/internal/tenant"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTasksRepository_SearchTasks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Now()
+	titles := []string{
+		"Import customer invoices",
+		"Export invoices to archive",
+		"Rebuild search index",
+		"Import products",
+		"Indexer maintenance",
+	}
+
+	repo := NewTasksRepository()
+	ids := make([]uuid.UUID, len(titles))
+	for i, title := range titles {
+		ids[i] = uuid.New()
+		_, err := repo.CreateTask(ctx, model.Task{
+			ID:        ids[i],
+			Status:    model.Pending,
+			Title:     title,
+			CreatedAt: now.Add(time.Duration(i) * time.Second),
+		})
+		require.NoError(t, err)
+	}
+
+	testTable := []struct {
+		name     string
+		query    string
+		expected []uuid.UUID
+	}{
+		{
+			name:     "single term",
+			query:    "invoices",
+			expected: []uuid.UUID{ids[1], ids[0]},
+		},
+		{
+			name:     "all terms must match",
+			query:    "import invoices",
+			expected: []uuid.UUID{ids[0]},
+		},
+		{
+			name:     "prefix of last term",
+			query:    "imp",
+			expected: []uuid.UUID{ids[3], ids[0]},
+		},
+		{
+			name:     "exact match ranks above prefix",
+			query:    "index",
+			expected: []uuid.UUID{ids[2], ids[4]},
+		},
+		{
+			name:     "case insensitive",
+			query:    "REBUILD",
+			expected: []uuid.UUID{ids[2]},
+		},
+		{
+			name:     "no matches",
+			query:    "unknown",
+			expected: []uuid.UUID{},
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tasks, err := repo.SearchTasks(ctx, tt.query, 10)
+			require.NoError(t, err)
+
+			found := make([]uuid.UUID, 0, len(tasks))
+			for _, task := range tasks {
+				found = append(found, task.ID)
+			}
+			assert.Equal(t, tt.expected, found)
+		})
+	}
+}
+
+func TestTasksRepository_SearchTasks_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := NewTasksRepository()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+
+			task := model.Task{ID: uuid.New(), Title: "concurrent task", CreatedAt: time.Now()}
+			_, err := repo.CreateTask(ctx, task)
+			assert.NoError(t, err)
+			_, err = repo.DeleteTask(ctx, task.ID.String(), nil, "")
+			assert.NoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+
+			_, err := repo.SearchTasks(ctx, "concurrent", 10)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// soft deleted tasks are hidden from search
+	tasks, err := repo.SearchTasks(ctx, "concurrent", 10)
+	require.NoError(t, err)
+	assert.Empty(t, tasks)
+
+	// purged tasks are removed from the index
+	_, err = repo.PurgeDeletedTasks(ctx, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	assert.Empty(t, repo.search.terms)
+	assert.Empty(t, repo.search.postings)
+}
+
+func TestTasksRepository_SoftDelete(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := NewTasksRepository()
+
+	task := model.Task{
+		ID:        uuid.New(),
+		Status:    model.Pending,
+		Title:     "soft deleted",
+		CreatedAt: time.Now(),
+		Labels:    map[string]string{"team": "io"},
+	}
+	id := task.ID.String()
+	_, err := repo.CreateTask(ctx, task)
+	require.NoError(t, err)
+
+	_, err = repo.RestoreTask(ctx, id, "")
+	assert.ErrorIs(t, err, model.ErrTaskNotDeleted)
+
+	deleted, err := repo.DeleteTask(ctx, id, nil, "")
+	require.NoError(t, err)
+	assert.False(t, deleted.Before.IsDeleted())
+	assert.True(t, deleted.After.IsDeleted())
+	_, err = repo.DeleteTask(ctx, id, nil, "")
+	assert.ErrorIs(t, err, model.ErrTaskNotFound)
+
+	// deleted task is hidden from reads
+	_, err = repo.GetTask(ctx, id)
+	assert.ErrorIs(t, err, model.ErrTaskNotFound)
+	selector, err := model.ParseSelector("team=io")
+	require.NoError(t, err)
+	tasks, err := repo.ListTasks(ctx, selector)
+	require.NoError(t, err)
+	assert.Empty(t, tasks)
+
+	restored, err := repo.RestoreTask(ctx, id, "")
+	require.NoError(t, err)
+	assert.Equal(t, *deleted.After, *restored.Before)
+	assert.Nil(t, restored.After.DeletedAt)
+	assert.Equal(t, int64(3), restored.After.Version)
+
+	tasks, err = repo.ListTasks(ctx, selector)
+	require.NoError(t, err)
+	assert.Len(t, tasks, 1)
+
+	// purge doesn't remove tasks deleted after the given moment
+	_, err = repo.DeleteTask(ctx, id, nil, "")
+	require.NoError(t, err)
+	purged, err := repo.PurgeDeletedTasks(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, purged)
+
+	purged, err = repo.PurgeDeletedTasks(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Len(t, purged, 1)
+
+	_, err = repo.RestoreTask(ctx, id, "")
+	assert.ErrorIs(t, err, model.ErrTaskNotFound)
+}
+
+func TestTasksRepository_DeleteExpiredTasks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Now()
+	past, future := now.Add(-time.Minute), now.Add(time.Minute)
+
+	repo := NewTasksRepository()
+	expiredTask := model.Task{ID: uuid.New(), Status: model.Completed, ExpiresAt: &past}
+	for _, task := range []model.Task{
+		expiredTask,
+		{ID: uuid.New(), Status: model.Failed, ExpiresAt: &future},
+		{ID: uuid.New(), Status: model.Pending},
+	} {
+		_, err := repo.CreateTask(ctx, task)
+		require.NoError(t, err)
+	}
+
+	deleted, err := repo.DeleteExpiredTasks(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+	assert.Equal(t, expiredTask.ID, deleted[0].ID)
+
+	_, err = repo.GetTask(ctx, expiredTask.ID.String())
+	assert.ErrorIs(t, err, model.ErrTaskNotFound)
+	assert.Len(t, repo.storage, 2)
+}
+
+func TestTasksRepository_EvictOldestTasks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Now()
+
+	repo := NewTasksRepository()
+	tasks := []model.Task{
+		{ID: uuid.New(), Status: model.Pending, CreatedAt: now},
+		{ID: uuid.New(), Status: model.Completed, CreatedAt: now.Add(time.Second)},
+		{ID: uuid.New(), Status: model.Failed, CreatedAt: now.Add(2 * time.Second)},
+		{ID: uuid.New(), Status: model.Completed, CreatedAt: now.Add(3 * time.Second)},
+	}
+	for _, task := range tasks {
+		_, err := repo.CreateTask(ctx, task)
+		require.NoError(t, err)
+	}
+
+	evicted, err := repo.EvictOldestTasks(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, evicted, 2)
+	// the oldest task is pending, so it is skipped
+	assert.Equal(t, tasks[1].ID, evicted[0].ID)
+	assert.Equal(t, tasks[2].ID, evicted[1].ID)
+
+	evicted, err = repo.EvictOldestTasks(ctx, 2)
+	require.NoError(t, err)
+	assert.Empty(t, evicted)
+}
+
+func TestTasksRepository_Snapshot(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "task-db.json")
+
+	// missing snapshot is an empty repository
+	n, err := NewTasksRepository().LoadSnapshot(ctx, path)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	repo := NewTasksRepository()
+	interrupted := model.Task{ID: uuid.New(), Status: model.Pending, Title: "Import invoices", CreatedAt: time.Now(), Labels: map[string]string{"team": "io"}}
+	deleted := model.Task{ID: uuid.New(), Status: model.Completed, Title: "Export invoices", CreatedAt: time.Now()}
+	_, err = repo.CreateTask(ctx, interrupted)
+	require.NoError(t, err)
+	_, err = repo.CreateTask(ctx, deleted)
+	require.NoError(t, err)
+	_, err = repo.UpdateTask(ctx, interrupted.ID.String(), model.TaskUpdate{Status: model.Interrupted, Duration: time.Second})
+	require.NoError(t, err)
+	_, err = repo.DeleteTask(ctx, deleted.ID.String(), nil, "")
+	require.NoError(t, err)
+
+	require.NoError(t, repo.SaveSnapshot(ctx, path))
+
+	loaded := NewTasksRepository()
+	n, err = loaded.LoadSnapshot(ctx, path)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	task, err := loaded.GetTask(ctx, interrupted.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, model.Interrupted, task.Status)
+	assert.Equal(t, time.Second, task.Duration)
+	assert.Equal(t, int64(2), task.Version)
+
+	// indexes are rebuilt
+	found, err := loaded.SearchTasks(ctx, "invoices", 10)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	selector, err := model.ParseSelector("team=io")
+	require.NoError(t, err)
+	listed, err := loaded.ListTasks(ctx, selector)
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+
+	// soft deleted tasks stay restorable
+	restored, err := loaded.RestoreTask(ctx, deleted.ID.String(), "")
+	require.NoError(t, err)
+	assert.False(t, restored.After.IsDeleted())
+	assert.Equal(t, map[string]map[model.Status]int{tenant.Default: {model.Interrupted: 1, model.Completed: 1}}, loaded.CountTasks())
+}
+
+func TestTasksRepository_Tenants(t *testing.T) {
+	t.Parallel()
+
+	repo := NewTasksRepository()
+	acme := tenant.With(context.Background(), "acme")
+	globex := tenant.With(context.Background(), "globex")
+
+	task := model.Task{ID: uuid.New(), Status: model.Pending, Title: "Import invoices", CreatedAt: time.Now(), Labels: map[string]string{"team": "io"}}
+	_, err := repo.CreateTask(acme, task)
+	require.NoError(t, err)
+	// the same id can be registered by another tenant
+	_, err = repo.CreateTask(globex, task)
+	require.NoError(t, err)
+
+	stored, err := repo.GetTask(acme, task.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, "acme", stored.Tenant)
+
+	_, err = repo.DeleteTask(globex, task.ID.String(), nil, "")
+	require.NoError(t, err)
+	_, err = repo.GetTask(globex, task.ID.String())
+	assert.ErrorIs(t, err, model.ErrTaskNotFound)
+	_, err = repo.GetTask(acme, task.ID.String())
+	require.NoError(t, err)
+
+	_, err = repo.GetTask(context.Background(), task.ID.String())
+	assert.ErrorIs(t, err, model.ErrTaskNotFound)
+	listed, err := repo.ListTasks(context.Background(), model.LabelSelector{})
+	require.NoError(t, err)
+	assert.Empty(t, listed)
+	selector, err := model.ParseSelector("team=io")
+	require.NoError(t, err)
+	listed, err = repo.ListTasks(acme, selector)
+	require.NoError(t, err)
+	assert.Len(t, listed, 1)
+	found, err := repo.SearchTasks(globex, "invoices", 10)
+	require.NoError(t, err)
+	assert.Empty(t, found)
+
+	assert.Equal(t, map[string]map[model.Status]int{
+		"acme":   {model.Pending: 1},
+		"globex": {model.Pending: 1},
+	}, repo.CountTasks())
+
+	// tenants without tasks aren't counted anymore
+	_, errs := repo.PurgeTasks(globex, []string{task.ID.String()})
+	assert.NoError(t, errs[0])
+	assert.Equal(t, map[string]map[model.Status]int{"acme": {model.Pending: 1}}, repo.CountTasks())
+	assert.Zero(t, repo.CountActiveTasks(globex))
+}
+
+func TestTasksRepository_UpdateTask_Events(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := NewTasksRepository()
+	created := time.Date(2025, 8, 23, 10, 0, 0, 0, time.UTC)
+	task := model.Task{ID: uuid.New(), Status: model.Pending, CreatedAt: created, Events: []model.TaskEvent{{Type: model.EventQueued, Time: created}}}
+	_, err := repo.CreateTask(ctx, task)
+	require.NoError(t, err)
+	id := task.ID.String()
+
+	started := created.Add(time.Second)
+	_, err = repo.UpdateTask(ctx, id, model.TaskUpdate{Status: model.Pending, Event: model.TaskEvent{Type: model.EventStarted, Time: started}})
+	require.NoError(t, err)
+	before, err := repo.GetTask(ctx, id)
+	require.NoError(t, err)
+
+	finished := started.Add(3 * time.Second)
+	change, err := repo.UpdateTask(ctx, id, model.TaskUpdate{
+		Status:   model.Completed,
+		Duration: finished.Sub(started),
+		Event:    model.TaskEvent{Type: model.EventFinished, Time: finished, Status: model.Completed},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, *before, *change.Before)
+	after, err := repo.GetTask(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, *after, *change.After)
+
+	assert.Equal(t, []model.TaskEvent{
+		{Type: model.EventQueued, Time: created},
+		{Type: model.EventStarted, Time: started},
+		{Type: model.EventFinished, Time: finished, Status: model.Completed},
+	}, after.Events)
+	assert.Equal(t, started, *after.StartedAt)
+	assert.Equal(t, finished, *after.FinishedAt)
+
+	// copies taken earlier are unaffected
+	assert.Len(t, before.Events, 2)
+	assert.Nil(t, before.FinishedAt)
+}
+
+func TestTasksRepository_RemoveHook(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := NewTasksRepository()
+	var removed []uuid.UUID
+	repo.SetRemoveHook(func(task model.Task) {
+		// the hook runs after the lock is released, so it can use the repository
+		_, err := repo.GetTask(ctx, task.ID.String())
+		assert.ErrorIs(t, err, model.ErrTaskNotFound)
+		removed = append(removed, task.ID)
+	})
+
+	now := time.Now()
+	purged := model.Task{ID: uuid.New(), Status: model.Pending, CreatedAt: now}
+	expired := model.Task{ID: uuid.New(), Status: model.Completed, CreatedAt: now, ExpiresAt: &now}
+	kept := model.Task{ID: uuid.New(), Status: model.Pending, CreatedAt: now}
+	for _, task := range []model.Task{purged, expired, kept} {
+		_, err := repo.CreateTask(ctx, task)
+		require.NoError(t, err)
+	}
+
+	_, errs := repo.PurgeTasks(ctx, []string{purged.ID.String()})
+	require.NoError(t, errs[0])
+	_, err := repo.DeleteExpiredTasks(ctx, now.Add(time.Second))
+	require.NoError(t, err)
+	_, err = repo.DeleteTask(ctx, kept.ID.String(), nil, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, []uuid.UUID{purged.ID, expired.ID}, removed, "soft deleted tasks aren't removed")
+}
+
+func TestTasksRepository_CreateTasks(t *testing.T) {
+	t.Parallel()
+
+	existing := model.Task{ID: uuid.New(), Status: model.Pending, Title: "existing", CreatedAt: time.Now()}
+	first := model.Task{ID: uuid.New(), Status: model.Pending, Title: "first", CreatedAt: time.Now()}
+	second := model.Task{ID: uuid.New(), Status: model.Pending, Title: "second", CreatedAt: time.Now()}
+
+	testTable := []struct {
+		name      string
+		tasks     []model.Task
+		wantErrs  []error
+		wantTasks []model.Task
+	}{
+		{
+			name:      "all created",
+			tasks:     []model.Task{first, second},
+			wantErrs:  []error{nil, nil},
+			wantTasks: []model.Task{first, second},
+		},
+		{
+			name:      "partial failure keeps the other tasks",
+			tasks:     []model.Task{first, existing, second},
+			wantErrs:  []error{nil, model.ErrTaskAlreadyExists, nil},
+			wantTasks: []model.Task{first, second},
+		},
+		{
+			name:      "duplicate ids in the batch",
+			tasks:     []model.Task{first, {ID: first.ID, Status: model.Pending, Title: "duplicate", CreatedAt: time.Now()}},
+			wantErrs:  []error{nil, model.ErrTaskAlreadyExists},
+			wantTasks: []model.Task{first},
+		},
+		{
+			name:     "empty batch",
+			wantErrs: []error{},
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			repo := NewTasksRepository()
+			_, err := repo.CreateTask(ctx, existing)
+			require.NoError(t, err)
+
+			_, errs := repo.CreateTasks(ctx, tt.tasks)
+			require.Len(t, errs, len(tt.wantErrs))
+			for i, wantErr := range tt.wantErrs {
+				if wantErr == nil {
+					assert.NoError(t, errs[i], "task %d", i)
+				} else {
+					assert.ErrorIs(t, errs[i], wantErr, "task %d", i)
+				}
+			}
+
+			for _, want := range tt.wantTasks {
+				task, err := repo.GetTask(ctx, want.ID.String())
+				require.NoError(t, err)
+				assert.Equal(t, want.Title, task.Title)
+				assert.Equal(t, int64(1), task.Version)
+				assert.Equal(t, tenant.Default, task.Tenant)
+			}
+			assert.Equal(t, 1+len(tt.wantTasks), repo.CountActiveTasks(ctx))
+		})
+	}
+}
+
+func TestTasksRepository_DeleteTasks(t *testing.T) {
+	t.Parallel()
+
+	first := model.Task{ID: uuid.New(), Status: model.Pending, Title: "first", CreatedAt: time.Now()}
+	second := model.Task{ID: uuid.New(), Status: model.Completed, Title: "second", CreatedAt: time.Now()}
+	deleted := model.Task{ID: uuid.New(), Status: model.Completed, Title: "deleted", CreatedAt: time.Now()}
+	missing := uuid.NewString()
+
+	testTable := []struct {
+		name        string
+		tenant      string
+		ids         []string
+		wantErrs    []error
+		wantDeleted []string
+	}{
+		{
+			name:        "all deleted",
+			ids:         []string{first.ID.String(), second.ID.String()},
+			wantErrs:    []error{nil, nil},
+			wantDeleted: []string{first.ID.String(), second.ID.String()},
+		},
+		{
+			name:        "per item errors",
+			ids:         []string{missing, first.ID.String(), deleted.ID.String()},
+			wantErrs:    []error{model.ErrTaskNotFound, nil, model.ErrTaskNotFound},
+			wantDeleted: []string{first.ID.String()},
+		},
+		{
+			name:        "duplicate ids in the batch",
+			ids:         []string{second.ID.String(), second.ID.String()},
+			wantErrs:    []error{nil, model.ErrTaskNotFound},
+			wantDeleted: []string{second.ID.String()},
+		},
+		{
+			name:     "other tenant",
+			tenant:   "acme",
+			ids:      []string{first.ID.String()},
+			wantErrs: []error{model.ErrTaskNotFound},
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			repo := NewTasksRepository()
+			_, errs := repo.CreateTasks(ctx, []model.Task{first, second, deleted})
+			require.Equal(t, []error{nil, nil, nil}, errs)
+			_, err := repo.DeleteTask(ctx, deleted.ID.String(), nil, "")
+			require.NoError(t, err)
+
+			deleteCtx := ctx
+			if tt.tenant != "" {
+				deleteCtx = tenant.With(ctx, tt.tenant)
+			}
+			_, errs = repo.DeleteTasks(deleteCtx, tt.ids, "")
+			require.Len(t, errs, len(tt.wantErrs))
+			for i, wantErr := range tt.wantErrs {
+				if wantErr == nil {
+					assert.NoError(t, errs[i], "id %d", i)
+				} else {
+					assert.ErrorIs(t, errs[i], wantErr, "id %d", i)
+				}
+			}
+
+			for _, id := range []string{first.ID.String(), second.ID.String()} {
+				_, err := repo.GetTask(ctx, id)
+				if slices.Contains(tt.wantDeleted, id) {
+					assert.ErrorIs(t, err, model.ErrTaskNotFound, "deleted task is hidden")
+					task, err := repo.GetDeletedTask(ctx, id)
+					require.NoError(t, err)
+					assert.Equal(t, int64(2), task.Version)
+				} else {
+					assert.NoError(t, err, "other tasks are kept")
+				}
+			}
+		})
+	}
+}
+
+func TestTasksRepository_Owner(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := NewTasksRepository()
+	task := model.Task{ID: uuid.New(), Status: model.Pending, CreatedBy: "alice"}
+	id := task.ID.String()
+	_, err := repo.CreateTask(ctx, task)
+	require.NoError(t, err)
+
+	// other owners see the task as missing, even with a stale version
+	stale := int64(42)
+	_, err = repo.DeleteTask(ctx, id, &stale, "bob")
+	assert.ErrorIs(t, err, model.ErrTaskNotFound)
+	_, errs := repo.DeleteTasks(ctx, []string{id}, "bob")
+	assert.Equal(t, []error{model.ErrTaskNotFound}, errs)
+
+	_, err = repo.DeleteTask(ctx, id, nil, "alice")
+	require.NoError(t, err)
+	_, err = repo.RestoreTask(ctx, id, "bob")
+	assert.ErrorIs(t, err, model.ErrTaskNotFound)
+
+	restored, err := repo.RestoreTask(ctx, id, "alice")
+	require.NoError(t, err)
+	assert.Nil(t, restored.After.DeletedAt)
+
+	_, errs = repo.DeleteTasks(ctx, []string{id}, "")
+	assert.Equal(t, []error{nil}, errs)
+}
+
+func TestTasksRepository_PatchTask(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := NewTasksRepository()
+	task := model.Task{ID: uuid.New(), Status: model.Pending, Title: "draft", CreatedAt: time.Now(), Labels: map[string]string{"team": "a"}}
+	_, err := repo.CreateTask(ctx, task)
+	require.NoError(t, err)
+	id := task.ID.String()
+
+	patched, err := repo.PatchTask(ctx, id, func(task *model.Task) error {
+		assert.Equal(t, int64(1), task.Version)
+		task.Title = "final"
+		task.Version = 10
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "draft", patched.Before.Title)
+	assert.Equal(t, "final", patched.After.Title)
+	assert.Equal(t, int64(2), patched.After.Version, "apply can't set the version")
+
+	// failing patches leave the task untouched, even through shared maps
+	errRejected := errors.New("rejected")
+	_, err = repo.PatchTask(ctx, id, func(task *model.Task) error {
+		task.Title = "rejected"
+		task.Labels["team"] = "b"
+		return errRejected
+	})
+	assert.ErrorIs(t, err, errRejected)
+	stored, err := repo.GetTask(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, *patched.After, *stored)
+	assert.Equal(t, map[string]string{"team": "a"}, stored.Labels)
+
+	_, err = repo.DeleteTask(ctx, id, nil, "")
+	require.NoError(t, err)
+	_, err = repo.PatchTask(ctx, id, func(*model.Task) error { return nil })
+	assert.ErrorIs(t, err, model.ErrTaskNotFound)
+}
+
+func TestTasksRepository_CopiesMetadata(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := NewTasksRepository()
+
+	metadata := map[string]any{
+		"source": map[string]any{"bucket": "invoices"},
+		"tags":   []any{"a", map[string]any{"b": "c"}},
+	}
+	single := model.Task{ID: uuid.New(), Status: model.Pending, CreatedAt: time.Now(), Metadata: metadata}
+	batched := model.Task{ID: uuid.New(), Status: model.Pending, CreatedAt: time.Now(), Metadata: metadata}
+	_, err := repo.CreateTask(ctx, single)
+	require.NoError(t, err)
+	_, errs := repo.CreateTasks(ctx, []model.Task{batched})
+	require.Equal(t, []error{nil}, errs)
+
+	_, err = repo.PatchTask(ctx, single.ID.String(), func(task *model.Task) error {
+		task.Metadata = metadata
+		return nil
+	})
+	require.NoError(t, err)
+
+	// the caller keeps mutating its maps
+	metadata["source"].(map[string]any)["bucket"] = "changed"
+	metadata["tags"].([]any)[1].(map[string]any)["b"] = "changed"
+	metadata["added"] = true
+
+	want := map[string]any{
+		"source": map[string]any{"bucket": "invoices"},
+		"tags":   []any{"a", map[string]any{"b": "c"}},
+	}
+	for _, id := range []uuid.UUID{single.ID, batched.ID} {
+		task, err := repo.GetTask(ctx, id.String())
+		require.NoError(t, err)
+		assert.Equal(t, want, task.Metadata)
+	}
+}
@@ -0,0 +1,173 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"test-server/internal/domain/model"
+	"test-server/internal/metrics"
+)
+
+// DefaultInterval is used when the janitor interval isn't configured.
+const DefaultInterval = time.Minute
+
+// stalledIntervals is the number of intervals without a sweep after which
+// the running janitor is considered stalled.
+const stalledIntervals = 3
+
+// Eviction reasons used as label values of metrics.TaskEvictions.
+const (
+	ReasonExpired  = "expired"
+	ReasonCapacity = "capacity"
+	ReasonPurged   = "purged"
+)
+
+//go:generate minimock -i TasksRepository -o ./mock -s _mock.go
+type TasksRepository interface {
+	DeleteExpiredTasks(ctx context.Context, now time.Time) ([]model.Task, error)
+	EvictOldestTasks(ctx context.Context, maxTasks int) ([]model.Task, error)
+	PurgeDeletedTasks(ctx context.Context, before time.Time) ([]model.Task, error)
+}
+
+// Janitor periodically removes expired finished tasks, purges soft deleted
+// tasks after the grace period and keeps the total number of stored tasks
+// within the retention policy limit.
+type Janitor struct {
+	mu       sync.RWMutex
+	policy   model.RetentionPolicy
+	interval time.Duration
+	// intervalCh passes interval changes to the running janitor.
+	intervalCh chan time.Duration
+	tasksRepo  TasksRepository
+	// lastRun is the unix time in nanoseconds of the last iteration of the
+	// running janitor, 0 until it runs.
+	lastRun atomic.Int64
+}
+
+func NewJanitor(policy model.RetentionPolicy, interval time.Duration, tasksRepo TasksRepository) *Janitor {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	return &Janitor{
+		policy:     policy,
+		interval:   interval,
+		intervalCh: make(chan time.Duration, 1),
+		tasksRepo:  tasksRepo,
+	}
+}
+
+// SetPolicy replaces the retention policy used by the following sweeps.
+func (j *Janitor) SetPolicy(policy model.RetentionPolicy) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.policy = policy
+}
+
+// SetInterval changes the interval of the running janitor.
+func (j *Janitor) SetInterval(interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.interval = interval
+	// replace a change the janitor hasn't picked up yet
+	select {
+	case <-j.intervalCh:
+	default:
+	}
+	j.intervalCh <- interval
+}
+
+// Run sweeps storage every interval until ctx is done.
+func (j *Janitor) Run(ctx context.Context) {
+	j.mu.RLock()
+	ticker := time.NewTicker(j.interval)
+	j.mu.RUnlock()
+	defer ticker.Stop()
+
+	j.lastRun.Store(time.Now().UnixNano())
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case interval := <-j.intervalCh:
+			ticker.Reset(interval)
+		case now := <-ticker.C:
+			j.Sweep(ctx, now)
+		}
+		j.lastRun.Store(time.Now().UnixNano())
+	}
+}
+
+// Check fails when the running janitor hasn't completed a sweep for several
+// intervals, e.g. because it's stuck on the repository lock, which the
+// process can't recover from. It's intended as a liveness check.
+func (j *Janitor) Check(ctx context.Context) error {
+	lastRun := j.lastRun.Load()
+	if lastRun == 0 {
+		return nil
+	}
+
+	j.mu.RLock()
+	interval := j.interval
+	j.mu.RUnlock()
+
+	if since := time.Since(time.Unix(0, lastRun)); since > stalledIntervals*interval {
+		return fmt.Errorf("janitor hasn't run for %s, interval is %s", since.Round(time.Second), interval)
+	}
+	return nil
+}
+
+// Sweep removes tasks expired by now and soft deleted tasks past the grace
+// period, then evicts the oldest finished tasks over the MaxTasks limit.
+// It returns the number of removed tasks.
+func (j *Janitor) Sweep(ctx context.Context, now time.Time) int {
+	j.mu.RLock()
+	policy := j.policy
+	j.mu.RUnlock()
+
+	removed := 0
+
+	expired, err := j.tasksRepo.DeleteExpiredTasks(ctx, now)
+	if err != nil {
+		slog.ErrorContext(ctx, "Janitor.Sweep: error while deleting expired tasks", "error", err)
+	}
+	removed += countEvictions(ReasonExpired, expired)
+
+	if policy.DeletedGrace > 0 {
+		purged, err := j.tasksRepo.PurgeDeletedTasks(ctx, now.Add(-policy.DeletedGrace))
+		if err != nil {
+			slog.ErrorContext(ctx, "Janitor.Sweep: error while purging deleted tasks", "error", err)
+		}
+		removed += countEvictions(ReasonPurged, purged)
+	}
+
+	if policy.MaxTasks > 0 {
+		evicted, err := j.tasksRepo.EvictOldestTasks(ctx, policy.MaxTasks)
+		if err != nil {
+			slog.ErrorContext(ctx, "Janitor.Sweep: error while evicting oldest tasks", "error", err)
+		}
+		removed += countEvictions(ReasonCapacity, evicted)
+	}
+
+	if removed > 0 {
+		slog.InfoContext(ctx, "Janitor.Sweep: removed tasks", "removed", removed, "expired", len(expired))
+	}
+	return removed
+}
+
+func countEvictions(reason string, tasks []model.Task) int {
+	for _, task := range tasks {
+		metrics.TaskEvictions.WithLabelValues(reason, string(task.Status)).Inc()
+	}
+	return len(tasks)
+}
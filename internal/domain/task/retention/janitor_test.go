@@ -0,0 +1,119 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"test-server/internal/domain/model"
+	mocks "test-server/internal/domain/task/retention/mock"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJanitor_Sweep(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	completedTask := model.Task{ID: uuid.New(), Status: model.Completed}
+	failedTask := model.Task{ID: uuid.New(), Status: model.Failed}
+
+	testTable := []struct {
+		name      string
+		policy    model.RetentionPolicy
+		mockSetup func(mc *minimock.Controller) TasksRepository
+		expected  int
+	}{
+		{
+			name:   "expired tasks only",
+			policy: model.RetentionPolicy{Completed: time.Hour},
+			mockSetup: func(mc *minimock.Controller) TasksRepository {
+				return mocks.NewTasksRepositoryMock(mc).
+					DeleteExpiredTasksMock.Expect(minimock.AnyContext, now).Return([]model.Task{completedTask, failedTask}, nil)
+			},
+			expected: 2,
+		},
+		{
+			name:   "expired and over capacity",
+			policy: model.RetentionPolicy{Completed: time.Hour, MaxTasks: 10},
+			mockSetup: func(mc *minimock.Controller) TasksRepository {
+				return mocks.NewTasksRepositoryMock(mc).
+					DeleteExpiredTasksMock.Expect(minimock.AnyContext, now).Return([]model.Task{failedTask}, nil).
+					EvictOldestTasksMock.Expect(minimock.AnyContext, 10).Return([]model.Task{completedTask}, nil)
+			},
+			expected: 2,
+		},
+		{
+			name:   "repository error doesn't stop eviction",
+			policy: model.RetentionPolicy{MaxTasks: 10},
+			mockSetup: func(mc *minimock.Controller) TasksRepository {
+				return mocks.NewTasksRepositoryMock(mc).
+					DeleteExpiredTasksMock.Expect(minimock.AnyContext, now).Return(nil, errors.New("repository error")).
+					EvictOldestTasksMock.Expect(minimock.AnyContext, 10).Return(nil, nil)
+			},
+			expected: 0,
+		},
+		{
+			name:   "soft deleted tasks past grace period",
+			policy: model.RetentionPolicy{DeletedGrace: time.Hour},
+			mockSetup: func(mc *minimock.Controller) TasksRepository {
+				return mocks.NewTasksRepositoryMock(mc).
+					DeleteExpiredTasksMock.Expect(minimock.AnyContext, now).Return(nil, nil).
+					PurgeDeletedTasksMock.Expect(minimock.AnyContext, now.Add(-time.Hour)).Return([]model.Task{completedTask}, nil)
+			},
+			expected: 1,
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			repo := tt.mockSetup(mc)
+
+			janitor := NewJanitor(tt.policy, time.Minute, repo)
+
+			assert.Equal(t, tt.expected, janitor.Sweep(context.Background(), now))
+		})
+	}
+}
+
+func TestJanitor_Reload(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	repo := mocks.NewTasksRepositoryMock(mc).
+		DeleteExpiredTasksMock.Return(nil, nil).
+		EvictOldestTasksMock.Expect(minimock.AnyContext, 5).Return(nil, nil)
+
+	janitor := NewJanitor(model.RetentionPolicy{}, time.Hour, repo)
+
+	// the first sweep happens only after the interval is shortened
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go janitor.Run(ctx)
+
+	janitor.SetPolicy(model.RetentionPolicy{MaxTasks: 5})
+	janitor.SetInterval(10 * time.Millisecond)
+
+	assert.Eventually(t, func() bool {
+		return repo.EvictOldestTasksAfterCounter() > 0
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestJanitor_Check(t *testing.T) {
+	t.Parallel()
+
+	janitor := NewJanitor(model.RetentionPolicy{}, time.Minute, nil)
+	// the janitor isn't running yet
+	assert.NoError(t, janitor.Check(context.Background()))
+
+	janitor.lastRun.Store(time.Now().Add(-2 * time.Minute).UnixNano())
+	assert.NoError(t, janitor.Check(context.Background()))
+
+	janitor.lastRun.Store(time.Now().Add(-4 * time.Minute).UnixNano())
+	assert.ErrorContains(t, janitor.Check(context.Background()), "janitor hasn't run")
+}
@@ -0,0 +1,1131 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mock
+
+//go:generate minimock -i test-server/internal/domain/task/retention.TasksRepository -o tasks_repository_mock.go -n TasksRepositoryMock -p mock
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"test-server/internal/domain/model"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// TasksRepositoryMock implements mm_retention.TasksRepository
+type TasksRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDeleteExpiredTasks          func(ctx context.Context, now time.Time) (ta1 []model.Task, err error)
+	funcDeleteExpiredTasksOrigin    string
+	inspectFuncDeleteExpiredTasks   func(ctx context.Context, now time.Time)
+	afterDeleteExpiredTasksCounter  uint64
+	beforeDeleteExpiredTasksCounter uint64
+	DeleteExpiredTasksMock          mTasksRepositoryMockDeleteExpiredTasks
+
+	funcEvictOldestTasks          func(ctx context.Context, maxTasks int) (ta1 []model.Task, err error)
+	funcEvictOldestTasksOrigin    string
+	inspectFuncEvictOldestTasks   func(ctx context.Context, maxTasks int)
+	afterEvictOldestTasksCounter  uint64
+	beforeEvictOldestTasksCounter uint64
+	EvictOldestTasksMock          mTasksRepositoryMockEvictOldestTasks
+
+	funcPurgeDeletedTasks          func(ctx context.Context, before time.Time) (ta1 []model.Task, err error)
+	funcPurgeDeletedTasksOrigin    string
+	inspectFuncPurgeDeletedTasks   func(ctx context.Context, before time.Time)
+	afterPurgeDeletedTasksCounter  uint64
+	beforePurgeDeletedTasksCounter uint64
+	PurgeDeletedTasksMock          mTasksRepositoryMockPurgeDeletedTasks
+}
+
+// NewTasksRepositoryMock returns a mock for mm_retention.TasksRepository
+func NewTasksRepositoryMock(t minimock.Tester) *TasksRepositoryMock {
+	m := &TasksRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteExpiredTasksMock = mTasksRepositoryMockDeleteExpiredTasks{mock: m}
+	m.DeleteExpiredTasksMock.callArgs = []*TasksRepositoryMockDeleteExpiredTasksParams{}
+
+	m.EvictOldestTasksMock = mTasksRepositoryMockEvictOldestTasks{mock: m}
+	m.EvictOldestTasksMock.callArgs = []*TasksRepositoryMockEvictOldestTasksParams{}
+
+	m.PurgeDeletedTasksMock = mTasksRepositoryMockPurgeDeletedTasks{mock: m}
+	m.PurgeDeletedTasksMock.callArgs = []*TasksRepositoryMockPurgeDeletedTasksParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mTasksRepositoryMockDeleteExpiredTasks struct {
+	optional           bool
+	mock               *TasksRepositoryMock
+	defaultExpectation *TasksRepositoryMockDeleteExpiredTasksExpectation
+	expectations       []*TasksRepositoryMockDeleteExpiredTasksExpectation
+
+	callArgs []*TasksRepositoryMockDeleteExpiredTasksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TasksRepositoryMockDeleteExpiredTasksExpectation specifies expectation struct of the TasksRepository.DeleteExpiredTasks
+type TasksRepositoryMockDeleteExpiredTasksExpectation struct {
+	mock               *TasksRepositoryMock
+	params             *TasksRepositoryMockDeleteExpiredTasksParams
+	paramPtrs          *TasksRepositoryMockDeleteExpiredTasksParamPtrs
+	expectationOrigins TasksRepositoryMockDeleteExpiredTasksExpectationOrigins
+	results            *TasksRepositoryMockDeleteExpiredTasksResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TasksRepositoryMockDeleteExpiredTasksParams contains parameters of the TasksRepository.DeleteExpiredTasks
+type TasksRepositoryMockDeleteExpiredTasksParams struct {
+	ctx context.Context
+	now time.Time
+}
+
+// TasksRepositoryMockDeleteExpiredTasksParamPtrs contains pointers to parameters of the TasksRepository.DeleteExpiredTasks
+type TasksRepositoryMockDeleteExpiredTasksParamPtrs struct {
+	ctx *context.Context
+	now *time.Time
+}
+
+// TasksRepositoryMockDeleteExpiredTasksResults contains results of the TasksRepository.DeleteExpiredTasks
+type TasksRepositoryMockDeleteExpiredTasksResults struct {
+	ta1 []model.Task
+	err error
+}
+
+// TasksRepositoryMockDeleteExpiredTasksOrigins contains origins of expectations of the TasksRepository.DeleteExpiredTasks
+type TasksRepositoryMockDeleteExpiredTasksExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originNow string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteExpiredTasks *mTasksRepositoryMockDeleteExpiredTasks) Optional() *mTasksRepositoryMockDeleteExpiredTasks {
+	mmDeleteExpiredTasks.optional = true
+	return mmDeleteExpiredTasks
+}
+
+// Expect sets up expected params for TasksRepository.DeleteExpiredTasks
+func (mmDeleteExpiredTasks *mTasksRepositoryMockDeleteExpiredTasks) Expect(ctx context.Context, now time.Time) *mTasksRepositoryMockDeleteExpiredTasks {
+	if mmDeleteExpiredTasks.mock.funcDeleteExpiredTasks != nil {
+		mmDeleteExpiredTasks.mock.t.Fatalf("TasksRepositoryMock.DeleteExpiredTasks mock is already set by Set")
+	}
+
+	if mmDeleteExpiredTasks.defaultExpectation == nil {
+		mmDeleteExpiredTasks.defaultExpectation = &TasksRepositoryMockDeleteExpiredTasksExpectation{}
+	}
+
+	if mmDeleteExpiredTasks.defaultExpectation.paramPtrs != nil {
+		mmDeleteExpiredTasks.mock.t.Fatalf("TasksRepositoryMock.DeleteExpiredTasks mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteExpiredTasks.defaultExpectation.params = &TasksRepositoryMockDeleteExpiredTasksParams{ctx, now}
+	mmDeleteExpiredTasks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteExpiredTasks.expectations {
+		if minimock.Equal(e.params, mmDeleteExpiredTasks.defaultExpectation.params) {
+			mmDeleteExpiredTasks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteExpiredTasks.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteExpiredTasks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TasksRepository.DeleteExpiredTasks
+func (mmDeleteExpiredTasks *mTasksRepositoryMockDeleteExpiredTasks) ExpectCtxParam1(ctx context.Context) *mTasksRepositoryMockDeleteExpiredTasks {
+	if mmDeleteExpiredTasks.mock.funcDeleteExpiredTasks != nil {
+		mmDeleteExpiredTasks.mock.t.Fatalf("TasksRepositoryMock.DeleteExpiredTasks mock is already set by Set")
+	}
+
+	if mmDeleteExpiredTasks.defaultExpectation == nil {
+		mmDeleteExpiredTasks.defaultExpectation = &TasksRepositoryMockDeleteExpiredTasksExpectation{}
+	}
+
+	if mmDeleteExpiredTasks.defaultExpectation.params != nil {
+		mmDeleteExpiredTasks.mock.t.Fatalf("TasksRepositoryMock.DeleteExpiredTasks mock is already set by Expect")
+	}
+
+	if mmDeleteExpiredTasks.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpiredTasks.defaultExpectation.paramPtrs = &TasksRepositoryMockDeleteExpiredTasksParamPtrs{}
+	}
+	mmDeleteExpiredTasks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteExpiredTasks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteExpiredTasks
+}
+
+// ExpectNowParam2 sets up expected param now for TasksRepository.DeleteExpiredTasks
+func (mmDeleteExpiredTasks *mTasksRepositoryMockDeleteExpiredTasks) ExpectNowParam2(now time.Time) *mTasksRepositoryMockDeleteExpiredTasks {
+	if mmDeleteExpiredTasks.mock.funcDeleteExpiredTasks != nil {
+		mmDeleteExpiredTasks.mock.t.Fatalf("TasksRepositoryMock.DeleteExpiredTasks mock is already set by Set")
+	}
+
+	if mmDeleteExpiredTasks.defaultExpectation == nil {
+		mmDeleteExpiredTasks.defaultExpectation = &TasksRepositoryMockDeleteExpiredTasksExpectation{}
+	}
+
+	if mmDeleteExpiredTasks.defaultExpectation.params != nil {
+		mmDeleteExpiredTasks.mock.t.Fatalf("TasksRepositoryMock.DeleteExpiredTasks mock is already set by Expect")
+	}
+
+	if mmDeleteExpiredTasks.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpiredTasks.defaultExpectation.paramPtrs = &TasksRepositoryMockDeleteExpiredTasksParamPtrs{}
+	}
+	mmDeleteExpiredTasks.defaultExpectation.paramPtrs.now = &now
+	mmDeleteExpiredTasks.defaultExpectation.expectationOrigins.originNow = minimock.CallerInfo(1)
+
+	return mmDeleteExpiredTasks
+}
+
+// Inspect accepts an inspector function that has same arguments as the TasksRepository.DeleteExpiredTasks
+func (mmDeleteExpiredTasks *mTasksRepositoryMockDeleteExpiredTasks) Inspect(f func(ctx context.Context, now time.Time)) *mTasksRepositoryMockDeleteExpiredTasks {
+	if mmDeleteExpiredTasks.mock.inspectFuncDeleteExpiredTasks != nil {
+		mmDeleteExpiredTasks.mock.t.Fatalf("Inspect function is already set for TasksRepositoryMock.DeleteExpiredTasks")
+	}
+
+	mmDeleteExpiredTasks.mock.inspectFuncDeleteExpiredTasks = f
+
+	return mmDeleteExpiredTasks
+}
+
+// Return sets up results that will be returned by TasksRepository.DeleteExpiredTasks
+func (mmDeleteExpiredTasks *mTasksRepositoryMockDeleteExpiredTasks) Return(ta1 []model.Task, err error) *TasksRepositoryMock {
+	if mmDeleteExpiredTasks.mock.funcDeleteExpiredTasks != nil {
+		mmDeleteExpiredTasks.mock.t.Fatalf("TasksRepositoryMock.DeleteExpiredTasks mock is already set by Set")
+	}
+
+	if mmDeleteExpiredTasks.defaultExpectation == nil {
+		mmDeleteExpiredTasks.defaultExpectation = &TasksRepositoryMockDeleteExpiredTasksExpectation{mock: mmDeleteExpiredTasks.mock}
+	}
+	mmDeleteExpiredTasks.defaultExpectation.results = &TasksRepositoryMockDeleteExpiredTasksResults{ta1, err}
+	mmDeleteExpiredTasks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredTasks.mock
+}
+
+// Set uses given function f to mock the TasksRepository.DeleteExpiredTasks method
+func (mmDeleteExpiredTasks *mTasksRepositoryMockDeleteExpiredTasks) Set(f func(ctx context.Context, now time.Time) (ta1 []model.Task, err error)) *TasksRepositoryMock {
+	if mmDeleteExpiredTasks.defaultExpectation != nil {
+		mmDeleteExpiredTasks.mock.t.Fatalf("Default expectation is already set for the TasksRepository.DeleteExpiredTasks method")
+	}
+
+	if len(mmDeleteExpiredTasks.expectations) > 0 {
+		mmDeleteExpiredTasks.mock.t.Fatalf("Some expectations are already set for the TasksRepository.DeleteExpiredTasks method")
+	}
+
+	mmDeleteExpiredTasks.mock.funcDeleteExpiredTasks = f
+	mmDeleteExpiredTasks.mock.funcDeleteExpiredTasksOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredTasks.mock
+}
+
+// When sets expectation for the TasksRepository.DeleteExpiredTasks which will trigger the result defined by the following
+// Then helper
+func (mmDeleteExpiredTasks *mTasksRepositoryMockDeleteExpiredTasks) When(ctx context.Context, now time.Time) *TasksRepositoryMockDeleteExpiredTasksExpectation {
+	if mmDeleteExpiredTasks.mock.funcDeleteExpiredTasks != nil {
+		mmDeleteExpiredTasks.mock.t.Fatalf("TasksRepositoryMock.DeleteExpiredTasks mock is already set by Set")
+	}
+
+	expectation := &TasksRepositoryMockDeleteExpiredTasksExpectation{
+		mock:               mmDeleteExpiredTasks.mock,
+		params:             &TasksRepositoryMockDeleteExpiredTasksParams{ctx, now},
+		expectationOrigins: TasksRepositoryMockDeleteExpiredTasksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteExpiredTasks.expectations = append(mmDeleteExpiredTasks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TasksRepository.DeleteExpiredTasks return parameters for the expectation previously defined by the When method
+func (e *TasksRepositoryMockDeleteExpiredTasksExpectation) Then(ta1 []model.Task, err error) *TasksRepositoryMock {
+	e.results = &TasksRepositoryMockDeleteExpiredTasksResults{ta1, err}
+	return e.mock
+}
+
+// Times sets number of times TasksRepository.DeleteExpiredTasks should be invoked
+func (mmDeleteExpiredTasks *mTasksRepositoryMockDeleteExpiredTasks) Times(n uint64) *mTasksRepositoryMockDeleteExpiredTasks {
+	if n == 0 {
+		mmDeleteExpiredTasks.mock.t.Fatalf("Times of TasksRepositoryMock.DeleteExpiredTasks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteExpiredTasks.expectedInvocations, n)
+	mmDeleteExpiredTasks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredTasks
+}
+
+func (mmDeleteExpiredTasks *mTasksRepositoryMockDeleteExpiredTasks) invocationsDone() bool {
+	if len(mmDeleteExpiredTasks.expectations) == 0 && mmDeleteExpiredTasks.defaultExpectation == nil && mmDeleteExpiredTasks.mock.funcDeleteExpiredTasks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteExpiredTasks.mock.afterDeleteExpiredTasksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteExpiredTasks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteExpiredTasks implements mm_retention.TasksRepository
+func (mmDeleteExpiredTasks *TasksRepositoryMock) DeleteExpiredTasks(ctx context.Context, now time.Time) (ta1 []model.Task, err error) {
+	mm_atomic.AddUint64(&mmDeleteExpiredTasks.beforeDeleteExpiredTasksCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteExpiredTasks.afterDeleteExpiredTasksCounter, 1)
+
+	mmDeleteExpiredTasks.t.Helper()
+
+	if mmDeleteExpiredTasks.inspectFuncDeleteExpiredTasks != nil {
+		mmDeleteExpiredTasks.inspectFuncDeleteExpiredTasks(ctx, now)
+	}
+
+	mm_params := TasksRepositoryMockDeleteExpiredTasksParams{ctx, now}
+
+	// Record call args
+	mmDeleteExpiredTasks.DeleteExpiredTasksMock.mutex.Lock()
+	mmDeleteExpiredTasks.DeleteExpiredTasksMock.callArgs = append(mmDeleteExpiredTasks.DeleteExpiredTasksMock.callArgs, &mm_params)
+	mmDeleteExpiredTasks.DeleteExpiredTasksMock.mutex.Unlock()
+
+	for _, e := range mmDeleteExpiredTasks.DeleteExpiredTasksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ta1, e.results.err
+		}
+	}
+
+	if mmDeleteExpiredTasks.DeleteExpiredTasksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteExpiredTasks.DeleteExpiredTasksMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteExpiredTasks.DeleteExpiredTasksMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteExpiredTasks.DeleteExpiredTasksMock.defaultExpectation.paramPtrs
+
+		mm_got := TasksRepositoryMockDeleteExpiredTasksParams{ctx, now}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteExpiredTasks.t.Errorf("TasksRepositoryMock.DeleteExpiredTasks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteExpiredTasks.DeleteExpiredTasksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmDeleteExpiredTasks.t.Errorf("TasksRepositoryMock.DeleteExpiredTasks got unexpected parameter now, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteExpiredTasks.DeleteExpiredTasksMock.defaultExpectation.expectationOrigins.originNow, *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteExpiredTasks.t.Errorf("TasksRepositoryMock.DeleteExpiredTasks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteExpiredTasks.DeleteExpiredTasksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteExpiredTasks.DeleteExpiredTasksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteExpiredTasks.t.Fatal("No results are set for the TasksRepositoryMock.DeleteExpiredTasks")
+		}
+		return (*mm_results).ta1, (*mm_results).err
+	}
+	if mmDeleteExpiredTasks.funcDeleteExpiredTasks != nil {
+		return mmDeleteExpiredTasks.funcDeleteExpiredTasks(ctx, now)
+	}
+	mmDeleteExpiredTasks.t.Fatalf("Unexpected call to TasksRepositoryMock.DeleteExpiredTasks. %v %v", ctx, now)
+	return
+}
+
+// DeleteExpiredTasksAfterCounter returns a count of finished TasksRepositoryMock.DeleteExpiredTasks invocations
+func (mmDeleteExpiredTasks *TasksRepositoryMock) DeleteExpiredTasksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpiredTasks.afterDeleteExpiredTasksCounter)
+}
+
+// DeleteExpiredTasksBeforeCounter returns a count of TasksRepositoryMock.DeleteExpiredTasks invocations
+func (mmDeleteExpiredTasks *TasksRepositoryMock) DeleteExpiredTasksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpiredTasks.beforeDeleteExpiredTasksCounter)
+}
+
+// Calls returns a list of arguments used in each call to TasksRepositoryMock.DeleteExpiredTasks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteExpiredTasks *mTasksRepositoryMockDeleteExpiredTasks) Calls() []*TasksRepositoryMockDeleteExpiredTasksParams {
+	mmDeleteExpiredTasks.mutex.RLock()
+
+	argCopy := make([]*TasksRepositoryMockDeleteExpiredTasksParams, len(mmDeleteExpiredTasks.callArgs))
+	copy(argCopy, mmDeleteExpiredTasks.callArgs)
+
+	mmDeleteExpiredTasks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteExpiredTasksDone returns true if the count of the DeleteExpiredTasks invocations corresponds
+// the number of defined expectations
+func (m *TasksRepositoryMock) MinimockDeleteExpiredTasksDone() bool {
+	if m.DeleteExpiredTasksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteExpiredTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteExpiredTasksMock.invocationsDone()
+}
+
+// MinimockDeleteExpiredTasksInspect logs each unmet expectation
+func (m *TasksRepositoryMock) MinimockDeleteExpiredTasksInspect() {
+	for _, e := range m.DeleteExpiredTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TasksRepositoryMock.DeleteExpiredTasks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteExpiredTasksCounter := mm_atomic.LoadUint64(&m.afterDeleteExpiredTasksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteExpiredTasksMock.defaultExpectation != nil && afterDeleteExpiredTasksCounter < 1 {
+		if m.DeleteExpiredTasksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksRepositoryMock.DeleteExpiredTasks at\n%s", m.DeleteExpiredTasksMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TasksRepositoryMock.DeleteExpiredTasks at\n%s with params: %#v", m.DeleteExpiredTasksMock.defaultExpectation.expectationOrigins.origin, *m.DeleteExpiredTasksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteExpiredTasks != nil && afterDeleteExpiredTasksCounter < 1 {
+		m.t.Errorf("Expected call to TasksRepositoryMock.DeleteExpiredTasks at\n%s", m.funcDeleteExpiredTasksOrigin)
+	}
+
+	if !m.DeleteExpiredTasksMock.invocationsDone() && afterDeleteExpiredTasksCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksRepositoryMock.DeleteExpiredTasks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteExpiredTasksMock.expectedInvocations), m.DeleteExpiredTasksMock.expectedInvocationsOrigin, afterDeleteExpiredTasksCounter)
+	}
+}
+
+type mTasksRepositoryMockEvictOldestTasks struct {
+	optional           bool
+	mock               *TasksRepositoryMock
+	defaultExpectation *TasksRepositoryMockEvictOldestTasksExpectation
+	expectations       []*TasksRepositoryMockEvictOldestTasksExpectation
+
+	callArgs []*TasksRepositoryMockEvictOldestTasksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TasksRepositoryMockEvictOldestTasksExpectation specifies expectation struct of the TasksRepository.EvictOldestTasks
+type TasksRepositoryMockEvictOldestTasksExpectation struct {
+	mock               *TasksRepositoryMock
+	params             *TasksRepositoryMockEvictOldestTasksParams
+	paramPtrs          *TasksRepositoryMockEvictOldestTasksParamPtrs
+	expectationOrigins TasksRepositoryMockEvictOldestTasksExpectationOrigins
+	results            *TasksRepositoryMockEvictOldestTasksResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TasksRepositoryMockEvictOldestTasksParams contains parameters of the TasksRepository.EvictOldestTasks
+type TasksRepositoryMockEvictOldestTasksParams struct {
+	ctx      context.Context
+	maxTasks int
+}
+
+// TasksRepositoryMockEvictOldestTasksParamPtrs contains pointers to parameters of the TasksRepository.EvictOldestTasks
+type TasksRepositoryMockEvictOldestTasksParamPtrs struct {
+	ctx      *context.Context
+	maxTasks *int
+}
+
+// TasksRepositoryMockEvictOldestTasksResults contains results of the TasksRepository.EvictOldestTasks
+type TasksRepositoryMockEvictOldestTasksResults struct {
+	ta1 []model.Task
+	err error
+}
+
+// TasksRepositoryMockEvictOldestTasksOrigins contains origins of expectations of the TasksRepository.EvictOldestTasks
+type TasksRepositoryMockEvictOldestTasksExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originMaxTasks string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEvictOldestTasks *mTasksRepositoryMockEvictOldestTasks) Optional() *mTasksRepositoryMockEvictOldestTasks {
+	mmEvictOldestTasks.optional = true
+	return mmEvictOldestTasks
+}
+
+// Expect sets up expected params for TasksRepository.EvictOldestTasks
+func (mmEvictOldestTasks *mTasksRepositoryMockEvictOldestTasks) Expect(ctx context.Context, maxTasks int) *mTasksRepositoryMockEvictOldestTasks {
+	if mmEvictOldestTasks.mock.funcEvictOldestTasks != nil {
+		mmEvictOldestTasks.mock.t.Fatalf("TasksRepositoryMock.EvictOldestTasks mock is already set by Set")
+	}
+
+	if mmEvictOldestTasks.defaultExpectation == nil {
+		mmEvictOldestTasks.defaultExpectation = &TasksRepositoryMockEvictOldestTasksExpectation{}
+	}
+
+	if mmEvictOldestTasks.defaultExpectation.paramPtrs != nil {
+		mmEvictOldestTasks.mock.t.Fatalf("TasksRepositoryMock.EvictOldestTasks mock is already set by ExpectParams functions")
+	}
+
+	mmEvictOldestTasks.defaultExpectation.params = &TasksRepositoryMockEvictOldestTasksParams{ctx, maxTasks}
+	mmEvictOldestTasks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEvictOldestTasks.expectations {
+		if minimock.Equal(e.params, mmEvictOldestTasks.defaultExpectation.params) {
+			mmEvictOldestTasks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEvictOldestTasks.defaultExpectation.params)
+		}
+	}
+
+	return mmEvictOldestTasks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TasksRepository.EvictOldestTasks
+func (mmEvictOldestTasks *mTasksRepositoryMockEvictOldestTasks) ExpectCtxParam1(ctx context.Context) *mTasksRepositoryMockEvictOldestTasks {
+	if mmEvictOldestTasks.mock.funcEvictOldestTasks != nil {
+		mmEvictOldestTasks.mock.t.Fatalf("TasksRepositoryMock.EvictOldestTasks mock is already set by Set")
+	}
+
+	if mmEvictOldestTasks.defaultExpectation == nil {
+		mmEvictOldestTasks.defaultExpectation = &TasksRepositoryMockEvictOldestTasksExpectation{}
+	}
+
+	if mmEvictOldestTasks.defaultExpectation.params != nil {
+		mmEvictOldestTasks.mock.t.Fatalf("TasksRepositoryMock.EvictOldestTasks mock is already set by Expect")
+	}
+
+	if mmEvictOldestTasks.defaultExpectation.paramPtrs == nil {
+		mmEvictOldestTasks.defaultExpectation.paramPtrs = &TasksRepositoryMockEvictOldestTasksParamPtrs{}
+	}
+	mmEvictOldestTasks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEvictOldestTasks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmEvictOldestTasks
+}
+
+// ExpectMaxTasksParam2 sets up expected param maxTasks for TasksRepository.EvictOldestTasks
+func (mmEvictOldestTasks *mTasksRepositoryMockEvictOldestTasks) ExpectMaxTasksParam2(maxTasks int) *mTasksRepositoryMockEvictOldestTasks {
+	if mmEvictOldestTasks.mock.funcEvictOldestTasks != nil {
+		mmEvictOldestTasks.mock.t.Fatalf("TasksRepositoryMock.EvictOldestTasks mock is already set by Set")
+	}
+
+	if mmEvictOldestTasks.defaultExpectation == nil {
+		mmEvictOldestTasks.defaultExpectation = &TasksRepositoryMockEvictOldestTasksExpectation{}
+	}
+
+	if mmEvictOldestTasks.defaultExpectation.params != nil {
+		mmEvictOldestTasks.mock.t.Fatalf("TasksRepositoryMock.EvictOldestTasks mock is already set by Expect")
+	}
+
+	if mmEvictOldestTasks.defaultExpectation.paramPtrs == nil {
+		mmEvictOldestTasks.defaultExpectation.paramPtrs = &TasksRepositoryMockEvictOldestTasksParamPtrs{}
+	}
+	mmEvictOldestTasks.defaultExpectation.paramPtrs.maxTasks = &maxTasks
+	mmEvictOldestTasks.defaultExpectation.expectationOrigins.originMaxTasks = minimock.CallerInfo(1)
+
+	return mmEvictOldestTasks
+}
+
+// Inspect accepts an inspector function that has same arguments as the TasksRepository.EvictOldestTasks
+func (mmEvictOldestTasks *mTasksRepositoryMockEvictOldestTasks) Inspect(f func(ctx context.Context, maxTasks int)) *mTasksRepositoryMockEvictOldestTasks {
+	if mmEvictOldestTasks.mock.inspectFuncEvictOldestTasks != nil {
+		mmEvictOldestTasks.mock.t.Fatalf("Inspect function is already set for TasksRepositoryMock.EvictOldestTasks")
+	}
+
+	mmEvictOldestTasks.mock.inspectFuncEvictOldestTasks = f
+
+	return mmEvictOldestTasks
+}
+
+// Return sets up results that will be returned by TasksRepository.EvictOldestTasks
+func (mmEvictOldestTasks *mTasksRepositoryMockEvictOldestTasks) Return(ta1 []model.Task, err error) *TasksRepositoryMock {
+	if mmEvictOldestTasks.mock.funcEvictOldestTasks != nil {
+		mmEvictOldestTasks.mock.t.Fatalf("TasksRepositoryMock.EvictOldestTasks mock is already set by Set")
+	}
+
+	if mmEvictOldestTasks.defaultExpectation == nil {
+		mmEvictOldestTasks.defaultExpectation = &TasksRepositoryMockEvictOldestTasksExpectation{mock: mmEvictOldestTasks.mock}
+	}
+	mmEvictOldestTasks.defaultExpectation.results = &TasksRepositoryMockEvictOldestTasksResults{ta1, err}
+	mmEvictOldestTasks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEvictOldestTasks.mock
+}
+
+// Set uses given function f to mock the TasksRepository.EvictOldestTasks method
+func (mmEvictOldestTasks *mTasksRepositoryMockEvictOldestTasks) Set(f func(ctx context.Context, maxTasks int) (ta1 []model.Task, err error)) *TasksRepositoryMock {
+	if mmEvictOldestTasks.defaultExpectation != nil {
+		mmEvictOldestTasks.mock.t.Fatalf("Default expectation is already set for the TasksRepository.EvictOldestTasks method")
+	}
+
+	if len(mmEvictOldestTasks.expectations) > 0 {
+		mmEvictOldestTasks.mock.t.Fatalf("Some expectations are already set for the TasksRepository.EvictOldestTasks method")
+	}
+
+	mmEvictOldestTasks.mock.funcEvictOldestTasks = f
+	mmEvictOldestTasks.mock.funcEvictOldestTasksOrigin = minimock.CallerInfo(1)
+	return mmEvictOldestTasks.mock
+}
+
+// When sets expectation for the TasksRepository.EvictOldestTasks which will trigger the result defined by the following
+// Then helper
+func (mmEvictOldestTasks *mTasksRepositoryMockEvictOldestTasks) When(ctx context.Context, maxTasks int) *TasksRepositoryMockEvictOldestTasksExpectation {
+	if mmEvictOldestTasks.mock.funcEvictOldestTasks != nil {
+		mmEvictOldestTasks.mock.t.Fatalf("TasksRepositoryMock.EvictOldestTasks mock is already set by Set")
+	}
+
+	expectation := &TasksRepositoryMockEvictOldestTasksExpectation{
+		mock:               mmEvictOldestTasks.mock,
+		params:             &TasksRepositoryMockEvictOldestTasksParams{ctx, maxTasks},
+		expectationOrigins: TasksRepositoryMockEvictOldestTasksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEvictOldestTasks.expectations = append(mmEvictOldestTasks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TasksRepository.EvictOldestTasks return parameters for the expectation previously defined by the When method
+func (e *TasksRepositoryMockEvictOldestTasksExpectation) Then(ta1 []model.Task, err error) *TasksRepositoryMock {
+	e.results = &TasksRepositoryMockEvictOldestTasksResults{ta1, err}
+	return e.mock
+}
+
+// Times sets number of times TasksRepository.EvictOldestTasks should be invoked
+func (mmEvictOldestTasks *mTasksRepositoryMockEvictOldestTasks) Times(n uint64) *mTasksRepositoryMockEvictOldestTasks {
+	if n == 0 {
+		mmEvictOldestTasks.mock.t.Fatalf("Times of TasksRepositoryMock.EvictOldestTasks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEvictOldestTasks.expectedInvocations, n)
+	mmEvictOldestTasks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEvictOldestTasks
+}
+
+func (mmEvictOldestTasks *mTasksRepositoryMockEvictOldestTasks) invocationsDone() bool {
+	if len(mmEvictOldestTasks.expectations) == 0 && mmEvictOldestTasks.defaultExpectation == nil && mmEvictOldestTasks.mock.funcEvictOldestTasks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEvictOldestTasks.mock.afterEvictOldestTasksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEvictOldestTasks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// EvictOldestTasks implements mm_retention.TasksRepository
+func (mmEvictOldestTasks *TasksRepositoryMock) EvictOldestTasks(ctx context.Context, maxTasks int) (ta1 []model.Task, err error) {
+	mm_atomic.AddUint64(&mmEvictOldestTasks.beforeEvictOldestTasksCounter, 1)
+	defer mm_atomic.AddUint64(&mmEvictOldestTasks.afterEvictOldestTasksCounter, 1)
+
+	mmEvictOldestTasks.t.Helper()
+
+	if mmEvictOldestTasks.inspectFuncEvictOldestTasks != nil {
+		mmEvictOldestTasks.inspectFuncEvictOldestTasks(ctx, maxTasks)
+	}
+
+	mm_params := TasksRepositoryMockEvictOldestTasksParams{ctx, maxTasks}
+
+	// Record call args
+	mmEvictOldestTasks.EvictOldestTasksMock.mutex.Lock()
+	mmEvictOldestTasks.EvictOldestTasksMock.callArgs = append(mmEvictOldestTasks.EvictOldestTasksMock.callArgs, &mm_params)
+	mmEvictOldestTasks.EvictOldestTasksMock.mutex.Unlock()
+
+	for _, e := range mmEvictOldestTasks.EvictOldestTasksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ta1, e.results.err
+		}
+	}
+
+	if mmEvictOldestTasks.EvictOldestTasksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEvictOldestTasks.EvictOldestTasksMock.defaultExpectation.Counter, 1)
+		mm_want := mmEvictOldestTasks.EvictOldestTasksMock.defaultExpectation.params
+		mm_want_ptrs := mmEvictOldestTasks.EvictOldestTasksMock.defaultExpectation.paramPtrs
+
+		mm_got := TasksRepositoryMockEvictOldestTasksParams{ctx, maxTasks}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEvictOldestTasks.t.Errorf("TasksRepositoryMock.EvictOldestTasks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEvictOldestTasks.EvictOldestTasksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.maxTasks != nil && !minimock.Equal(*mm_want_ptrs.maxTasks, mm_got.maxTasks) {
+				mmEvictOldestTasks.t.Errorf("TasksRepositoryMock.EvictOldestTasks got unexpected parameter maxTasks, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEvictOldestTasks.EvictOldestTasksMock.defaultExpectation.expectationOrigins.originMaxTasks, *mm_want_ptrs.maxTasks, mm_got.maxTasks, minimock.Diff(*mm_want_ptrs.maxTasks, mm_got.maxTasks))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEvictOldestTasks.t.Errorf("TasksRepositoryMock.EvictOldestTasks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEvictOldestTasks.EvictOldestTasksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEvictOldestTasks.EvictOldestTasksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEvictOldestTasks.t.Fatal("No results are set for the TasksRepositoryMock.EvictOldestTasks")
+		}
+		return (*mm_results).ta1, (*mm_results).err
+	}
+	if mmEvictOldestTasks.funcEvictOldestTasks != nil {
+		return mmEvictOldestTasks.funcEvictOldestTasks(ctx, maxTasks)
+	}
+	mmEvictOldestTasks.t.Fatalf("Unexpected call to TasksRepositoryMock.EvictOldestTasks. %v %v", ctx, maxTasks)
+	return
+}
+
+// EvictOldestTasksAfterCounter returns a count of finished TasksRepositoryMock.EvictOldestTasks invocations
+func (mmEvictOldestTasks *TasksRepositoryMock) EvictOldestTasksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEvictOldestTasks.afterEvictOldestTasksCounter)
+}
+
+// EvictOldestTasksBeforeCounter returns a count of TasksRepositoryMock.EvictOldestTasks invocations
+func (mmEvictOldestTasks *TasksRepositoryMock) EvictOldestTasksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEvictOldestTasks.beforeEvictOldestTasksCounter)
+}
+
+// Calls returns a list of arguments used in each call to TasksRepositoryMock.EvictOldestTasks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEvictOldestTasks *mTasksRepositoryMockEvictOldestTasks) Calls() []*TasksRepositoryMockEvictOldestTasksParams {
+	mmEvictOldestTasks.mutex.RLock()
+
+	argCopy := make([]*TasksRepositoryMockEvictOldestTasksParams, len(mmEvictOldestTasks.callArgs))
+	copy(argCopy, mmEvictOldestTasks.callArgs)
+
+	mmEvictOldestTasks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEvictOldestTasksDone returns true if the count of the EvictOldestTasks invocations corresponds
+// the number of defined expectations
+func (m *TasksRepositoryMock) MinimockEvictOldestTasksDone() bool {
+	if m.EvictOldestTasksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EvictOldestTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EvictOldestTasksMock.invocationsDone()
+}
+
+// MinimockEvictOldestTasksInspect logs each unmet expectation
+func (m *TasksRepositoryMock) MinimockEvictOldestTasksInspect() {
+	for _, e := range m.EvictOldestTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TasksRepositoryMock.EvictOldestTasks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEvictOldestTasksCounter := mm_atomic.LoadUint64(&m.afterEvictOldestTasksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EvictOldestTasksMock.defaultExpectation != nil && afterEvictOldestTasksCounter < 1 {
+		if m.EvictOldestTasksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksRepositoryMock.EvictOldestTasks at\n%s", m.EvictOldestTasksMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TasksRepositoryMock.EvictOldestTasks at\n%s with params: %#v", m.EvictOldestTasksMock.defaultExpectation.expectationOrigins.origin, *m.EvictOldestTasksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEvictOldestTasks != nil && afterEvictOldestTasksCounter < 1 {
+		m.t.Errorf("Expected call to TasksRepositoryMock.EvictOldestTasks at\n%s", m.funcEvictOldestTasksOrigin)
+	}
+
+	if !m.EvictOldestTasksMock.invocationsDone() && afterEvictOldestTasksCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksRepositoryMock.EvictOldestTasks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EvictOldestTasksMock.expectedInvocations), m.EvictOldestTasksMock.expectedInvocationsOrigin, afterEvictOldestTasksCounter)
+	}
+}
+
+type mTasksRepositoryMockPurgeDeletedTasks struct {
+	optional           bool
+	mock               *TasksRepositoryMock
+	defaultExpectation *TasksRepositoryMockPurgeDeletedTasksExpectation
+	expectations       []*TasksRepositoryMockPurgeDeletedTasksExpectation
+
+	callArgs []*TasksRepositoryMockPurgeDeletedTasksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TasksRepositoryMockPurgeDeletedTasksExpectation specifies expectation struct of the TasksRepository.PurgeDeletedTasks
+type TasksRepositoryMockPurgeDeletedTasksExpectation struct {
+	mock               *TasksRepositoryMock
+	params             *TasksRepositoryMockPurgeDeletedTasksParams
+	paramPtrs          *TasksRepositoryMockPurgeDeletedTasksParamPtrs
+	expectationOrigins TasksRepositoryMockPurgeDeletedTasksExpectationOrigins
+	results            *TasksRepositoryMockPurgeDeletedTasksResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TasksRepositoryMockPurgeDeletedTasksParams contains parameters of the TasksRepository.PurgeDeletedTasks
+type TasksRepositoryMockPurgeDeletedTasksParams struct {
+	ctx    context.Context
+	before time.Time
+}
+
+// TasksRepositoryMockPurgeDeletedTasksParamPtrs contains pointers to parameters of the TasksRepository.PurgeDeletedTasks
+type TasksRepositoryMockPurgeDeletedTasksParamPtrs struct {
+	ctx    *context.Context
+	before *time.Time
+}
+
+// TasksRepositoryMockPurgeDeletedTasksResults contains results of the TasksRepository.PurgeDeletedTasks
+type TasksRepositoryMockPurgeDeletedTasksResults struct {
+	ta1 []model.Task
+	err error
+}
+
+// TasksRepositoryMockPurgeDeletedTasksOrigins contains origins of expectations of the TasksRepository.PurgeDeletedTasks
+type TasksRepositoryMockPurgeDeletedTasksExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originBefore string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeDeletedTasks *mTasksRepositoryMockPurgeDeletedTasks) Optional() *mTasksRepositoryMockPurgeDeletedTasks {
+	mmPurgeDeletedTasks.optional = true
+	return mmPurgeDeletedTasks
+}
+
+// Expect sets up expected params for TasksRepository.PurgeDeletedTasks
+func (mmPurgeDeletedTasks *mTasksRepositoryMockPurgeDeletedTasks) Expect(ctx context.Context, before time.Time) *mTasksRepositoryMockPurgeDeletedTasks {
+	if mmPurgeDeletedTasks.mock.funcPurgeDeletedTasks != nil {
+		mmPurgeDeletedTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeDeletedTasks mock is already set by Set")
+	}
+
+	if mmPurgeDeletedTasks.defaultExpectation == nil {
+		mmPurgeDeletedTasks.defaultExpectation = &TasksRepositoryMockPurgeDeletedTasksExpectation{}
+	}
+
+	if mmPurgeDeletedTasks.defaultExpectation.paramPtrs != nil {
+		mmPurgeDeletedTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeDeletedTasks mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeDeletedTasks.defaultExpectation.params = &TasksRepositoryMockPurgeDeletedTasksParams{ctx, before}
+	mmPurgeDeletedTasks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeDeletedTasks.expectations {
+		if minimock.Equal(e.params, mmPurgeDeletedTasks.defaultExpectation.params) {
+			mmPurgeDeletedTasks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeDeletedTasks.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeDeletedTasks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TasksRepository.PurgeDeletedTasks
+func (mmPurgeDeletedTasks *mTasksRepositoryMockPurgeDeletedTasks) ExpectCtxParam1(ctx context.Context) *mTasksRepositoryMockPurgeDeletedTasks {
+	if mmPurgeDeletedTasks.mock.funcPurgeDeletedTasks != nil {
+		mmPurgeDeletedTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeDeletedTasks mock is already set by Set")
+	}
+
+	if mmPurgeDeletedTasks.defaultExpectation == nil {
+		mmPurgeDeletedTasks.defaultExpectation = &TasksRepositoryMockPurgeDeletedTasksExpectation{}
+	}
+
+	if mmPurgeDeletedTasks.defaultExpectation.params != nil {
+		mmPurgeDeletedTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeDeletedTasks mock is already set by Expect")
+	}
+
+	if mmPurgeDeletedTasks.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeletedTasks.defaultExpectation.paramPtrs = &TasksRepositoryMockPurgeDeletedTasksParamPtrs{}
+	}
+	mmPurgeDeletedTasks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeDeletedTasks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeDeletedTasks
+}
+
+// ExpectBeforeParam2 sets up expected param before for TasksRepository.PurgeDeletedTasks
+func (mmPurgeDeletedTasks *mTasksRepositoryMockPurgeDeletedTasks) ExpectBeforeParam2(before time.Time) *mTasksRepositoryMockPurgeDeletedTasks {
+	if mmPurgeDeletedTasks.mock.funcPurgeDeletedTasks != nil {
+		mmPurgeDeletedTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeDeletedTasks mock is already set by Set")
+	}
+
+	if mmPurgeDeletedTasks.defaultExpectation == nil {
+		mmPurgeDeletedTasks.defaultExpectation = &TasksRepositoryMockPurgeDeletedTasksExpectation{}
+	}
+
+	if mmPurgeDeletedTasks.defaultExpectation.params != nil {
+		mmPurgeDeletedTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeDeletedTasks mock is already set by Expect")
+	}
+
+	if mmPurgeDeletedTasks.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeletedTasks.defaultExpectation.paramPtrs = &TasksRepositoryMockPurgeDeletedTasksParamPtrs{}
+	}
+	mmPurgeDeletedTasks.defaultExpectation.paramPtrs.before = &before
+	mmPurgeDeletedTasks.defaultExpectation.expectationOrigins.originBefore = minimock.CallerInfo(1)
+
+	return mmPurgeDeletedTasks
+}
+
+// Inspect accepts an inspector function that has same arguments as the TasksRepository.PurgeDeletedTasks
+func (mmPurgeDeletedTasks *mTasksRepositoryMockPurgeDeletedTasks) Inspect(f func(ctx context.Context, before time.Time)) *mTasksRepositoryMockPurgeDeletedTasks {
+	if mmPurgeDeletedTasks.mock.inspectFuncPurgeDeletedTasks != nil {
+		mmPurgeDeletedTasks.mock.t.Fatalf("Inspect function is already set for TasksRepositoryMock.PurgeDeletedTasks")
+	}
+
+	mmPurgeDeletedTasks.mock.inspectFuncPurgeDeletedTasks = f
+
+	return mmPurgeDeletedTasks
+}
+
+// Return sets up results that will be returned by TasksRepository.PurgeDeletedTasks
+func (mmPurgeDeletedTasks *mTasksRepositoryMockPurgeDeletedTasks) Return(ta1 []model.Task, err error) *TasksRepositoryMock {
+	if mmPurgeDeletedTasks.mock.funcPurgeDeletedTasks != nil {
+		mmPurgeDeletedTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeDeletedTasks mock is already set by Set")
+	}
+
+	if mmPurgeDeletedTasks.defaultExpectation == nil {
+		mmPurgeDeletedTasks.defaultExpectation = &TasksRepositoryMockPurgeDeletedTasksExpectation{mock: mmPurgeDeletedTasks.mock}
+	}
+	mmPurgeDeletedTasks.defaultExpectation.results = &TasksRepositoryMockPurgeDeletedTasksResults{ta1, err}
+	mmPurgeDeletedTasks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeletedTasks.mock
+}
+
+// Set uses given function f to mock the TasksRepository.PurgeDeletedTasks method
+func (mmPurgeDeletedTasks *mTasksRepositoryMockPurgeDeletedTasks) Set(f func(ctx context.Context, before time.Time) (ta1 []model.Task, err error)) *TasksRepositoryMock {
+	if mmPurgeDeletedTasks.defaultExpectation != nil {
+		mmPurgeDeletedTasks.mock.t.Fatalf("Default expectation is already set for the TasksRepository.PurgeDeletedTasks method")
+	}
+
+	if len(mmPurgeDeletedTasks.expectations) > 0 {
+		mmPurgeDeletedTasks.mock.t.Fatalf("Some expectations are already set for the TasksRepository.PurgeDeletedTasks method")
+	}
+
+	mmPurgeDeletedTasks.mock.funcPurgeDeletedTasks = f
+	mmPurgeDeletedTasks.mock.funcPurgeDeletedTasksOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeletedTasks.mock
+}
+
+// When sets expectation for the TasksRepository.PurgeDeletedTasks which will trigger the result defined by the following
+// Then helper
+func (mmPurgeDeletedTasks *mTasksRepositoryMockPurgeDeletedTasks) When(ctx context.Context, before time.Time) *TasksRepositoryMockPurgeDeletedTasksExpectation {
+	if mmPurgeDeletedTasks.mock.funcPurgeDeletedTasks != nil {
+		mmPurgeDeletedTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeDeletedTasks mock is already set by Set")
+	}
+
+	expectation := &TasksRepositoryMockPurgeDeletedTasksExpectation{
+		mock:               mmPurgeDeletedTasks.mock,
+		params:             &TasksRepositoryMockPurgeDeletedTasksParams{ctx, before},
+		expectationOrigins: TasksRepositoryMockPurgeDeletedTasksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeDeletedTasks.expectations = append(mmPurgeDeletedTasks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TasksRepository.PurgeDeletedTasks return parameters for the expectation previously defined by the When method
+func (e *TasksRepositoryMockPurgeDeletedTasksExpectation) Then(ta1 []model.Task, err error) *TasksRepositoryMock {
+	e.results = &TasksRepositoryMockPurgeDeletedTasksResults{ta1, err}
+	return e.mock
+}
+
+// Times sets number of times TasksRepository.PurgeDeletedTasks should be invoked
+func (mmPurgeDeletedTasks *mTasksRepositoryMockPurgeDeletedTasks) Times(n uint64) *mTasksRepositoryMockPurgeDeletedTasks {
+	if n == 0 {
+		mmPurgeDeletedTasks.mock.t.Fatalf("Times of TasksRepositoryMock.PurgeDeletedTasks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeDeletedTasks.expectedInvocations, n)
+	mmPurgeDeletedTasks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeletedTasks
+}
+
+func (mmPurgeDeletedTasks *mTasksRepositoryMockPurgeDeletedTasks) invocationsDone() bool {
+	if len(mmPurgeDeletedTasks.expectations) == 0 && mmPurgeDeletedTasks.defaultExpectation == nil && mmPurgeDeletedTasks.mock.funcPurgeDeletedTasks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeDeletedTasks.mock.afterPurgeDeletedTasksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeDeletedTasks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeDeletedTasks implements mm_retention.TasksRepository
+func (mmPurgeDeletedTasks *TasksRepositoryMock) PurgeDeletedTasks(ctx context.Context, before time.Time) (ta1 []model.Task, err error) {
+	mm_atomic.AddUint64(&mmPurgeDeletedTasks.beforePurgeDeletedTasksCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeDeletedTasks.afterPurgeDeletedTasksCounter, 1)
+
+	mmPurgeDeletedTasks.t.Helper()
+
+	if mmPurgeDeletedTasks.inspectFuncPurgeDeletedTasks != nil {
+		mmPurgeDeletedTasks.inspectFuncPurgeDeletedTasks(ctx, before)
+	}
+
+	mm_params := TasksRepositoryMockPurgeDeletedTasksParams{ctx, before}
+
+	// Record call args
+	mmPurgeDeletedTasks.PurgeDeletedTasksMock.mutex.Lock()
+	mmPurgeDeletedTasks.PurgeDeletedTasksMock.callArgs = append(mmPurgeDeletedTasks.PurgeDeletedTasksMock.callArgs, &mm_params)
+	mmPurgeDeletedTasks.PurgeDeletedTasksMock.mutex.Unlock()
+
+	for _, e := range mmPurgeDeletedTasks.PurgeDeletedTasksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ta1, e.results.err
+		}
+	}
+
+	if mmPurgeDeletedTasks.PurgeDeletedTasksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeDeletedTasks.PurgeDeletedTasksMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeDeletedTasks.PurgeDeletedTasksMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeDeletedTasks.PurgeDeletedTasksMock.defaultExpectation.paramPtrs
+
+		mm_got := TasksRepositoryMockPurgeDeletedTasksParams{ctx, before}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeDeletedTasks.t.Errorf("TasksRepositoryMock.PurgeDeletedTasks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeletedTasks.PurgeDeletedTasksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.before != nil && !minimock.Equal(*mm_want_ptrs.before, mm_got.before) {
+				mmPurgeDeletedTasks.t.Errorf("TasksRepositoryMock.PurgeDeletedTasks got unexpected parameter before, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeletedTasks.PurgeDeletedTasksMock.defaultExpectation.expectationOrigins.originBefore, *mm_want_ptrs.before, mm_got.before, minimock.Diff(*mm_want_ptrs.before, mm_got.before))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeDeletedTasks.t.Errorf("TasksRepositoryMock.PurgeDeletedTasks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeDeletedTasks.PurgeDeletedTasksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeDeletedTasks.PurgeDeletedTasksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeDeletedTasks.t.Fatal("No results are set for the TasksRepositoryMock.PurgeDeletedTasks")
+		}
+		return (*mm_results).ta1, (*mm_results).err
+	}
+	if mmPurgeDeletedTasks.funcPurgeDeletedTasks != nil {
+		return mmPurgeDeletedTasks.funcPurgeDeletedTasks(ctx, before)
+	}
+	mmPurgeDeletedTasks.t.Fatalf("Unexpected call to TasksRepositoryMock.PurgeDeletedTasks. %v %v", ctx, before)
+	return
+}
+
+// PurgeDeletedTasksAfterCounter returns a count of finished TasksRepositoryMock.PurgeDeletedTasks invocations
+func (mmPurgeDeletedTasks *TasksRepositoryMock) PurgeDeletedTasksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeletedTasks.afterPurgeDeletedTasksCounter)
+}
+
+// PurgeDeletedTasksBeforeCounter returns a count of TasksRepositoryMock.PurgeDeletedTasks invocations
+func (mmPurgeDeletedTasks *TasksRepositoryMock) PurgeDeletedTasksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeletedTasks.beforePurgeDeletedTasksCounter)
+}
+
+// Calls returns a list of arguments used in each call to TasksRepositoryMock.PurgeDeletedTasks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeDeletedTasks *mTasksRepositoryMockPurgeDeletedTasks) Calls() []*TasksRepositoryMockPurgeDeletedTasksParams {
+	mmPurgeDeletedTasks.mutex.RLock()
+
+	argCopy := make([]*TasksRepositoryMockPurgeDeletedTasksParams, len(mmPurgeDeletedTasks.callArgs))
+	copy(argCopy, mmPurgeDeletedTasks.callArgs)
+
+	mmPurgeDeletedTasks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeDeletedTasksDone returns true if the count of the PurgeDeletedTasks invocations corresponds
+// the number of defined expectations
+func (m *TasksRepositoryMock) MinimockPurgeDeletedTasksDone() bool {
+	if m.PurgeDeletedTasksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeDeletedTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeDeletedTasksMock.invocationsDone()
+}
+
+// MinimockPurgeDeletedTasksInspect logs each unmet expectation
+func (m *TasksRepositoryMock) MinimockPurgeDeletedTasksInspect() {
+	for _, e := range m.PurgeDeletedTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TasksRepositoryMock.PurgeDeletedTasks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeDeletedTasksCounter := mm_atomic.LoadUint64(&m.afterPurgeDeletedTasksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeDeletedTasksMock.defaultExpectation != nil && afterPurgeDeletedTasksCounter < 1 {
+		if m.PurgeDeletedTasksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksRepositoryMock.PurgeDeletedTasks at\n%s", m.PurgeDeletedTasksMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TasksRepositoryMock.PurgeDeletedTasks at\n%s with params: %#v", m.PurgeDeletedTasksMock.defaultExpectation.expectationOrigins.origin, *m.PurgeDeletedTasksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeDeletedTasks != nil && afterPurgeDeletedTasksCounter < 1 {
+		m.t.Errorf("Expected call to TasksRepositoryMock.PurgeDeletedTasks at\n%s", m.funcPurgeDeletedTasksOrigin)
+	}
+
+	if !m.PurgeDeletedTasksMock.invocationsDone() && afterPurgeDeletedTasksCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksRepositoryMock.PurgeDeletedTasks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeDeletedTasksMock.expectedInvocations), m.PurgeDeletedTasksMock.expectedInvocationsOrigin, afterPurgeDeletedTasksCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *TasksRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDeleteExpiredTasksInspect()
+
+			m.MinimockEvictOldestTasksInspect()
+
+			m.MinimockPurgeDeletedTasksInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *TasksRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *TasksRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeleteExpiredTasksDone() &&
+		m.MinimockEvictOldestTasksDone() &&
+		m.MinimockPurgeDeletedTasksDone()
+}
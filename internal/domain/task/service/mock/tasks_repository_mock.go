@@ -19,20 +19,41 @@ type TasksRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcCreateTask          func(ctx context.Context, task model.Task) (err error)
+	funcCreateTask          func(ctx context.Context, task model.Task) (t1 model.TaskChange, err error)
 	funcCreateTaskOrigin    string
 	inspectFuncCreateTask   func(ctx context.Context, task model.Task)
 	afterCreateTaskCounter  uint64
 	beforeCreateTaskCounter uint64
 	CreateTaskMock          mTasksRepositoryMockCreateTask
 
-	funcDeleteTask          func(ctx context.Context, id string) (err error)
+	funcCreateTasks          func(ctx context.Context, tasks []model.Task) (ta1 []model.TaskChange, ea1 []error)
+	funcCreateTasksOrigin    string
+	inspectFuncCreateTasks   func(ctx context.Context, tasks []model.Task)
+	afterCreateTasksCounter  uint64
+	beforeCreateTasksCounter uint64
+	CreateTasksMock          mTasksRepositoryMockCreateTasks
+
+	funcDeleteTask          func(ctx context.Context, id string, version *int64, owner string) (t1 model.TaskChange, err error)
 	funcDeleteTaskOrigin    string
-	inspectFuncDeleteTask   func(ctx context.Context, id string)
+	inspectFuncDeleteTask   func(ctx context.Context, id string, version *int64, owner string)
 	afterDeleteTaskCounter  uint64
 	beforeDeleteTaskCounter uint64
 	DeleteTaskMock          mTasksRepositoryMockDeleteTask
 
+	funcDeleteTasks          func(ctx context.Context, ids []string, owner string) (ta1 []model.TaskChange, ea1 []error)
+	funcDeleteTasksOrigin    string
+	inspectFuncDeleteTasks   func(ctx context.Context, ids []string, owner string)
+	afterDeleteTasksCounter  uint64
+	beforeDeleteTasksCounter uint64
+	DeleteTasksMock          mTasksRepositoryMockDeleteTasks
+
+	funcGetDeletedTask          func(ctx context.Context, id string) (tp1 *model.Task, err error)
+	funcGetDeletedTaskOrigin    string
+	inspectFuncGetDeletedTask   func(ctx context.Context, id string)
+	afterGetDeletedTaskCounter  uint64
+	beforeGetDeletedTaskCounter uint64
+	GetDeletedTaskMock          mTasksRepositoryMockGetDeletedTask
+
 	funcGetTask          func(ctx context.Context, id string) (tp1 *model.Task, err error)
 	funcGetTaskOrigin    string
 	inspectFuncGetTask   func(ctx context.Context, id string)
@@ -40,9 +61,51 @@ type TasksRepositoryMock struct {
 	beforeGetTaskCounter uint64
 	GetTaskMock          mTasksRepositoryMockGetTask
 
-	funcUpdateTask          func(ctx context.Context, id string, status model.Status) (err error)
+	funcListTasks          func(ctx context.Context, selector model.LabelSelector) (ta1 []model.Task, err error)
+	funcListTasksOrigin    string
+	inspectFuncListTasks   func(ctx context.Context, selector model.LabelSelector)
+	afterListTasksCounter  uint64
+	beforeListTasksCounter uint64
+	ListTasksMock          mTasksRepositoryMockListTasks
+
+	funcPatchTask          func(ctx context.Context, id string, apply func(task *model.Task) error) (t1 model.TaskChange, err error)
+	funcPatchTaskOrigin    string
+	inspectFuncPatchTask   func(ctx context.Context, id string, apply func(task *model.Task) error)
+	afterPatchTaskCounter  uint64
+	beforePatchTaskCounter uint64
+	PatchTaskMock          mTasksRepositoryMockPatchTask
+
+	funcPurgeTasks          func(ctx context.Context, ids []string) (ta1 []model.TaskChange, ea1 []error)
+	funcPurgeTasksOrigin    string
+	inspectFuncPurgeTasks   func(ctx context.Context, ids []string)
+	afterPurgeTasksCounter  uint64
+	beforePurgeTasksCounter uint64
+	PurgeTasksMock          mTasksRepositoryMockPurgeTasks
+
+	funcRestoreTask          func(ctx context.Context, id string, owner string) (t1 model.TaskChange, err error)
+	funcRestoreTaskOrigin    string
+	inspectFuncRestoreTask   func(ctx context.Context, id string, owner string)
+	afterRestoreTaskCounter  uint64
+	beforeRestoreTaskCounter uint64
+	RestoreTaskMock          mTasksRepositoryMockRestoreTask
+
+	funcSearchTasks          func(ctx context.Context, query string, limit int) (ta1 []model.Task, err error)
+	funcSearchTasksOrigin    string
+	inspectFuncSearchTasks   func(ctx context.Context, query string, limit int)
+	afterSearchTasksCounter  uint64
+	beforeSearchTasksCounter uint64
+	SearchTasksMock          mTasksRepositoryMockSearchTasks
+
+	funcUnfinishedTasks          func(ctx context.Context) (ta1 []model.Task, err error)
+	funcUnfinishedTasksOrigin    string
+	inspectFuncUnfinishedTasks   func(ctx context.Context)
+	afterUnfinishedTasksCounter  uint64
+	beforeUnfinishedTasksCounter uint64
+	UnfinishedTasksMock          mTasksRepositoryMockUnfinishedTasks
+
+	funcUpdateTask          func(ctx context.Context, id string, update model.TaskUpdate) (t1 model.TaskChange, err error)
 	funcUpdateTaskOrigin    string
-	inspectFuncUpdateTask   func(ctx context.Context, id string, status model.Status)
+	inspectFuncUpdateTask   func(ctx context.Context, id string, update model.TaskUpdate)
 	afterUpdateTaskCounter  uint64
 	beforeUpdateTaskCounter uint64
 	UpdateTaskMock          mTasksRepositoryMockUpdateTask
@@ -59,12 +122,39 @@ func NewTasksRepositoryMock(t minimock.Tester) *TasksRepositoryMock {
 	m.CreateTaskMock = mTasksRepositoryMockCreateTask{mock: m}
 	m.CreateTaskMock.callArgs = []*TasksRepositoryMockCreateTaskParams{}
 
+	m.CreateTasksMock = mTasksRepositoryMockCreateTasks{mock: m}
+	m.CreateTasksMock.callArgs = []*TasksRepositoryMockCreateTasksParams{}
+
 	m.DeleteTaskMock = mTasksRepositoryMockDeleteTask{mock: m}
 	m.DeleteTaskMock.callArgs = []*TasksRepositoryMockDeleteTaskParams{}
 
+	m.DeleteTasksMock = mTasksRepositoryMockDeleteTasks{mock: m}
+	m.DeleteTasksMock.callArgs = []*TasksRepositoryMockDeleteTasksParams{}
+
+	m.GetDeletedTaskMock = mTasksRepositoryMockGetDeletedTask{mock: m}
+	m.GetDeletedTaskMock.callArgs = []*TasksRepositoryMockGetDeletedTaskParams{}
+
 	m.GetTaskMock = mTasksRepositoryMockGetTask{mock: m}
 	m.GetTaskMock.callArgs = []*TasksRepositoryMockGetTaskParams{}
 
+	m.ListTasksMock = mTasksRepositoryMockListTasks{mock: m}
+	m.ListTasksMock.callArgs = []*TasksRepositoryMockListTasksParams{}
+
+	m.PatchTaskMock = mTasksRepositoryMockPatchTask{mock: m}
+	m.PatchTaskMock.callArgs = []*TasksRepositoryMockPatchTaskParams{}
+
+	m.PurgeTasksMock = mTasksRepositoryMockPurgeTasks{mock: m}
+	m.PurgeTasksMock.callArgs = []*TasksRepositoryMockPurgeTasksParams{}
+
+	m.RestoreTaskMock = mTasksRepositoryMockRestoreTask{mock: m}
+	m.RestoreTaskMock.callArgs = []*TasksRepositoryMockRestoreTaskParams{}
+
+	m.SearchTasksMock = mTasksRepositoryMockSearchTasks{mock: m}
+	m.SearchTasksMock.callArgs = []*TasksRepositoryMockSearchTasksParams{}
+
+	m.UnfinishedTasksMock = mTasksRepositoryMockUnfinishedTasks{mock: m}
+	m.UnfinishedTasksMock.callArgs = []*TasksRepositoryMockUnfinishedTasksParams{}
+
 	m.UpdateTaskMock = mTasksRepositoryMockUpdateTask{mock: m}
 	m.UpdateTaskMock.callArgs = []*TasksRepositoryMockUpdateTaskParams{}
 
@@ -111,6 +201,7 @@ type TasksRepositoryMockCreateTaskParamPtrs struct {
 
 // TasksRepositoryMockCreateTaskResults contains results of the TasksRepository.CreateTask
 type TasksRepositoryMockCreateTaskResults struct {
+	t1  model.TaskChange
 	err error
 }
 
@@ -214,7 +305,7 @@ func (mmCreateTask *mTasksRepositoryMockCreateTask) Inspect(f func(ctx context.C
 }
 
 // Return sets up results that will be returned by TasksRepository.CreateTask
-func (mmCreateTask *mTasksRepositoryMockCreateTask) Return(err error) *TasksRepositoryMock {
+func (mmCreateTask *mTasksRepositoryMockCreateTask) Return(t1 model.TaskChange, err error) *TasksRepositoryMock {
 	if mmCreateTask.mock.funcCreateTask != nil {
 		mmCreateTask.mock.t.Fatalf("TasksRepositoryMock.CreateTask mock is already set by Set")
 	}
@@ -222,13 +313,13 @@ func (mmCreateTask *mTasksRepositoryMockCreateTask) Return(err error) *TasksRepo
 	if mmCreateTask.defaultExpectation == nil {
 		mmCreateTask.defaultExpectation = &TasksRepositoryMockCreateTaskExpectation{mock: mmCreateTask.mock}
 	}
-	mmCreateTask.defaultExpectation.results = &TasksRepositoryMockCreateTaskResults{err}
+	mmCreateTask.defaultExpectation.results = &TasksRepositoryMockCreateTaskResults{t1, err}
 	mmCreateTask.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
 	return mmCreateTask.mock
 }
 
 // Set uses given function f to mock the TasksRepository.CreateTask method
-func (mmCreateTask *mTasksRepositoryMockCreateTask) Set(f func(ctx context.Context, task model.Task) (err error)) *TasksRepositoryMock {
+func (mmCreateTask *mTasksRepositoryMockCreateTask) Set(f func(ctx context.Context, task model.Task) (t1 model.TaskChange, err error)) *TasksRepositoryMock {
 	if mmCreateTask.defaultExpectation != nil {
 		mmCreateTask.mock.t.Fatalf("Default expectation is already set for the TasksRepository.CreateTask method")
 	}
@@ -259,8 +350,8 @@ func (mmCreateTask *mTasksRepositoryMockCreateTask) When(ctx context.Context, ta
 }
 
 // Then sets up TasksRepository.CreateTask return parameters for the expectation previously defined by the When method
-func (e *TasksRepositoryMockCreateTaskExpectation) Then(err error) *TasksRepositoryMock {
-	e.results = &TasksRepositoryMockCreateTaskResults{err}
+func (e *TasksRepositoryMockCreateTaskExpectation) Then(t1 model.TaskChange, err error) *TasksRepositoryMock {
+	e.results = &TasksRepositoryMockCreateTaskResults{t1, err}
 	return e.mock
 }
 
@@ -286,7 +377,7 @@ func (mmCreateTask *mTasksRepositoryMockCreateTask) invocationsDone() bool {
 }
 
 // CreateTask implements mm_service.TasksRepository
-func (mmCreateTask *TasksRepositoryMock) CreateTask(ctx context.Context, task model.Task) (err error) {
+func (mmCreateTask *TasksRepositoryMock) CreateTask(ctx context.Context, task model.Task) (t1 model.TaskChange, err error) {
 	mm_atomic.AddUint64(&mmCreateTask.beforeCreateTaskCounter, 1)
 	defer mm_atomic.AddUint64(&mmCreateTask.afterCreateTaskCounter, 1)
 
@@ -306,7 +397,7 @@ func (mmCreateTask *TasksRepositoryMock) CreateTask(ctx context.Context, task mo
 	for _, e := range mmCreateTask.CreateTaskMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.t1, e.results.err
 		}
 	}
 
@@ -338,7 +429,7 @@ func (mmCreateTask *TasksRepositoryMock) CreateTask(ctx context.Context, task mo
 		if mm_results == nil {
 			mmCreateTask.t.Fatal("No results are set for the TasksRepositoryMock.CreateTask")
 		}
-		return (*mm_results).err
+		return (*mm_results).t1, (*mm_results).err
 	}
 	if mmCreateTask.funcCreateTask != nil {
 		return mmCreateTask.funcCreateTask(ctx, task)
@@ -415,6 +506,349 @@ func (m *TasksRepositoryMock) MinimockCreateTaskInspect() {
 	}
 }
 
+type mTasksRepositoryMockCreateTasks struct {
+	optional           bool
+	mock               *TasksRepositoryMock
+	defaultExpectation *TasksRepositoryMockCreateTasksExpectation
+	expectations       []*TasksRepositoryMockCreateTasksExpectation
+
+	callArgs []*TasksRepositoryMockCreateTasksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TasksRepositoryMockCreateTasksExpectation specifies expectation struct of the TasksRepository.CreateTasks
+type TasksRepositoryMockCreateTasksExpectation struct {
+	mock               *TasksRepositoryMock
+	params             *TasksRepositoryMockCreateTasksParams
+	paramPtrs          *TasksRepositoryMockCreateTasksParamPtrs
+	expectationOrigins TasksRepositoryMockCreateTasksExpectationOrigins
+	results            *TasksRepositoryMockCreateTasksResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TasksRepositoryMockCreateTasksParams contains parameters of the TasksRepository.CreateTasks
+type TasksRepositoryMockCreateTasksParams struct {
+	ctx   context.Context
+	tasks []model.Task
+}
+
+// TasksRepositoryMockCreateTasksParamPtrs contains pointers to parameters of the TasksRepository.CreateTasks
+type TasksRepositoryMockCreateTasksParamPtrs struct {
+	ctx   *context.Context
+	tasks *[]model.Task
+}
+
+// TasksRepositoryMockCreateTasksResults contains results of the TasksRepository.CreateTasks
+type TasksRepositoryMockCreateTasksResults struct {
+	ta1 []model.TaskChange
+	ea1 []error
+}
+
+// TasksRepositoryMockCreateTasksOrigins contains origins of expectations of the TasksRepository.CreateTasks
+type TasksRepositoryMockCreateTasksExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originTasks string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateTasks *mTasksRepositoryMockCreateTasks) Optional() *mTasksRepositoryMockCreateTasks {
+	mmCreateTasks.optional = true
+	return mmCreateTasks
+}
+
+// Expect sets up expected params for TasksRepository.CreateTasks
+func (mmCreateTasks *mTasksRepositoryMockCreateTasks) Expect(ctx context.Context, tasks []model.Task) *mTasksRepositoryMockCreateTasks {
+	if mmCreateTasks.mock.funcCreateTasks != nil {
+		mmCreateTasks.mock.t.Fatalf("TasksRepositoryMock.CreateTasks mock is already set by Set")
+	}
+
+	if mmCreateTasks.defaultExpectation == nil {
+		mmCreateTasks.defaultExpectation = &TasksRepositoryMockCreateTasksExpectation{}
+	}
+
+	if mmCreateTasks.defaultExpectation.paramPtrs != nil {
+		mmCreateTasks.mock.t.Fatalf("TasksRepositoryMock.CreateTasks mock is already set by ExpectParams functions")
+	}
+
+	mmCreateTasks.defaultExpectation.params = &TasksRepositoryMockCreateTasksParams{ctx, tasks}
+	mmCreateTasks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateTasks.expectations {
+		if minimock.Equal(e.params, mmCreateTasks.defaultExpectation.params) {
+			mmCreateTasks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateTasks.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateTasks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TasksRepository.CreateTasks
+func (mmCreateTasks *mTasksRepositoryMockCreateTasks) ExpectCtxParam1(ctx context.Context) *mTasksRepositoryMockCreateTasks {
+	if mmCreateTasks.mock.funcCreateTasks != nil {
+		mmCreateTasks.mock.t.Fatalf("TasksRepositoryMock.CreateTasks mock is already set by Set")
+	}
+
+	if mmCreateTasks.defaultExpectation == nil {
+		mmCreateTasks.defaultExpectation = &TasksRepositoryMockCreateTasksExpectation{}
+	}
+
+	if mmCreateTasks.defaultExpectation.params != nil {
+		mmCreateTasks.mock.t.Fatalf("TasksRepositoryMock.CreateTasks mock is already set by Expect")
+	}
+
+	if mmCreateTasks.defaultExpectation.paramPtrs == nil {
+		mmCreateTasks.defaultExpectation.paramPtrs = &TasksRepositoryMockCreateTasksParamPtrs{}
+	}
+	mmCreateTasks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateTasks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateTasks
+}
+
+// ExpectTasksParam2 sets up expected param tasks for TasksRepository.CreateTasks
+func (mmCreateTasks *mTasksRepositoryMockCreateTasks) ExpectTasksParam2(tasks []model.Task) *mTasksRepositoryMockCreateTasks {
+	if mmCreateTasks.mock.funcCreateTasks != nil {
+		mmCreateTasks.mock.t.Fatalf("TasksRepositoryMock.CreateTasks mock is already set by Set")
+	}
+
+	if mmCreateTasks.defaultExpectation == nil {
+		mmCreateTasks.defaultExpectation = &TasksRepositoryMockCreateTasksExpectation{}
+	}
+
+	if mmCreateTasks.defaultExpectation.params != nil {
+		mmCreateTasks.mock.t.Fatalf("TasksRepositoryMock.CreateTasks mock is already set by Expect")
+	}
+
+	if mmCreateTasks.defaultExpectation.paramPtrs == nil {
+		mmCreateTasks.defaultExpectation.paramPtrs = &TasksRepositoryMockCreateTasksParamPtrs{}
+	}
+	mmCreateTasks.defaultExpectation.paramPtrs.tasks = &tasks
+	mmCreateTasks.defaultExpectation.expectationOrigins.originTasks = minimock.CallerInfo(1)
+
+	return mmCreateTasks
+}
+
+// Inspect accepts an inspector function that has same arguments as the TasksRepository.CreateTasks
+func (mmCreateTasks *mTasksRepositoryMockCreateTasks) Inspect(f func(ctx context.Context, tasks []model.Task)) *mTasksRepositoryMockCreateTasks {
+	if mmCreateTasks.mock.inspectFuncCreateTasks != nil {
+		mmCreateTasks.mock.t.Fatalf("Inspect function is already set for TasksRepositoryMock.CreateTasks")
+	}
+
+	mmCreateTasks.mock.inspectFuncCreateTasks = f
+
+	return mmCreateTasks
+}
+
+// Return sets up results that will be returned by TasksRepository.CreateTasks
+func (mmCreateTasks *mTasksRepositoryMockCreateTasks) Return(ta1 []model.TaskChange, ea1 []error) *TasksRepositoryMock {
+	if mmCreateTasks.mock.funcCreateTasks != nil {
+		mmCreateTasks.mock.t.Fatalf("TasksRepositoryMock.CreateTasks mock is already set by Set")
+	}
+
+	if mmCreateTasks.defaultExpectation == nil {
+		mmCreateTasks.defaultExpectation = &TasksRepositoryMockCreateTasksExpectation{mock: mmCreateTasks.mock}
+	}
+	mmCreateTasks.defaultExpectation.results = &TasksRepositoryMockCreateTasksResults{ta1, ea1}
+	mmCreateTasks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateTasks.mock
+}
+
+// Set uses given function f to mock the TasksRepository.CreateTasks method
+func (mmCreateTasks *mTasksRepositoryMockCreateTasks) Set(f func(ctx context.Context, tasks []model.Task) (ta1 []model.TaskChange, ea1 []error)) *TasksRepositoryMock {
+	if mmCreateTasks.defaultExpectation != nil {
+		mmCreateTasks.mock.t.Fatalf("Default expectation is already set for the TasksRepository.CreateTasks method")
+	}
+
+	if len(mmCreateTasks.expectations) > 0 {
+		mmCreateTasks.mock.t.Fatalf("Some expectations are already set for the TasksRepository.CreateTasks method")
+	}
+
+	mmCreateTasks.mock.funcCreateTasks = f
+	mmCreateTasks.mock.funcCreateTasksOrigin = minimock.CallerInfo(1)
+	return mmCreateTasks.mock
+}
+
+// When sets expectation for the TasksRepository.CreateTasks which will trigger the result defined by the following
+// Then helper
+func (mmCreateTasks *mTasksRepositoryMockCreateTasks) When(ctx context.Context, tasks []model.Task) *TasksRepositoryMockCreateTasksExpectation {
+	if mmCreateTasks.mock.funcCreateTasks != nil {
+		mmCreateTasks.mock.t.Fatalf("TasksRepositoryMock.CreateTasks mock is already set by Set")
+	}
+
+	expectation := &TasksRepositoryMockCreateTasksExpectation{
+		mock:               mmCreateTasks.mock,
+		params:             &TasksRepositoryMockCreateTasksParams{ctx, tasks},
+		expectationOrigins: TasksRepositoryMockCreateTasksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateTasks.expectations = append(mmCreateTasks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TasksRepository.CreateTasks return parameters for the expectation previously defined by the When method
+func (e *TasksRepositoryMockCreateTasksExpectation) Then(ta1 []model.TaskChange, ea1 []error) *TasksRepositoryMock {
+	e.results = &TasksRepositoryMockCreateTasksResults{ta1, ea1}
+	return e.mock
+}
+
+// Times sets number of times TasksRepository.CreateTasks should be invoked
+func (mmCreateTasks *mTasksRepositoryMockCreateTasks) Times(n uint64) *mTasksRepositoryMockCreateTasks {
+	if n == 0 {
+		mmCreateTasks.mock.t.Fatalf("Times of TasksRepositoryMock.CreateTasks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateTasks.expectedInvocations, n)
+	mmCreateTasks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateTasks
+}
+
+func (mmCreateTasks *mTasksRepositoryMockCreateTasks) invocationsDone() bool {
+	if len(mmCreateTasks.expectations) == 0 && mmCreateTasks.defaultExpectation == nil && mmCreateTasks.mock.funcCreateTasks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateTasks.mock.afterCreateTasksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateTasks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateTasks implements mm_service.TasksRepository
+func (mmCreateTasks *TasksRepositoryMock) CreateTasks(ctx context.Context, tasks []model.Task) (ta1 []model.TaskChange, ea1 []error) {
+	mm_atomic.AddUint64(&mmCreateTasks.beforeCreateTasksCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateTasks.afterCreateTasksCounter, 1)
+
+	mmCreateTasks.t.Helper()
+
+	if mmCreateTasks.inspectFuncCreateTasks != nil {
+		mmCreateTasks.inspectFuncCreateTasks(ctx, tasks)
+	}
+
+	mm_params := TasksRepositoryMockCreateTasksParams{ctx, tasks}
+
+	// Record call args
+	mmCreateTasks.CreateTasksMock.mutex.Lock()
+	mmCreateTasks.CreateTasksMock.callArgs = append(mmCreateTasks.CreateTasksMock.callArgs, &mm_params)
+	mmCreateTasks.CreateTasksMock.mutex.Unlock()
+
+	for _, e := range mmCreateTasks.CreateTasksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ta1, e.results.ea1
+		}
+	}
+
+	if mmCreateTasks.CreateTasksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateTasks.CreateTasksMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateTasks.CreateTasksMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateTasks.CreateTasksMock.defaultExpectation.paramPtrs
+
+		mm_got := TasksRepositoryMockCreateTasksParams{ctx, tasks}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateTasks.t.Errorf("TasksRepositoryMock.CreateTasks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateTasks.CreateTasksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tasks != nil && !minimock.Equal(*mm_want_ptrs.tasks, mm_got.tasks) {
+				mmCreateTasks.t.Errorf("TasksRepositoryMock.CreateTasks got unexpected parameter tasks, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateTasks.CreateTasksMock.defaultExpectation.expectationOrigins.originTasks, *mm_want_ptrs.tasks, mm_got.tasks, minimock.Diff(*mm_want_ptrs.tasks, mm_got.tasks))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateTasks.t.Errorf("TasksRepositoryMock.CreateTasks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateTasks.CreateTasksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateTasks.CreateTasksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateTasks.t.Fatal("No results are set for the TasksRepositoryMock.CreateTasks")
+		}
+		return (*mm_results).ta1, (*mm_results).ea1
+	}
+	if mmCreateTasks.funcCreateTasks != nil {
+		return mmCreateTasks.funcCreateTasks(ctx, tasks)
+	}
+	mmCreateTasks.t.Fatalf("Unexpected call to TasksRepositoryMock.CreateTasks. %v %v", ctx, tasks)
+	return
+}
+
+// CreateTasksAfterCounter returns a count of finished TasksRepositoryMock.CreateTasks invocations
+func (mmCreateTasks *TasksRepositoryMock) CreateTasksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateTasks.afterCreateTasksCounter)
+}
+
+// CreateTasksBeforeCounter returns a count of TasksRepositoryMock.CreateTasks invocations
+func (mmCreateTasks *TasksRepositoryMock) CreateTasksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateTasks.beforeCreateTasksCounter)
+}
+
+// Calls returns a list of arguments used in each call to TasksRepositoryMock.CreateTasks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateTasks *mTasksRepositoryMockCreateTasks) Calls() []*TasksRepositoryMockCreateTasksParams {
+	mmCreateTasks.mutex.RLock()
+
+	argCopy := make([]*TasksRepositoryMockCreateTasksParams, len(mmCreateTasks.callArgs))
+	copy(argCopy, mmCreateTasks.callArgs)
+
+	mmCreateTasks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateTasksDone returns true if the count of the CreateTasks invocations corresponds
+// the number of defined expectations
+func (m *TasksRepositoryMock) MinimockCreateTasksDone() bool {
+	if m.CreateTasksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateTasksMock.invocationsDone()
+}
+
+// MinimockCreateTasksInspect logs each unmet expectation
+func (m *TasksRepositoryMock) MinimockCreateTasksInspect() {
+	for _, e := range m.CreateTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TasksRepositoryMock.CreateTasks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateTasksCounter := mm_atomic.LoadUint64(&m.afterCreateTasksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateTasksMock.defaultExpectation != nil && afterCreateTasksCounter < 1 {
+		if m.CreateTasksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksRepositoryMock.CreateTasks at\n%s", m.CreateTasksMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TasksRepositoryMock.CreateTasks at\n%s with params: %#v", m.CreateTasksMock.defaultExpectation.expectationOrigins.origin, *m.CreateTasksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateTasks != nil && afterCreateTasksCounter < 1 {
+		m.t.Errorf("Expected call to TasksRepositoryMock.CreateTasks at\n%s", m.funcCreateTasksOrigin)
+	}
+
+	if !m.CreateTasksMock.invocationsDone() && afterCreateTasksCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksRepositoryMock.CreateTasks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateTasksMock.expectedInvocations), m.CreateTasksMock.expectedInvocationsOrigin, afterCreateTasksCounter)
+	}
+}
+
 type mTasksRepositoryMockDeleteTask struct {
 	optional           bool
 	mock               *TasksRepositoryMock
@@ -441,26 +875,33 @@ type TasksRepositoryMockDeleteTaskExpectation struct {
 
 // TasksRepositoryMockDeleteTaskParams contains parameters of the TasksRepository.DeleteTask
 type TasksRepositoryMockDeleteTaskParams struct {
-	ctx context.Context
-	id  string
+	ctx     context.Context
+	id      string
+	version *int64
+	owner   string
 }
 
 // TasksRepositoryMockDeleteTaskParamPtrs contains pointers to parameters of the TasksRepository.DeleteTask
 type TasksRepositoryMockDeleteTaskParamPtrs struct {
-	ctx *context.Context
-	id  *string
+	ctx     *context.Context
+	id      *string
+	version **int64
+	owner   *string
 }
 
 // TasksRepositoryMockDeleteTaskResults contains results of the TasksRepository.DeleteTask
 type TasksRepositoryMockDeleteTaskResults struct {
+	t1  model.TaskChange
 	err error
 }
 
 // TasksRepositoryMockDeleteTaskOrigins contains origins of expectations of the TasksRepository.DeleteTask
 type TasksRepositoryMockDeleteTaskExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originId  string
+	origin        string
+	originCtx     string
+	originId      string
+	originVersion string
+	originOwner   string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -474,7 +915,7 @@ func (mmDeleteTask *mTasksRepositoryMockDeleteTask) Optional() *mTasksRepository
 }
 
 // Expect sets up expected params for TasksRepository.DeleteTask
-func (mmDeleteTask *mTasksRepositoryMockDeleteTask) Expect(ctx context.Context, id string) *mTasksRepositoryMockDeleteTask {
+func (mmDeleteTask *mTasksRepositoryMockDeleteTask) Expect(ctx context.Context, id string, version *int64, owner string) *mTasksRepositoryMockDeleteTask {
 	if mmDeleteTask.mock.funcDeleteTask != nil {
 		mmDeleteTask.mock.t.Fatalf("TasksRepositoryMock.DeleteTask mock is already set by Set")
 	}
@@ -487,7 +928,7 @@ func (mmDeleteTask *mTasksRepositoryMockDeleteTask) Expect(ctx context.Context,
 		mmDeleteTask.mock.t.Fatalf("TasksRepositoryMock.DeleteTask mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteTask.defaultExpectation.params = &TasksRepositoryMockDeleteTaskParams{ctx, id}
+	mmDeleteTask.defaultExpectation.params = &TasksRepositoryMockDeleteTaskParams{ctx, id, version, owner}
 	mmDeleteTask.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmDeleteTask.expectations {
 		if minimock.Equal(e.params, mmDeleteTask.defaultExpectation.params) {
@@ -544,8 +985,54 @@ func (mmDeleteTask *mTasksRepositoryMockDeleteTask) ExpectIdParam2(id string) *m
 	return mmDeleteTask
 }
 
+// ExpectVersionParam3 sets up expected param version for TasksRepository.DeleteTask
+func (mmDeleteTask *mTasksRepositoryMockDeleteTask) ExpectVersionParam3(version *int64) *mTasksRepositoryMockDeleteTask {
+	if mmDeleteTask.mock.funcDeleteTask != nil {
+		mmDeleteTask.mock.t.Fatalf("TasksRepositoryMock.DeleteTask mock is already set by Set")
+	}
+
+	if mmDeleteTask.defaultExpectation == nil {
+		mmDeleteTask.defaultExpectation = &TasksRepositoryMockDeleteTaskExpectation{}
+	}
+
+	if mmDeleteTask.defaultExpectation.params != nil {
+		mmDeleteTask.mock.t.Fatalf("TasksRepositoryMock.DeleteTask mock is already set by Expect")
+	}
+
+	if mmDeleteTask.defaultExpectation.paramPtrs == nil {
+		mmDeleteTask.defaultExpectation.paramPtrs = &TasksRepositoryMockDeleteTaskParamPtrs{}
+	}
+	mmDeleteTask.defaultExpectation.paramPtrs.version = &version
+	mmDeleteTask.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
+
+	return mmDeleteTask
+}
+
+// ExpectOwnerParam4 sets up expected param owner for TasksRepository.DeleteTask
+func (mmDeleteTask *mTasksRepositoryMockDeleteTask) ExpectOwnerParam4(owner string) *mTasksRepositoryMockDeleteTask {
+	if mmDeleteTask.mock.funcDeleteTask != nil {
+		mmDeleteTask.mock.t.Fatalf("TasksRepositoryMock.DeleteTask mock is already set by Set")
+	}
+
+	if mmDeleteTask.defaultExpectation == nil {
+		mmDeleteTask.defaultExpectation = &TasksRepositoryMockDeleteTaskExpectation{}
+	}
+
+	if mmDeleteTask.defaultExpectation.params != nil {
+		mmDeleteTask.mock.t.Fatalf("TasksRepositoryMock.DeleteTask mock is already set by Expect")
+	}
+
+	if mmDeleteTask.defaultExpectation.paramPtrs == nil {
+		mmDeleteTask.defaultExpectation.paramPtrs = &TasksRepositoryMockDeleteTaskParamPtrs{}
+	}
+	mmDeleteTask.defaultExpectation.paramPtrs.owner = &owner
+	mmDeleteTask.defaultExpectation.expectationOrigins.originOwner = minimock.CallerInfo(1)
+
+	return mmDeleteTask
+}
+
 // Inspect accepts an inspector function that has same arguments as the TasksRepository.DeleteTask
-func (mmDeleteTask *mTasksRepositoryMockDeleteTask) Inspect(f func(ctx context.Context, id string)) *mTasksRepositoryMockDeleteTask {
+func (mmDeleteTask *mTasksRepositoryMockDeleteTask) Inspect(f func(ctx context.Context, id string, version *int64, owner string)) *mTasksRepositoryMockDeleteTask {
 	if mmDeleteTask.mock.inspectFuncDeleteTask != nil {
 		mmDeleteTask.mock.t.Fatalf("Inspect function is already set for TasksRepositoryMock.DeleteTask")
 	}
@@ -556,7 +1043,7 @@ func (mmDeleteTask *mTasksRepositoryMockDeleteTask) Inspect(f func(ctx context.C
 }
 
 // Return sets up results that will be returned by TasksRepository.DeleteTask
-func (mmDeleteTask *mTasksRepositoryMockDeleteTask) Return(err error) *TasksRepositoryMock {
+func (mmDeleteTask *mTasksRepositoryMockDeleteTask) Return(t1 model.TaskChange, err error) *TasksRepositoryMock {
 	if mmDeleteTask.mock.funcDeleteTask != nil {
 		mmDeleteTask.mock.t.Fatalf("TasksRepositoryMock.DeleteTask mock is already set by Set")
 	}
@@ -564,13 +1051,13 @@ func (mmDeleteTask *mTasksRepositoryMockDeleteTask) Return(err error) *TasksRepo
 	if mmDeleteTask.defaultExpectation == nil {
 		mmDeleteTask.defaultExpectation = &TasksRepositoryMockDeleteTaskExpectation{mock: mmDeleteTask.mock}
 	}
-	mmDeleteTask.defaultExpectation.results = &TasksRepositoryMockDeleteTaskResults{err}
+	mmDeleteTask.defaultExpectation.results = &TasksRepositoryMockDeleteTaskResults{t1, err}
 	mmDeleteTask.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
 	return mmDeleteTask.mock
 }
 
 // Set uses given function f to mock the TasksRepository.DeleteTask method
-func (mmDeleteTask *mTasksRepositoryMockDeleteTask) Set(f func(ctx context.Context, id string) (err error)) *TasksRepositoryMock {
+func (mmDeleteTask *mTasksRepositoryMockDeleteTask) Set(f func(ctx context.Context, id string, version *int64, owner string) (t1 model.TaskChange, err error)) *TasksRepositoryMock {
 	if mmDeleteTask.defaultExpectation != nil {
 		mmDeleteTask.mock.t.Fatalf("Default expectation is already set for the TasksRepository.DeleteTask method")
 	}
@@ -586,14 +1073,14 @@ func (mmDeleteTask *mTasksRepositoryMockDeleteTask) Set(f func(ctx context.Conte
 
 // When sets expectation for the TasksRepository.DeleteTask which will trigger the result defined by the following
 // Then helper
-func (mmDeleteTask *mTasksRepositoryMockDeleteTask) When(ctx context.Context, id string) *TasksRepositoryMockDeleteTaskExpectation {
+func (mmDeleteTask *mTasksRepositoryMockDeleteTask) When(ctx context.Context, id string, version *int64, owner string) *TasksRepositoryMockDeleteTaskExpectation {
 	if mmDeleteTask.mock.funcDeleteTask != nil {
 		mmDeleteTask.mock.t.Fatalf("TasksRepositoryMock.DeleteTask mock is already set by Set")
 	}
 
 	expectation := &TasksRepositoryMockDeleteTaskExpectation{
 		mock:               mmDeleteTask.mock,
-		params:             &TasksRepositoryMockDeleteTaskParams{ctx, id},
+		params:             &TasksRepositoryMockDeleteTaskParams{ctx, id, version, owner},
 		expectationOrigins: TasksRepositoryMockDeleteTaskExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmDeleteTask.expectations = append(mmDeleteTask.expectations, expectation)
@@ -601,8 +1088,8 @@ func (mmDeleteTask *mTasksRepositoryMockDeleteTask) When(ctx context.Context, id
 }
 
 // Then sets up TasksRepository.DeleteTask return parameters for the expectation previously defined by the When method
-func (e *TasksRepositoryMockDeleteTaskExpectation) Then(err error) *TasksRepositoryMock {
-	e.results = &TasksRepositoryMockDeleteTaskResults{err}
+func (e *TasksRepositoryMockDeleteTaskExpectation) Then(t1 model.TaskChange, err error) *TasksRepositoryMock {
+	e.results = &TasksRepositoryMockDeleteTaskResults{t1, err}
 	return e.mock
 }
 
@@ -628,17 +1115,17 @@ func (mmDeleteTask *mTasksRepositoryMockDeleteTask) invocationsDone() bool {
 }
 
 // DeleteTask implements mm_service.TasksRepository
-func (mmDeleteTask *TasksRepositoryMock) DeleteTask(ctx context.Context, id string) (err error) {
+func (mmDeleteTask *TasksRepositoryMock) DeleteTask(ctx context.Context, id string, version *int64, owner string) (t1 model.TaskChange, err error) {
 	mm_atomic.AddUint64(&mmDeleteTask.beforeDeleteTaskCounter, 1)
 	defer mm_atomic.AddUint64(&mmDeleteTask.afterDeleteTaskCounter, 1)
 
 	mmDeleteTask.t.Helper()
 
 	if mmDeleteTask.inspectFuncDeleteTask != nil {
-		mmDeleteTask.inspectFuncDeleteTask(ctx, id)
+		mmDeleteTask.inspectFuncDeleteTask(ctx, id, version, owner)
 	}
 
-	mm_params := TasksRepositoryMockDeleteTaskParams{ctx, id}
+	mm_params := TasksRepositoryMockDeleteTaskParams{ctx, id, version, owner}
 
 	// Record call args
 	mmDeleteTask.DeleteTaskMock.mutex.Lock()
@@ -648,7 +1135,7 @@ func (mmDeleteTask *TasksRepositoryMock) DeleteTask(ctx context.Context, id stri
 	for _, e := range mmDeleteTask.DeleteTaskMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.t1, e.results.err
 		}
 	}
 
@@ -657,7 +1144,7 @@ func (mmDeleteTask *TasksRepositoryMock) DeleteTask(ctx context.Context, id stri
 		mm_want := mmDeleteTask.DeleteTaskMock.defaultExpectation.params
 		mm_want_ptrs := mmDeleteTask.DeleteTaskMock.defaultExpectation.paramPtrs
 
-		mm_got := TasksRepositoryMockDeleteTaskParams{ctx, id}
+		mm_got := TasksRepositoryMockDeleteTaskParams{ctx, id, version, owner}
 
 		if mm_want_ptrs != nil {
 
@@ -671,6 +1158,16 @@ func (mmDeleteTask *TasksRepositoryMock) DeleteTask(ctx context.Context, id stri
 					mmDeleteTask.DeleteTaskMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmDeleteTask.t.Errorf("TasksRepositoryMock.DeleteTask got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTask.DeleteTaskMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
+			if mm_want_ptrs.owner != nil && !minimock.Equal(*mm_want_ptrs.owner, mm_got.owner) {
+				mmDeleteTask.t.Errorf("TasksRepositoryMock.DeleteTask got unexpected parameter owner, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTask.DeleteTaskMock.defaultExpectation.expectationOrigins.originOwner, *mm_want_ptrs.owner, mm_got.owner, minimock.Diff(*mm_want_ptrs.owner, mm_got.owner))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmDeleteTask.t.Errorf("TasksRepositoryMock.DeleteTask got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmDeleteTask.DeleteTaskMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -680,12 +1177,12 @@ func (mmDeleteTask *TasksRepositoryMock) DeleteTask(ctx context.Context, id stri
 		if mm_results == nil {
 			mmDeleteTask.t.Fatal("No results are set for the TasksRepositoryMock.DeleteTask")
 		}
-		return (*mm_results).err
+		return (*mm_results).t1, (*mm_results).err
 	}
 	if mmDeleteTask.funcDeleteTask != nil {
-		return mmDeleteTask.funcDeleteTask(ctx, id)
+		return mmDeleteTask.funcDeleteTask(ctx, id, version, owner)
 	}
-	mmDeleteTask.t.Fatalf("Unexpected call to TasksRepositoryMock.DeleteTask. %v %v", ctx, id)
+	mmDeleteTask.t.Fatalf("Unexpected call to TasksRepositoryMock.DeleteTask. %v %v %v %v", ctx, id, version, owner)
 	return
 }
 
@@ -757,53 +1254,56 @@ func (m *TasksRepositoryMock) MinimockDeleteTaskInspect() {
 	}
 }
 
-type mTasksRepositoryMockGetTask struct {
+type mTasksRepositoryMockDeleteTasks struct {
 	optional           bool
 	mock               *TasksRepositoryMock
-	defaultExpectation *TasksRepositoryMockGetTaskExpectation
-	expectations       []*TasksRepositoryMockGetTaskExpectation
+	defaultExpectation *TasksRepositoryMockDeleteTasksExpectation
+	expectations       []*TasksRepositoryMockDeleteTasksExpectation
 
-	callArgs []*TasksRepositoryMockGetTaskParams
+	callArgs []*TasksRepositoryMockDeleteTasksParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// TasksRepositoryMockGetTaskExpectation specifies expectation struct of the TasksRepository.GetTask
-type TasksRepositoryMockGetTaskExpectation struct {
+// TasksRepositoryMockDeleteTasksExpectation specifies expectation struct of the TasksRepository.DeleteTasks
+type TasksRepositoryMockDeleteTasksExpectation struct {
 	mock               *TasksRepositoryMock
-	params             *TasksRepositoryMockGetTaskParams
-	paramPtrs          *TasksRepositoryMockGetTaskParamPtrs
-	expectationOrigins TasksRepositoryMockGetTaskExpectationOrigins
-	results            *TasksRepositoryMockGetTaskResults
+	params             *TasksRepositoryMockDeleteTasksParams
+	paramPtrs          *TasksRepositoryMockDeleteTasksParamPtrs
+	expectationOrigins TasksRepositoryMockDeleteTasksExpectationOrigins
+	results            *TasksRepositoryMockDeleteTasksResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// TasksRepositoryMockGetTaskParams contains parameters of the TasksRepository.GetTask
-type TasksRepositoryMockGetTaskParams struct {
-	ctx context.Context
-	id  string
+// TasksRepositoryMockDeleteTasksParams contains parameters of the TasksRepository.DeleteTasks
+type TasksRepositoryMockDeleteTasksParams struct {
+	ctx   context.Context
+	ids   []string
+	owner string
 }
 
-// TasksRepositoryMockGetTaskParamPtrs contains pointers to parameters of the TasksRepository.GetTask
-type TasksRepositoryMockGetTaskParamPtrs struct {
-	ctx *context.Context
-	id  *string
+// TasksRepositoryMockDeleteTasksParamPtrs contains pointers to parameters of the TasksRepository.DeleteTasks
+type TasksRepositoryMockDeleteTasksParamPtrs struct {
+	ctx   *context.Context
+	ids   *[]string
+	owner *string
 }
 
-// TasksRepositoryMockGetTaskResults contains results of the TasksRepository.GetTask
-type TasksRepositoryMockGetTaskResults struct {
-	tp1 *model.Task
-	err error
+// TasksRepositoryMockDeleteTasksResults contains results of the TasksRepository.DeleteTasks
+type TasksRepositoryMockDeleteTasksResults struct {
+	ta1 []model.TaskChange
+	ea1 []error
 }
 
-// TasksRepositoryMockGetTaskOrigins contains origins of expectations of the TasksRepository.GetTask
-type TasksRepositoryMockGetTaskExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originId  string
+// TasksRepositoryMockDeleteTasksOrigins contains origins of expectations of the TasksRepository.DeleteTasks
+type TasksRepositoryMockDeleteTasksExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originIds   string
+	originOwner string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -811,9 +1311,723 @@ type TasksRepositoryMockGetTaskExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetTask *mTasksRepositoryMockGetTask) Optional() *mTasksRepositoryMockGetTask {
-	mmGetTask.optional = true
-	return mmGetTask
+func (mmDeleteTasks *mTasksRepositoryMockDeleteTasks) Optional() *mTasksRepositoryMockDeleteTasks {
+	mmDeleteTasks.optional = true
+	return mmDeleteTasks
+}
+
+// Expect sets up expected params for TasksRepository.DeleteTasks
+func (mmDeleteTasks *mTasksRepositoryMockDeleteTasks) Expect(ctx context.Context, ids []string, owner string) *mTasksRepositoryMockDeleteTasks {
+	if mmDeleteTasks.mock.funcDeleteTasks != nil {
+		mmDeleteTasks.mock.t.Fatalf("TasksRepositoryMock.DeleteTasks mock is already set by Set")
+	}
+
+	if mmDeleteTasks.defaultExpectation == nil {
+		mmDeleteTasks.defaultExpectation = &TasksRepositoryMockDeleteTasksExpectation{}
+	}
+
+	if mmDeleteTasks.defaultExpectation.paramPtrs != nil {
+		mmDeleteTasks.mock.t.Fatalf("TasksRepositoryMock.DeleteTasks mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteTasks.defaultExpectation.params = &TasksRepositoryMockDeleteTasksParams{ctx, ids, owner}
+	mmDeleteTasks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteTasks.expectations {
+		if minimock.Equal(e.params, mmDeleteTasks.defaultExpectation.params) {
+			mmDeleteTasks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteTasks.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteTasks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TasksRepository.DeleteTasks
+func (mmDeleteTasks *mTasksRepositoryMockDeleteTasks) ExpectCtxParam1(ctx context.Context) *mTasksRepositoryMockDeleteTasks {
+	if mmDeleteTasks.mock.funcDeleteTasks != nil {
+		mmDeleteTasks.mock.t.Fatalf("TasksRepositoryMock.DeleteTasks mock is already set by Set")
+	}
+
+	if mmDeleteTasks.defaultExpectation == nil {
+		mmDeleteTasks.defaultExpectation = &TasksRepositoryMockDeleteTasksExpectation{}
+	}
+
+	if mmDeleteTasks.defaultExpectation.params != nil {
+		mmDeleteTasks.mock.t.Fatalf("TasksRepositoryMock.DeleteTasks mock is already set by Expect")
+	}
+
+	if mmDeleteTasks.defaultExpectation.paramPtrs == nil {
+		mmDeleteTasks.defaultExpectation.paramPtrs = &TasksRepositoryMockDeleteTasksParamPtrs{}
+	}
+	mmDeleteTasks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteTasks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteTasks
+}
+
+// ExpectIdsParam2 sets up expected param ids for TasksRepository.DeleteTasks
+func (mmDeleteTasks *mTasksRepositoryMockDeleteTasks) ExpectIdsParam2(ids []string) *mTasksRepositoryMockDeleteTasks {
+	if mmDeleteTasks.mock.funcDeleteTasks != nil {
+		mmDeleteTasks.mock.t.Fatalf("TasksRepositoryMock.DeleteTasks mock is already set by Set")
+	}
+
+	if mmDeleteTasks.defaultExpectation == nil {
+		mmDeleteTasks.defaultExpectation = &TasksRepositoryMockDeleteTasksExpectation{}
+	}
+
+	if mmDeleteTasks.defaultExpectation.params != nil {
+		mmDeleteTasks.mock.t.Fatalf("TasksRepositoryMock.DeleteTasks mock is already set by Expect")
+	}
+
+	if mmDeleteTasks.defaultExpectation.paramPtrs == nil {
+		mmDeleteTasks.defaultExpectation.paramPtrs = &TasksRepositoryMockDeleteTasksParamPtrs{}
+	}
+	mmDeleteTasks.defaultExpectation.paramPtrs.ids = &ids
+	mmDeleteTasks.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
+
+	return mmDeleteTasks
+}
+
+// ExpectOwnerParam3 sets up expected param owner for TasksRepository.DeleteTasks
+func (mmDeleteTasks *mTasksRepositoryMockDeleteTasks) ExpectOwnerParam3(owner string) *mTasksRepositoryMockDeleteTasks {
+	if mmDeleteTasks.mock.funcDeleteTasks != nil {
+		mmDeleteTasks.mock.t.Fatalf("TasksRepositoryMock.DeleteTasks mock is already set by Set")
+	}
+
+	if mmDeleteTasks.defaultExpectation == nil {
+		mmDeleteTasks.defaultExpectation = &TasksRepositoryMockDeleteTasksExpectation{}
+	}
+
+	if mmDeleteTasks.defaultExpectation.params != nil {
+		mmDeleteTasks.mock.t.Fatalf("TasksRepositoryMock.DeleteTasks mock is already set by Expect")
+	}
+
+	if mmDeleteTasks.defaultExpectation.paramPtrs == nil {
+		mmDeleteTasks.defaultExpectation.paramPtrs = &TasksRepositoryMockDeleteTasksParamPtrs{}
+	}
+	mmDeleteTasks.defaultExpectation.paramPtrs.owner = &owner
+	mmDeleteTasks.defaultExpectation.expectationOrigins.originOwner = minimock.CallerInfo(1)
+
+	return mmDeleteTasks
+}
+
+// Inspect accepts an inspector function that has same arguments as the TasksRepository.DeleteTasks
+func (mmDeleteTasks *mTasksRepositoryMockDeleteTasks) Inspect(f func(ctx context.Context, ids []string, owner string)) *mTasksRepositoryMockDeleteTasks {
+	if mmDeleteTasks.mock.inspectFuncDeleteTasks != nil {
+		mmDeleteTasks.mock.t.Fatalf("Inspect function is already set for TasksRepositoryMock.DeleteTasks")
+	}
+
+	mmDeleteTasks.mock.inspectFuncDeleteTasks = f
+
+	return mmDeleteTasks
+}
+
+// Return sets up results that will be returned by TasksRepository.DeleteTasks
+func (mmDeleteTasks *mTasksRepositoryMockDeleteTasks) Return(ta1 []model.TaskChange, ea1 []error) *TasksRepositoryMock {
+	if mmDeleteTasks.mock.funcDeleteTasks != nil {
+		mmDeleteTasks.mock.t.Fatalf("TasksRepositoryMock.DeleteTasks mock is already set by Set")
+	}
+
+	if mmDeleteTasks.defaultExpectation == nil {
+		mmDeleteTasks.defaultExpectation = &TasksRepositoryMockDeleteTasksExpectation{mock: mmDeleteTasks.mock}
+	}
+	mmDeleteTasks.defaultExpectation.results = &TasksRepositoryMockDeleteTasksResults{ta1, ea1}
+	mmDeleteTasks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteTasks.mock
+}
+
+// Set uses given function f to mock the TasksRepository.DeleteTasks method
+func (mmDeleteTasks *mTasksRepositoryMockDeleteTasks) Set(f func(ctx context.Context, ids []string, owner string) (ta1 []model.TaskChange, ea1 []error)) *TasksRepositoryMock {
+	if mmDeleteTasks.defaultExpectation != nil {
+		mmDeleteTasks.mock.t.Fatalf("Default expectation is already set for the TasksRepository.DeleteTasks method")
+	}
+
+	if len(mmDeleteTasks.expectations) > 0 {
+		mmDeleteTasks.mock.t.Fatalf("Some expectations are already set for the TasksRepository.DeleteTasks method")
+	}
+
+	mmDeleteTasks.mock.funcDeleteTasks = f
+	mmDeleteTasks.mock.funcDeleteTasksOrigin = minimock.CallerInfo(1)
+	return mmDeleteTasks.mock
+}
+
+// When sets expectation for the TasksRepository.DeleteTasks which will trigger the result defined by the following
+// Then helper
+func (mmDeleteTasks *mTasksRepositoryMockDeleteTasks) When(ctx context.Context, ids []string, owner string) *TasksRepositoryMockDeleteTasksExpectation {
+	if mmDeleteTasks.mock.funcDeleteTasks != nil {
+		mmDeleteTasks.mock.t.Fatalf("TasksRepositoryMock.DeleteTasks mock is already set by Set")
+	}
+
+	expectation := &TasksRepositoryMockDeleteTasksExpectation{
+		mock:               mmDeleteTasks.mock,
+		params:             &TasksRepositoryMockDeleteTasksParams{ctx, ids, owner},
+		expectationOrigins: TasksRepositoryMockDeleteTasksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteTasks.expectations = append(mmDeleteTasks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TasksRepository.DeleteTasks return parameters for the expectation previously defined by the When method
+func (e *TasksRepositoryMockDeleteTasksExpectation) Then(ta1 []model.TaskChange, ea1 []error) *TasksRepositoryMock {
+	e.results = &TasksRepositoryMockDeleteTasksResults{ta1, ea1}
+	return e.mock
+}
+
+// Times sets number of times TasksRepository.DeleteTasks should be invoked
+func (mmDeleteTasks *mTasksRepositoryMockDeleteTasks) Times(n uint64) *mTasksRepositoryMockDeleteTasks {
+	if n == 0 {
+		mmDeleteTasks.mock.t.Fatalf("Times of TasksRepositoryMock.DeleteTasks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteTasks.expectedInvocations, n)
+	mmDeleteTasks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteTasks
+}
+
+func (mmDeleteTasks *mTasksRepositoryMockDeleteTasks) invocationsDone() bool {
+	if len(mmDeleteTasks.expectations) == 0 && mmDeleteTasks.defaultExpectation == nil && mmDeleteTasks.mock.funcDeleteTasks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteTasks.mock.afterDeleteTasksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteTasks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteTasks implements mm_service.TasksRepository
+func (mmDeleteTasks *TasksRepositoryMock) DeleteTasks(ctx context.Context, ids []string, owner string) (ta1 []model.TaskChange, ea1 []error) {
+	mm_atomic.AddUint64(&mmDeleteTasks.beforeDeleteTasksCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteTasks.afterDeleteTasksCounter, 1)
+
+	mmDeleteTasks.t.Helper()
+
+	if mmDeleteTasks.inspectFuncDeleteTasks != nil {
+		mmDeleteTasks.inspectFuncDeleteTasks(ctx, ids, owner)
+	}
+
+	mm_params := TasksRepositoryMockDeleteTasksParams{ctx, ids, owner}
+
+	// Record call args
+	mmDeleteTasks.DeleteTasksMock.mutex.Lock()
+	mmDeleteTasks.DeleteTasksMock.callArgs = append(mmDeleteTasks.DeleteTasksMock.callArgs, &mm_params)
+	mmDeleteTasks.DeleteTasksMock.mutex.Unlock()
+
+	for _, e := range mmDeleteTasks.DeleteTasksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ta1, e.results.ea1
+		}
+	}
+
+	if mmDeleteTasks.DeleteTasksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteTasks.DeleteTasksMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteTasks.DeleteTasksMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteTasks.DeleteTasksMock.defaultExpectation.paramPtrs
+
+		mm_got := TasksRepositoryMockDeleteTasksParams{ctx, ids, owner}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteTasks.t.Errorf("TasksRepositoryMock.DeleteTasks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTasks.DeleteTasksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmDeleteTasks.t.Errorf("TasksRepositoryMock.DeleteTasks got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTasks.DeleteTasksMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+			if mm_want_ptrs.owner != nil && !minimock.Equal(*mm_want_ptrs.owner, mm_got.owner) {
+				mmDeleteTasks.t.Errorf("TasksRepositoryMock.DeleteTasks got unexpected parameter owner, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTasks.DeleteTasksMock.defaultExpectation.expectationOrigins.originOwner, *mm_want_ptrs.owner, mm_got.owner, minimock.Diff(*mm_want_ptrs.owner, mm_got.owner))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteTasks.t.Errorf("TasksRepositoryMock.DeleteTasks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteTasks.DeleteTasksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteTasks.DeleteTasksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteTasks.t.Fatal("No results are set for the TasksRepositoryMock.DeleteTasks")
+		}
+		return (*mm_results).ta1, (*mm_results).ea1
+	}
+	if mmDeleteTasks.funcDeleteTasks != nil {
+		return mmDeleteTasks.funcDeleteTasks(ctx, ids, owner)
+	}
+	mmDeleteTasks.t.Fatalf("Unexpected call to TasksRepositoryMock.DeleteTasks. %v %v %v", ctx, ids, owner)
+	return
+}
+
+// DeleteTasksAfterCounter returns a count of finished TasksRepositoryMock.DeleteTasks invocations
+func (mmDeleteTasks *TasksRepositoryMock) DeleteTasksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTasks.afterDeleteTasksCounter)
+}
+
+// DeleteTasksBeforeCounter returns a count of TasksRepositoryMock.DeleteTasks invocations
+func (mmDeleteTasks *TasksRepositoryMock) DeleteTasksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTasks.beforeDeleteTasksCounter)
+}
+
+// Calls returns a list of arguments used in each call to TasksRepositoryMock.DeleteTasks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteTasks *mTasksRepositoryMockDeleteTasks) Calls() []*TasksRepositoryMockDeleteTasksParams {
+	mmDeleteTasks.mutex.RLock()
+
+	argCopy := make([]*TasksRepositoryMockDeleteTasksParams, len(mmDeleteTasks.callArgs))
+	copy(argCopy, mmDeleteTasks.callArgs)
+
+	mmDeleteTasks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteTasksDone returns true if the count of the DeleteTasks invocations corresponds
+// the number of defined expectations
+func (m *TasksRepositoryMock) MinimockDeleteTasksDone() bool {
+	if m.DeleteTasksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteTasksMock.invocationsDone()
+}
+
+// MinimockDeleteTasksInspect logs each unmet expectation
+func (m *TasksRepositoryMock) MinimockDeleteTasksInspect() {
+	for _, e := range m.DeleteTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TasksRepositoryMock.DeleteTasks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteTasksCounter := mm_atomic.LoadUint64(&m.afterDeleteTasksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteTasksMock.defaultExpectation != nil && afterDeleteTasksCounter < 1 {
+		if m.DeleteTasksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksRepositoryMock.DeleteTasks at\n%s", m.DeleteTasksMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TasksRepositoryMock.DeleteTasks at\n%s with params: %#v", m.DeleteTasksMock.defaultExpectation.expectationOrigins.origin, *m.DeleteTasksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteTasks != nil && afterDeleteTasksCounter < 1 {
+		m.t.Errorf("Expected call to TasksRepositoryMock.DeleteTasks at\n%s", m.funcDeleteTasksOrigin)
+	}
+
+	if !m.DeleteTasksMock.invocationsDone() && afterDeleteTasksCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksRepositoryMock.DeleteTasks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteTasksMock.expectedInvocations), m.DeleteTasksMock.expectedInvocationsOrigin, afterDeleteTasksCounter)
+	}
+}
+
+type mTasksRepositoryMockGetDeletedTask struct {
+	optional           bool
+	mock               *TasksRepositoryMock
+	defaultExpectation *TasksRepositoryMockGetDeletedTaskExpectation
+	expectations       []*TasksRepositoryMockGetDeletedTaskExpectation
+
+	callArgs []*TasksRepositoryMockGetDeletedTaskParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TasksRepositoryMockGetDeletedTaskExpectation specifies expectation struct of the TasksRepository.GetDeletedTask
+type TasksRepositoryMockGetDeletedTaskExpectation struct {
+	mock               *TasksRepositoryMock
+	params             *TasksRepositoryMockGetDeletedTaskParams
+	paramPtrs          *TasksRepositoryMockGetDeletedTaskParamPtrs
+	expectationOrigins TasksRepositoryMockGetDeletedTaskExpectationOrigins
+	results            *TasksRepositoryMockGetDeletedTaskResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TasksRepositoryMockGetDeletedTaskParams contains parameters of the TasksRepository.GetDeletedTask
+type TasksRepositoryMockGetDeletedTaskParams struct {
+	ctx context.Context
+	id  string
+}
+
+// TasksRepositoryMockGetDeletedTaskParamPtrs contains pointers to parameters of the TasksRepository.GetDeletedTask
+type TasksRepositoryMockGetDeletedTaskParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// TasksRepositoryMockGetDeletedTaskResults contains results of the TasksRepository.GetDeletedTask
+type TasksRepositoryMockGetDeletedTaskResults struct {
+	tp1 *model.Task
+	err error
+}
+
+// TasksRepositoryMockGetDeletedTaskOrigins contains origins of expectations of the TasksRepository.GetDeletedTask
+type TasksRepositoryMockGetDeletedTaskExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetDeletedTask *mTasksRepositoryMockGetDeletedTask) Optional() *mTasksRepositoryMockGetDeletedTask {
+	mmGetDeletedTask.optional = true
+	return mmGetDeletedTask
+}
+
+// Expect sets up expected params for TasksRepository.GetDeletedTask
+func (mmGetDeletedTask *mTasksRepositoryMockGetDeletedTask) Expect(ctx context.Context, id string) *mTasksRepositoryMockGetDeletedTask {
+	if mmGetDeletedTask.mock.funcGetDeletedTask != nil {
+		mmGetDeletedTask.mock.t.Fatalf("TasksRepositoryMock.GetDeletedTask mock is already set by Set")
+	}
+
+	if mmGetDeletedTask.defaultExpectation == nil {
+		mmGetDeletedTask.defaultExpectation = &TasksRepositoryMockGetDeletedTaskExpectation{}
+	}
+
+	if mmGetDeletedTask.defaultExpectation.paramPtrs != nil {
+		mmGetDeletedTask.mock.t.Fatalf("TasksRepositoryMock.GetDeletedTask mock is already set by ExpectParams functions")
+	}
+
+	mmGetDeletedTask.defaultExpectation.params = &TasksRepositoryMockGetDeletedTaskParams{ctx, id}
+	mmGetDeletedTask.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetDeletedTask.expectations {
+		if minimock.Equal(e.params, mmGetDeletedTask.defaultExpectation.params) {
+			mmGetDeletedTask.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetDeletedTask.defaultExpectation.params)
+		}
+	}
+
+	return mmGetDeletedTask
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TasksRepository.GetDeletedTask
+func (mmGetDeletedTask *mTasksRepositoryMockGetDeletedTask) ExpectCtxParam1(ctx context.Context) *mTasksRepositoryMockGetDeletedTask {
+	if mmGetDeletedTask.mock.funcGetDeletedTask != nil {
+		mmGetDeletedTask.mock.t.Fatalf("TasksRepositoryMock.GetDeletedTask mock is already set by Set")
+	}
+
+	if mmGetDeletedTask.defaultExpectation == nil {
+		mmGetDeletedTask.defaultExpectation = &TasksRepositoryMockGetDeletedTaskExpectation{}
+	}
+
+	if mmGetDeletedTask.defaultExpectation.params != nil {
+		mmGetDeletedTask.mock.t.Fatalf("TasksRepositoryMock.GetDeletedTask mock is already set by Expect")
+	}
+
+	if mmGetDeletedTask.defaultExpectation.paramPtrs == nil {
+		mmGetDeletedTask.defaultExpectation.paramPtrs = &TasksRepositoryMockGetDeletedTaskParamPtrs{}
+	}
+	mmGetDeletedTask.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetDeletedTask.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetDeletedTask
+}
+
+// ExpectIdParam2 sets up expected param id for TasksRepository.GetDeletedTask
+func (mmGetDeletedTask *mTasksRepositoryMockGetDeletedTask) ExpectIdParam2(id string) *mTasksRepositoryMockGetDeletedTask {
+	if mmGetDeletedTask.mock.funcGetDeletedTask != nil {
+		mmGetDeletedTask.mock.t.Fatalf("TasksRepositoryMock.GetDeletedTask mock is already set by Set")
+	}
+
+	if mmGetDeletedTask.defaultExpectation == nil {
+		mmGetDeletedTask.defaultExpectation = &TasksRepositoryMockGetDeletedTaskExpectation{}
+	}
+
+	if mmGetDeletedTask.defaultExpectation.params != nil {
+		mmGetDeletedTask.mock.t.Fatalf("TasksRepositoryMock.GetDeletedTask mock is already set by Expect")
+	}
+
+	if mmGetDeletedTask.defaultExpectation.paramPtrs == nil {
+		mmGetDeletedTask.defaultExpectation.paramPtrs = &TasksRepositoryMockGetDeletedTaskParamPtrs{}
+	}
+	mmGetDeletedTask.defaultExpectation.paramPtrs.id = &id
+	mmGetDeletedTask.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetDeletedTask
+}
+
+// Inspect accepts an inspector function that has same arguments as the TasksRepository.GetDeletedTask
+func (mmGetDeletedTask *mTasksRepositoryMockGetDeletedTask) Inspect(f func(ctx context.Context, id string)) *mTasksRepositoryMockGetDeletedTask {
+	if mmGetDeletedTask.mock.inspectFuncGetDeletedTask != nil {
+		mmGetDeletedTask.mock.t.Fatalf("Inspect function is already set for TasksRepositoryMock.GetDeletedTask")
+	}
+
+	mmGetDeletedTask.mock.inspectFuncGetDeletedTask = f
+
+	return mmGetDeletedTask
+}
+
+// Return sets up results that will be returned by TasksRepository.GetDeletedTask
+func (mmGetDeletedTask *mTasksRepositoryMockGetDeletedTask) Return(tp1 *model.Task, err error) *TasksRepositoryMock {
+	if mmGetDeletedTask.mock.funcGetDeletedTask != nil {
+		mmGetDeletedTask.mock.t.Fatalf("TasksRepositoryMock.GetDeletedTask mock is already set by Set")
+	}
+
+	if mmGetDeletedTask.defaultExpectation == nil {
+		mmGetDeletedTask.defaultExpectation = &TasksRepositoryMockGetDeletedTaskExpectation{mock: mmGetDeletedTask.mock}
+	}
+	mmGetDeletedTask.defaultExpectation.results = &TasksRepositoryMockGetDeletedTaskResults{tp1, err}
+	mmGetDeletedTask.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetDeletedTask.mock
+}
+
+// Set uses given function f to mock the TasksRepository.GetDeletedTask method
+func (mmGetDeletedTask *mTasksRepositoryMockGetDeletedTask) Set(f func(ctx context.Context, id string) (tp1 *model.Task, err error)) *TasksRepositoryMock {
+	if mmGetDeletedTask.defaultExpectation != nil {
+		mmGetDeletedTask.mock.t.Fatalf("Default expectation is already set for the TasksRepository.GetDeletedTask method")
+	}
+
+	if len(mmGetDeletedTask.expectations) > 0 {
+		mmGetDeletedTask.mock.t.Fatalf("Some expectations are already set for the TasksRepository.GetDeletedTask method")
+	}
+
+	mmGetDeletedTask.mock.funcGetDeletedTask = f
+	mmGetDeletedTask.mock.funcGetDeletedTaskOrigin = minimock.CallerInfo(1)
+	return mmGetDeletedTask.mock
+}
+
+// When sets expectation for the TasksRepository.GetDeletedTask which will trigger the result defined by the following
+// Then helper
+func (mmGetDeletedTask *mTasksRepositoryMockGetDeletedTask) When(ctx context.Context, id string) *TasksRepositoryMockGetDeletedTaskExpectation {
+	if mmGetDeletedTask.mock.funcGetDeletedTask != nil {
+		mmGetDeletedTask.mock.t.Fatalf("TasksRepositoryMock.GetDeletedTask mock is already set by Set")
+	}
+
+	expectation := &TasksRepositoryMockGetDeletedTaskExpectation{
+		mock:               mmGetDeletedTask.mock,
+		params:             &TasksRepositoryMockGetDeletedTaskParams{ctx, id},
+		expectationOrigins: TasksRepositoryMockGetDeletedTaskExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetDeletedTask.expectations = append(mmGetDeletedTask.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TasksRepository.GetDeletedTask return parameters for the expectation previously defined by the When method
+func (e *TasksRepositoryMockGetDeletedTaskExpectation) Then(tp1 *model.Task, err error) *TasksRepositoryMock {
+	e.results = &TasksRepositoryMockGetDeletedTaskResults{tp1, err}
+	return e.mock
+}
+
+// Times sets number of times TasksRepository.GetDeletedTask should be invoked
+func (mmGetDeletedTask *mTasksRepositoryMockGetDeletedTask) Times(n uint64) *mTasksRepositoryMockGetDeletedTask {
+	if n == 0 {
+		mmGetDeletedTask.mock.t.Fatalf("Times of TasksRepositoryMock.GetDeletedTask mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetDeletedTask.expectedInvocations, n)
+	mmGetDeletedTask.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetDeletedTask
+}
+
+func (mmGetDeletedTask *mTasksRepositoryMockGetDeletedTask) invocationsDone() bool {
+	if len(mmGetDeletedTask.expectations) == 0 && mmGetDeletedTask.defaultExpectation == nil && mmGetDeletedTask.mock.funcGetDeletedTask == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetDeletedTask.mock.afterGetDeletedTaskCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetDeletedTask.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetDeletedTask implements mm_service.TasksRepository
+func (mmGetDeletedTask *TasksRepositoryMock) GetDeletedTask(ctx context.Context, id string) (tp1 *model.Task, err error) {
+	mm_atomic.AddUint64(&mmGetDeletedTask.beforeGetDeletedTaskCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetDeletedTask.afterGetDeletedTaskCounter, 1)
+
+	mmGetDeletedTask.t.Helper()
+
+	if mmGetDeletedTask.inspectFuncGetDeletedTask != nil {
+		mmGetDeletedTask.inspectFuncGetDeletedTask(ctx, id)
+	}
+
+	mm_params := TasksRepositoryMockGetDeletedTaskParams{ctx, id}
+
+	// Record call args
+	mmGetDeletedTask.GetDeletedTaskMock.mutex.Lock()
+	mmGetDeletedTask.GetDeletedTaskMock.callArgs = append(mmGetDeletedTask.GetDeletedTaskMock.callArgs, &mm_params)
+	mmGetDeletedTask.GetDeletedTaskMock.mutex.Unlock()
+
+	for _, e := range mmGetDeletedTask.GetDeletedTaskMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tp1, e.results.err
+		}
+	}
+
+	if mmGetDeletedTask.GetDeletedTaskMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetDeletedTask.GetDeletedTaskMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetDeletedTask.GetDeletedTaskMock.defaultExpectation.params
+		mm_want_ptrs := mmGetDeletedTask.GetDeletedTaskMock.defaultExpectation.paramPtrs
+
+		mm_got := TasksRepositoryMockGetDeletedTaskParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetDeletedTask.t.Errorf("TasksRepositoryMock.GetDeletedTask got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDeletedTask.GetDeletedTaskMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetDeletedTask.t.Errorf("TasksRepositoryMock.GetDeletedTask got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDeletedTask.GetDeletedTaskMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetDeletedTask.t.Errorf("TasksRepositoryMock.GetDeletedTask got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetDeletedTask.GetDeletedTaskMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetDeletedTask.GetDeletedTaskMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetDeletedTask.t.Fatal("No results are set for the TasksRepositoryMock.GetDeletedTask")
+		}
+		return (*mm_results).tp1, (*mm_results).err
+	}
+	if mmGetDeletedTask.funcGetDeletedTask != nil {
+		return mmGetDeletedTask.funcGetDeletedTask(ctx, id)
+	}
+	mmGetDeletedTask.t.Fatalf("Unexpected call to TasksRepositoryMock.GetDeletedTask. %v %v", ctx, id)
+	return
+}
+
+// GetDeletedTaskAfterCounter returns a count of finished TasksRepositoryMock.GetDeletedTask invocations
+func (mmGetDeletedTask *TasksRepositoryMock) GetDeletedTaskAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDeletedTask.afterGetDeletedTaskCounter)
+}
+
+// GetDeletedTaskBeforeCounter returns a count of TasksRepositoryMock.GetDeletedTask invocations
+func (mmGetDeletedTask *TasksRepositoryMock) GetDeletedTaskBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDeletedTask.beforeGetDeletedTaskCounter)
+}
+
+// Calls returns a list of arguments used in each call to TasksRepositoryMock.GetDeletedTask.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetDeletedTask *mTasksRepositoryMockGetDeletedTask) Calls() []*TasksRepositoryMockGetDeletedTaskParams {
+	mmGetDeletedTask.mutex.RLock()
+
+	argCopy := make([]*TasksRepositoryMockGetDeletedTaskParams, len(mmGetDeletedTask.callArgs))
+	copy(argCopy, mmGetDeletedTask.callArgs)
+
+	mmGetDeletedTask.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDeletedTaskDone returns true if the count of the GetDeletedTask invocations corresponds
+// the number of defined expectations
+func (m *TasksRepositoryMock) MinimockGetDeletedTaskDone() bool {
+	if m.GetDeletedTaskMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetDeletedTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetDeletedTaskMock.invocationsDone()
+}
+
+// MinimockGetDeletedTaskInspect logs each unmet expectation
+func (m *TasksRepositoryMock) MinimockGetDeletedTaskInspect() {
+	for _, e := range m.GetDeletedTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TasksRepositoryMock.GetDeletedTask at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetDeletedTaskCounter := mm_atomic.LoadUint64(&m.afterGetDeletedTaskCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetDeletedTaskMock.defaultExpectation != nil && afterGetDeletedTaskCounter < 1 {
+		if m.GetDeletedTaskMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksRepositoryMock.GetDeletedTask at\n%s", m.GetDeletedTaskMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TasksRepositoryMock.GetDeletedTask at\n%s with params: %#v", m.GetDeletedTaskMock.defaultExpectation.expectationOrigins.origin, *m.GetDeletedTaskMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetDeletedTask != nil && afterGetDeletedTaskCounter < 1 {
+		m.t.Errorf("Expected call to TasksRepositoryMock.GetDeletedTask at\n%s", m.funcGetDeletedTaskOrigin)
+	}
+
+	if !m.GetDeletedTaskMock.invocationsDone() && afterGetDeletedTaskCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksRepositoryMock.GetDeletedTask at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetDeletedTaskMock.expectedInvocations), m.GetDeletedTaskMock.expectedInvocationsOrigin, afterGetDeletedTaskCounter)
+	}
+}
+
+type mTasksRepositoryMockGetTask struct {
+	optional           bool
+	mock               *TasksRepositoryMock
+	defaultExpectation *TasksRepositoryMockGetTaskExpectation
+	expectations       []*TasksRepositoryMockGetTaskExpectation
+
+	callArgs []*TasksRepositoryMockGetTaskParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TasksRepositoryMockGetTaskExpectation specifies expectation struct of the TasksRepository.GetTask
+type TasksRepositoryMockGetTaskExpectation struct {
+	mock               *TasksRepositoryMock
+	params             *TasksRepositoryMockGetTaskParams
+	paramPtrs          *TasksRepositoryMockGetTaskParamPtrs
+	expectationOrigins TasksRepositoryMockGetTaskExpectationOrigins
+	results            *TasksRepositoryMockGetTaskResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TasksRepositoryMockGetTaskParams contains parameters of the TasksRepository.GetTask
+type TasksRepositoryMockGetTaskParams struct {
+	ctx context.Context
+	id  string
+}
+
+// TasksRepositoryMockGetTaskParamPtrs contains pointers to parameters of the TasksRepository.GetTask
+type TasksRepositoryMockGetTaskParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// TasksRepositoryMockGetTaskResults contains results of the TasksRepository.GetTask
+type TasksRepositoryMockGetTaskResults struct {
+	tp1 *model.Task
+	err error
+}
+
+// TasksRepositoryMockGetTaskOrigins contains origins of expectations of the TasksRepository.GetTask
+type TasksRepositoryMockGetTaskExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetTask *mTasksRepositoryMockGetTask) Optional() *mTasksRepositoryMockGetTask {
+	mmGetTask.optional = true
+	return mmGetTask
 }
 
 // Expect sets up expected params for TasksRepository.GetTask
@@ -822,281 +2036,2401 @@ func (mmGetTask *mTasksRepositoryMockGetTask) Expect(ctx context.Context, id str
 		mmGetTask.mock.t.Fatalf("TasksRepositoryMock.GetTask mock is already set by Set")
 	}
 
-	if mmGetTask.defaultExpectation == nil {
-		mmGetTask.defaultExpectation = &TasksRepositoryMockGetTaskExpectation{}
+	if mmGetTask.defaultExpectation == nil {
+		mmGetTask.defaultExpectation = &TasksRepositoryMockGetTaskExpectation{}
+	}
+
+	if mmGetTask.defaultExpectation.paramPtrs != nil {
+		mmGetTask.mock.t.Fatalf("TasksRepositoryMock.GetTask mock is already set by ExpectParams functions")
+	}
+
+	mmGetTask.defaultExpectation.params = &TasksRepositoryMockGetTaskParams{ctx, id}
+	mmGetTask.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetTask.expectations {
+		if minimock.Equal(e.params, mmGetTask.defaultExpectation.params) {
+			mmGetTask.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetTask.defaultExpectation.params)
+		}
+	}
+
+	return mmGetTask
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TasksRepository.GetTask
+func (mmGetTask *mTasksRepositoryMockGetTask) ExpectCtxParam1(ctx context.Context) *mTasksRepositoryMockGetTask {
+	if mmGetTask.mock.funcGetTask != nil {
+		mmGetTask.mock.t.Fatalf("TasksRepositoryMock.GetTask mock is already set by Set")
+	}
+
+	if mmGetTask.defaultExpectation == nil {
+		mmGetTask.defaultExpectation = &TasksRepositoryMockGetTaskExpectation{}
+	}
+
+	if mmGetTask.defaultExpectation.params != nil {
+		mmGetTask.mock.t.Fatalf("TasksRepositoryMock.GetTask mock is already set by Expect")
+	}
+
+	if mmGetTask.defaultExpectation.paramPtrs == nil {
+		mmGetTask.defaultExpectation.paramPtrs = &TasksRepositoryMockGetTaskParamPtrs{}
+	}
+	mmGetTask.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetTask.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetTask
+}
+
+// ExpectIdParam2 sets up expected param id for TasksRepository.GetTask
+func (mmGetTask *mTasksRepositoryMockGetTask) ExpectIdParam2(id string) *mTasksRepositoryMockGetTask {
+	if mmGetTask.mock.funcGetTask != nil {
+		mmGetTask.mock.t.Fatalf("TasksRepositoryMock.GetTask mock is already set by Set")
+	}
+
+	if mmGetTask.defaultExpectation == nil {
+		mmGetTask.defaultExpectation = &TasksRepositoryMockGetTaskExpectation{}
+	}
+
+	if mmGetTask.defaultExpectation.params != nil {
+		mmGetTask.mock.t.Fatalf("TasksRepositoryMock.GetTask mock is already set by Expect")
+	}
+
+	if mmGetTask.defaultExpectation.paramPtrs == nil {
+		mmGetTask.defaultExpectation.paramPtrs = &TasksRepositoryMockGetTaskParamPtrs{}
+	}
+	mmGetTask.defaultExpectation.paramPtrs.id = &id
+	mmGetTask.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetTask
+}
+
+// Inspect accepts an inspector function that has same arguments as the TasksRepository.GetTask
+func (mmGetTask *mTasksRepositoryMockGetTask) Inspect(f func(ctx context.Context, id string)) *mTasksRepositoryMockGetTask {
+	if mmGetTask.mock.inspectFuncGetTask != nil {
+		mmGetTask.mock.t.Fatalf("Inspect function is already set for TasksRepositoryMock.GetTask")
+	}
+
+	mmGetTask.mock.inspectFuncGetTask = f
+
+	return mmGetTask
+}
+
+// Return sets up results that will be returned by TasksRepository.GetTask
+func (mmGetTask *mTasksRepositoryMockGetTask) Return(tp1 *model.Task, err error) *TasksRepositoryMock {
+	if mmGetTask.mock.funcGetTask != nil {
+		mmGetTask.mock.t.Fatalf("TasksRepositoryMock.GetTask mock is already set by Set")
+	}
+
+	if mmGetTask.defaultExpectation == nil {
+		mmGetTask.defaultExpectation = &TasksRepositoryMockGetTaskExpectation{mock: mmGetTask.mock}
+	}
+	mmGetTask.defaultExpectation.results = &TasksRepositoryMockGetTaskResults{tp1, err}
+	mmGetTask.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetTask.mock
+}
+
+// Set uses given function f to mock the TasksRepository.GetTask method
+func (mmGetTask *mTasksRepositoryMockGetTask) Set(f func(ctx context.Context, id string) (tp1 *model.Task, err error)) *TasksRepositoryMock {
+	if mmGetTask.defaultExpectation != nil {
+		mmGetTask.mock.t.Fatalf("Default expectation is already set for the TasksRepository.GetTask method")
+	}
+
+	if len(mmGetTask.expectations) > 0 {
+		mmGetTask.mock.t.Fatalf("Some expectations are already set for the TasksRepository.GetTask method")
+	}
+
+	mmGetTask.mock.funcGetTask = f
+	mmGetTask.mock.funcGetTaskOrigin = minimock.CallerInfo(1)
+	return mmGetTask.mock
+}
+
+// When sets expectation for the TasksRepository.GetTask which will trigger the result defined by the following
+// Then helper
+func (mmGetTask *mTasksRepositoryMockGetTask) When(ctx context.Context, id string) *TasksRepositoryMockGetTaskExpectation {
+	if mmGetTask.mock.funcGetTask != nil {
+		mmGetTask.mock.t.Fatalf("TasksRepositoryMock.GetTask mock is already set by Set")
+	}
+
+	expectation := &TasksRepositoryMockGetTaskExpectation{
+		mock:               mmGetTask.mock,
+		params:             &TasksRepositoryMockGetTaskParams{ctx, id},
+		expectationOrigins: TasksRepositoryMockGetTaskExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetTask.expectations = append(mmGetTask.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TasksRepository.GetTask return parameters for the expectation previously defined by the When method
+func (e *TasksRepositoryMockGetTaskExpectation) Then(tp1 *model.Task, err error) *TasksRepositoryMock {
+	e.results = &TasksRepositoryMockGetTaskResults{tp1, err}
+	return e.mock
+}
+
+// Times sets number of times TasksRepository.GetTask should be invoked
+func (mmGetTask *mTasksRepositoryMockGetTask) Times(n uint64) *mTasksRepositoryMockGetTask {
+	if n == 0 {
+		mmGetTask.mock.t.Fatalf("Times of TasksRepositoryMock.GetTask mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetTask.expectedInvocations, n)
+	mmGetTask.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetTask
+}
+
+func (mmGetTask *mTasksRepositoryMockGetTask) invocationsDone() bool {
+	if len(mmGetTask.expectations) == 0 && mmGetTask.defaultExpectation == nil && mmGetTask.mock.funcGetTask == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetTask.mock.afterGetTaskCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetTask.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetTask implements mm_service.TasksRepository
+func (mmGetTask *TasksRepositoryMock) GetTask(ctx context.Context, id string) (tp1 *model.Task, err error) {
+	mm_atomic.AddUint64(&mmGetTask.beforeGetTaskCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetTask.afterGetTaskCounter, 1)
+
+	mmGetTask.t.Helper()
+
+	if mmGetTask.inspectFuncGetTask != nil {
+		mmGetTask.inspectFuncGetTask(ctx, id)
+	}
+
+	mm_params := TasksRepositoryMockGetTaskParams{ctx, id}
+
+	// Record call args
+	mmGetTask.GetTaskMock.mutex.Lock()
+	mmGetTask.GetTaskMock.callArgs = append(mmGetTask.GetTaskMock.callArgs, &mm_params)
+	mmGetTask.GetTaskMock.mutex.Unlock()
+
+	for _, e := range mmGetTask.GetTaskMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tp1, e.results.err
+		}
+	}
+
+	if mmGetTask.GetTaskMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetTask.GetTaskMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetTask.GetTaskMock.defaultExpectation.params
+		mm_want_ptrs := mmGetTask.GetTaskMock.defaultExpectation.paramPtrs
+
+		mm_got := TasksRepositoryMockGetTaskParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetTask.t.Errorf("TasksRepositoryMock.GetTask got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTask.GetTaskMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetTask.t.Errorf("TasksRepositoryMock.GetTask got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTask.GetTaskMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetTask.t.Errorf("TasksRepositoryMock.GetTask got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetTask.GetTaskMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetTask.GetTaskMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetTask.t.Fatal("No results are set for the TasksRepositoryMock.GetTask")
+		}
+		return (*mm_results).tp1, (*mm_results).err
+	}
+	if mmGetTask.funcGetTask != nil {
+		return mmGetTask.funcGetTask(ctx, id)
+	}
+	mmGetTask.t.Fatalf("Unexpected call to TasksRepositoryMock.GetTask. %v %v", ctx, id)
+	return
+}
+
+// GetTaskAfterCounter returns a count of finished TasksRepositoryMock.GetTask invocations
+func (mmGetTask *TasksRepositoryMock) GetTaskAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTask.afterGetTaskCounter)
+}
+
+// GetTaskBeforeCounter returns a count of TasksRepositoryMock.GetTask invocations
+func (mmGetTask *TasksRepositoryMock) GetTaskBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTask.beforeGetTaskCounter)
+}
+
+// Calls returns a list of arguments used in each call to TasksRepositoryMock.GetTask.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetTask *mTasksRepositoryMockGetTask) Calls() []*TasksRepositoryMockGetTaskParams {
+	mmGetTask.mutex.RLock()
+
+	argCopy := make([]*TasksRepositoryMockGetTaskParams, len(mmGetTask.callArgs))
+	copy(argCopy, mmGetTask.callArgs)
+
+	mmGetTask.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetTaskDone returns true if the count of the GetTask invocations corresponds
+// the number of defined expectations
+func (m *TasksRepositoryMock) MinimockGetTaskDone() bool {
+	if m.GetTaskMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetTaskMock.invocationsDone()
+}
+
+// MinimockGetTaskInspect logs each unmet expectation
+func (m *TasksRepositoryMock) MinimockGetTaskInspect() {
+	for _, e := range m.GetTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TasksRepositoryMock.GetTask at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetTaskCounter := mm_atomic.LoadUint64(&m.afterGetTaskCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetTaskMock.defaultExpectation != nil && afterGetTaskCounter < 1 {
+		if m.GetTaskMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksRepositoryMock.GetTask at\n%s", m.GetTaskMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TasksRepositoryMock.GetTask at\n%s with params: %#v", m.GetTaskMock.defaultExpectation.expectationOrigins.origin, *m.GetTaskMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetTask != nil && afterGetTaskCounter < 1 {
+		m.t.Errorf("Expected call to TasksRepositoryMock.GetTask at\n%s", m.funcGetTaskOrigin)
+	}
+
+	if !m.GetTaskMock.invocationsDone() && afterGetTaskCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksRepositoryMock.GetTask at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetTaskMock.expectedInvocations), m.GetTaskMock.expectedInvocationsOrigin, afterGetTaskCounter)
+	}
+}
+
+type mTasksRepositoryMockListTasks struct {
+	optional           bool
+	mock               *TasksRepositoryMock
+	defaultExpectation *TasksRepositoryMockListTasksExpectation
+	expectations       []*TasksRepositoryMockListTasksExpectation
+
+	callArgs []*TasksRepositoryMockListTasksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TasksRepositoryMockListTasksExpectation specifies expectation struct of the TasksRepository.ListTasks
+type TasksRepositoryMockListTasksExpectation struct {
+	mock               *TasksRepositoryMock
+	params             *TasksRepositoryMockListTasksParams
+	paramPtrs          *TasksRepositoryMockListTasksParamPtrs
+	expectationOrigins TasksRepositoryMockListTasksExpectationOrigins
+	results            *TasksRepositoryMockListTasksResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TasksRepositoryMockListTasksParams contains parameters of the TasksRepository.ListTasks
+type TasksRepositoryMockListTasksParams struct {
+	ctx      context.Context
+	selector model.LabelSelector
+}
+
+// TasksRepositoryMockListTasksParamPtrs contains pointers to parameters of the TasksRepository.ListTasks
+type TasksRepositoryMockListTasksParamPtrs struct {
+	ctx      *context.Context
+	selector *model.LabelSelector
+}
+
+// TasksRepositoryMockListTasksResults contains results of the TasksRepository.ListTasks
+type TasksRepositoryMockListTasksResults struct {
+	ta1 []model.Task
+	err error
+}
+
+// TasksRepositoryMockListTasksOrigins contains origins of expectations of the TasksRepository.ListTasks
+type TasksRepositoryMockListTasksExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originSelector string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListTasks *mTasksRepositoryMockListTasks) Optional() *mTasksRepositoryMockListTasks {
+	mmListTasks.optional = true
+	return mmListTasks
+}
+
+// Expect sets up expected params for TasksRepository.ListTasks
+func (mmListTasks *mTasksRepositoryMockListTasks) Expect(ctx context.Context, selector model.LabelSelector) *mTasksRepositoryMockListTasks {
+	if mmListTasks.mock.funcListTasks != nil {
+		mmListTasks.mock.t.Fatalf("TasksRepositoryMock.ListTasks mock is already set by Set")
+	}
+
+	if mmListTasks.defaultExpectation == nil {
+		mmListTasks.defaultExpectation = &TasksRepositoryMockListTasksExpectation{}
+	}
+
+	if mmListTasks.defaultExpectation.paramPtrs != nil {
+		mmListTasks.mock.t.Fatalf("TasksRepositoryMock.ListTasks mock is already set by ExpectParams functions")
+	}
+
+	mmListTasks.defaultExpectation.params = &TasksRepositoryMockListTasksParams{ctx, selector}
+	mmListTasks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListTasks.expectations {
+		if minimock.Equal(e.params, mmListTasks.defaultExpectation.params) {
+			mmListTasks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListTasks.defaultExpectation.params)
+		}
+	}
+
+	return mmListTasks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TasksRepository.ListTasks
+func (mmListTasks *mTasksRepositoryMockListTasks) ExpectCtxParam1(ctx context.Context) *mTasksRepositoryMockListTasks {
+	if mmListTasks.mock.funcListTasks != nil {
+		mmListTasks.mock.t.Fatalf("TasksRepositoryMock.ListTasks mock is already set by Set")
+	}
+
+	if mmListTasks.defaultExpectation == nil {
+		mmListTasks.defaultExpectation = &TasksRepositoryMockListTasksExpectation{}
+	}
+
+	if mmListTasks.defaultExpectation.params != nil {
+		mmListTasks.mock.t.Fatalf("TasksRepositoryMock.ListTasks mock is already set by Expect")
+	}
+
+	if mmListTasks.defaultExpectation.paramPtrs == nil {
+		mmListTasks.defaultExpectation.paramPtrs = &TasksRepositoryMockListTasksParamPtrs{}
+	}
+	mmListTasks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListTasks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListTasks
+}
+
+// ExpectSelectorParam2 sets up expected param selector for TasksRepository.ListTasks
+func (mmListTasks *mTasksRepositoryMockListTasks) ExpectSelectorParam2(selector model.LabelSelector) *mTasksRepositoryMockListTasks {
+	if mmListTasks.mock.funcListTasks != nil {
+		mmListTasks.mock.t.Fatalf("TasksRepositoryMock.ListTasks mock is already set by Set")
+	}
+
+	if mmListTasks.defaultExpectation == nil {
+		mmListTasks.defaultExpectation = &TasksRepositoryMockListTasksExpectation{}
+	}
+
+	if mmListTasks.defaultExpectation.params != nil {
+		mmListTasks.mock.t.Fatalf("TasksRepositoryMock.ListTasks mock is already set by Expect")
+	}
+
+	if mmListTasks.defaultExpectation.paramPtrs == nil {
+		mmListTasks.defaultExpectation.paramPtrs = &TasksRepositoryMockListTasksParamPtrs{}
+	}
+	mmListTasks.defaultExpectation.paramPtrs.selector = &selector
+	mmListTasks.defaultExpectation.expectationOrigins.originSelector = minimock.CallerInfo(1)
+
+	return mmListTasks
+}
+
+// Inspect accepts an inspector function that has same arguments as the TasksRepository.ListTasks
+func (mmListTasks *mTasksRepositoryMockListTasks) Inspect(f func(ctx context.Context, selector model.LabelSelector)) *mTasksRepositoryMockListTasks {
+	if mmListTasks.mock.inspectFuncListTasks != nil {
+		mmListTasks.mock.t.Fatalf("Inspect function is already set for TasksRepositoryMock.ListTasks")
+	}
+
+	mmListTasks.mock.inspectFuncListTasks = f
+
+	return mmListTasks
+}
+
+// Return sets up results that will be returned by TasksRepository.ListTasks
+func (mmListTasks *mTasksRepositoryMockListTasks) Return(ta1 []model.Task, err error) *TasksRepositoryMock {
+	if mmListTasks.mock.funcListTasks != nil {
+		mmListTasks.mock.t.Fatalf("TasksRepositoryMock.ListTasks mock is already set by Set")
+	}
+
+	if mmListTasks.defaultExpectation == nil {
+		mmListTasks.defaultExpectation = &TasksRepositoryMockListTasksExpectation{mock: mmListTasks.mock}
+	}
+	mmListTasks.defaultExpectation.results = &TasksRepositoryMockListTasksResults{ta1, err}
+	mmListTasks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListTasks.mock
+}
+
+// Set uses given function f to mock the TasksRepository.ListTasks method
+func (mmListTasks *mTasksRepositoryMockListTasks) Set(f func(ctx context.Context, selector model.LabelSelector) (ta1 []model.Task, err error)) *TasksRepositoryMock {
+	if mmListTasks.defaultExpectation != nil {
+		mmListTasks.mock.t.Fatalf("Default expectation is already set for the TasksRepository.ListTasks method")
+	}
+
+	if len(mmListTasks.expectations) > 0 {
+		mmListTasks.mock.t.Fatalf("Some expectations are already set for the TasksRepository.ListTasks method")
+	}
+
+	mmListTasks.mock.funcListTasks = f
+	mmListTasks.mock.funcListTasksOrigin = minimock.CallerInfo(1)
+	return mmListTasks.mock
+}
+
+// When sets expectation for the TasksRepository.ListTasks which will trigger the result defined by the following
+// Then helper
+func (mmListTasks *mTasksRepositoryMockListTasks) When(ctx context.Context, selector model.LabelSelector) *TasksRepositoryMockListTasksExpectation {
+	if mmListTasks.mock.funcListTasks != nil {
+		mmListTasks.mock.t.Fatalf("TasksRepositoryMock.ListTasks mock is already set by Set")
+	}
+
+	expectation := &TasksRepositoryMockListTasksExpectation{
+		mock:               mmListTasks.mock,
+		params:             &TasksRepositoryMockListTasksParams{ctx, selector},
+		expectationOrigins: TasksRepositoryMockListTasksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListTasks.expectations = append(mmListTasks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TasksRepository.ListTasks return parameters for the expectation previously defined by the When method
+func (e *TasksRepositoryMockListTasksExpectation) Then(ta1 []model.Task, err error) *TasksRepositoryMock {
+	e.results = &TasksRepositoryMockListTasksResults{ta1, err}
+	return e.mock
+}
+
+// Times sets number of times TasksRepository.ListTasks should be invoked
+func (mmListTasks *mTasksRepositoryMockListTasks) Times(n uint64) *mTasksRepositoryMockListTasks {
+	if n == 0 {
+		mmListTasks.mock.t.Fatalf("Times of TasksRepositoryMock.ListTasks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListTasks.expectedInvocations, n)
+	mmListTasks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListTasks
+}
+
+func (mmListTasks *mTasksRepositoryMockListTasks) invocationsDone() bool {
+	if len(mmListTasks.expectations) == 0 && mmListTasks.defaultExpectation == nil && mmListTasks.mock.funcListTasks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListTasks.mock.afterListTasksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListTasks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListTasks implements mm_service.TasksRepository
+func (mmListTasks *TasksRepositoryMock) ListTasks(ctx context.Context, selector model.LabelSelector) (ta1 []model.Task, err error) {
+	mm_atomic.AddUint64(&mmListTasks.beforeListTasksCounter, 1)
+	defer mm_atomic.AddUint64(&mmListTasks.afterListTasksCounter, 1)
+
+	mmListTasks.t.Helper()
+
+	if mmListTasks.inspectFuncListTasks != nil {
+		mmListTasks.inspectFuncListTasks(ctx, selector)
+	}
+
+	mm_params := TasksRepositoryMockListTasksParams{ctx, selector}
+
+	// Record call args
+	mmListTasks.ListTasksMock.mutex.Lock()
+	mmListTasks.ListTasksMock.callArgs = append(mmListTasks.ListTasksMock.callArgs, &mm_params)
+	mmListTasks.ListTasksMock.mutex.Unlock()
+
+	for _, e := range mmListTasks.ListTasksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ta1, e.results.err
+		}
+	}
+
+	if mmListTasks.ListTasksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListTasks.ListTasksMock.defaultExpectation.Counter, 1)
+		mm_want := mmListTasks.ListTasksMock.defaultExpectation.params
+		mm_want_ptrs := mmListTasks.ListTasksMock.defaultExpectation.paramPtrs
+
+		mm_got := TasksRepositoryMockListTasksParams{ctx, selector}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListTasks.t.Errorf("TasksRepositoryMock.ListTasks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListTasks.ListTasksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.selector != nil && !minimock.Equal(*mm_want_ptrs.selector, mm_got.selector) {
+				mmListTasks.t.Errorf("TasksRepositoryMock.ListTasks got unexpected parameter selector, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListTasks.ListTasksMock.defaultExpectation.expectationOrigins.originSelector, *mm_want_ptrs.selector, mm_got.selector, minimock.Diff(*mm_want_ptrs.selector, mm_got.selector))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListTasks.t.Errorf("TasksRepositoryMock.ListTasks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListTasks.ListTasksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListTasks.ListTasksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListTasks.t.Fatal("No results are set for the TasksRepositoryMock.ListTasks")
+		}
+		return (*mm_results).ta1, (*mm_results).err
+	}
+	if mmListTasks.funcListTasks != nil {
+		return mmListTasks.funcListTasks(ctx, selector)
+	}
+	mmListTasks.t.Fatalf("Unexpected call to TasksRepositoryMock.ListTasks. %v %v", ctx, selector)
+	return
+}
+
+// ListTasksAfterCounter returns a count of finished TasksRepositoryMock.ListTasks invocations
+func (mmListTasks *TasksRepositoryMock) ListTasksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListTasks.afterListTasksCounter)
+}
+
+// ListTasksBeforeCounter returns a count of TasksRepositoryMock.ListTasks invocations
+func (mmListTasks *TasksRepositoryMock) ListTasksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListTasks.beforeListTasksCounter)
+}
+
+// Calls returns a list of arguments used in each call to TasksRepositoryMock.ListTasks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListTasks *mTasksRepositoryMockListTasks) Calls() []*TasksRepositoryMockListTasksParams {
+	mmListTasks.mutex.RLock()
+
+	argCopy := make([]*TasksRepositoryMockListTasksParams, len(mmListTasks.callArgs))
+	copy(argCopy, mmListTasks.callArgs)
+
+	mmListTasks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListTasksDone returns true if the count of the ListTasks invocations corresponds
+// the number of defined expectations
+func (m *TasksRepositoryMock) MinimockListTasksDone() bool {
+	if m.ListTasksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListTasksMock.invocationsDone()
+}
+
+// MinimockListTasksInspect logs each unmet expectation
+func (m *TasksRepositoryMock) MinimockListTasksInspect() {
+	for _, e := range m.ListTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TasksRepositoryMock.ListTasks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListTasksCounter := mm_atomic.LoadUint64(&m.afterListTasksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListTasksMock.defaultExpectation != nil && afterListTasksCounter < 1 {
+		if m.ListTasksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksRepositoryMock.ListTasks at\n%s", m.ListTasksMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TasksRepositoryMock.ListTasks at\n%s with params: %#v", m.ListTasksMock.defaultExpectation.expectationOrigins.origin, *m.ListTasksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListTasks != nil && afterListTasksCounter < 1 {
+		m.t.Errorf("Expected call to TasksRepositoryMock.ListTasks at\n%s", m.funcListTasksOrigin)
+	}
+
+	if !m.ListTasksMock.invocationsDone() && afterListTasksCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksRepositoryMock.ListTasks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListTasksMock.expectedInvocations), m.ListTasksMock.expectedInvocationsOrigin, afterListTasksCounter)
+	}
+}
+
+type mTasksRepositoryMockPatchTask struct {
+	optional           bool
+	mock               *TasksRepositoryMock
+	defaultExpectation *TasksRepositoryMockPatchTaskExpectation
+	expectations       []*TasksRepositoryMockPatchTaskExpectation
+
+	callArgs []*TasksRepositoryMockPatchTaskParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TasksRepositoryMockPatchTaskExpectation specifies expectation struct of the TasksRepository.PatchTask
+type TasksRepositoryMockPatchTaskExpectation struct {
+	mock               *TasksRepositoryMock
+	params             *TasksRepositoryMockPatchTaskParams
+	paramPtrs          *TasksRepositoryMockPatchTaskParamPtrs
+	expectationOrigins TasksRepositoryMockPatchTaskExpectationOrigins
+	results            *TasksRepositoryMockPatchTaskResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TasksRepositoryMockPatchTaskParams contains parameters of the TasksRepository.PatchTask
+type TasksRepositoryMockPatchTaskParams struct {
+	ctx   context.Context
+	id    string
+	apply func(task *model.Task) error
+}
+
+// TasksRepositoryMockPatchTaskParamPtrs contains pointers to parameters of the TasksRepository.PatchTask
+type TasksRepositoryMockPatchTaskParamPtrs struct {
+	ctx   *context.Context
+	id    *string
+	apply *func(task *model.Task) error
+}
+
+// TasksRepositoryMockPatchTaskResults contains results of the TasksRepository.PatchTask
+type TasksRepositoryMockPatchTaskResults struct {
+	t1  model.TaskChange
+	err error
+}
+
+// TasksRepositoryMockPatchTaskOrigins contains origins of expectations of the TasksRepository.PatchTask
+type TasksRepositoryMockPatchTaskExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originId    string
+	originApply string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPatchTask *mTasksRepositoryMockPatchTask) Optional() *mTasksRepositoryMockPatchTask {
+	mmPatchTask.optional = true
+	return mmPatchTask
+}
+
+// Expect sets up expected params for TasksRepository.PatchTask
+func (mmPatchTask *mTasksRepositoryMockPatchTask) Expect(ctx context.Context, id string, apply func(task *model.Task) error) *mTasksRepositoryMockPatchTask {
+	if mmPatchTask.mock.funcPatchTask != nil {
+		mmPatchTask.mock.t.Fatalf("TasksRepositoryMock.PatchTask mock is already set by Set")
+	}
+
+	if mmPatchTask.defaultExpectation == nil {
+		mmPatchTask.defaultExpectation = &TasksRepositoryMockPatchTaskExpectation{}
+	}
+
+	if mmPatchTask.defaultExpectation.paramPtrs != nil {
+		mmPatchTask.mock.t.Fatalf("TasksRepositoryMock.PatchTask mock is already set by ExpectParams functions")
+	}
+
+	mmPatchTask.defaultExpectation.params = &TasksRepositoryMockPatchTaskParams{ctx, id, apply}
+	mmPatchTask.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPatchTask.expectations {
+		if minimock.Equal(e.params, mmPatchTask.defaultExpectation.params) {
+			mmPatchTask.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPatchTask.defaultExpectation.params)
+		}
+	}
+
+	return mmPatchTask
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TasksRepository.PatchTask
+func (mmPatchTask *mTasksRepositoryMockPatchTask) ExpectCtxParam1(ctx context.Context) *mTasksRepositoryMockPatchTask {
+	if mmPatchTask.mock.funcPatchTask != nil {
+		mmPatchTask.mock.t.Fatalf("TasksRepositoryMock.PatchTask mock is already set by Set")
+	}
+
+	if mmPatchTask.defaultExpectation == nil {
+		mmPatchTask.defaultExpectation = &TasksRepositoryMockPatchTaskExpectation{}
+	}
+
+	if mmPatchTask.defaultExpectation.params != nil {
+		mmPatchTask.mock.t.Fatalf("TasksRepositoryMock.PatchTask mock is already set by Expect")
+	}
+
+	if mmPatchTask.defaultExpectation.paramPtrs == nil {
+		mmPatchTask.defaultExpectation.paramPtrs = &TasksRepositoryMockPatchTaskParamPtrs{}
+	}
+	mmPatchTask.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPatchTask.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPatchTask
+}
+
+// ExpectIdParam2 sets up expected param id for TasksRepository.PatchTask
+func (mmPatchTask *mTasksRepositoryMockPatchTask) ExpectIdParam2(id string) *mTasksRepositoryMockPatchTask {
+	if mmPatchTask.mock.funcPatchTask != nil {
+		mmPatchTask.mock.t.Fatalf("TasksRepositoryMock.PatchTask mock is already set by Set")
+	}
+
+	if mmPatchTask.defaultExpectation == nil {
+		mmPatchTask.defaultExpectation = &TasksRepositoryMockPatchTaskExpectation{}
+	}
+
+	if mmPatchTask.defaultExpectation.params != nil {
+		mmPatchTask.mock.t.Fatalf("TasksRepositoryMock.PatchTask mock is already set by Expect")
+	}
+
+	if mmPatchTask.defaultExpectation.paramPtrs == nil {
+		mmPatchTask.defaultExpectation.paramPtrs = &TasksRepositoryMockPatchTaskParamPtrs{}
+	}
+	mmPatchTask.defaultExpectation.paramPtrs.id = &id
+	mmPatchTask.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmPatchTask
+}
+
+// ExpectApplyParam3 sets up expected param apply for TasksRepository.PatchTask
+func (mmPatchTask *mTasksRepositoryMockPatchTask) ExpectApplyParam3(apply func(task *model.Task) error) *mTasksRepositoryMockPatchTask {
+	if mmPatchTask.mock.funcPatchTask != nil {
+		mmPatchTask.mock.t.Fatalf("TasksRepositoryMock.PatchTask mock is already set by Set")
+	}
+
+	if mmPatchTask.defaultExpectation == nil {
+		mmPatchTask.defaultExpectation = &TasksRepositoryMockPatchTaskExpectation{}
+	}
+
+	if mmPatchTask.defaultExpectation.params != nil {
+		mmPatchTask.mock.t.Fatalf("TasksRepositoryMock.PatchTask mock is already set by Expect")
+	}
+
+	if mmPatchTask.defaultExpectation.paramPtrs == nil {
+		mmPatchTask.defaultExpectation.paramPtrs = &TasksRepositoryMockPatchTaskParamPtrs{}
+	}
+	mmPatchTask.defaultExpectation.paramPtrs.apply = &apply
+	mmPatchTask.defaultExpectation.expectationOrigins.originApply = minimock.CallerInfo(1)
+
+	return mmPatchTask
+}
+
+// Inspect accepts an inspector function that has same arguments as the TasksRepository.PatchTask
+func (mmPatchTask *mTasksRepositoryMockPatchTask) Inspect(f func(ctx context.Context, id string, apply func(task *model.Task) error)) *mTasksRepositoryMockPatchTask {
+	if mmPatchTask.mock.inspectFuncPatchTask != nil {
+		mmPatchTask.mock.t.Fatalf("Inspect function is already set for TasksRepositoryMock.PatchTask")
+	}
+
+	mmPatchTask.mock.inspectFuncPatchTask = f
+
+	return mmPatchTask
+}
+
+// Return sets up results that will be returned by TasksRepository.PatchTask
+func (mmPatchTask *mTasksRepositoryMockPatchTask) Return(t1 model.TaskChange, err error) *TasksRepositoryMock {
+	if mmPatchTask.mock.funcPatchTask != nil {
+		mmPatchTask.mock.t.Fatalf("TasksRepositoryMock.PatchTask mock is already set by Set")
+	}
+
+	if mmPatchTask.defaultExpectation == nil {
+		mmPatchTask.defaultExpectation = &TasksRepositoryMockPatchTaskExpectation{mock: mmPatchTask.mock}
+	}
+	mmPatchTask.defaultExpectation.results = &TasksRepositoryMockPatchTaskResults{t1, err}
+	mmPatchTask.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPatchTask.mock
+}
+
+// Set uses given function f to mock the TasksRepository.PatchTask method
+func (mmPatchTask *mTasksRepositoryMockPatchTask) Set(f func(ctx context.Context, id string, apply func(task *model.Task) error) (t1 model.TaskChange, err error)) *TasksRepositoryMock {
+	if mmPatchTask.defaultExpectation != nil {
+		mmPatchTask.mock.t.Fatalf("Default expectation is already set for the TasksRepository.PatchTask method")
+	}
+
+	if len(mmPatchTask.expectations) > 0 {
+		mmPatchTask.mock.t.Fatalf("Some expectations are already set for the TasksRepository.PatchTask method")
+	}
+
+	mmPatchTask.mock.funcPatchTask = f
+	mmPatchTask.mock.funcPatchTaskOrigin = minimock.CallerInfo(1)
+	return mmPatchTask.mock
+}
+
+// When sets expectation for the TasksRepository.PatchTask which will trigger the result defined by the following
+// Then helper
+func (mmPatchTask *mTasksRepositoryMockPatchTask) When(ctx context.Context, id string, apply func(task *model.Task) error) *TasksRepositoryMockPatchTaskExpectation {
+	if mmPatchTask.mock.funcPatchTask != nil {
+		mmPatchTask.mock.t.Fatalf("TasksRepositoryMock.PatchTask mock is already set by Set")
+	}
+
+	expectation := &TasksRepositoryMockPatchTaskExpectation{
+		mock:               mmPatchTask.mock,
+		params:             &TasksRepositoryMockPatchTaskParams{ctx, id, apply},
+		expectationOrigins: TasksRepositoryMockPatchTaskExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPatchTask.expectations = append(mmPatchTask.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TasksRepository.PatchTask return parameters for the expectation previously defined by the When method
+func (e *TasksRepositoryMockPatchTaskExpectation) Then(t1 model.TaskChange, err error) *TasksRepositoryMock {
+	e.results = &TasksRepositoryMockPatchTaskResults{t1, err}
+	return e.mock
+}
+
+// Times sets number of times TasksRepository.PatchTask should be invoked
+func (mmPatchTask *mTasksRepositoryMockPatchTask) Times(n uint64) *mTasksRepositoryMockPatchTask {
+	if n == 0 {
+		mmPatchTask.mock.t.Fatalf("Times of TasksRepositoryMock.PatchTask mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPatchTask.expectedInvocations, n)
+	mmPatchTask.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPatchTask
+}
+
+func (mmPatchTask *mTasksRepositoryMockPatchTask) invocationsDone() bool {
+	if len(mmPatchTask.expectations) == 0 && mmPatchTask.defaultExpectation == nil && mmPatchTask.mock.funcPatchTask == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPatchTask.mock.afterPatchTaskCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPatchTask.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PatchTask implements mm_service.TasksRepository
+func (mmPatchTask *TasksRepositoryMock) PatchTask(ctx context.Context, id string, apply func(task *model.Task) error) (t1 model.TaskChange, err error) {
+	mm_atomic.AddUint64(&mmPatchTask.beforePatchTaskCounter, 1)
+	defer mm_atomic.AddUint64(&mmPatchTask.afterPatchTaskCounter, 1)
+
+	mmPatchTask.t.Helper()
+
+	if mmPatchTask.inspectFuncPatchTask != nil {
+		mmPatchTask.inspectFuncPatchTask(ctx, id, apply)
+	}
+
+	mm_params := TasksRepositoryMockPatchTaskParams{ctx, id, apply}
+
+	// Record call args
+	mmPatchTask.PatchTaskMock.mutex.Lock()
+	mmPatchTask.PatchTaskMock.callArgs = append(mmPatchTask.PatchTaskMock.callArgs, &mm_params)
+	mmPatchTask.PatchTaskMock.mutex.Unlock()
+
+	for _, e := range mmPatchTask.PatchTaskMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.t1, e.results.err
+		}
+	}
+
+	if mmPatchTask.PatchTaskMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPatchTask.PatchTaskMock.defaultExpectation.Counter, 1)
+		mm_want := mmPatchTask.PatchTaskMock.defaultExpectation.params
+		mm_want_ptrs := mmPatchTask.PatchTaskMock.defaultExpectation.paramPtrs
+
+		mm_got := TasksRepositoryMockPatchTaskParams{ctx, id, apply}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPatchTask.t.Errorf("TasksRepositoryMock.PatchTask got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchTask.PatchTaskMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmPatchTask.t.Errorf("TasksRepositoryMock.PatchTask got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchTask.PatchTaskMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.apply != nil && !minimock.Equal(*mm_want_ptrs.apply, mm_got.apply) {
+				mmPatchTask.t.Errorf("TasksRepositoryMock.PatchTask got unexpected parameter apply, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchTask.PatchTaskMock.defaultExpectation.expectationOrigins.originApply, *mm_want_ptrs.apply, mm_got.apply, minimock.Diff(*mm_want_ptrs.apply, mm_got.apply))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPatchTask.t.Errorf("TasksRepositoryMock.PatchTask got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPatchTask.PatchTaskMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPatchTask.PatchTaskMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPatchTask.t.Fatal("No results are set for the TasksRepositoryMock.PatchTask")
+		}
+		return (*mm_results).t1, (*mm_results).err
+	}
+	if mmPatchTask.funcPatchTask != nil {
+		return mmPatchTask.funcPatchTask(ctx, id, apply)
+	}
+	mmPatchTask.t.Fatalf("Unexpected call to TasksRepositoryMock.PatchTask. %v %v %v", ctx, id, apply)
+	return
+}
+
+// PatchTaskAfterCounter returns a count of finished TasksRepositoryMock.PatchTask invocations
+func (mmPatchTask *TasksRepositoryMock) PatchTaskAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPatchTask.afterPatchTaskCounter)
+}
+
+// PatchTaskBeforeCounter returns a count of TasksRepositoryMock.PatchTask invocations
+func (mmPatchTask *TasksRepositoryMock) PatchTaskBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPatchTask.beforePatchTaskCounter)
+}
+
+// Calls returns a list of arguments used in each call to TasksRepositoryMock.PatchTask.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPatchTask *mTasksRepositoryMockPatchTask) Calls() []*TasksRepositoryMockPatchTaskParams {
+	mmPatchTask.mutex.RLock()
+
+	argCopy := make([]*TasksRepositoryMockPatchTaskParams, len(mmPatchTask.callArgs))
+	copy(argCopy, mmPatchTask.callArgs)
+
+	mmPatchTask.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPatchTaskDone returns true if the count of the PatchTask invocations corresponds
+// the number of defined expectations
+func (m *TasksRepositoryMock) MinimockPatchTaskDone() bool {
+	if m.PatchTaskMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PatchTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PatchTaskMock.invocationsDone()
+}
+
+// MinimockPatchTaskInspect logs each unmet expectation
+func (m *TasksRepositoryMock) MinimockPatchTaskInspect() {
+	for _, e := range m.PatchTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TasksRepositoryMock.PatchTask at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPatchTaskCounter := mm_atomic.LoadUint64(&m.afterPatchTaskCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PatchTaskMock.defaultExpectation != nil && afterPatchTaskCounter < 1 {
+		if m.PatchTaskMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksRepositoryMock.PatchTask at\n%s", m.PatchTaskMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TasksRepositoryMock.PatchTask at\n%s with params: %#v", m.PatchTaskMock.defaultExpectation.expectationOrigins.origin, *m.PatchTaskMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPatchTask != nil && afterPatchTaskCounter < 1 {
+		m.t.Errorf("Expected call to TasksRepositoryMock.PatchTask at\n%s", m.funcPatchTaskOrigin)
+	}
+
+	if !m.PatchTaskMock.invocationsDone() && afterPatchTaskCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksRepositoryMock.PatchTask at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PatchTaskMock.expectedInvocations), m.PatchTaskMock.expectedInvocationsOrigin, afterPatchTaskCounter)
+	}
+}
+
+type mTasksRepositoryMockPurgeTasks struct {
+	optional           bool
+	mock               *TasksRepositoryMock
+	defaultExpectation *TasksRepositoryMockPurgeTasksExpectation
+	expectations       []*TasksRepositoryMockPurgeTasksExpectation
+
+	callArgs []*TasksRepositoryMockPurgeTasksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TasksRepositoryMockPurgeTasksExpectation specifies expectation struct of the TasksRepository.PurgeTasks
+type TasksRepositoryMockPurgeTasksExpectation struct {
+	mock               *TasksRepositoryMock
+	params             *TasksRepositoryMockPurgeTasksParams
+	paramPtrs          *TasksRepositoryMockPurgeTasksParamPtrs
+	expectationOrigins TasksRepositoryMockPurgeTasksExpectationOrigins
+	results            *TasksRepositoryMockPurgeTasksResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TasksRepositoryMockPurgeTasksParams contains parameters of the TasksRepository.PurgeTasks
+type TasksRepositoryMockPurgeTasksParams struct {
+	ctx context.Context
+	ids []string
+}
+
+// TasksRepositoryMockPurgeTasksParamPtrs contains pointers to parameters of the TasksRepository.PurgeTasks
+type TasksRepositoryMockPurgeTasksParamPtrs struct {
+	ctx *context.Context
+	ids *[]string
+}
+
+// TasksRepositoryMockPurgeTasksResults contains results of the TasksRepository.PurgeTasks
+type TasksRepositoryMockPurgeTasksResults struct {
+	ta1 []model.TaskChange
+	ea1 []error
+}
+
+// TasksRepositoryMockPurgeTasksOrigins contains origins of expectations of the TasksRepository.PurgeTasks
+type TasksRepositoryMockPurgeTasksExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIds string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeTasks *mTasksRepositoryMockPurgeTasks) Optional() *mTasksRepositoryMockPurgeTasks {
+	mmPurgeTasks.optional = true
+	return mmPurgeTasks
+}
+
+// Expect sets up expected params for TasksRepository.PurgeTasks
+func (mmPurgeTasks *mTasksRepositoryMockPurgeTasks) Expect(ctx context.Context, ids []string) *mTasksRepositoryMockPurgeTasks {
+	if mmPurgeTasks.mock.funcPurgeTasks != nil {
+		mmPurgeTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeTasks mock is already set by Set")
+	}
+
+	if mmPurgeTasks.defaultExpectation == nil {
+		mmPurgeTasks.defaultExpectation = &TasksRepositoryMockPurgeTasksExpectation{}
+	}
+
+	if mmPurgeTasks.defaultExpectation.paramPtrs != nil {
+		mmPurgeTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeTasks mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeTasks.defaultExpectation.params = &TasksRepositoryMockPurgeTasksParams{ctx, ids}
+	mmPurgeTasks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeTasks.expectations {
+		if minimock.Equal(e.params, mmPurgeTasks.defaultExpectation.params) {
+			mmPurgeTasks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeTasks.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeTasks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TasksRepository.PurgeTasks
+func (mmPurgeTasks *mTasksRepositoryMockPurgeTasks) ExpectCtxParam1(ctx context.Context) *mTasksRepositoryMockPurgeTasks {
+	if mmPurgeTasks.mock.funcPurgeTasks != nil {
+		mmPurgeTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeTasks mock is already set by Set")
+	}
+
+	if mmPurgeTasks.defaultExpectation == nil {
+		mmPurgeTasks.defaultExpectation = &TasksRepositoryMockPurgeTasksExpectation{}
+	}
+
+	if mmPurgeTasks.defaultExpectation.params != nil {
+		mmPurgeTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeTasks mock is already set by Expect")
+	}
+
+	if mmPurgeTasks.defaultExpectation.paramPtrs == nil {
+		mmPurgeTasks.defaultExpectation.paramPtrs = &TasksRepositoryMockPurgeTasksParamPtrs{}
+	}
+	mmPurgeTasks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeTasks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeTasks
+}
+
+// ExpectIdsParam2 sets up expected param ids for TasksRepository.PurgeTasks
+func (mmPurgeTasks *mTasksRepositoryMockPurgeTasks) ExpectIdsParam2(ids []string) *mTasksRepositoryMockPurgeTasks {
+	if mmPurgeTasks.mock.funcPurgeTasks != nil {
+		mmPurgeTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeTasks mock is already set by Set")
+	}
+
+	if mmPurgeTasks.defaultExpectation == nil {
+		mmPurgeTasks.defaultExpectation = &TasksRepositoryMockPurgeTasksExpectation{}
+	}
+
+	if mmPurgeTasks.defaultExpectation.params != nil {
+		mmPurgeTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeTasks mock is already set by Expect")
+	}
+
+	if mmPurgeTasks.defaultExpectation.paramPtrs == nil {
+		mmPurgeTasks.defaultExpectation.paramPtrs = &TasksRepositoryMockPurgeTasksParamPtrs{}
+	}
+	mmPurgeTasks.defaultExpectation.paramPtrs.ids = &ids
+	mmPurgeTasks.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
+
+	return mmPurgeTasks
+}
+
+// Inspect accepts an inspector function that has same arguments as the TasksRepository.PurgeTasks
+func (mmPurgeTasks *mTasksRepositoryMockPurgeTasks) Inspect(f func(ctx context.Context, ids []string)) *mTasksRepositoryMockPurgeTasks {
+	if mmPurgeTasks.mock.inspectFuncPurgeTasks != nil {
+		mmPurgeTasks.mock.t.Fatalf("Inspect function is already set for TasksRepositoryMock.PurgeTasks")
+	}
+
+	mmPurgeTasks.mock.inspectFuncPurgeTasks = f
+
+	return mmPurgeTasks
+}
+
+// Return sets up results that will be returned by TasksRepository.PurgeTasks
+func (mmPurgeTasks *mTasksRepositoryMockPurgeTasks) Return(ta1 []model.TaskChange, ea1 []error) *TasksRepositoryMock {
+	if mmPurgeTasks.mock.funcPurgeTasks != nil {
+		mmPurgeTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeTasks mock is already set by Set")
+	}
+
+	if mmPurgeTasks.defaultExpectation == nil {
+		mmPurgeTasks.defaultExpectation = &TasksRepositoryMockPurgeTasksExpectation{mock: mmPurgeTasks.mock}
+	}
+	mmPurgeTasks.defaultExpectation.results = &TasksRepositoryMockPurgeTasksResults{ta1, ea1}
+	mmPurgeTasks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeTasks.mock
+}
+
+// Set uses given function f to mock the TasksRepository.PurgeTasks method
+func (mmPurgeTasks *mTasksRepositoryMockPurgeTasks) Set(f func(ctx context.Context, ids []string) (ta1 []model.TaskChange, ea1 []error)) *TasksRepositoryMock {
+	if mmPurgeTasks.defaultExpectation != nil {
+		mmPurgeTasks.mock.t.Fatalf("Default expectation is already set for the TasksRepository.PurgeTasks method")
+	}
+
+	if len(mmPurgeTasks.expectations) > 0 {
+		mmPurgeTasks.mock.t.Fatalf("Some expectations are already set for the TasksRepository.PurgeTasks method")
+	}
+
+	mmPurgeTasks.mock.funcPurgeTasks = f
+	mmPurgeTasks.mock.funcPurgeTasksOrigin = minimock.CallerInfo(1)
+	return mmPurgeTasks.mock
+}
+
+// When sets expectation for the TasksRepository.PurgeTasks which will trigger the result defined by the following
+// Then helper
+func (mmPurgeTasks *mTasksRepositoryMockPurgeTasks) When(ctx context.Context, ids []string) *TasksRepositoryMockPurgeTasksExpectation {
+	if mmPurgeTasks.mock.funcPurgeTasks != nil {
+		mmPurgeTasks.mock.t.Fatalf("TasksRepositoryMock.PurgeTasks mock is already set by Set")
+	}
+
+	expectation := &TasksRepositoryMockPurgeTasksExpectation{
+		mock:               mmPurgeTasks.mock,
+		params:             &TasksRepositoryMockPurgeTasksParams{ctx, ids},
+		expectationOrigins: TasksRepositoryMockPurgeTasksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeTasks.expectations = append(mmPurgeTasks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TasksRepository.PurgeTasks return parameters for the expectation previously defined by the When method
+func (e *TasksRepositoryMockPurgeTasksExpectation) Then(ta1 []model.TaskChange, ea1 []error) *TasksRepositoryMock {
+	e.results = &TasksRepositoryMockPurgeTasksResults{ta1, ea1}
+	return e.mock
+}
+
+// Times sets number of times TasksRepository.PurgeTasks should be invoked
+func (mmPurgeTasks *mTasksRepositoryMockPurgeTasks) Times(n uint64) *mTasksRepositoryMockPurgeTasks {
+	if n == 0 {
+		mmPurgeTasks.mock.t.Fatalf("Times of TasksRepositoryMock.PurgeTasks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeTasks.expectedInvocations, n)
+	mmPurgeTasks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeTasks
+}
+
+func (mmPurgeTasks *mTasksRepositoryMockPurgeTasks) invocationsDone() bool {
+	if len(mmPurgeTasks.expectations) == 0 && mmPurgeTasks.defaultExpectation == nil && mmPurgeTasks.mock.funcPurgeTasks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeTasks.mock.afterPurgeTasksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeTasks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeTasks implements mm_service.TasksRepository
+func (mmPurgeTasks *TasksRepositoryMock) PurgeTasks(ctx context.Context, ids []string) (ta1 []model.TaskChange, ea1 []error) {
+	mm_atomic.AddUint64(&mmPurgeTasks.beforePurgeTasksCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeTasks.afterPurgeTasksCounter, 1)
+
+	mmPurgeTasks.t.Helper()
+
+	if mmPurgeTasks.inspectFuncPurgeTasks != nil {
+		mmPurgeTasks.inspectFuncPurgeTasks(ctx, ids)
+	}
+
+	mm_params := TasksRepositoryMockPurgeTasksParams{ctx, ids}
+
+	// Record call args
+	mmPurgeTasks.PurgeTasksMock.mutex.Lock()
+	mmPurgeTasks.PurgeTasksMock.callArgs = append(mmPurgeTasks.PurgeTasksMock.callArgs, &mm_params)
+	mmPurgeTasks.PurgeTasksMock.mutex.Unlock()
+
+	for _, e := range mmPurgeTasks.PurgeTasksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ta1, e.results.ea1
+		}
+	}
+
+	if mmPurgeTasks.PurgeTasksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeTasks.PurgeTasksMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeTasks.PurgeTasksMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeTasks.PurgeTasksMock.defaultExpectation.paramPtrs
+
+		mm_got := TasksRepositoryMockPurgeTasksParams{ctx, ids}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeTasks.t.Errorf("TasksRepositoryMock.PurgeTasks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeTasks.PurgeTasksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmPurgeTasks.t.Errorf("TasksRepositoryMock.PurgeTasks got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeTasks.PurgeTasksMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeTasks.t.Errorf("TasksRepositoryMock.PurgeTasks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeTasks.PurgeTasksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeTasks.PurgeTasksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeTasks.t.Fatal("No results are set for the TasksRepositoryMock.PurgeTasks")
+		}
+		return (*mm_results).ta1, (*mm_results).ea1
+	}
+	if mmPurgeTasks.funcPurgeTasks != nil {
+		return mmPurgeTasks.funcPurgeTasks(ctx, ids)
+	}
+	mmPurgeTasks.t.Fatalf("Unexpected call to TasksRepositoryMock.PurgeTasks. %v %v", ctx, ids)
+	return
+}
+
+// PurgeTasksAfterCounter returns a count of finished TasksRepositoryMock.PurgeTasks invocations
+func (mmPurgeTasks *TasksRepositoryMock) PurgeTasksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeTasks.afterPurgeTasksCounter)
+}
+
+// PurgeTasksBeforeCounter returns a count of TasksRepositoryMock.PurgeTasks invocations
+func (mmPurgeTasks *TasksRepositoryMock) PurgeTasksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeTasks.beforePurgeTasksCounter)
+}
+
+// Calls returns a list of arguments used in each call to TasksRepositoryMock.PurgeTasks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeTasks *mTasksRepositoryMockPurgeTasks) Calls() []*TasksRepositoryMockPurgeTasksParams {
+	mmPurgeTasks.mutex.RLock()
+
+	argCopy := make([]*TasksRepositoryMockPurgeTasksParams, len(mmPurgeTasks.callArgs))
+	copy(argCopy, mmPurgeTasks.callArgs)
+
+	mmPurgeTasks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeTasksDone returns true if the count of the PurgeTasks invocations corresponds
+// the number of defined expectations
+func (m *TasksRepositoryMock) MinimockPurgeTasksDone() bool {
+	if m.PurgeTasksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeTasksMock.invocationsDone()
+}
+
+// MinimockPurgeTasksInspect logs each unmet expectation
+func (m *TasksRepositoryMock) MinimockPurgeTasksInspect() {
+	for _, e := range m.PurgeTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TasksRepositoryMock.PurgeTasks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeTasksCounter := mm_atomic.LoadUint64(&m.afterPurgeTasksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeTasksMock.defaultExpectation != nil && afterPurgeTasksCounter < 1 {
+		if m.PurgeTasksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksRepositoryMock.PurgeTasks at\n%s", m.PurgeTasksMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TasksRepositoryMock.PurgeTasks at\n%s with params: %#v", m.PurgeTasksMock.defaultExpectation.expectationOrigins.origin, *m.PurgeTasksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeTasks != nil && afterPurgeTasksCounter < 1 {
+		m.t.Errorf("Expected call to TasksRepositoryMock.PurgeTasks at\n%s", m.funcPurgeTasksOrigin)
+	}
+
+	if !m.PurgeTasksMock.invocationsDone() && afterPurgeTasksCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksRepositoryMock.PurgeTasks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeTasksMock.expectedInvocations), m.PurgeTasksMock.expectedInvocationsOrigin, afterPurgeTasksCounter)
+	}
+}
+
+type mTasksRepositoryMockRestoreTask struct {
+	optional           bool
+	mock               *TasksRepositoryMock
+	defaultExpectation *TasksRepositoryMockRestoreTaskExpectation
+	expectations       []*TasksRepositoryMockRestoreTaskExpectation
+
+	callArgs []*TasksRepositoryMockRestoreTaskParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TasksRepositoryMockRestoreTaskExpectation specifies expectation struct of the TasksRepository.RestoreTask
+type TasksRepositoryMockRestoreTaskExpectation struct {
+	mock               *TasksRepositoryMock
+	params             *TasksRepositoryMockRestoreTaskParams
+	paramPtrs          *TasksRepositoryMockRestoreTaskParamPtrs
+	expectationOrigins TasksRepositoryMockRestoreTaskExpectationOrigins
+	results            *TasksRepositoryMockRestoreTaskResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TasksRepositoryMockRestoreTaskParams contains parameters of the TasksRepository.RestoreTask
+type TasksRepositoryMockRestoreTaskParams struct {
+	ctx   context.Context
+	id    string
+	owner string
+}
+
+// TasksRepositoryMockRestoreTaskParamPtrs contains pointers to parameters of the TasksRepository.RestoreTask
+type TasksRepositoryMockRestoreTaskParamPtrs struct {
+	ctx   *context.Context
+	id    *string
+	owner *string
+}
+
+// TasksRepositoryMockRestoreTaskResults contains results of the TasksRepository.RestoreTask
+type TasksRepositoryMockRestoreTaskResults struct {
+	t1  model.TaskChange
+	err error
+}
+
+// TasksRepositoryMockRestoreTaskOrigins contains origins of expectations of the TasksRepository.RestoreTask
+type TasksRepositoryMockRestoreTaskExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originId    string
+	originOwner string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRestoreTask *mTasksRepositoryMockRestoreTask) Optional() *mTasksRepositoryMockRestoreTask {
+	mmRestoreTask.optional = true
+	return mmRestoreTask
+}
+
+// Expect sets up expected params for TasksRepository.RestoreTask
+func (mmRestoreTask *mTasksRepositoryMockRestoreTask) Expect(ctx context.Context, id string, owner string) *mTasksRepositoryMockRestoreTask {
+	if mmRestoreTask.mock.funcRestoreTask != nil {
+		mmRestoreTask.mock.t.Fatalf("TasksRepositoryMock.RestoreTask mock is already set by Set")
+	}
+
+	if mmRestoreTask.defaultExpectation == nil {
+		mmRestoreTask.defaultExpectation = &TasksRepositoryMockRestoreTaskExpectation{}
+	}
+
+	if mmRestoreTask.defaultExpectation.paramPtrs != nil {
+		mmRestoreTask.mock.t.Fatalf("TasksRepositoryMock.RestoreTask mock is already set by ExpectParams functions")
+	}
+
+	mmRestoreTask.defaultExpectation.params = &TasksRepositoryMockRestoreTaskParams{ctx, id, owner}
+	mmRestoreTask.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRestoreTask.expectations {
+		if minimock.Equal(e.params, mmRestoreTask.defaultExpectation.params) {
+			mmRestoreTask.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRestoreTask.defaultExpectation.params)
+		}
+	}
+
+	return mmRestoreTask
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TasksRepository.RestoreTask
+func (mmRestoreTask *mTasksRepositoryMockRestoreTask) ExpectCtxParam1(ctx context.Context) *mTasksRepositoryMockRestoreTask {
+	if mmRestoreTask.mock.funcRestoreTask != nil {
+		mmRestoreTask.mock.t.Fatalf("TasksRepositoryMock.RestoreTask mock is already set by Set")
+	}
+
+	if mmRestoreTask.defaultExpectation == nil {
+		mmRestoreTask.defaultExpectation = &TasksRepositoryMockRestoreTaskExpectation{}
+	}
+
+	if mmRestoreTask.defaultExpectation.params != nil {
+		mmRestoreTask.mock.t.Fatalf("TasksRepositoryMock.RestoreTask mock is already set by Expect")
+	}
+
+	if mmRestoreTask.defaultExpectation.paramPtrs == nil {
+		mmRestoreTask.defaultExpectation.paramPtrs = &TasksRepositoryMockRestoreTaskParamPtrs{}
+	}
+	mmRestoreTask.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRestoreTask.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRestoreTask
+}
+
+// ExpectIdParam2 sets up expected param id for TasksRepository.RestoreTask
+func (mmRestoreTask *mTasksRepositoryMockRestoreTask) ExpectIdParam2(id string) *mTasksRepositoryMockRestoreTask {
+	if mmRestoreTask.mock.funcRestoreTask != nil {
+		mmRestoreTask.mock.t.Fatalf("TasksRepositoryMock.RestoreTask mock is already set by Set")
+	}
+
+	if mmRestoreTask.defaultExpectation == nil {
+		mmRestoreTask.defaultExpectation = &TasksRepositoryMockRestoreTaskExpectation{}
+	}
+
+	if mmRestoreTask.defaultExpectation.params != nil {
+		mmRestoreTask.mock.t.Fatalf("TasksRepositoryMock.RestoreTask mock is already set by Expect")
+	}
+
+	if mmRestoreTask.defaultExpectation.paramPtrs == nil {
+		mmRestoreTask.defaultExpectation.paramPtrs = &TasksRepositoryMockRestoreTaskParamPtrs{}
+	}
+	mmRestoreTask.defaultExpectation.paramPtrs.id = &id
+	mmRestoreTask.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmRestoreTask
+}
+
+// ExpectOwnerParam3 sets up expected param owner for TasksRepository.RestoreTask
+func (mmRestoreTask *mTasksRepositoryMockRestoreTask) ExpectOwnerParam3(owner string) *mTasksRepositoryMockRestoreTask {
+	if mmRestoreTask.mock.funcRestoreTask != nil {
+		mmRestoreTask.mock.t.Fatalf("TasksRepositoryMock.RestoreTask mock is already set by Set")
+	}
+
+	if mmRestoreTask.defaultExpectation == nil {
+		mmRestoreTask.defaultExpectation = &TasksRepositoryMockRestoreTaskExpectation{}
+	}
+
+	if mmRestoreTask.defaultExpectation.params != nil {
+		mmRestoreTask.mock.t.Fatalf("TasksRepositoryMock.RestoreTask mock is already set by Expect")
+	}
+
+	if mmRestoreTask.defaultExpectation.paramPtrs == nil {
+		mmRestoreTask.defaultExpectation.paramPtrs = &TasksRepositoryMockRestoreTaskParamPtrs{}
+	}
+	mmRestoreTask.defaultExpectation.paramPtrs.owner = &owner
+	mmRestoreTask.defaultExpectation.expectationOrigins.originOwner = minimock.CallerInfo(1)
+
+	return mmRestoreTask
+}
+
+// Inspect accepts an inspector function that has same arguments as the TasksRepository.RestoreTask
+func (mmRestoreTask *mTasksRepositoryMockRestoreTask) Inspect(f func(ctx context.Context, id string, owner string)) *mTasksRepositoryMockRestoreTask {
+	if mmRestoreTask.mock.inspectFuncRestoreTask != nil {
+		mmRestoreTask.mock.t.Fatalf("Inspect function is already set for TasksRepositoryMock.RestoreTask")
+	}
+
+	mmRestoreTask.mock.inspectFuncRestoreTask = f
+
+	return mmRestoreTask
+}
+
+// Return sets up results that will be returned by TasksRepository.RestoreTask
+func (mmRestoreTask *mTasksRepositoryMockRestoreTask) Return(t1 model.TaskChange, err error) *TasksRepositoryMock {
+	if mmRestoreTask.mock.funcRestoreTask != nil {
+		mmRestoreTask.mock.t.Fatalf("TasksRepositoryMock.RestoreTask mock is already set by Set")
+	}
+
+	if mmRestoreTask.defaultExpectation == nil {
+		mmRestoreTask.defaultExpectation = &TasksRepositoryMockRestoreTaskExpectation{mock: mmRestoreTask.mock}
+	}
+	mmRestoreTask.defaultExpectation.results = &TasksRepositoryMockRestoreTaskResults{t1, err}
+	mmRestoreTask.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRestoreTask.mock
+}
+
+// Set uses given function f to mock the TasksRepository.RestoreTask method
+func (mmRestoreTask *mTasksRepositoryMockRestoreTask) Set(f func(ctx context.Context, id string, owner string) (t1 model.TaskChange, err error)) *TasksRepositoryMock {
+	if mmRestoreTask.defaultExpectation != nil {
+		mmRestoreTask.mock.t.Fatalf("Default expectation is already set for the TasksRepository.RestoreTask method")
+	}
+
+	if len(mmRestoreTask.expectations) > 0 {
+		mmRestoreTask.mock.t.Fatalf("Some expectations are already set for the TasksRepository.RestoreTask method")
+	}
+
+	mmRestoreTask.mock.funcRestoreTask = f
+	mmRestoreTask.mock.funcRestoreTaskOrigin = minimock.CallerInfo(1)
+	return mmRestoreTask.mock
+}
+
+// When sets expectation for the TasksRepository.RestoreTask which will trigger the result defined by the following
+// Then helper
+func (mmRestoreTask *mTasksRepositoryMockRestoreTask) When(ctx context.Context, id string, owner string) *TasksRepositoryMockRestoreTaskExpectation {
+	if mmRestoreTask.mock.funcRestoreTask != nil {
+		mmRestoreTask.mock.t.Fatalf("TasksRepositoryMock.RestoreTask mock is already set by Set")
+	}
+
+	expectation := &TasksRepositoryMockRestoreTaskExpectation{
+		mock:               mmRestoreTask.mock,
+		params:             &TasksRepositoryMockRestoreTaskParams{ctx, id, owner},
+		expectationOrigins: TasksRepositoryMockRestoreTaskExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRestoreTask.expectations = append(mmRestoreTask.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TasksRepository.RestoreTask return parameters for the expectation previously defined by the When method
+func (e *TasksRepositoryMockRestoreTaskExpectation) Then(t1 model.TaskChange, err error) *TasksRepositoryMock {
+	e.results = &TasksRepositoryMockRestoreTaskResults{t1, err}
+	return e.mock
+}
+
+// Times sets number of times TasksRepository.RestoreTask should be invoked
+func (mmRestoreTask *mTasksRepositoryMockRestoreTask) Times(n uint64) *mTasksRepositoryMockRestoreTask {
+	if n == 0 {
+		mmRestoreTask.mock.t.Fatalf("Times of TasksRepositoryMock.RestoreTask mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRestoreTask.expectedInvocations, n)
+	mmRestoreTask.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRestoreTask
+}
+
+func (mmRestoreTask *mTasksRepositoryMockRestoreTask) invocationsDone() bool {
+	if len(mmRestoreTask.expectations) == 0 && mmRestoreTask.defaultExpectation == nil && mmRestoreTask.mock.funcRestoreTask == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRestoreTask.mock.afterRestoreTaskCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRestoreTask.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RestoreTask implements mm_service.TasksRepository
+func (mmRestoreTask *TasksRepositoryMock) RestoreTask(ctx context.Context, id string, owner string) (t1 model.TaskChange, err error) {
+	mm_atomic.AddUint64(&mmRestoreTask.beforeRestoreTaskCounter, 1)
+	defer mm_atomic.AddUint64(&mmRestoreTask.afterRestoreTaskCounter, 1)
+
+	mmRestoreTask.t.Helper()
+
+	if mmRestoreTask.inspectFuncRestoreTask != nil {
+		mmRestoreTask.inspectFuncRestoreTask(ctx, id, owner)
+	}
+
+	mm_params := TasksRepositoryMockRestoreTaskParams{ctx, id, owner}
+
+	// Record call args
+	mmRestoreTask.RestoreTaskMock.mutex.Lock()
+	mmRestoreTask.RestoreTaskMock.callArgs = append(mmRestoreTask.RestoreTaskMock.callArgs, &mm_params)
+	mmRestoreTask.RestoreTaskMock.mutex.Unlock()
+
+	for _, e := range mmRestoreTask.RestoreTaskMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.t1, e.results.err
+		}
+	}
+
+	if mmRestoreTask.RestoreTaskMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRestoreTask.RestoreTaskMock.defaultExpectation.Counter, 1)
+		mm_want := mmRestoreTask.RestoreTaskMock.defaultExpectation.params
+		mm_want_ptrs := mmRestoreTask.RestoreTaskMock.defaultExpectation.paramPtrs
+
+		mm_got := TasksRepositoryMockRestoreTaskParams{ctx, id, owner}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRestoreTask.t.Errorf("TasksRepositoryMock.RestoreTask got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreTask.RestoreTaskMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRestoreTask.t.Errorf("TasksRepositoryMock.RestoreTask got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreTask.RestoreTaskMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.owner != nil && !minimock.Equal(*mm_want_ptrs.owner, mm_got.owner) {
+				mmRestoreTask.t.Errorf("TasksRepositoryMock.RestoreTask got unexpected parameter owner, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreTask.RestoreTaskMock.defaultExpectation.expectationOrigins.originOwner, *mm_want_ptrs.owner, mm_got.owner, minimock.Diff(*mm_want_ptrs.owner, mm_got.owner))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRestoreTask.t.Errorf("TasksRepositoryMock.RestoreTask got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRestoreTask.RestoreTaskMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRestoreTask.RestoreTaskMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRestoreTask.t.Fatal("No results are set for the TasksRepositoryMock.RestoreTask")
+		}
+		return (*mm_results).t1, (*mm_results).err
+	}
+	if mmRestoreTask.funcRestoreTask != nil {
+		return mmRestoreTask.funcRestoreTask(ctx, id, owner)
+	}
+	mmRestoreTask.t.Fatalf("Unexpected call to TasksRepositoryMock.RestoreTask. %v %v %v", ctx, id, owner)
+	return
+}
+
+// RestoreTaskAfterCounter returns a count of finished TasksRepositoryMock.RestoreTask invocations
+func (mmRestoreTask *TasksRepositoryMock) RestoreTaskAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestoreTask.afterRestoreTaskCounter)
+}
+
+// RestoreTaskBeforeCounter returns a count of TasksRepositoryMock.RestoreTask invocations
+func (mmRestoreTask *TasksRepositoryMock) RestoreTaskBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestoreTask.beforeRestoreTaskCounter)
+}
+
+// Calls returns a list of arguments used in each call to TasksRepositoryMock.RestoreTask.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRestoreTask *mTasksRepositoryMockRestoreTask) Calls() []*TasksRepositoryMockRestoreTaskParams {
+	mmRestoreTask.mutex.RLock()
+
+	argCopy := make([]*TasksRepositoryMockRestoreTaskParams, len(mmRestoreTask.callArgs))
+	copy(argCopy, mmRestoreTask.callArgs)
+
+	mmRestoreTask.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRestoreTaskDone returns true if the count of the RestoreTask invocations corresponds
+// the number of defined expectations
+func (m *TasksRepositoryMock) MinimockRestoreTaskDone() bool {
+	if m.RestoreTaskMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RestoreTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RestoreTaskMock.invocationsDone()
+}
+
+// MinimockRestoreTaskInspect logs each unmet expectation
+func (m *TasksRepositoryMock) MinimockRestoreTaskInspect() {
+	for _, e := range m.RestoreTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TasksRepositoryMock.RestoreTask at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRestoreTaskCounter := mm_atomic.LoadUint64(&m.afterRestoreTaskCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RestoreTaskMock.defaultExpectation != nil && afterRestoreTaskCounter < 1 {
+		if m.RestoreTaskMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksRepositoryMock.RestoreTask at\n%s", m.RestoreTaskMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TasksRepositoryMock.RestoreTask at\n%s with params: %#v", m.RestoreTaskMock.defaultExpectation.expectationOrigins.origin, *m.RestoreTaskMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRestoreTask != nil && afterRestoreTaskCounter < 1 {
+		m.t.Errorf("Expected call to TasksRepositoryMock.RestoreTask at\n%s", m.funcRestoreTaskOrigin)
+	}
+
+	if !m.RestoreTaskMock.invocationsDone() && afterRestoreTaskCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksRepositoryMock.RestoreTask at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RestoreTaskMock.expectedInvocations), m.RestoreTaskMock.expectedInvocationsOrigin, afterRestoreTaskCounter)
+	}
+}
+
+type mTasksRepositoryMockSearchTasks struct {
+	optional           bool
+	mock               *TasksRepositoryMock
+	defaultExpectation *TasksRepositoryMockSearchTasksExpectation
+	expectations       []*TasksRepositoryMockSearchTasksExpectation
+
+	callArgs []*TasksRepositoryMockSearchTasksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TasksRepositoryMockSearchTasksExpectation specifies expectation struct of the TasksRepository.SearchTasks
+type TasksRepositoryMockSearchTasksExpectation struct {
+	mock               *TasksRepositoryMock
+	params             *TasksRepositoryMockSearchTasksParams
+	paramPtrs          *TasksRepositoryMockSearchTasksParamPtrs
+	expectationOrigins TasksRepositoryMockSearchTasksExpectationOrigins
+	results            *TasksRepositoryMockSearchTasksResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TasksRepositoryMockSearchTasksParams contains parameters of the TasksRepository.SearchTasks
+type TasksRepositoryMockSearchTasksParams struct {
+	ctx   context.Context
+	query string
+	limit int
+}
+
+// TasksRepositoryMockSearchTasksParamPtrs contains pointers to parameters of the TasksRepository.SearchTasks
+type TasksRepositoryMockSearchTasksParamPtrs struct {
+	ctx   *context.Context
+	query *string
+	limit *int
+}
+
+// TasksRepositoryMockSearchTasksResults contains results of the TasksRepository.SearchTasks
+type TasksRepositoryMockSearchTasksResults struct {
+	ta1 []model.Task
+	err error
+}
+
+// TasksRepositoryMockSearchTasksOrigins contains origins of expectations of the TasksRepository.SearchTasks
+type TasksRepositoryMockSearchTasksExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originQuery string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSearchTasks *mTasksRepositoryMockSearchTasks) Optional() *mTasksRepositoryMockSearchTasks {
+	mmSearchTasks.optional = true
+	return mmSearchTasks
+}
+
+// Expect sets up expected params for TasksRepository.SearchTasks
+func (mmSearchTasks *mTasksRepositoryMockSearchTasks) Expect(ctx context.Context, query string, limit int) *mTasksRepositoryMockSearchTasks {
+	if mmSearchTasks.mock.funcSearchTasks != nil {
+		mmSearchTasks.mock.t.Fatalf("TasksRepositoryMock.SearchTasks mock is already set by Set")
+	}
+
+	if mmSearchTasks.defaultExpectation == nil {
+		mmSearchTasks.defaultExpectation = &TasksRepositoryMockSearchTasksExpectation{}
+	}
+
+	if mmSearchTasks.defaultExpectation.paramPtrs != nil {
+		mmSearchTasks.mock.t.Fatalf("TasksRepositoryMock.SearchTasks mock is already set by ExpectParams functions")
+	}
+
+	mmSearchTasks.defaultExpectation.params = &TasksRepositoryMockSearchTasksParams{ctx, query, limit}
+	mmSearchTasks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSearchTasks.expectations {
+		if minimock.Equal(e.params, mmSearchTasks.defaultExpectation.params) {
+			mmSearchTasks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearchTasks.defaultExpectation.params)
+		}
+	}
+
+	return mmSearchTasks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TasksRepository.SearchTasks
+func (mmSearchTasks *mTasksRepositoryMockSearchTasks) ExpectCtxParam1(ctx context.Context) *mTasksRepositoryMockSearchTasks {
+	if mmSearchTasks.mock.funcSearchTasks != nil {
+		mmSearchTasks.mock.t.Fatalf("TasksRepositoryMock.SearchTasks mock is already set by Set")
+	}
+
+	if mmSearchTasks.defaultExpectation == nil {
+		mmSearchTasks.defaultExpectation = &TasksRepositoryMockSearchTasksExpectation{}
+	}
+
+	if mmSearchTasks.defaultExpectation.params != nil {
+		mmSearchTasks.mock.t.Fatalf("TasksRepositoryMock.SearchTasks mock is already set by Expect")
+	}
+
+	if mmSearchTasks.defaultExpectation.paramPtrs == nil {
+		mmSearchTasks.defaultExpectation.paramPtrs = &TasksRepositoryMockSearchTasksParamPtrs{}
+	}
+	mmSearchTasks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSearchTasks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSearchTasks
+}
+
+// ExpectQueryParam2 sets up expected param query for TasksRepository.SearchTasks
+func (mmSearchTasks *mTasksRepositoryMockSearchTasks) ExpectQueryParam2(query string) *mTasksRepositoryMockSearchTasks {
+	if mmSearchTasks.mock.funcSearchTasks != nil {
+		mmSearchTasks.mock.t.Fatalf("TasksRepositoryMock.SearchTasks mock is already set by Set")
+	}
+
+	if mmSearchTasks.defaultExpectation == nil {
+		mmSearchTasks.defaultExpectation = &TasksRepositoryMockSearchTasksExpectation{}
+	}
+
+	if mmSearchTasks.defaultExpectation.params != nil {
+		mmSearchTasks.mock.t.Fatalf("TasksRepositoryMock.SearchTasks mock is already set by Expect")
+	}
+
+	if mmSearchTasks.defaultExpectation.paramPtrs == nil {
+		mmSearchTasks.defaultExpectation.paramPtrs = &TasksRepositoryMockSearchTasksParamPtrs{}
+	}
+	mmSearchTasks.defaultExpectation.paramPtrs.query = &query
+	mmSearchTasks.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
+
+	return mmSearchTasks
+}
+
+// ExpectLimitParam3 sets up expected param limit for TasksRepository.SearchTasks
+func (mmSearchTasks *mTasksRepositoryMockSearchTasks) ExpectLimitParam3(limit int) *mTasksRepositoryMockSearchTasks {
+	if mmSearchTasks.mock.funcSearchTasks != nil {
+		mmSearchTasks.mock.t.Fatalf("TasksRepositoryMock.SearchTasks mock is already set by Set")
+	}
+
+	if mmSearchTasks.defaultExpectation == nil {
+		mmSearchTasks.defaultExpectation = &TasksRepositoryMockSearchTasksExpectation{}
+	}
+
+	if mmSearchTasks.defaultExpectation.params != nil {
+		mmSearchTasks.mock.t.Fatalf("TasksRepositoryMock.SearchTasks mock is already set by Expect")
+	}
+
+	if mmSearchTasks.defaultExpectation.paramPtrs == nil {
+		mmSearchTasks.defaultExpectation.paramPtrs = &TasksRepositoryMockSearchTasksParamPtrs{}
+	}
+	mmSearchTasks.defaultExpectation.paramPtrs.limit = &limit
+	mmSearchTasks.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmSearchTasks
+}
+
+// Inspect accepts an inspector function that has same arguments as the TasksRepository.SearchTasks
+func (mmSearchTasks *mTasksRepositoryMockSearchTasks) Inspect(f func(ctx context.Context, query string, limit int)) *mTasksRepositoryMockSearchTasks {
+	if mmSearchTasks.mock.inspectFuncSearchTasks != nil {
+		mmSearchTasks.mock.t.Fatalf("Inspect function is already set for TasksRepositoryMock.SearchTasks")
+	}
+
+	mmSearchTasks.mock.inspectFuncSearchTasks = f
+
+	return mmSearchTasks
+}
+
+// Return sets up results that will be returned by TasksRepository.SearchTasks
+func (mmSearchTasks *mTasksRepositoryMockSearchTasks) Return(ta1 []model.Task, err error) *TasksRepositoryMock {
+	if mmSearchTasks.mock.funcSearchTasks != nil {
+		mmSearchTasks.mock.t.Fatalf("TasksRepositoryMock.SearchTasks mock is already set by Set")
+	}
+
+	if mmSearchTasks.defaultExpectation == nil {
+		mmSearchTasks.defaultExpectation = &TasksRepositoryMockSearchTasksExpectation{mock: mmSearchTasks.mock}
+	}
+	mmSearchTasks.defaultExpectation.results = &TasksRepositoryMockSearchTasksResults{ta1, err}
+	mmSearchTasks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSearchTasks.mock
+}
+
+// Set uses given function f to mock the TasksRepository.SearchTasks method
+func (mmSearchTasks *mTasksRepositoryMockSearchTasks) Set(f func(ctx context.Context, query string, limit int) (ta1 []model.Task, err error)) *TasksRepositoryMock {
+	if mmSearchTasks.defaultExpectation != nil {
+		mmSearchTasks.mock.t.Fatalf("Default expectation is already set for the TasksRepository.SearchTasks method")
+	}
+
+	if len(mmSearchTasks.expectations) > 0 {
+		mmSearchTasks.mock.t.Fatalf("Some expectations are already set for the TasksRepository.SearchTasks method")
+	}
+
+	mmSearchTasks.mock.funcSearchTasks = f
+	mmSearchTasks.mock.funcSearchTasksOrigin = minimock.CallerInfo(1)
+	return mmSearchTasks.mock
+}
+
+// When sets expectation for the TasksRepository.SearchTasks which will trigger the result defined by the following
+// Then helper
+func (mmSearchTasks *mTasksRepositoryMockSearchTasks) When(ctx context.Context, query string, limit int) *TasksRepositoryMockSearchTasksExpectation {
+	if mmSearchTasks.mock.funcSearchTasks != nil {
+		mmSearchTasks.mock.t.Fatalf("TasksRepositoryMock.SearchTasks mock is already set by Set")
+	}
+
+	expectation := &TasksRepositoryMockSearchTasksExpectation{
+		mock:               mmSearchTasks.mock,
+		params:             &TasksRepositoryMockSearchTasksParams{ctx, query, limit},
+		expectationOrigins: TasksRepositoryMockSearchTasksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSearchTasks.expectations = append(mmSearchTasks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TasksRepository.SearchTasks return parameters for the expectation previously defined by the When method
+func (e *TasksRepositoryMockSearchTasksExpectation) Then(ta1 []model.Task, err error) *TasksRepositoryMock {
+	e.results = &TasksRepositoryMockSearchTasksResults{ta1, err}
+	return e.mock
+}
+
+// Times sets number of times TasksRepository.SearchTasks should be invoked
+func (mmSearchTasks *mTasksRepositoryMockSearchTasks) Times(n uint64) *mTasksRepositoryMockSearchTasks {
+	if n == 0 {
+		mmSearchTasks.mock.t.Fatalf("Times of TasksRepositoryMock.SearchTasks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSearchTasks.expectedInvocations, n)
+	mmSearchTasks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSearchTasks
+}
+
+func (mmSearchTasks *mTasksRepositoryMockSearchTasks) invocationsDone() bool {
+	if len(mmSearchTasks.expectations) == 0 && mmSearchTasks.defaultExpectation == nil && mmSearchTasks.mock.funcSearchTasks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSearchTasks.mock.afterSearchTasksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSearchTasks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SearchTasks implements mm_service.TasksRepository
+func (mmSearchTasks *TasksRepositoryMock) SearchTasks(ctx context.Context, query string, limit int) (ta1 []model.Task, err error) {
+	mm_atomic.AddUint64(&mmSearchTasks.beforeSearchTasksCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearchTasks.afterSearchTasksCounter, 1)
+
+	mmSearchTasks.t.Helper()
+
+	if mmSearchTasks.inspectFuncSearchTasks != nil {
+		mmSearchTasks.inspectFuncSearchTasks(ctx, query, limit)
 	}
 
-	if mmGetTask.defaultExpectation.paramPtrs != nil {
-		mmGetTask.mock.t.Fatalf("TasksRepositoryMock.GetTask mock is already set by ExpectParams functions")
+	mm_params := TasksRepositoryMockSearchTasksParams{ctx, query, limit}
+
+	// Record call args
+	mmSearchTasks.SearchTasksMock.mutex.Lock()
+	mmSearchTasks.SearchTasksMock.callArgs = append(mmSearchTasks.SearchTasksMock.callArgs, &mm_params)
+	mmSearchTasks.SearchTasksMock.mutex.Unlock()
+
+	for _, e := range mmSearchTasks.SearchTasksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ta1, e.results.err
+		}
 	}
 
-	mmGetTask.defaultExpectation.params = &TasksRepositoryMockGetTaskParams{ctx, id}
-	mmGetTask.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetTask.expectations {
-		if minimock.Equal(e.params, mmGetTask.defaultExpectation.params) {
-			mmGetTask.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetTask.defaultExpectation.params)
+	if mmSearchTasks.SearchTasksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearchTasks.SearchTasksMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearchTasks.SearchTasksMock.defaultExpectation.params
+		mm_want_ptrs := mmSearchTasks.SearchTasksMock.defaultExpectation.paramPtrs
+
+		mm_got := TasksRepositoryMockSearchTasksParams{ctx, query, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSearchTasks.t.Errorf("TasksRepositoryMock.SearchTasks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchTasks.SearchTasksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmSearchTasks.t.Errorf("TasksRepositoryMock.SearchTasks got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchTasks.SearchTasksMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmSearchTasks.t.Errorf("TasksRepositoryMock.SearchTasks got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchTasks.SearchTasksMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSearchTasks.t.Errorf("TasksRepositoryMock.SearchTasks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSearchTasks.SearchTasksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSearchTasks.SearchTasksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSearchTasks.t.Fatal("No results are set for the TasksRepositoryMock.SearchTasks")
 		}
+		return (*mm_results).ta1, (*mm_results).err
+	}
+	if mmSearchTasks.funcSearchTasks != nil {
+		return mmSearchTasks.funcSearchTasks(ctx, query, limit)
 	}
+	mmSearchTasks.t.Fatalf("Unexpected call to TasksRepositoryMock.SearchTasks. %v %v %v", ctx, query, limit)
+	return
+}
 
-	return mmGetTask
+// SearchTasksAfterCounter returns a count of finished TasksRepositoryMock.SearchTasks invocations
+func (mmSearchTasks *TasksRepositoryMock) SearchTasksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchTasks.afterSearchTasksCounter)
 }
 
-// ExpectCtxParam1 sets up expected param ctx for TasksRepository.GetTask
-func (mmGetTask *mTasksRepositoryMockGetTask) ExpectCtxParam1(ctx context.Context) *mTasksRepositoryMockGetTask {
-	if mmGetTask.mock.funcGetTask != nil {
-		mmGetTask.mock.t.Fatalf("TasksRepositoryMock.GetTask mock is already set by Set")
+// SearchTasksBeforeCounter returns a count of TasksRepositoryMock.SearchTasks invocations
+func (mmSearchTasks *TasksRepositoryMock) SearchTasksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchTasks.beforeSearchTasksCounter)
+}
+
+// Calls returns a list of arguments used in each call to TasksRepositoryMock.SearchTasks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSearchTasks *mTasksRepositoryMockSearchTasks) Calls() []*TasksRepositoryMockSearchTasksParams {
+	mmSearchTasks.mutex.RLock()
+
+	argCopy := make([]*TasksRepositoryMockSearchTasksParams, len(mmSearchTasks.callArgs))
+	copy(argCopy, mmSearchTasks.callArgs)
+
+	mmSearchTasks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSearchTasksDone returns true if the count of the SearchTasks invocations corresponds
+// the number of defined expectations
+func (m *TasksRepositoryMock) MinimockSearchTasksDone() bool {
+	if m.SearchTasksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
 	}
 
-	if mmGetTask.defaultExpectation == nil {
-		mmGetTask.defaultExpectation = &TasksRepositoryMockGetTaskExpectation{}
+	for _, e := range m.SearchTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
 	}
 
-	if mmGetTask.defaultExpectation.params != nil {
-		mmGetTask.mock.t.Fatalf("TasksRepositoryMock.GetTask mock is already set by Expect")
+	return m.SearchTasksMock.invocationsDone()
+}
+
+// MinimockSearchTasksInspect logs each unmet expectation
+func (m *TasksRepositoryMock) MinimockSearchTasksInspect() {
+	for _, e := range m.SearchTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TasksRepositoryMock.SearchTasks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
 	}
 
-	if mmGetTask.defaultExpectation.paramPtrs == nil {
-		mmGetTask.defaultExpectation.paramPtrs = &TasksRepositoryMockGetTaskParamPtrs{}
+	afterSearchTasksCounter := mm_atomic.LoadUint64(&m.afterSearchTasksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SearchTasksMock.defaultExpectation != nil && afterSearchTasksCounter < 1 {
+		if m.SearchTasksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksRepositoryMock.SearchTasks at\n%s", m.SearchTasksMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TasksRepositoryMock.SearchTasks at\n%s with params: %#v", m.SearchTasksMock.defaultExpectation.expectationOrigins.origin, *m.SearchTasksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSearchTasks != nil && afterSearchTasksCounter < 1 {
+		m.t.Errorf("Expected call to TasksRepositoryMock.SearchTasks at\n%s", m.funcSearchTasksOrigin)
 	}
-	mmGetTask.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetTask.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetTask
+	if !m.SearchTasksMock.invocationsDone() && afterSearchTasksCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksRepositoryMock.SearchTasks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SearchTasksMock.expectedInvocations), m.SearchTasksMock.expectedInvocationsOrigin, afterSearchTasksCounter)
+	}
 }
 
-// ExpectIdParam2 sets up expected param id for TasksRepository.GetTask
-func (mmGetTask *mTasksRepositoryMockGetTask) ExpectIdParam2(id string) *mTasksRepositoryMockGetTask {
-	if mmGetTask.mock.funcGetTask != nil {
-		mmGetTask.mock.t.Fatalf("TasksRepositoryMock.GetTask mock is already set by Set")
+type mTasksRepositoryMockUnfinishedTasks struct {
+	optional           bool
+	mock               *TasksRepositoryMock
+	defaultExpectation *TasksRepositoryMockUnfinishedTasksExpectation
+	expectations       []*TasksRepositoryMockUnfinishedTasksExpectation
+
+	callArgs []*TasksRepositoryMockUnfinishedTasksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TasksRepositoryMockUnfinishedTasksExpectation specifies expectation struct of the TasksRepository.UnfinishedTasks
+type TasksRepositoryMockUnfinishedTasksExpectation struct {
+	mock               *TasksRepositoryMock
+	params             *TasksRepositoryMockUnfinishedTasksParams
+	paramPtrs          *TasksRepositoryMockUnfinishedTasksParamPtrs
+	expectationOrigins TasksRepositoryMockUnfinishedTasksExpectationOrigins
+	results            *TasksRepositoryMockUnfinishedTasksResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TasksRepositoryMockUnfinishedTasksParams contains parameters of the TasksRepository.UnfinishedTasks
+type TasksRepositoryMockUnfinishedTasksParams struct {
+	ctx context.Context
+}
+
+// TasksRepositoryMockUnfinishedTasksParamPtrs contains pointers to parameters of the TasksRepository.UnfinishedTasks
+type TasksRepositoryMockUnfinishedTasksParamPtrs struct {
+	ctx *context.Context
+}
+
+// TasksRepositoryMockUnfinishedTasksResults contains results of the TasksRepository.UnfinishedTasks
+type TasksRepositoryMockUnfinishedTasksResults struct {
+	ta1 []model.Task
+	err error
+}
+
+// TasksRepositoryMockUnfinishedTasksOrigins contains origins of expectations of the TasksRepository.UnfinishedTasks
+type TasksRepositoryMockUnfinishedTasksExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUnfinishedTasks *mTasksRepositoryMockUnfinishedTasks) Optional() *mTasksRepositoryMockUnfinishedTasks {
+	mmUnfinishedTasks.optional = true
+	return mmUnfinishedTasks
+}
+
+// Expect sets up expected params for TasksRepository.UnfinishedTasks
+func (mmUnfinishedTasks *mTasksRepositoryMockUnfinishedTasks) Expect(ctx context.Context) *mTasksRepositoryMockUnfinishedTasks {
+	if mmUnfinishedTasks.mock.funcUnfinishedTasks != nil {
+		mmUnfinishedTasks.mock.t.Fatalf("TasksRepositoryMock.UnfinishedTasks mock is already set by Set")
 	}
 
-	if mmGetTask.defaultExpectation == nil {
-		mmGetTask.defaultExpectation = &TasksRepositoryMockGetTaskExpectation{}
+	if mmUnfinishedTasks.defaultExpectation == nil {
+		mmUnfinishedTasks.defaultExpectation = &TasksRepositoryMockUnfinishedTasksExpectation{}
 	}
 
-	if mmGetTask.defaultExpectation.params != nil {
-		mmGetTask.mock.t.Fatalf("TasksRepositoryMock.GetTask mock is already set by Expect")
+	if mmUnfinishedTasks.defaultExpectation.paramPtrs != nil {
+		mmUnfinishedTasks.mock.t.Fatalf("TasksRepositoryMock.UnfinishedTasks mock is already set by ExpectParams functions")
 	}
 
-	if mmGetTask.defaultExpectation.paramPtrs == nil {
-		mmGetTask.defaultExpectation.paramPtrs = &TasksRepositoryMockGetTaskParamPtrs{}
+	mmUnfinishedTasks.defaultExpectation.params = &TasksRepositoryMockUnfinishedTasksParams{ctx}
+	mmUnfinishedTasks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUnfinishedTasks.expectations {
+		if minimock.Equal(e.params, mmUnfinishedTasks.defaultExpectation.params) {
+			mmUnfinishedTasks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUnfinishedTasks.defaultExpectation.params)
+		}
 	}
-	mmGetTask.defaultExpectation.paramPtrs.id = &id
-	mmGetTask.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmGetTask
+	return mmUnfinishedTasks
 }
 
-// Inspect accepts an inspector function that has same arguments as the TasksRepository.GetTask
-func (mmGetTask *mTasksRepositoryMockGetTask) Inspect(f func(ctx context.Context, id string)) *mTasksRepositoryMockGetTask {
-	if mmGetTask.mock.inspectFuncGetTask != nil {
-		mmGetTask.mock.t.Fatalf("Inspect function is already set for TasksRepositoryMock.GetTask")
+// ExpectCtxParam1 sets up expected param ctx for TasksRepository.UnfinishedTasks
+func (mmUnfinishedTasks *mTasksRepositoryMockUnfinishedTasks) ExpectCtxParam1(ctx context.Context) *mTasksRepositoryMockUnfinishedTasks {
+	if mmUnfinishedTasks.mock.funcUnfinishedTasks != nil {
+		mmUnfinishedTasks.mock.t.Fatalf("TasksRepositoryMock.UnfinishedTasks mock is already set by Set")
 	}
 
-	mmGetTask.mock.inspectFuncGetTask = f
+	if mmUnfinishedTasks.defaultExpectation == nil {
+		mmUnfinishedTasks.defaultExpectation = &TasksRepositoryMockUnfinishedTasksExpectation{}
+	}
 
-	return mmGetTask
+	if mmUnfinishedTasks.defaultExpectation.params != nil {
+		mmUnfinishedTasks.mock.t.Fatalf("TasksRepositoryMock.UnfinishedTasks mock is already set by Expect")
+	}
+
+	if mmUnfinishedTasks.defaultExpectation.paramPtrs == nil {
+		mmUnfinishedTasks.defaultExpectation.paramPtrs = &TasksRepositoryMockUnfinishedTasksParamPtrs{}
+	}
+	mmUnfinishedTasks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUnfinishedTasks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUnfinishedTasks
 }
 
-// Return sets up results that will be returned by TasksRepository.GetTask
-func (mmGetTask *mTasksRepositoryMockGetTask) Return(tp1 *model.Task, err error) *TasksRepositoryMock {
-	if mmGetTask.mock.funcGetTask != nil {
-		mmGetTask.mock.t.Fatalf("TasksRepositoryMock.GetTask mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the TasksRepository.UnfinishedTasks
+func (mmUnfinishedTasks *mTasksRepositoryMockUnfinishedTasks) Inspect(f func(ctx context.Context)) *mTasksRepositoryMockUnfinishedTasks {
+	if mmUnfinishedTasks.mock.inspectFuncUnfinishedTasks != nil {
+		mmUnfinishedTasks.mock.t.Fatalf("Inspect function is already set for TasksRepositoryMock.UnfinishedTasks")
 	}
 
-	if mmGetTask.defaultExpectation == nil {
-		mmGetTask.defaultExpectation = &TasksRepositoryMockGetTaskExpectation{mock: mmGetTask.mock}
+	mmUnfinishedTasks.mock.inspectFuncUnfinishedTasks = f
+
+	return mmUnfinishedTasks
+}
+
+// Return sets up results that will be returned by TasksRepository.UnfinishedTasks
+func (mmUnfinishedTasks *mTasksRepositoryMockUnfinishedTasks) Return(ta1 []model.Task, err error) *TasksRepositoryMock {
+	if mmUnfinishedTasks.mock.funcUnfinishedTasks != nil {
+		mmUnfinishedTasks.mock.t.Fatalf("TasksRepositoryMock.UnfinishedTasks mock is already set by Set")
 	}
-	mmGetTask.defaultExpectation.results = &TasksRepositoryMockGetTaskResults{tp1, err}
-	mmGetTask.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetTask.mock
+
+	if mmUnfinishedTasks.defaultExpectation == nil {
+		mmUnfinishedTasks.defaultExpectation = &TasksRepositoryMockUnfinishedTasksExpectation{mock: mmUnfinishedTasks.mock}
+	}
+	mmUnfinishedTasks.defaultExpectation.results = &TasksRepositoryMockUnfinishedTasksResults{ta1, err}
+	mmUnfinishedTasks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUnfinishedTasks.mock
 }
 
-// Set uses given function f to mock the TasksRepository.GetTask method
-func (mmGetTask *mTasksRepositoryMockGetTask) Set(f func(ctx context.Context, id string) (tp1 *model.Task, err error)) *TasksRepositoryMock {
-	if mmGetTask.defaultExpectation != nil {
-		mmGetTask.mock.t.Fatalf("Default expectation is already set for the TasksRepository.GetTask method")
+// Set uses given function f to mock the TasksRepository.UnfinishedTasks method
+func (mmUnfinishedTasks *mTasksRepositoryMockUnfinishedTasks) Set(f func(ctx context.Context) (ta1 []model.Task, err error)) *TasksRepositoryMock {
+	if mmUnfinishedTasks.defaultExpectation != nil {
+		mmUnfinishedTasks.mock.t.Fatalf("Default expectation is already set for the TasksRepository.UnfinishedTasks method")
 	}
 
-	if len(mmGetTask.expectations) > 0 {
-		mmGetTask.mock.t.Fatalf("Some expectations are already set for the TasksRepository.GetTask method")
+	if len(mmUnfinishedTasks.expectations) > 0 {
+		mmUnfinishedTasks.mock.t.Fatalf("Some expectations are already set for the TasksRepository.UnfinishedTasks method")
 	}
 
-	mmGetTask.mock.funcGetTask = f
-	mmGetTask.mock.funcGetTaskOrigin = minimock.CallerInfo(1)
-	return mmGetTask.mock
+	mmUnfinishedTasks.mock.funcUnfinishedTasks = f
+	mmUnfinishedTasks.mock.funcUnfinishedTasksOrigin = minimock.CallerInfo(1)
+	return mmUnfinishedTasks.mock
 }
 
-// When sets expectation for the TasksRepository.GetTask which will trigger the result defined by the following
+// When sets expectation for the TasksRepository.UnfinishedTasks which will trigger the result defined by the following
 // Then helper
-func (mmGetTask *mTasksRepositoryMockGetTask) When(ctx context.Context, id string) *TasksRepositoryMockGetTaskExpectation {
-	if mmGetTask.mock.funcGetTask != nil {
-		mmGetTask.mock.t.Fatalf("TasksRepositoryMock.GetTask mock is already set by Set")
+func (mmUnfinishedTasks *mTasksRepositoryMockUnfinishedTasks) When(ctx context.Context) *TasksRepositoryMockUnfinishedTasksExpectation {
+	if mmUnfinishedTasks.mock.funcUnfinishedTasks != nil {
+		mmUnfinishedTasks.mock.t.Fatalf("TasksRepositoryMock.UnfinishedTasks mock is already set by Set")
 	}
 
-	expectation := &TasksRepositoryMockGetTaskExpectation{
-		mock:               mmGetTask.mock,
-		params:             &TasksRepositoryMockGetTaskParams{ctx, id},
-		expectationOrigins: TasksRepositoryMockGetTaskExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &TasksRepositoryMockUnfinishedTasksExpectation{
+		mock:               mmUnfinishedTasks.mock,
+		params:             &TasksRepositoryMockUnfinishedTasksParams{ctx},
+		expectationOrigins: TasksRepositoryMockUnfinishedTasksExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetTask.expectations = append(mmGetTask.expectations, expectation)
+	mmUnfinishedTasks.expectations = append(mmUnfinishedTasks.expectations, expectation)
 	return expectation
 }
 
-// Then sets up TasksRepository.GetTask return parameters for the expectation previously defined by the When method
-func (e *TasksRepositoryMockGetTaskExpectation) Then(tp1 *model.Task, err error) *TasksRepositoryMock {
-	e.results = &TasksRepositoryMockGetTaskResults{tp1, err}
+// Then sets up TasksRepository.UnfinishedTasks return parameters for the expectation previously defined by the When method
+func (e *TasksRepositoryMockUnfinishedTasksExpectation) Then(ta1 []model.Task, err error) *TasksRepositoryMock {
+	e.results = &TasksRepositoryMockUnfinishedTasksResults{ta1, err}
 	return e.mock
 }
 
-// Times sets number of times TasksRepository.GetTask should be invoked
-func (mmGetTask *mTasksRepositoryMockGetTask) Times(n uint64) *mTasksRepositoryMockGetTask {
+// Times sets number of times TasksRepository.UnfinishedTasks should be invoked
+func (mmUnfinishedTasks *mTasksRepositoryMockUnfinishedTasks) Times(n uint64) *mTasksRepositoryMockUnfinishedTasks {
 	if n == 0 {
-		mmGetTask.mock.t.Fatalf("Times of TasksRepositoryMock.GetTask mock can not be zero")
+		mmUnfinishedTasks.mock.t.Fatalf("Times of TasksRepositoryMock.UnfinishedTasks mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetTask.expectedInvocations, n)
-	mmGetTask.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetTask
+	mm_atomic.StoreUint64(&mmUnfinishedTasks.expectedInvocations, n)
+	mmUnfinishedTasks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUnfinishedTasks
 }
 
-func (mmGetTask *mTasksRepositoryMockGetTask) invocationsDone() bool {
-	if len(mmGetTask.expectations) == 0 && mmGetTask.defaultExpectation == nil && mmGetTask.mock.funcGetTask == nil {
+func (mmUnfinishedTasks *mTasksRepositoryMockUnfinishedTasks) invocationsDone() bool {
+	if len(mmUnfinishedTasks.expectations) == 0 && mmUnfinishedTasks.defaultExpectation == nil && mmUnfinishedTasks.mock.funcUnfinishedTasks == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetTask.mock.afterGetTaskCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetTask.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUnfinishedTasks.mock.afterUnfinishedTasksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUnfinishedTasks.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetTask implements mm_service.TasksRepository
-func (mmGetTask *TasksRepositoryMock) GetTask(ctx context.Context, id string) (tp1 *model.Task, err error) {
-	mm_atomic.AddUint64(&mmGetTask.beforeGetTaskCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetTask.afterGetTaskCounter, 1)
+// UnfinishedTasks implements mm_service.TasksRepository
+func (mmUnfinishedTasks *TasksRepositoryMock) UnfinishedTasks(ctx context.Context) (ta1 []model.Task, err error) {
+	mm_atomic.AddUint64(&mmUnfinishedTasks.beforeUnfinishedTasksCounter, 1)
+	defer mm_atomic.AddUint64(&mmUnfinishedTasks.afterUnfinishedTasksCounter, 1)
 
-	mmGetTask.t.Helper()
+	mmUnfinishedTasks.t.Helper()
 
-	if mmGetTask.inspectFuncGetTask != nil {
-		mmGetTask.inspectFuncGetTask(ctx, id)
+	if mmUnfinishedTasks.inspectFuncUnfinishedTasks != nil {
+		mmUnfinishedTasks.inspectFuncUnfinishedTasks(ctx)
 	}
 
-	mm_params := TasksRepositoryMockGetTaskParams{ctx, id}
+	mm_params := TasksRepositoryMockUnfinishedTasksParams{ctx}
 
 	// Record call args
-	mmGetTask.GetTaskMock.mutex.Lock()
-	mmGetTask.GetTaskMock.callArgs = append(mmGetTask.GetTaskMock.callArgs, &mm_params)
-	mmGetTask.GetTaskMock.mutex.Unlock()
+	mmUnfinishedTasks.UnfinishedTasksMock.mutex.Lock()
+	mmUnfinishedTasks.UnfinishedTasksMock.callArgs = append(mmUnfinishedTasks.UnfinishedTasksMock.callArgs, &mm_params)
+	mmUnfinishedTasks.UnfinishedTasksMock.mutex.Unlock()
 
-	for _, e := range mmGetTask.GetTaskMock.expectations {
+	for _, e := range mmUnfinishedTasks.UnfinishedTasksMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.tp1, e.results.err
+			return e.results.ta1, e.results.err
 		}
 	}
 
-	if mmGetTask.GetTaskMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetTask.GetTaskMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetTask.GetTaskMock.defaultExpectation.params
-		mm_want_ptrs := mmGetTask.GetTaskMock.defaultExpectation.paramPtrs
+	if mmUnfinishedTasks.UnfinishedTasksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUnfinishedTasks.UnfinishedTasksMock.defaultExpectation.Counter, 1)
+		mm_want := mmUnfinishedTasks.UnfinishedTasksMock.defaultExpectation.params
+		mm_want_ptrs := mmUnfinishedTasks.UnfinishedTasksMock.defaultExpectation.paramPtrs
 
-		mm_got := TasksRepositoryMockGetTaskParams{ctx, id}
+		mm_got := TasksRepositoryMockUnfinishedTasksParams{ctx}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetTask.t.Errorf("TasksRepositoryMock.GetTask got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetTask.GetTaskMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmGetTask.t.Errorf("TasksRepositoryMock.GetTask got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetTask.GetTaskMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+				mmUnfinishedTasks.t.Errorf("TasksRepositoryMock.UnfinishedTasks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnfinishedTasks.UnfinishedTasksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetTask.t.Errorf("TasksRepositoryMock.GetTask got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetTask.GetTaskMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUnfinishedTasks.t.Errorf("TasksRepositoryMock.UnfinishedTasks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUnfinishedTasks.UnfinishedTasksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetTask.GetTaskMock.defaultExpectation.results
+		mm_results := mmUnfinishedTasks.UnfinishedTasksMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetTask.t.Fatal("No results are set for the TasksRepositoryMock.GetTask")
+			mmUnfinishedTasks.t.Fatal("No results are set for the TasksRepositoryMock.UnfinishedTasks")
 		}
-		return (*mm_results).tp1, (*mm_results).err
+		return (*mm_results).ta1, (*mm_results).err
 	}
-	if mmGetTask.funcGetTask != nil {
-		return mmGetTask.funcGetTask(ctx, id)
+	if mmUnfinishedTasks.funcUnfinishedTasks != nil {
+		return mmUnfinishedTasks.funcUnfinishedTasks(ctx)
 	}
-	mmGetTask.t.Fatalf("Unexpected call to TasksRepositoryMock.GetTask. %v %v", ctx, id)
+	mmUnfinishedTasks.t.Fatalf("Unexpected call to TasksRepositoryMock.UnfinishedTasks. %v", ctx)
 	return
 }
 
-// GetTaskAfterCounter returns a count of finished TasksRepositoryMock.GetTask invocations
-func (mmGetTask *TasksRepositoryMock) GetTaskAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetTask.afterGetTaskCounter)
+// UnfinishedTasksAfterCounter returns a count of finished TasksRepositoryMock.UnfinishedTasks invocations
+func (mmUnfinishedTasks *TasksRepositoryMock) UnfinishedTasksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnfinishedTasks.afterUnfinishedTasksCounter)
 }
 
-// GetTaskBeforeCounter returns a count of TasksRepositoryMock.GetTask invocations
-func (mmGetTask *TasksRepositoryMock) GetTaskBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetTask.beforeGetTaskCounter)
+// UnfinishedTasksBeforeCounter returns a count of TasksRepositoryMock.UnfinishedTasks invocations
+func (mmUnfinishedTasks *TasksRepositoryMock) UnfinishedTasksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnfinishedTasks.beforeUnfinishedTasksCounter)
 }
 
-// Calls returns a list of arguments used in each call to TasksRepositoryMock.GetTask.
+// Calls returns a list of arguments used in each call to TasksRepositoryMock.UnfinishedTasks.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetTask *mTasksRepositoryMockGetTask) Calls() []*TasksRepositoryMockGetTaskParams {
-	mmGetTask.mutex.RLock()
+func (mmUnfinishedTasks *mTasksRepositoryMockUnfinishedTasks) Calls() []*TasksRepositoryMockUnfinishedTasksParams {
+	mmUnfinishedTasks.mutex.RLock()
 
-	argCopy := make([]*TasksRepositoryMockGetTaskParams, len(mmGetTask.callArgs))
-	copy(argCopy, mmGetTask.callArgs)
+	argCopy := make([]*TasksRepositoryMockUnfinishedTasksParams, len(mmUnfinishedTasks.callArgs))
+	copy(argCopy, mmUnfinishedTasks.callArgs)
 
-	mmGetTask.mutex.RUnlock()
+	mmUnfinishedTasks.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetTaskDone returns true if the count of the GetTask invocations corresponds
+// MinimockUnfinishedTasksDone returns true if the count of the UnfinishedTasks invocations corresponds
 // the number of defined expectations
-func (m *TasksRepositoryMock) MinimockGetTaskDone() bool {
-	if m.GetTaskMock.optional {
+func (m *TasksRepositoryMock) MinimockUnfinishedTasksDone() bool {
+	if m.UnfinishedTasksMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetTaskMock.expectations {
+	for _, e := range m.UnfinishedTasksMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetTaskMock.invocationsDone()
+	return m.UnfinishedTasksMock.invocationsDone()
 }
 
-// MinimockGetTaskInspect logs each unmet expectation
-func (m *TasksRepositoryMock) MinimockGetTaskInspect() {
-	for _, e := range m.GetTaskMock.expectations {
+// MinimockUnfinishedTasksInspect logs each unmet expectation
+func (m *TasksRepositoryMock) MinimockUnfinishedTasksInspect() {
+	for _, e := range m.UnfinishedTasksMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to TasksRepositoryMock.GetTask at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to TasksRepositoryMock.UnfinishedTasks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetTaskCounter := mm_atomic.LoadUint64(&m.afterGetTaskCounter)
+	afterUnfinishedTasksCounter := mm_atomic.LoadUint64(&m.afterUnfinishedTasksCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetTaskMock.defaultExpectation != nil && afterGetTaskCounter < 1 {
-		if m.GetTaskMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to TasksRepositoryMock.GetTask at\n%s", m.GetTaskMock.defaultExpectation.returnOrigin)
+	if m.UnfinishedTasksMock.defaultExpectation != nil && afterUnfinishedTasksCounter < 1 {
+		if m.UnfinishedTasksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TasksRepositoryMock.UnfinishedTasks at\n%s", m.UnfinishedTasksMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to TasksRepositoryMock.GetTask at\n%s with params: %#v", m.GetTaskMock.defaultExpectation.expectationOrigins.origin, *m.GetTaskMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to TasksRepositoryMock.UnfinishedTasks at\n%s with params: %#v", m.UnfinishedTasksMock.defaultExpectation.expectationOrigins.origin, *m.UnfinishedTasksMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetTask != nil && afterGetTaskCounter < 1 {
-		m.t.Errorf("Expected call to TasksRepositoryMock.GetTask at\n%s", m.funcGetTaskOrigin)
+	if m.funcUnfinishedTasks != nil && afterUnfinishedTasksCounter < 1 {
+		m.t.Errorf("Expected call to TasksRepositoryMock.UnfinishedTasks at\n%s", m.funcUnfinishedTasksOrigin)
 	}
 
-	if !m.GetTaskMock.invocationsDone() && afterGetTaskCounter > 0 {
-		m.t.Errorf("Expected %d calls to TasksRepositoryMock.GetTask at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetTaskMock.expectedInvocations), m.GetTaskMock.expectedInvocationsOrigin, afterGetTaskCounter)
+	if !m.UnfinishedTasksMock.invocationsDone() && afterUnfinishedTasksCounter > 0 {
+		m.t.Errorf("Expected %d calls to TasksRepositoryMock.UnfinishedTasks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UnfinishedTasksMock.expectedInvocations), m.UnfinishedTasksMock.expectedInvocationsOrigin, afterUnfinishedTasksCounter)
 	}
 }
 
@@ -1128,18 +4462,19 @@ type TasksRepositoryMockUpdateTaskExpectation struct {
 type TasksRepositoryMockUpdateTaskParams struct {
 	ctx    context.Context
 	id     string
-	status model.Status
+	update model.TaskUpdate
 }
 
 // TasksRepositoryMockUpdateTaskParamPtrs contains pointers to parameters of the TasksRepository.UpdateTask
 type TasksRepositoryMockUpdateTaskParamPtrs struct {
 	ctx    *context.Context
 	id     *string
-	status *model.Status
+	update *model.TaskUpdate
 }
 
 // TasksRepositoryMockUpdateTaskResults contains results of the TasksRepository.UpdateTask
 type TasksRepositoryMockUpdateTaskResults struct {
+	t1  model.TaskChange
 	err error
 }
 
@@ -1148,7 +4483,7 @@ type TasksRepositoryMockUpdateTaskExpectationOrigins struct {
 	origin       string
 	originCtx    string
 	originId     string
-	originStatus string
+	originUpdate string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1162,7 +4497,7 @@ func (mmUpdateTask *mTasksRepositoryMockUpdateTask) Optional() *mTasksRepository
 }
 
 // Expect sets up expected params for TasksRepository.UpdateTask
-func (mmUpdateTask *mTasksRepositoryMockUpdateTask) Expect(ctx context.Context, id string, status model.Status) *mTasksRepositoryMockUpdateTask {
+func (mmUpdateTask *mTasksRepositoryMockUpdateTask) Expect(ctx context.Context, id string, update model.TaskUpdate) *mTasksRepositoryMockUpdateTask {
 	if mmUpdateTask.mock.funcUpdateTask != nil {
 		mmUpdateTask.mock.t.Fatalf("TasksRepositoryMock.UpdateTask mock is already set by Set")
 	}
@@ -1175,7 +4510,7 @@ func (mmUpdateTask *mTasksRepositoryMockUpdateTask) Expect(ctx context.Context,
 		mmUpdateTask.mock.t.Fatalf("TasksRepositoryMock.UpdateTask mock is already set by ExpectParams functions")
 	}
 
-	mmUpdateTask.defaultExpectation.params = &TasksRepositoryMockUpdateTaskParams{ctx, id, status}
+	mmUpdateTask.defaultExpectation.params = &TasksRepositoryMockUpdateTaskParams{ctx, id, update}
 	mmUpdateTask.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmUpdateTask.expectations {
 		if minimock.Equal(e.params, mmUpdateTask.defaultExpectation.params) {
@@ -1232,8 +4567,8 @@ func (mmUpdateTask *mTasksRepositoryMockUpdateTask) ExpectIdParam2(id string) *m
 	return mmUpdateTask
 }
 
-// ExpectStatusParam3 sets up expected param status for TasksRepository.UpdateTask
-func (mmUpdateTask *mTasksRepositoryMockUpdateTask) ExpectStatusParam3(status model.Status) *mTasksRepositoryMockUpdateTask {
+// ExpectUpdateParam3 sets up expected param update for TasksRepository.UpdateTask
+func (mmUpdateTask *mTasksRepositoryMockUpdateTask) ExpectUpdateParam3(update model.TaskUpdate) *mTasksRepositoryMockUpdateTask {
 	if mmUpdateTask.mock.funcUpdateTask != nil {
 		mmUpdateTask.mock.t.Fatalf("TasksRepositoryMock.UpdateTask mock is already set by Set")
 	}
@@ -1249,14 +4584,14 @@ func (mmUpdateTask *mTasksRepositoryMockUpdateTask) ExpectStatusParam3(status mo
 	if mmUpdateTask.defaultExpectation.paramPtrs == nil {
 		mmUpdateTask.defaultExpectation.paramPtrs = &TasksRepositoryMockUpdateTaskParamPtrs{}
 	}
-	mmUpdateTask.defaultExpectation.paramPtrs.status = &status
-	mmUpdateTask.defaultExpectation.expectationOrigins.originStatus = minimock.CallerInfo(1)
+	mmUpdateTask.defaultExpectation.paramPtrs.update = &update
+	mmUpdateTask.defaultExpectation.expectationOrigins.originUpdate = minimock.CallerInfo(1)
 
 	return mmUpdateTask
 }
 
 // Inspect accepts an inspector function that has same arguments as the TasksRepository.UpdateTask
-func (mmUpdateTask *mTasksRepositoryMockUpdateTask) Inspect(f func(ctx context.Context, id string, status model.Status)) *mTasksRepositoryMockUpdateTask {
+func (mmUpdateTask *mTasksRepositoryMockUpdateTask) Inspect(f func(ctx context.Context, id string, update model.TaskUpdate)) *mTasksRepositoryMockUpdateTask {
 	if mmUpdateTask.mock.inspectFuncUpdateTask != nil {
 		mmUpdateTask.mock.t.Fatalf("Inspect function is already set for TasksRepositoryMock.UpdateTask")
 	}
@@ -1267,7 +4602,7 @@ func (mmUpdateTask *mTasksRepositoryMockUpdateTask) Inspect(f func(ctx context.C
 }
 
 // Return sets up results that will be returned by TasksRepository.UpdateTask
-func (mmUpdateTask *mTasksRepositoryMockUpdateTask) Return(err error) *TasksRepositoryMock {
+func (mmUpdateTask *mTasksRepositoryMockUpdateTask) Return(t1 model.TaskChange, err error) *TasksRepositoryMock {
 	if mmUpdateTask.mock.funcUpdateTask != nil {
 		mmUpdateTask.mock.t.Fatalf("TasksRepositoryMock.UpdateTask mock is already set by Set")
 	}
@@ -1275,13 +4610,13 @@ func (mmUpdateTask *mTasksRepositoryMockUpdateTask) Return(err error) *TasksRepo
 	if mmUpdateTask.defaultExpectation == nil {
 		mmUpdateTask.defaultExpectation = &TasksRepositoryMockUpdateTaskExpectation{mock: mmUpdateTask.mock}
 	}
-	mmUpdateTask.defaultExpectation.results = &TasksRepositoryMockUpdateTaskResults{err}
+	mmUpdateTask.defaultExpectation.results = &TasksRepositoryMockUpdateTaskResults{t1, err}
 	mmUpdateTask.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
 	return mmUpdateTask.mock
 }
 
 // Set uses given function f to mock the TasksRepository.UpdateTask method
-func (mmUpdateTask *mTasksRepositoryMockUpdateTask) Set(f func(ctx context.Context, id string, status model.Status) (err error)) *TasksRepositoryMock {
+func (mmUpdateTask *mTasksRepositoryMockUpdateTask) Set(f func(ctx context.Context, id string, update model.TaskUpdate) (t1 model.TaskChange, err error)) *TasksRepositoryMock {
 	if mmUpdateTask.defaultExpectation != nil {
 		mmUpdateTask.mock.t.Fatalf("Default expectation is already set for the TasksRepository.UpdateTask method")
 	}
@@ -1297,14 +4632,14 @@ func (mmUpdateTask *mTasksRepositoryMockUpdateTask) Set(f func(ctx context.Conte
 
 // When sets expectation for the TasksRepository.UpdateTask which will trigger the result defined by the following
 // Then helper
-func (mmUpdateTask *mTasksRepositoryMockUpdateTask) When(ctx context.Context, id string, status model.Status) *TasksRepositoryMockUpdateTaskExpectation {
+func (mmUpdateTask *mTasksRepositoryMockUpdateTask) When(ctx context.Context, id string, update model.TaskUpdate) *TasksRepositoryMockUpdateTaskExpectation {
 	if mmUpdateTask.mock.funcUpdateTask != nil {
 		mmUpdateTask.mock.t.Fatalf("TasksRepositoryMock.UpdateTask mock is already set by Set")
 	}
 
 	expectation := &TasksRepositoryMockUpdateTaskExpectation{
 		mock:               mmUpdateTask.mock,
-		params:             &TasksRepositoryMockUpdateTaskParams{ctx, id, status},
+		params:             &TasksRepositoryMockUpdateTaskParams{ctx, id, update},
 		expectationOrigins: TasksRepositoryMockUpdateTaskExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmUpdateTask.expectations = append(mmUpdateTask.expectations, expectation)
@@ -1312,8 +4647,8 @@ func (mmUpdateTask *mTasksRepositoryMockUpdateTask) When(ctx context.Context, id
 }
 
 // Then sets up TasksRepository.UpdateTask return parameters for the expectation previously defined by the When method
-func (e *TasksRepositoryMockUpdateTaskExpectation) Then(err error) *TasksRepositoryMock {
-	e.results = &TasksRepositoryMockUpdateTaskResults{err}
+func (e *TasksRepositoryMockUpdateTaskExpectation) Then(t1 model.TaskChange, err error) *TasksRepositoryMock {
+	e.results = &TasksRepositoryMockUpdateTaskResults{t1, err}
 	return e.mock
 }
 
@@ -1339,17 +4674,17 @@ func (mmUpdateTask *mTasksRepositoryMockUpdateTask) invocationsDone() bool {
 }
 
 // UpdateTask implements mm_service.TasksRepository
-func (mmUpdateTask *TasksRepositoryMock) UpdateTask(ctx context.Context, id string, status model.Status) (err error) {
+func (mmUpdateTask *TasksRepositoryMock) UpdateTask(ctx context.Context, id string, update model.TaskUpdate) (t1 model.TaskChange, err error) {
 	mm_atomic.AddUint64(&mmUpdateTask.beforeUpdateTaskCounter, 1)
 	defer mm_atomic.AddUint64(&mmUpdateTask.afterUpdateTaskCounter, 1)
 
 	mmUpdateTask.t.Helper()
 
 	if mmUpdateTask.inspectFuncUpdateTask != nil {
-		mmUpdateTask.inspectFuncUpdateTask(ctx, id, status)
+		mmUpdateTask.inspectFuncUpdateTask(ctx, id, update)
 	}
 
-	mm_params := TasksRepositoryMockUpdateTaskParams{ctx, id, status}
+	mm_params := TasksRepositoryMockUpdateTaskParams{ctx, id, update}
 
 	// Record call args
 	mmUpdateTask.UpdateTaskMock.mutex.Lock()
@@ -1359,7 +4694,7 @@ func (mmUpdateTask *TasksRepositoryMock) UpdateTask(ctx context.Context, id stri
 	for _, e := range mmUpdateTask.UpdateTaskMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.t1, e.results.err
 		}
 	}
 
@@ -1368,7 +4703,7 @@ func (mmUpdateTask *TasksRepositoryMock) UpdateTask(ctx context.Context, id stri
 		mm_want := mmUpdateTask.UpdateTaskMock.defaultExpectation.params
 		mm_want_ptrs := mmUpdateTask.UpdateTaskMock.defaultExpectation.paramPtrs
 
-		mm_got := TasksRepositoryMockUpdateTaskParams{ctx, id, status}
+		mm_got := TasksRepositoryMockUpdateTaskParams{ctx, id, update}
 
 		if mm_want_ptrs != nil {
 
@@ -1382,9 +4717,9 @@ func (mmUpdateTask *TasksRepositoryMock) UpdateTask(ctx context.Context, id stri
 					mmUpdateTask.UpdateTaskMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
-			if mm_want_ptrs.status != nil && !minimock.Equal(*mm_want_ptrs.status, mm_got.status) {
-				mmUpdateTask.t.Errorf("TasksRepositoryMock.UpdateTask got unexpected parameter status, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUpdateTask.UpdateTaskMock.defaultExpectation.expectationOrigins.originStatus, *mm_want_ptrs.status, mm_got.status, minimock.Diff(*mm_want_ptrs.status, mm_got.status))
+			if mm_want_ptrs.update != nil && !minimock.Equal(*mm_want_ptrs.update, mm_got.update) {
+				mmUpdateTask.t.Errorf("TasksRepositoryMock.UpdateTask got unexpected parameter update, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateTask.UpdateTaskMock.defaultExpectation.expectationOrigins.originUpdate, *mm_want_ptrs.update, mm_got.update, minimock.Diff(*mm_want_ptrs.update, mm_got.update))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
@@ -1396,12 +4731,12 @@ func (mmUpdateTask *TasksRepositoryMock) UpdateTask(ctx context.Context, id stri
 		if mm_results == nil {
 			mmUpdateTask.t.Fatal("No results are set for the TasksRepositoryMock.UpdateTask")
 		}
-		return (*mm_results).err
+		return (*mm_results).t1, (*mm_results).err
 	}
 	if mmUpdateTask.funcUpdateTask != nil {
-		return mmUpdateTask.funcUpdateTask(ctx, id, status)
+		return mmUpdateTask.funcUpdateTask(ctx, id, update)
 	}
-	mmUpdateTask.t.Fatalf("Unexpected call to TasksRepositoryMock.UpdateTask. %v %v %v", ctx, id, status)
+	mmUpdateTask.t.Fatalf("Unexpected call to TasksRepositoryMock.UpdateTask. %v %v %v", ctx, id, update)
 	return
 }
 
@@ -1479,10 +4814,28 @@ func (m *TasksRepositoryMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockCreateTaskInspect()
 
+			m.MinimockCreateTasksInspect()
+
 			m.MinimockDeleteTaskInspect()
 
+			m.MinimockDeleteTasksInspect()
+
+			m.MinimockGetDeletedTaskInspect()
+
 			m.MinimockGetTaskInspect()
 
+			m.MinimockListTasksInspect()
+
+			m.MinimockPatchTaskInspect()
+
+			m.MinimockPurgeTasksInspect()
+
+			m.MinimockRestoreTaskInspect()
+
+			m.MinimockSearchTasksInspect()
+
+			m.MinimockUnfinishedTasksInspect()
+
 			m.MinimockUpdateTaskInspect()
 		}
 	})
@@ -1508,7 +4861,16 @@ func (m *TasksRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockCreateTaskDone() &&
+		m.MinimockCreateTasksDone() &&
 		m.MinimockDeleteTaskDone() &&
+		m.MinimockDeleteTasksDone() &&
+		m.MinimockGetDeletedTaskDone() &&
 		m.MinimockGetTaskDone() &&
+		m.MinimockListTasksDone() &&
+		m.MinimockPatchTaskDone() &&
+		m.MinimockPurgeTasksDone() &&
+		m.MinimockRestoreTaskDone() &&
+		m.MinimockSearchTasksDone() &&
+		m.MinimockUnfinishedTasksDone() &&
 		m.MinimockUpdateTaskDone()
 }
@@ -3,80 +3,677 @@ package service
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
+	"math"
 	"math/rand"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"test-server/internal/auth"
 	"test-server/internal/domain/model"
+	"test-server/internal/domain/task/archive"
+	"test-server/internal/domain/task/audit"
+	"test-server/internal/domain/task/quota"
+	"test-server/internal/domain/task/tasklog"
+	"test-server/internal/metrics"
+	"test-server/internal/tenant"
+	"test-server/internal/tracing"
 	"time"
 
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
 )
 
 //go:generate minimock -i TasksRepository -o ./mock -s _mock.go
 type TasksRepository interface {
-	CreateTask(ctx context.Context, task model.Task) error
+	CreateTask(ctx context.Context, task model.Task) (model.TaskChange, error)
+	CreateTasks(ctx context.Context, tasks []model.Task) ([]model.TaskChange, []error)
 	GetTask(ctx context.Context, id string) (*model.Task, error)
-	UpdateTask(ctx context.Context, id string, status model.Status) error
-	DeleteTask(ctx context.Context, id string) error
+	GetDeletedTask(ctx context.Context, id string) (*model.Task, error)
+	ListTasks(ctx context.Context, selector model.LabelSelector) ([]model.Task, error)
+	SearchTasks(ctx context.Context, query string, limit int) ([]model.Task, error)
+	UpdateTask(ctx context.Context, id string, update model.TaskUpdate) (model.TaskChange, error)
+	PatchTask(ctx context.Context, id string, apply func(task *model.Task) error) (model.TaskChange, error)
+	DeleteTask(ctx context.Context, id string, version *int64, owner string) (model.TaskChange, error)
+	DeleteTasks(ctx context.Context, ids []string, owner string) ([]model.TaskChange, []error)
+	RestoreTask(ctx context.Context, id string, owner string) (model.TaskChange, error)
+	PurgeTasks(ctx context.Context, ids []string) ([]model.TaskChange, []error)
+	UnfinishedTasks(ctx context.Context) ([]model.Task, error)
+}
+
+// patchableFields lists the task fields that can be edited in each status.
+// Running tasks keep the pending status, see checkPatchable.
+var patchableFields = map[model.Status][]string{
+	model.Pending: {"title"},
+}
+
+// checkPatchable returns model.ErrFieldNotEditable unless every field of the
+// patch can be edited. Only tasks that haven't started are editable.
+func checkPatchable(task *model.Task, patch model.TaskPatch) error {
+	for _, field := range patch.Fields() {
+		if !slices.Contains(patchableFields[task.Status], field) {
+			return fmt.Errorf("field %q in status %q: %w", field, task.Status, model.ErrFieldNotEditable)
+		}
+		if task.StartedAt != nil {
+			return fmt.Errorf("field %q of started task: %w", field, model.ErrFieldNotEditable)
+		}
+	}
+	return nil
 }
 
 type TasksService struct {
-	SaveInterval int // seconds
-	tasksRepo    TasksRepository
+	tasksRepo TasksRepository
+	inFlight  atomic.Int64
+	quota     *quota.Limiter    // nil admits every task
+	audit     *audit.Log        // nil records nothing
+	logs      *tasklog.Store    // nil keeps no task logs
+	archive   *archive.Archiver // nil has no archived tasks
+
+	// mu guards the settings, draining and additions to running, so no
+	// task starts after Drain began waiting.
+	mu            sync.Mutex
+	saveInterval  int // seconds
+	retention     model.RetentionPolicy
+	draining      bool
+	running       sync.WaitGroup
+	interrupt     chan struct{} // closed when running tasks must stop
+	interruptOnce sync.Once
 }
 
-func NewTasksService(interval int, tasksRepo TasksRepository) *TasksService {
+func NewTasksService(interval int, retention model.RetentionPolicy, tasksRepo TasksRepository) *TasksService {
 	return &TasksService{
-		SaveInterval: interval,
+		saveInterval: interval,
+		retention:    retention,
 		tasksRepo:    tasksRepo,
+		interrupt:    make(chan struct{}),
 	}
 }
 
-func (s *TasksService) RegisterTask(ctx context.Context, title string) (string, error) {
-	task := model.Task{
-		ID:        uuid.New(),
-		Status:    model.Pending,
-		Title:     title,
-		CreatedAt: time.Now(),
+// SetInterval changes the save interval of tasks started afterwards.
+func (s *TasksService) SetInterval(interval int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.saveInterval = interval
+}
+
+// SetRetention changes the retention policy of tasks finished afterwards.
+func (s *TasksService) SetRetention(retention model.RetentionPolicy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.retention = retention
+}
+
+// SetQuota makes the service admit new tasks within the limiter quotas.
+// It must be called before the service is used.
+func (s *TasksService) SetQuota(limiter *quota.Limiter) {
+	s.quota = limiter
+}
+
+// SetAudit makes the service record every task mutation in the audit log.
+// It must be called before the service is used.
+func (s *TasksService) SetAudit(log *audit.Log) {
+	s.audit = log
+}
+
+// SetTaskLogs makes the service pass a sink of the store to every task it
+// runs. It must be called before the service is used.
+func (s *TasksService) SetTaskLogs(store *tasklog.Store) {
+	s.logs = store
+}
+
+// SetArchive makes the service serve archived tasks of the archiver. It must
+// be called before the service is used.
+func (s *TasksService) SetArchive(archiver *archive.Archiver) {
+	s.archive = archiver
+}
+
+func (s *TasksService) settings() (int, model.RetentionPolicy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.saveInterval, s.retention
+}
+
+func (s *TasksService) RegisterTask(ctx context.Context, spec model.TaskSpec) (string, error) {
+	ctx, span := tracing.Start(ctx, "TasksService.RegisterTask")
+	defer span.End()
+
+	if s.isDraining() {
+		return "", fmt.Errorf("TasksService.RegisterTask: %w", model.ErrServiceDraining)
+	}
+
+	reservation, err := s.quota.Reserve(ctx, 1)
+	if err != nil {
+		return "", fmt.Errorf("TasksService.RegisterTask: %w", err)
 	}
 
-	err := s.tasksRepo.CreateTask(ctx, task)
+	task := newTask(ctx, spec)
+
+	change, err := s.tasksRepo.CreateTask(ctx, task)
 	if err != nil {
+		reservation.Done(0)
+		tracing.RecordError(span, err)
 		return "", fmt.Errorf("TasksService.RegisterTask: failed to create new task: %w", err)
 	}
+	reservation.Done(1)
+	s.audit.Record(ctx, model.AuditCreate, task.ID.String(), change.Before, change.After)
+
+	s.runTask(ctx, task)
+
+	return task.ID.String(), nil
+}
+
+// RegisterTasks creates a task for every spec and starts the successfully
+// created ones. Specs beyond the tenant quota fail with
+// model.QuotaExceededError. Results are returned in the order of specs.
+func (s *TasksService) RegisterTasks(ctx context.Context, specs []model.TaskSpec) []model.BatchResult {
+	ctx, span := tracing.Start(ctx, "TasksService.RegisterTasks")
+	defer span.End()
+
+	if s.isDraining() {
+		results := make([]model.BatchResult, len(specs))
+		for i := range results {
+			results[i].Err = fmt.Errorf("TasksService.RegisterTasks: %w", model.ErrServiceDraining)
+		}
+		return results
+	}
+
+	results := make([]model.BatchResult, len(specs))
+	reservation, err := s.quota.Reserve(ctx, len(specs))
+	if err != nil {
+		for i := range results {
+			results[i].Err = fmt.Errorf("TasksService.RegisterTasks: %w", err)
+		}
+		return results
+	}
+	for i := reservation.N; i < len(specs); i++ {
+		results[i].Err = fmt.Errorf("TasksService.RegisterTasks: %w", reservation.Exceeded)
+	}
+
+	tasks := make([]model.Task, reservation.N)
+	for i, spec := range specs[:reservation.N] {
+		tasks[i] = newTask(ctx, spec)
+	}
+
+	changes, errs := s.tasksRepo.CreateTasks(ctx, tasks)
+
+	created := 0
+	for i, task := range tasks {
+		results[i].ID = task.ID.String()
+		if errs[i] != nil {
+			results[i].Err = fmt.Errorf("TasksService.RegisterTasks: failed to create new task: %w", errs[i])
+			continue
+		}
+		created++
+		s.audit.Record(ctx, model.AuditCreate, task.ID.String(), changes[i].Before, changes[i].After)
+	}
+	reservation.Done(created)
+
+	for i, task := range tasks {
+		if errs[i] == nil {
+			s.runTask(ctx, task)
+		}
+	}
+
+	return results
+}
+
+// newTask creates a pending task owned by the principal of ctx.
+func newTask(ctx context.Context, spec model.TaskSpec) model.Task {
+	principal, _ := auth.PrincipalFrom(ctx)
+	now := time.Now()
+	return model.Task{
+		ID:        uuid.New(),
+		Status:    model.Pending,
+		Title:     spec.Title,
+		CreatedAt: now,
+		Labels:    spec.Labels,
+		Metadata:  spec.Metadata,
+		CreatedBy: principal.ID,
+		Events:    []model.TaskEvent{{Type: model.EventQueued, Time: now}},
+	}
+}
 
+// owner returns the id of the principal whose tasks are the only ones
+// visible in ctx. Principals with the admin scope and calls without a
+// principal, such as background jobs, aren't restricted.
+func owner(ctx context.Context) (string, bool) {
+	principal, ok := auth.PrincipalFrom(ctx)
+	if !ok || principal.HasScope(auth.ScopeAdmin) {
+		return "", false
+	}
+	return principal.ID, true
+}
+
+// visible reports whether the task can be seen and changed in ctx.
+// Invisible tasks are reported as not found, so their existence isn't revealed.
+func visible(ctx context.Context, task *model.Task) bool {
+	id, restricted := owner(ctx)
+	return !restricted || task.CreatedBy == id
+}
+
+// filterVisible removes the tasks that can't be seen in ctx.
+func filterVisible(ctx context.Context, tasks []model.Task) []model.Task {
+	if _, restricted := owner(ctx); !restricted {
+		return tasks
+	}
+	return slices.DeleteFunc(tasks, func(task model.Task) bool {
+		return !visible(ctx, &task)
+	})
+}
+
+// runTask simulates long-running work of the task in a separate goroutine.
+// The work outlives the request, so it is traced as a new root span linked
+// to the span of the request that started it. The request context values,
+// such as the request id, are kept for logging. The work stops early with
+// the interrupted status when Drain times out.
+func (s *TasksService) runTask(ctx context.Context, task model.Task) {
+	slog.InfoContext(ctx, "task queued", "task_id", task.ID.String())
+
+	link := trace.LinkFromContext(ctx)
+	ctx = context.WithoutCancel(ctx)
+	if !s.track() {
+		// Drain started after the task was created
+		s.finishTask(ctx, task, model.Interrupted, time.Time{})
+		s.quota.Finish(ctx)
+		return
+	}
+
+	// created before the task starts, so its log can be followed right away
+	sink := s.logs.Sink(ctx, task.ID.String())
+	s.inFlight.Add(1)
+	metrics.TasksInFlight.Inc()
 	go func() {
-		sleepInterval := time.Duration(2+s.SaveInterval) * time.Second
-		time.Sleep(sleepInterval)
+		defer s.running.Done()
+		defer s.quota.Finish(ctx)
+		defer s.inFlight.Add(-1)
+		defer metrics.TasksInFlight.Dec()
+
+		ctx, span := tracing.Start(ctx, "TasksService.runTask",
+			trace.WithNewRoot(),
+			trace.WithLinks(link),
+			trace.WithAttributes(tracing.TaskID(task.ID.String())),
+		)
+		defer span.End()
 
+		startedAt, err := s.startTask(ctx, task)
+		if err != nil {
+			tracing.RecordError(span, err)
+		}
+
+		status := s.work(task, sink)
+		if err := s.finishTask(ctx, task, status, startedAt); err != nil {
+			tracing.RecordError(span, err)
+		}
+		if err := sink.Close(); err != nil {
+			slog.ErrorContext(ctx, "TasksService.runTask: error while closing task log", "task_id", task.ID.String(), "error", err)
+		}
+	}()
+}
+
+// work simulates the work of the task, writing its progress to the sink,
+// and returns the final status.
+func (s *TasksService) work(task model.Task, sink *tasklog.Sink) model.Status {
+	saveInterval, _ := s.settings()
+	duration := time.Duration(2+saveInterval) * time.Second
+	sink.Logf(model.LogInfo, "working on %q for %s", task.Title, duration)
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
 		if rand.Float32() < 0.2 {
-			task.Status = model.Failed
-		} else {
-			task.Status = model.Completed
+			sink.Logf(model.LogError, "work failed")
+			return model.Failed
 		}
+		sink.Logf(model.LogInfo, "work completed")
+		return model.Completed
+	case <-s.interrupt:
+		sink.Logf(model.LogWarn, "work interrupted by shutdown")
+		return model.Interrupted
+	}
+}
+
+// startTask records the start of the task and returns its time. The task
+// runs even if recording fails.
+func (s *TasksService) startTask(ctx context.Context, task model.Task) (time.Time, error) {
+	startedAt := time.Now()
+	update := model.TaskUpdate{
+		Event:  model.TaskEvent{Type: model.EventStarted, Time: startedAt},
+		Status: model.Pending,
+	}
+	change, err := s.tasksRepo.UpdateTask(ctx, task.ID.String(), update)
+	if err != nil {
+		slog.ErrorContext(ctx, "TasksService.startTask: error while recording task start", "task_id", task.ID.String(), "error", err)
+		return startedAt, err
+	}
+	s.audit.Record(ctx, model.AuditStart, task.ID.String(), change.Before, change.After)
+
+	slog.InfoContext(ctx, "task started", "task_id", task.ID.String())
+	return startedAt, nil
+}
+
+// finishTask records the final status of the task. The duration is the
+// time since startedAt, zero for tasks that never started.
+func (s *TasksService) finishTask(ctx context.Context, task model.Task, status model.Status, startedAt time.Time) error {
+	logger := slog.With("task_id", task.ID.String())
+
+	finishedAt := time.Now()
+	var duration time.Duration
+	if !startedAt.IsZero() {
+		duration = finishedAt.Sub(startedAt)
+	}
+
+	_, retention := s.settings()
+	metrics.TaskDuration.WithLabelValues(string(status)).Observe(duration.Seconds())
+	update := model.TaskUpdate{
+		Event:     model.TaskEvent{Type: model.EventFinished, Time: finishedAt, Status: status},
+		Status:    status,
+		Duration:  duration,
+		ExpiresAt: retention.ExpiresAt(status, finishedAt),
+	}
+	change, err := s.tasksRepo.UpdateTask(ctx, task.ID.String(), update)
+	if err != nil {
+		logger.ErrorContext(ctx, "TasksService.finishTask: error while updating task status", "error", err)
+		return err
+	}
+	s.audit.Record(ctx, model.AuditFinish, task.ID.String(), change.Before, change.After)
 
-		task.Duration = sleepInterval
-		if err := s.tasksRepo.UpdateTask(context.Background(), task.ID.String(), task.Status); err != nil {
-			log.Printf("TasksService.RegisterTask: error while updating task status: %v", err.Error())
+	logger.InfoContext(ctx, "task finished", "status", status, "duration", duration)
+	return nil
+}
+
+// track registers a new running task unless the service is draining.
+func (s *TasksService) track() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.draining {
+		return false
+	}
+	s.running.Add(1)
+	return true
+}
+
+func (s *TasksService) isDraining() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.draining
+}
+
+// StopAccepting makes the service reject new tasks with model.ErrServiceDraining.
+func (s *TasksService) StopAccepting() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.draining = true
+}
+
+// InterruptUnfinished records unfinished tasks, e.g. restored from the
+// snapshot of a previous run, as interrupted, since their work doesn't
+// survive a restart. It must be called before tasks are registered and
+// returns the number of interrupted tasks.
+func (s *TasksService) InterruptUnfinished(ctx context.Context) (int, error) {
+	tasks, err := s.tasksRepo.UnfinishedTasks(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("TasksRepo.UnfinishedTasks: failed to get unfinished tasks: %w", err)
+	}
+
+	interrupted := 0
+	for _, task := range tasks {
+		// the time the work stopped is unknown, so no duration is recorded
+		if s.finishTask(tenant.With(ctx, task.Tenant), task, model.Interrupted, time.Time{}) == nil {
+			interrupted++
 		}
+	}
+	return interrupted, nil
+}
+
+// Drain stops accepting new tasks and waits for running ones until ctx is
+// done. Tasks still running by then are recorded as interrupted. It returns
+// the number of interrupted tasks.
+func (s *TasksService) Drain(ctx context.Context) int {
+	s.StopAccepting()
+
+	done := make(chan struct{})
+	go func() {
+		s.running.Wait()
+		close(done)
 	}()
 
-	return task.ID.String(), nil
+	select {
+	case <-done:
+		return 0
+	case <-ctx.Done():
+	}
+
+	interrupted := s.TasksInFlight()
+	s.interruptOnce.Do(func() { close(s.interrupt) })
+	<-done
+	return interrupted
+}
+
+// TasksInFlight returns the number of currently executing tasks.
+func (s *TasksService) TasksInFlight() int {
+	return int(s.inFlight.Load())
 }
 
 func (s *TasksService) TaskInfo(ctx context.Context, taskId string) (*model.Task, error) {
+	ctx, span := tracing.Start(ctx, "TasksService.TaskInfo")
+	defer span.End()
+
 	taskInfo, err := s.tasksRepo.GetTask(ctx, taskId)
+	if err == nil && !visible(ctx, taskInfo) {
+		err = model.ErrTaskNotFound
+	}
 	if err != nil {
+		tracing.RecordError(span, err)
 		return nil, fmt.Errorf("TasksRepo.GetTask: failed to get task info by id: %w", err)
 	}
 
 	return taskInfo, nil
 }
 
-func (s *TasksService) DeleteTask(ctx context.Context, taskId string) error {
-	err := s.tasksRepo.DeleteTask(ctx, taskId)
+// ArchivedTask returns the archived task by id. Like stored tasks, archived
+// ones are only visible to their owner unless ctx has the admin scope.
+func (s *TasksService) ArchivedTask(ctx context.Context, taskId string) (*model.Task, error) {
+	ctx, span := tracing.Start(ctx, "TasksService.ArchivedTask")
+	defer span.End()
+
+	if s.archive == nil {
+		return nil, model.ErrTaskNotFound
+	}
+	task, err := s.archive.ArchivedTask(ctx, taskId)
+	if err == nil && !visible(ctx, task) {
+		err = model.ErrTaskNotFound
+	}
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("Archive.ArchivedTask: failed to get archived task by id: %w", err)
+	}
+
+	return task, nil
+}
+
+// TaskLogs returns the lines of the log of the task selected by the query.
+func (s *TasksService) TaskLogs(ctx context.Context, taskId string, query model.LogQuery) (model.LogPage, error) {
+	ctx, span := tracing.Start(ctx, "TasksService.TaskLogs")
+	defer span.End()
+
+	if err := s.checkTaskLogs(ctx, taskId); err != nil {
+		tracing.RecordError(span, err)
+		return model.LogPage{}, err
+	}
+	page, err := s.logs.Read(ctx, taskId, query)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return model.LogPage{}, fmt.Errorf("TaskLogs.Read: failed to read task log: %w", err)
+	}
+
+	return page, nil
+}
+
+// FollowTaskLogs passes the lines of the log of the task from offset to fn
+// as they are written, until the task finishes or fn fails, see
+// tasklog.Store.Follow.
+func (s *TasksService) FollowTaskLogs(ctx context.Context, taskId string, offset int64, fn func([]model.LogLine) error) error {
+	if err := s.checkTaskLogs(ctx, taskId); err != nil {
+		return err
+	}
+	return s.logs.Follow(ctx, taskId, offset, fn)
+}
+
+// checkTaskLogs returns model.ErrTaskNotFound unless the task exists and
+// can be seen in ctx.
+func (s *TasksService) checkTaskLogs(ctx context.Context, taskId string) error {
+	task, err := s.tasksRepo.GetTask(ctx, taskId)
+	if err == nil && !visible(ctx, task) {
+		err = model.ErrTaskNotFound
+	}
 	if err != nil {
+		return fmt.Errorf("TasksRepo.GetTask: failed to get task by id: %w", err)
+	}
+	return nil
+}
+
+// ListTasks returns tasks whose labels match the selector.
+func (s *TasksService) ListTasks(ctx context.Context, selector model.LabelSelector) ([]model.Task, error) {
+	ctx, span := tracing.Start(ctx, "TasksService.ListTasks")
+	defer span.End()
+
+	tasks, err := s.tasksRepo.ListTasks(ctx, selector)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("TasksRepo.ListTasks: failed to list tasks: %w", err)
+	}
+
+	return filterVisible(ctx, tasks), nil
+}
+
+// SearchTasks returns up to limit tasks whose titles match the query, most relevant first.
+func (s *TasksService) SearchTasks(ctx context.Context, query string, limit int) ([]model.Task, error) {
+	ctx, span := tracing.Start(ctx, "TasksService.SearchTasks")
+	defer span.End()
+
+	// the limit is applied after filtering, the repository ranks every match anyway
+	repoLimit := limit
+	if _, restricted := owner(ctx); restricted {
+		repoLimit = math.MaxInt
+	}
+	tasks, err := s.tasksRepo.SearchTasks(ctx, query, repoLimit)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("TasksRepo.SearchTasks: failed to search tasks: %w", err)
+	}
+
+	tasks = filterVisible(ctx, tasks)
+	if len(tasks) > limit {
+		tasks = tasks[:limit]
+	}
+	return tasks, nil
+}
+
+// PatchTask applies the patch to the task. The task is checked and changed
+// under the repository lock, so a concurrent start can't fail a patch
+// without an expected version.
+func (s *TasksService) PatchTask(ctx context.Context, taskId string, patch model.TaskPatch) (*model.Task, error) {
+	ctx, span := tracing.Start(ctx, "TasksService.PatchTask")
+	defer span.End()
+
+	change, err := s.tasksRepo.PatchTask(ctx, taskId, func(task *model.Task) error {
+		if !visible(ctx, task) {
+			return model.ErrTaskNotFound
+		}
+		if patch.Version != nil && *patch.Version != task.Version {
+			return fmt.Errorf("expected version %d, got %d: %w", *patch.Version, task.Version, model.ErrVersionMismatch)
+		}
+		if err := checkPatchable(task, patch); err != nil {
+			return err
+		}
+
+		if patch.Title != nil {
+			task.Title = *patch.Title
+		}
+		return nil
+	})
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("TasksRepo.PatchTask: failed to patch task: %w", err)
+	}
+	s.audit.Record(ctx, model.AuditUpdate, taskId, change.Before, change.After)
+
+	return change.After, nil
+}
+
+// DeleteTask soft deletes the task, so it can be restored until purged.
+// Non-nil version makes the deletion conditional on the current task version.
+func (s *TasksService) DeleteTask(ctx context.Context, taskId string, version *int64) error {
+	ctx, span := tracing.Start(ctx, "TasksService.DeleteTask")
+	defer span.End()
+
+	ownerID, _ := owner(ctx)
+	change, err := s.tasksRepo.DeleteTask(ctx, taskId, version, ownerID)
+	if err != nil {
+		tracing.RecordError(span, err)
 		return fmt.Errorf("TasksRepo.DeleteTask: failed to delete task info by id: %w", err)
 	}
+	s.audit.Record(ctx, model.AuditDelete, taskId, change.Before, change.After)
+
+	return nil
+}
+
+// DeleteTasks soft deletes tasks by ids. Results are returned in the order of ids.
+func (s *TasksService) DeleteTasks(ctx context.Context, taskIds []string) []model.BatchResult {
+	ctx, span := tracing.Start(ctx, "TasksService.DeleteTasks")
+	defer span.End()
+
+	ownerID, _ := owner(ctx)
+	changes, errs := s.tasksRepo.DeleteTasks(ctx, taskIds, ownerID)
+
+	results := make([]model.BatchResult, len(taskIds))
+	for i, err := range errs {
+		results[i].ID = taskIds[i]
+		if err != nil {
+			results[i].Err = fmt.Errorf("TasksRepo.DeleteTasks: failed to delete task info by id: %w", err)
+			continue
+		}
+		s.audit.Record(ctx, model.AuditDelete, taskIds[i], changes[i].Before, changes[i].After)
+	}
+
+	return results
+}
+
+// RestoreTask undoes soft deletion of the task.
+func (s *TasksService) RestoreTask(ctx context.Context, taskId string) (*model.Task, error) {
+	ctx, span := tracing.Start(ctx, "TasksService.RestoreTask")
+	defer span.End()
+
+	ownerID, _ := owner(ctx)
+	change, err := s.tasksRepo.RestoreTask(ctx, taskId, ownerID)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("TasksRepo.RestoreTask: failed to restore task by id: %w", err)
+	}
+	s.audit.Record(ctx, model.AuditRestore, taskId, change.Before, change.After)
+
+	return change.After, nil
+}
+
+// PurgeTask irreversibly removes the task whether it was soft deleted or not.
+func (s *TasksService) PurgeTask(ctx context.Context, taskId string) error {
+	ctx, span := tracing.Start(ctx, "TasksService.PurgeTask")
+	defer span.End()
+
+	changes, errs := s.tasksRepo.PurgeTasks(ctx, []string{taskId})
+	if err := errs[0]; err != nil {
+		tracing.RecordError(span, err)
+		return fmt.Errorf("TasksRepo.PurgeTasks: failed to purge task by id: %w", err)
+	}
+	s.audit.Record(ctx, model.AuditPurge, taskId, changes[0].Before, changes[0].After)
 
 	return nil
 }
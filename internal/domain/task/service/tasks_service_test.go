@@ -3,8 +3,16 @@ package service
 import (
 	"context"
 	"errors"
+	"test-server/internal/auth"
 	"test-server/internal/domain/model"
+	"test-server/internal/domain/task/archive"
+	"test-server/internal/domain/task/audit"
+	"test-server/internal/domain/task/quota"
+	"test-server/internal/domain/task/repository"
 	mocks "test-server/internal/domain/task/service/mock"
+	"test-server/internal/domain/task/tasklog"
+	"test-server/internal/logging"
+	"test-server/internal/tenant"
 	"testing"
 	"time"
 
@@ -12,6 +20,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 func TestTasksService_RegisterTask(t *testing.T) {
@@ -31,14 +42,17 @@ func TestTasksService_RegisterTask(t *testing.T) {
 			title:    testTaskTitle,
 			interval: 3,
 			mockSetup: func(mc *minimock.Controller) TasksRepository {
-				return mocks.NewTasksRepositoryMock(mc).CreateTaskMock.Set(func(ctx context.Context, task model.Task) error {
+				return mocks.NewTasksRepositoryMock(mc).CreateTaskMock.Set(func(ctx context.Context, task model.Task) (model.TaskChange, error) {
 					// Verify the task has expected values
 					assert.Equal(t, testTaskTitle, task.Title)
 					assert.Equal(t, model.Pending, task.Status)
 					assert.NotEqual(t, uuid.Nil, task.ID)
 					assert.False(t, task.CreatedAt.IsZero())
-					return nil
-				})
+					assert.Equal(t, []model.TaskEvent{{Type: model.EventQueued, Time: task.CreatedAt}}, task.Events)
+					return model.TaskChange{After: &task}, nil
+				}).
+					// the task starts in the background
+					UpdateTaskMock.Optional().Return(model.TaskChange{}, nil)
 			},
 			wantErr: require.NoError,
 		},
@@ -47,13 +61,13 @@ func TestTasksService_RegisterTask(t *testing.T) {
 			title:    testTaskTitle,
 			interval: 3,
 			mockSetup: func(mc *minimock.Controller) TasksRepository {
-				return mocks.NewTasksRepositoryMock(mc).CreateTaskMock.Set(func(ctx context.Context, task model.Task) error {
+				return mocks.NewTasksRepositoryMock(mc).CreateTaskMock.Set(func(ctx context.Context, task model.Task) (model.TaskChange, error) {
 					// Verify the task has expected values
 					assert.Equal(t, testTaskTitle, task.Title)
 					assert.Equal(t, model.Pending, task.Status)
 					assert.NotEqual(t, uuid.Nil, task.ID)
 					assert.False(t, task.CreatedAt.IsZero())
-					return errors.New("repository error")
+					return model.TaskChange{}, errors.New("repository error")
 				})
 			},
 			wantErr: require.Error,
@@ -67,9 +81,9 @@ func TestTasksService_RegisterTask(t *testing.T) {
 			mc := minimock.NewController(t)
 			repo := tt.mockSetup(mc)
 
-			service := NewTasksService(tt.interval, repo)
+			service := NewTasksService(tt.interval, model.RetentionPolicy{}, repo)
 
-			taskID, err := service.RegisterTask(context.Background(), tt.title)
+			taskID, err := service.RegisterTask(context.Background(), model.TaskSpec{Title: tt.title})
 			tt.wantErr(t, err)
 
 			if err == nil {
@@ -128,7 +142,7 @@ func TestTasksService_TaskInfo(t *testing.T) {
 			mc := minimock.NewController(t)
 			repo := tt.mockSetup(mc)
 
-			service := NewTasksService(3, repo)
+			service := NewTasksService(3, model.RetentionPolicy{}, repo)
 
 			task, err := service.TaskInfo(context.Background(), tt.taskID)
 			tt.wantErr(t, err)
@@ -142,6 +156,111 @@ func TestTasksService_TaskInfo(t *testing.T) {
 	}
 }
 
+func TestTasksService_PatchTask(t *testing.T) {
+	t.Parallel()
+
+	testTaskID := "ca545e27-4e9b-4c95-b38b-d72069e33975"
+	newTitle := "Renamed Task"
+	staleVersion := int64(1)
+	startedAt := time.Now()
+	newTask := func(status model.Status, startedAt *time.Time) *model.Task {
+		return &model.Task{
+			ID:        uuid.MustParse(testTaskID),
+			Status:    status,
+			Title:     "Test Task",
+			CreatedAt: time.Now(),
+			Version:   2,
+			StartedAt: startedAt,
+		}
+	}
+	// patchStored applies the patch to the stored task like the repository does
+	patchStored := func(stored *model.Task) func(ctx context.Context, id string, apply func(*model.Task) error) (model.TaskChange, error) {
+		return func(ctx context.Context, id string, apply func(*model.Task) error) (model.TaskChange, error) {
+			assert.Equal(t, testTaskID, id)
+			task := *stored
+			if err := apply(&task); err != nil {
+				return model.TaskChange{}, err
+			}
+			task.Version++
+			return model.TaskChange{Before: stored, After: &task}, nil
+		}
+	}
+
+	testTable := []struct {
+		name      string
+		patch     model.TaskPatch
+		mockSetup func(mc *minimock.Controller) TasksRepository
+		wantErr   require.ErrorAssertionFunc
+		errIs     error
+	}{
+		{
+			name:  "success",
+			patch: model.TaskPatch{Title: &newTitle},
+			mockSetup: func(mc *minimock.Controller) TasksRepository {
+				return mocks.NewTasksRepositoryMock(mc).PatchTaskMock.Set(patchStored(newTask(model.Pending, nil)))
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:  "title not editable after completion",
+			patch: model.TaskPatch{Title: &newTitle},
+			mockSetup: func(mc *minimock.Controller) TasksRepository {
+				return mocks.NewTasksRepositoryMock(mc).PatchTaskMock.Set(patchStored(newTask(model.Completed, &startedAt)))
+			},
+			wantErr: require.Error,
+			errIs:   model.ErrFieldNotEditable,
+		},
+		{
+			name:  "title not editable once started",
+			patch: model.TaskPatch{Title: &newTitle},
+			mockSetup: func(mc *minimock.Controller) TasksRepository {
+				return mocks.NewTasksRepositoryMock(mc).PatchTaskMock.Set(patchStored(newTask(model.Pending, &startedAt)))
+			},
+			wantErr: require.Error,
+			errIs:   model.ErrFieldNotEditable,
+		},
+		{
+			name:  "stale version",
+			patch: model.TaskPatch{Title: &newTitle, Version: &staleVersion},
+			mockSetup: func(mc *minimock.Controller) TasksRepository {
+				return mocks.NewTasksRepositoryMock(mc).PatchTaskMock.Set(patchStored(newTask(model.Pending, nil)))
+			},
+			wantErr: require.Error,
+			errIs:   model.ErrVersionMismatch,
+		},
+		{
+			name:  "repository error",
+			patch: model.TaskPatch{Title: &newTitle},
+			mockSetup: func(mc *minimock.Controller) TasksRepository {
+				return mocks.NewTasksRepositoryMock(mc).PatchTaskMock.Return(model.TaskChange{}, model.ErrTaskNotFound)
+			},
+			wantErr: require.Error,
+			errIs:   model.ErrTaskNotFound,
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			repo := tt.mockSetup(mc)
+
+			service := NewTasksService(3, model.RetentionPolicy{}, repo)
+
+			task, err := service.PatchTask(context.Background(), testTaskID, tt.patch)
+			tt.wantErr(t, err)
+
+			if err == nil {
+				assert.Equal(t, newTitle, task.Title)
+				assert.Equal(t, int64(3), task.Version)
+			} else {
+				assert.ErrorIs(t, err, tt.errIs)
+			}
+		})
+	}
+}
+
 func TestTasksService_DeleteTask(t *testing.T) {
 	t.Parallel()
 
@@ -157,7 +276,7 @@ func TestTasksService_DeleteTask(t *testing.T) {
 			name:   "success",
 			taskID: testTaskID,
 			mockSetup: func(mc *minimock.Controller) TasksRepository {
-				return mocks.NewTasksRepositoryMock(mc).DeleteTaskMock.Expect(minimock.AnyContext, testTaskID).Return(nil)
+				return mocks.NewTasksRepositoryMock(mc).DeleteTaskMock.Expect(minimock.AnyContext, testTaskID, nil, "").Return(model.TaskChange{}, nil)
 			},
 			wantErr: require.NoError,
 		},
@@ -165,7 +284,7 @@ func TestTasksService_DeleteTask(t *testing.T) {
 			name:   "repository error",
 			taskID: testTaskID,
 			mockSetup: func(mc *minimock.Controller) TasksRepository {
-				return mocks.NewTasksRepositoryMock(mc).DeleteTaskMock.Expect(minimock.AnyContext, testTaskID).Return(errors.New("delete failed"))
+				return mocks.NewTasksRepositoryMock(mc).DeleteTaskMock.Expect(minimock.AnyContext, testTaskID, nil, "").Return(model.TaskChange{}, errors.New("delete failed"))
 			},
 			wantErr: require.Error,
 		},
@@ -178,10 +297,460 @@ func TestTasksService_DeleteTask(t *testing.T) {
 			mc := minimock.NewController(t)
 			repo := tt.mockSetup(mc)
 
-			service := NewTasksService(3, repo)
+			service := NewTasksService(3, model.RetentionPolicy{}, repo)
 
-			err := service.DeleteTask(context.Background(), tt.taskID)
+			err := service.DeleteTask(context.Background(), tt.taskID, nil)
 			tt.wantErr(t, err)
 		})
 	}
 }
+
+func TestTasksService_DeleteTasks(t *testing.T) {
+	t.Parallel()
+
+	testTaskIDs := []string{
+		"ca545e27-4e9b-4c95-b38b-d72069e33975",
+		"0b1e3c43-5c57-4bd0-a4f1-31e1e1b1ad29",
+	}
+
+	mc := minimock.NewController(t)
+	repo := mocks.NewTasksRepositoryMock(mc).DeleteTasksMock.
+		Expect(minimock.AnyContext, testTaskIDs, "").
+		Return(make([]model.TaskChange, 2), []error{nil, model.ErrTaskNotFound})
+
+	service := NewTasksService(3, model.RetentionPolicy{}, repo)
+
+	results := service.DeleteTasks(context.Background(), testTaskIDs)
+	require.Len(t, results, 2)
+
+	assert.Equal(t, testTaskIDs[0], results[0].ID)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, testTaskIDs[1], results[1].ID)
+	assert.ErrorIs(t, results[1].Err, model.ErrTaskNotFound)
+}
+
+func TestTasksService_RegisterTask_Tracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	mc := minimock.NewController(t)
+	repo := mocks.NewTasksRepositoryMock(mc).
+		CreateTaskMock.Return(model.TaskChange{}, nil).
+		UpdateTaskMock.Return(model.TaskChange{}, nil)
+
+	// -2 makes the simulated work finish immediately
+	service := NewTasksService(-2, model.RetentionPolicy{}, repo)
+
+	ctx, requestSpan := provider.Tracer("test").Start(context.Background(), "request")
+	_, err := service.RegisterTask(ctx, model.TaskSpec{Title: "Traced Task"})
+	requestSpan.End()
+	require.NoError(t, err)
+
+	spanByName := func(name string) sdktrace.ReadOnlySpan {
+		for _, span := range recorder.Ended() {
+			if span.Name() == name {
+				return span
+			}
+		}
+		return nil
+	}
+	require.Eventually(t, func() bool {
+		return spanByName("TasksService.runTask") != nil
+	}, time.Second, 10*time.Millisecond)
+
+	registerSpan := spanByName("TasksService.RegisterTask")
+	require.NotNil(t, registerSpan)
+	assert.Equal(t, requestSpan.SpanContext().SpanID(), registerSpan.Parent().SpanID())
+
+	runSpan := spanByName("TasksService.runTask")
+	assert.NotEqual(t, requestSpan.SpanContext().TraceID(), runSpan.SpanContext().TraceID())
+	assert.False(t, runSpan.Parent().IsValid())
+	require.Len(t, runSpan.Links(), 1)
+	assert.Equal(t, registerSpan.SpanContext(), runSpan.Links()[0].SpanContext)
+}
+
+func TestTasksService_Drain(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name            string
+		interval        int
+		drainTimeout    time.Duration
+		wantInterrupted int
+		checkStatus     func(t *testing.T, status model.Status)
+	}{
+		{
+			name:            "tasks finish in time",
+			interval:        -2, // finishes immediately
+			drainTimeout:    time.Second,
+			wantInterrupted: 0,
+			checkStatus: func(t *testing.T, status model.Status) {
+				assert.Contains(t, []model.Status{model.Completed, model.Failed}, status)
+			},
+		},
+		{
+			name:            "tasks are interrupted",
+			interval:        60,
+			drainTimeout:    10 * time.Millisecond,
+			wantInterrupted: 1,
+			checkStatus: func(t *testing.T, status model.Status) {
+				assert.Equal(t, model.Interrupted, status)
+			},
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			repo := mocks.NewTasksRepositoryMock(mc).
+				CreateTaskMock.Return(model.TaskChange{}, nil).
+				UpdateTaskMock.Set(func(ctx context.Context, id string, update model.TaskUpdate) (model.TaskChange, error) {
+				if update.Event.Type == model.EventFinished {
+					tt.checkStatus(t, update.Status)
+				}
+				return model.TaskChange{}, nil
+			})
+
+			service := NewTasksService(tt.interval, model.RetentionPolicy{}, repo)
+			_, err := service.RegisterTask(context.Background(), model.TaskSpec{Title: "Test Task"})
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), tt.drainTimeout)
+			defer cancel()
+			assert.Equal(t, tt.wantInterrupted, service.Drain(ctx))
+			assert.Equal(t, 0, service.TasksInFlight())
+			assert.Equal(t, uint64(2), repo.UpdateTaskAfterCounter(), "started and finished")
+
+			_, err = service.RegisterTask(context.Background(), model.TaskSpec{Title: "Late Task"})
+			require.ErrorIs(t, err, model.ErrServiceDraining)
+
+			results := service.RegisterTasks(context.Background(), []model.TaskSpec{{Title: "Late Task"}})
+			require.ErrorIs(t, results[0].Err, model.ErrServiceDraining)
+		})
+	}
+}
+
+func TestTasksService_InterruptUnfinished(t *testing.T) {
+	t.Parallel()
+
+	repo := repository.NewTasksRepository()
+	ctx := tenant.With(context.Background(), "acme")
+	pending := model.Task{ID: uuid.New(), Status: model.Pending, Title: "Restored", CreatedAt: time.Now()}
+	completed := model.Task{ID: uuid.New(), Status: model.Completed, Title: "Done", CreatedAt: time.Now()}
+	_, err := repo.CreateTask(ctx, pending)
+	require.NoError(t, err)
+	_, err = repo.CreateTask(ctx, completed)
+	require.NoError(t, err)
+
+	service := NewTasksService(0, model.RetentionPolicy{Failed: time.Hour}, repo)
+	interrupted, err := service.InterruptUnfinished(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, interrupted)
+
+	task, err := repo.GetTask(ctx, pending.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, model.Interrupted, task.Status)
+	assert.NotNil(t, task.FinishedAt)
+	assert.NotNil(t, task.ExpiresAt, "interrupted tasks expire as failed ones")
+
+	task, err = repo.GetTask(ctx, completed.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, model.Completed, task.Status)
+
+	// nothing is left to interrupt
+	interrupted, err = service.InterruptUnfinished(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, interrupted)
+}
+
+func TestTasksService_Ownership(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	alice := auth.WithPrincipal(ctx, auth.Principal{ID: "alice", Scopes: []string{auth.ScopeRead, auth.ScopeWrite}})
+	bob := auth.WithPrincipal(ctx, auth.Principal{ID: "bob", Scopes: []string{auth.ScopeRead, auth.ScopeWrite}})
+	admin := auth.WithPrincipal(ctx, auth.Principal{ID: "root", Scopes: []string{auth.ScopeAdmin}})
+
+	repo := repository.NewTasksRepository()
+	service := NewTasksService(3, model.RetentionPolicy{}, repo)
+
+	aliceTask := newTask(alice, model.TaskSpec{Title: "report of alice"})
+	bobTask := newTask(bob, model.TaskSpec{Title: "report of bob"})
+	assert.Equal(t, "alice", aliceTask.CreatedBy)
+	_, err := repo.CreateTask(ctx, aliceTask)
+	require.NoError(t, err)
+	_, err = repo.CreateTask(ctx, bobTask)
+	require.NoError(t, err)
+	aliceID, bobID := aliceTask.ID.String(), bobTask.ID.String()
+
+	tasks, err := service.ListTasks(alice, model.LabelSelector{})
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, aliceTask.ID, tasks[0].ID)
+
+	tasks, err = service.SearchTasks(bob, "report", 1)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1, "limit applies to visible tasks")
+	assert.Equal(t, bobTask.ID, tasks[0].ID)
+
+	tasks, err = service.ListTasks(admin, model.LabelSelector{})
+	require.NoError(t, err)
+	assert.Len(t, tasks, 2, "admin sees all tasks")
+
+	_, err = service.TaskInfo(alice, bobID)
+	assert.ErrorIs(t, err, model.ErrTaskNotFound)
+	title := "stolen"
+	_, err = service.PatchTask(alice, bobID, model.TaskPatch{Title: &title})
+	assert.ErrorIs(t, err, model.ErrTaskNotFound)
+	assert.ErrorIs(t, service.DeleteTask(alice, bobID, nil), model.ErrTaskNotFound)
+
+	results := service.DeleteTasks(alice, []string{bobID, aliceID})
+	assert.ErrorIs(t, results[0].Err, model.ErrTaskNotFound)
+	assert.NoError(t, results[1].Err)
+
+	require.NoError(t, service.DeleteTask(bob, bobID, nil))
+	_, err = service.RestoreTask(alice, bobID)
+	assert.ErrorIs(t, err, model.ErrTaskNotFound)
+	_, err = service.RestoreTask(bob, bobID)
+	assert.NoError(t, err)
+}
+
+func TestTasksService_ArchivedTask(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	alice := auth.WithPrincipal(ctx, auth.Principal{ID: "key:alice", Scopes: []string{auth.ScopeRead}})
+	bob := auth.WithPrincipal(ctx, auth.Principal{ID: "key:bob", Scopes: []string{auth.ScopeRead}})
+	admin := auth.WithPrincipal(ctx, auth.Principal{ID: "key:root", Scopes: []string{auth.ScopeAdmin}})
+
+	repo := repository.NewTasksRepository()
+	task := newTask(alice, model.TaskSpec{Title: "archived report"})
+	task.Status = model.Completed
+	_, err := repo.CreateTask(ctx, task)
+	require.NoError(t, err)
+	archiver, err := archive.NewArchiver(t.TempDir(), 0, time.Minute, repo)
+	require.NoError(t, err)
+	_, err = archiver.Archive(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+
+	service := NewTasksService(3, model.RetentionPolicy{}, repo)
+	_, err = service.ArchivedTask(alice, task.ID.String())
+	assert.ErrorIs(t, err, model.ErrTaskNotFound, "archive isn't set")
+
+	service.SetArchive(archiver)
+	archived, err := service.ArchivedTask(alice, task.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, task.ID, archived.ID)
+	_, err = service.ArchivedTask(bob, task.ID.String())
+	assert.ErrorIs(t, err, model.ErrTaskNotFound)
+	_, err = service.ArchivedTask(admin, task.ID.String())
+	assert.NoError(t, err)
+}
+
+func TestTasksService_Quota(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := repository.NewTasksRepository()
+	service := NewTasksService(3, model.RetentionPolicy{}, repo)
+	service.SetQuota(quota.NewLimiter(model.QuotaPolicy{Default: model.QuotaLimits{MaxStored: 2}}, repo))
+
+	results := service.RegisterTasks(ctx, []model.TaskSpec{{Title: "first"}, {Title: "second"}, {Title: "third"}})
+	require.NoError(t, results[0].Err)
+	require.NoError(t, results[1].Err)
+	var exceeded *model.QuotaExceededError
+	require.ErrorAs(t, results[2].Err, &exceeded)
+	assert.Equal(t, model.QuotaStored, exceeded.Limit)
+
+	_, err := service.RegisterTask(ctx, model.TaskSpec{Title: "fourth"})
+	assert.ErrorIs(t, err, model.ErrQuotaExceeded)
+
+	// quotas are counted per tenant
+	_, err = service.RegisterTask(tenant.With(ctx, "acme"), model.TaskSpec{Title: "first of acme"})
+	require.NoError(t, err)
+
+	require.NoError(t, service.DeleteTask(ctx, results[0].ID, nil))
+	_, err = service.RegisterTask(ctx, model.TaskSpec{Title: "fourth"})
+	require.NoError(t, err, "soft deleted tasks don't count")
+}
+
+func TestTasksService_Quota_FailedCreate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mc := minimock.NewController(t)
+	repo := mocks.NewTasksRepositoryMock(mc).
+		CreateTaskMock.Return(model.TaskChange{}, errors.New("create failed")).
+		CreateTasksMock.Set(func(ctx context.Context, tasks []model.Task) ([]model.TaskChange, []error) {
+		errs := make([]error, len(tasks))
+		for i := range errs {
+			errs[i] = model.ErrTaskAlreadyExists
+		}
+		return make([]model.TaskChange, len(tasks)), errs
+	})
+	limiter := quota.NewLimiter(model.QuotaPolicy{Default: model.QuotaLimits{MaxRunning: 2, MaxCreations: 2}}, repository.NewTasksRepository())
+	service := NewTasksService(3, model.RetentionPolicy{}, repo)
+	service.SetQuota(limiter)
+
+	before := limiter.Usage(ctx)
+	_, err := service.RegisterTask(ctx, model.TaskSpec{Title: "first"})
+	require.Error(t, err)
+	for _, result := range service.RegisterTasks(ctx, []model.TaskSpec{{Title: "second"}, {Title: "third"}}) {
+		require.Error(t, result.Err)
+	}
+
+	// failed tasks don't use up the creations window
+	usage := limiter.Usage(ctx)
+	assert.Equal(t, before.Running, usage.Running)
+	assert.Equal(t, before.Creations, usage.Creations)
+}
+
+func TestTasksService_Audit(t *testing.T) {
+	t.Parallel()
+
+	auditLog, err := audit.Open(t.TempDir(), 0, 0)
+	require.NoError(t, err)
+	defer auditLog.Close()
+
+	ctx := logging.WithRequestID(auth.WithPrincipal(context.Background(), auth.Principal{ID: "alice", Scopes: []string{auth.ScopeAdmin}}), "req-1")
+	repo := repository.NewTasksRepository()
+	// -2 makes the simulated work finish immediately
+	service := NewTasksService(-2, model.RetentionPolicy{}, repo)
+	service.SetAudit(auditLog)
+
+	query := func(id string) []model.AuditEntry {
+		entries, err := auditLog.Query(ctx, model.AuditFilter{TaskID: id})
+		require.NoError(t, err)
+		for _, entry := range entries {
+			assert.Equal(t, "req-1", entry.RequestID)
+			assert.Equal(t, tenant.Default, entry.Tenant)
+		}
+		return entries
+	}
+
+	// registered tasks start and finish on their own
+	id, err := service.RegisterTask(ctx, model.TaskSpec{Title: "draft"})
+	require.NoError(t, err)
+	var entries []model.AuditEntry
+	require.Eventually(t, func() bool {
+		entries = query(id)
+		return len(entries) == 3
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, model.AuditFinish, entries[0].Action)
+	assert.Equal(t, model.SystemActor, entries[0].Actor)
+	assert.Equal(t, model.AuditStart, entries[1].Action)
+	assert.Equal(t, model.SystemActor, entries[1].Actor)
+	assert.Equal(t, model.AuditCreate, entries[2].Action)
+	assert.Equal(t, "alice", entries[2].Actor)
+	assert.Contains(t, entries[2].Changes, model.AuditChange{Field: "title", After: "draft"})
+
+	// a stored task that never started is edited by the principal
+	task := newTask(ctx, model.TaskSpec{Title: "draft"})
+	_, err = repo.CreateTask(ctx, task)
+	require.NoError(t, err)
+	id = task.ID.String()
+	title := "final"
+	_, err = service.PatchTask(ctx, id, model.TaskPatch{Title: &title})
+	require.NoError(t, err)
+	require.NoError(t, service.DeleteTask(ctx, id, nil))
+	_, err = service.RestoreTask(ctx, id)
+	require.NoError(t, err)
+	require.NoError(t, service.PurgeTask(ctx, id))
+
+	// failed mutations aren't recorded
+	assert.Error(t, service.DeleteTask(ctx, id, nil))
+
+	entries = query(id)
+	actions := make([]model.AuditAction, 0, len(entries))
+	for _, entry := range entries {
+		assert.Equal(t, "alice", entry.Actor)
+		actions = append(actions, entry.Action)
+	}
+	assert.Equal(t, []model.AuditAction{model.AuditPurge, model.AuditRestore, model.AuditDelete, model.AuditUpdate}, actions)
+
+	assert.Contains(t, entries[3].Changes, model.AuditChange{Field: "title", Before: "draft", After: "final"})
+	for _, change := range entries[0].Changes {
+		assert.Nil(t, change.After, "purged task has no fields after")
+	}
+}
+
+func TestTasksService_History(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	// -2 makes the simulated work finish immediately
+	service := NewTasksService(-2, model.RetentionPolicy{}, repository.NewTasksRepository())
+
+	id, err := service.RegisterTask(ctx, model.TaskSpec{Title: "Timed Task"})
+	require.NoError(t, err)
+
+	var task *model.Task
+	require.Eventually(t, func() bool {
+		task, err = service.TaskInfo(ctx, id)
+		return err == nil && task.IsFinished()
+	}, time.Second, 10*time.Millisecond)
+
+	require.Len(t, task.Events, 3)
+	assert.Equal(t, model.EventQueued, task.Events[0].Type)
+	assert.Equal(t, task.CreatedAt, task.Events[0].Time)
+	assert.Equal(t, model.EventStarted, task.Events[1].Type)
+	assert.Equal(t, model.EventFinished, task.Events[2].Type)
+	assert.Equal(t, task.Status, task.Events[2].Status)
+
+	require.NotNil(t, task.StartedAt)
+	require.NotNil(t, task.FinishedAt)
+	assert.Equal(t, task.Events[1].Time, *task.StartedAt)
+	assert.Equal(t, task.Events[2].Time, *task.FinishedAt)
+	assert.False(t, task.StartedAt.Before(task.CreatedAt))
+	assert.Equal(t, task.FinishedAt.Sub(*task.StartedAt), task.Duration)
+}
+
+func TestTasksService_TaskLogs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	alice := auth.WithPrincipal(ctx, auth.Principal{ID: "alice", Scopes: []string{auth.ScopeRead, auth.ScopeWrite}})
+	bob := auth.WithPrincipal(ctx, auth.Principal{ID: "bob", Scopes: []string{auth.ScopeRead, auth.ScopeWrite}})
+
+	store, err := tasklog.Open(t.TempDir(), 0, 0)
+	require.NoError(t, err)
+	// -2 makes the simulated work finish immediately
+	service := NewTasksService(-2, model.RetentionPolicy{}, repository.NewTasksRepository())
+	service.SetTaskLogs(store)
+
+	id, err := service.RegisterTask(alice, model.TaskSpec{Title: "Logged Task"})
+	require.NoError(t, err)
+
+	// following ends when the task finishes
+	var followed []model.LogLine
+	require.NoError(t, service.FollowTaskLogs(alice, id, 0, func(lines []model.LogLine) error {
+		followed = append(followed, lines...)
+		return nil
+	}))
+	require.Len(t, followed, 2)
+	assert.Equal(t, `working on "Logged Task" for 0s`, followed[0].Message)
+
+	page, err := service.TaskLogs(alice, id, model.LogQuery{Tail: 1})
+	require.NoError(t, err)
+	assert.True(t, page.Finished)
+	assert.Equal(t, followed[1:], page.Lines)
+
+	task, err := service.TaskInfo(alice, id)
+	require.NoError(t, err)
+	if task.Status == model.Failed {
+		assert.Equal(t, model.LogError, page.Lines[0].Level)
+	} else {
+		assert.Equal(t, "work completed", page.Lines[0].Message)
+	}
+
+	_, err = service.TaskLogs(bob, id, model.LogQuery{})
+	assert.ErrorIs(t, err, model.ErrTaskNotFound, "logs of others' tasks can't be read")
+	assert.ErrorIs(t, service.FollowTaskLogs(bob, id, 0, nil), model.ErrTaskNotFound)
+	_, err = service.TaskLogs(alice, uuid.NewString(), model.LogQuery{})
+	assert.ErrorIs(t, err, model.ErrTaskNotFound)
+}
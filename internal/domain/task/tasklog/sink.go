@@ -0,0 +1,181 @@
+package tasklog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"test-server/internal/domain/model"
+)
+
+// truncatedMessage is the last line of a log that reached the maximum size.
+const truncatedMessage = "log size limit reached, further lines are dropped"
+
+// Sink writes lines to the log of a running task. It is safe for
+// concurrent use. A nil Sink discards lines.
+type Sink struct {
+	store *Store
+	key   string
+	path  string
+
+	mu      sync.Mutex
+	lines   []model.LogLine // buffered lines, not written to the file yet
+	pending bytes.Buffer    // encoded buffered lines
+	spilled int64           // number of lines written to the file
+	next    int64           // offset of the next line
+	size    int64           // encoded size of the whole log
+	// truncated is set once the log reached the maximum size.
+	truncated bool
+	closed    bool
+	// notify is closed and replaced when lines are added and when the sink
+	// is closed.
+	notify chan struct{}
+}
+
+// Logf formats a line of the level and adds it to the log. Lines past the
+// maximum log size are dropped.
+func (s *Sink) Logf(level model.LogLevel, format string, args ...any) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed || s.truncated {
+		return
+	}
+
+	line := model.LogLine{Offset: s.next, Time: s.store.now().UTC(), Level: level, Message: fmt.Sprintf(format, args...)}
+	data, err := encode(line)
+	if err != nil {
+		slog.Error("Task log line encoding error", "file", s.path, "error", err)
+		return
+	}
+	if s.size+int64(len(data)) > s.store.maxSize {
+		// the marker may exceed the maximum size, it's the last line anyway
+		line.Level, line.Message = model.LogWarn, truncatedMessage
+		if data, err = encode(line); err != nil {
+			return
+		}
+		s.truncated = true
+	}
+
+	s.lines = append(s.lines, line)
+	s.pending.Write(data)
+	s.next++
+	s.size += int64(len(data))
+	if int64(s.pending.Len()) > s.store.bufferSize {
+		if err := s.spill(); err != nil {
+			slog.Error("Task log write error", "file", s.path, "error", err)
+		}
+	}
+	s.broadcast()
+}
+
+// Close writes the buffered lines to the file and ends the followers of
+// the log. Lines logged afterwards are dropped.
+func (s *Sink) Close() error {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	err := s.spill()
+	s.closed = true
+	s.broadcast()
+	s.mu.Unlock()
+
+	s.store.release(s)
+	if err != nil {
+		return fmt.Errorf("tasklog.Sink.Close: %w", err)
+	}
+	return nil
+}
+
+// discard drops the buffered lines and closes the sink.
+func (s *Sink) discard() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lines, s.closed = nil, true
+	s.pending.Reset()
+	s.broadcast()
+}
+
+// flush writes the buffered lines to the file.
+func (s *Sink) flush() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.spill()
+}
+
+// spill appends the buffered lines to the file. Lines that can't be written
+// are dropped, so the buffer stays bounded. Caller must hold s.mu.
+func (s *Sink) spill() error {
+	if len(s.lines) == 0 {
+		return nil
+	}
+
+	err := appendFile(s.path, s.pending.Bytes())
+	s.spilled += int64(len(s.lines))
+	s.lines = nil
+	s.pending.Reset()
+	return err
+}
+
+// broadcast wakes up the followers. Caller must hold s.mu.
+func (s *Sink) broadcast() {
+	close(s.notify)
+	s.notify = make(chan struct{})
+}
+
+// snapshot returns the state of the log for reading.
+func (s *Sink) snapshot() snapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return snapshot{
+		path:     s.path,
+		spilled:  s.spilled,
+		lines:    slices.Clone(s.lines),
+		finished: s.closed,
+		notify:   s.notify,
+	}
+}
+
+func encode(line model.LogLine) ([]byte, error) {
+	data, err := json.Marshal(line)
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+func appendFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("failed to create task log dir: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open task log file: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write task log file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to close task log file: %w", err)
+	}
+	return nil
+}
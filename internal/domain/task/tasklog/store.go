@@ -0,0 +1,351 @@
+package tasklog
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"test-server/internal/domain/model"
+	"test-server/internal/tenant"
+)
+
+// Defaults used when the sizes aren't configured.
+const (
+	DefaultBufferSize = 64 << 10
+	DefaultMaxSize    = 10 << 20
+)
+
+const (
+	fileExt = ".jsonl"
+	// followBatch is the maximum number of lines passed to a follower at once.
+	followBatch = 1000
+	// defaultKeepAlive is how long followers wait for new lines before
+	// their callback is called without lines.
+	defaultKeepAlive = 15 * time.Second
+)
+
+// Store keeps the logs of tasks as JSON lines in
+// "<dir>/<tenant>/<task id>.jsonl". Lines of running tasks are buffered in
+// memory and written to the file when the buffer exceeds bufferSize and
+// when the task finishes. A log stops growing at maxSize, further lines are
+// dropped. A nil Store keeps no logs.
+type Store struct {
+	dir        string
+	bufferSize int64
+	maxSize    int64
+	keepAlive  time.Duration
+	now        func() time.Time
+
+	mu     sync.Mutex
+	sinks  map[string]*Sink // of running tasks, by tenant and task id
+	closed chan struct{}    // closed when the store is closed, ends followers
+}
+
+// Open creates the directory if needed.
+func Open(dir string, bufferSize, maxSize int64) (*Store, error) {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+	if maxSize <= 0 {
+		maxSize = DefaultMaxSize
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("tasklog.Open: failed to create task log dir: %w", err)
+	}
+
+	return &Store{
+		dir:        dir,
+		bufferSize: bufferSize,
+		maxSize:    maxSize,
+		keepAlive:  defaultKeepAlive,
+		now:        time.Now,
+		sinks:      make(map[string]*Sink),
+		closed:     make(chan struct{}),
+	}, nil
+}
+
+// storeKey scopes the task id by tenant, like the tasks repository does.
+func storeKey(tenantID, taskID string) string {
+	return tenantID + "/" + taskID
+}
+
+// path returns the log file of the task. The ids are validated before they
+// get here, the check keeps an id like ".." from escaping the directory.
+func (s *Store) path(tenantID, taskID string) (string, error) {
+	tenantDir := filepath.Join(s.dir, tenantID)
+	path := filepath.Join(tenantDir, taskID+fileExt)
+	if filepath.Dir(tenantDir) != filepath.Clean(s.dir) || filepath.Dir(path) != tenantDir {
+		return "", fmt.Errorf("task log of tenant %q and task %q is outside of %s", tenantID, taskID, s.dir)
+	}
+	return path, nil
+}
+
+// Sink returns the sink of the log of the task with taskID in the tenant of
+// ctx, which must be closed when the task finishes.
+func (s *Store) Sink(ctx context.Context, taskID string) *Sink {
+	if s == nil {
+		return nil
+	}
+
+	tenantID := tenant.From(ctx)
+	path, err := s.path(tenantID, taskID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Task log path error", "error", err)
+		return nil
+	}
+	sink := &Sink{
+		store:  s,
+		key:    storeKey(tenantID, taskID),
+		path:   path,
+		notify: make(chan struct{}),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sinks[sink.key] = sink
+	return sink
+}
+
+// release forgets the closed sink, its log is read from the file from now on.
+func (s *Store) release(sink *Sink) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.sinks[sink.key] == sink {
+		delete(s.sinks, sink.key)
+	}
+}
+
+// Remove deletes the log of the task of the tenant. Lines still logged by
+// a running task are dropped.
+func (s *Store) Remove(tenantID, taskID string) {
+	if s == nil {
+		return
+	}
+
+	key := storeKey(tenantID, taskID)
+	s.mu.Lock()
+	sink := s.sinks[key]
+	delete(s.sinks, key)
+	s.mu.Unlock()
+
+	if sink != nil {
+		sink.discard()
+	}
+	path, err := s.path(tenantID, taskID)
+	if err != nil {
+		slog.Error("Task log path error", "error", err)
+		return
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		slog.Error("Task log removal error", "tenant", tenantID, "task_id", taskID, "error", err)
+	}
+}
+
+// Close ends every follower and writes the buffered lines to the files.
+// Sinks of running tasks can still be used and closed afterwards.
+func (s *Store) Close() error {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	select {
+	case <-s.closed:
+	default:
+		close(s.closed)
+	}
+	sinks := make([]*Sink, 0, len(s.sinks))
+	for _, sink := range s.sinks {
+		sinks = append(sinks, sink)
+	}
+	s.mu.Unlock()
+
+	var errs []error
+	for _, sink := range sinks {
+		if err := sink.flush(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("tasklog.Close: %w", err)
+	}
+	return nil
+}
+
+// snapshot is the state of a log at a moment. Lines with offsets below
+// spilled are read from the file, the following ones are buffered.
+type snapshot struct {
+	path     string
+	spilled  int64 // negative when the whole file belongs to the snapshot
+	lines    []model.LogLine
+	finished bool
+	notify   <-chan struct{} // nil for finished logs
+}
+
+func (s *Store) snapshot(tenantID, taskID string) (snapshot, error) {
+	s.mu.Lock()
+	sink := s.sinks[storeKey(tenantID, taskID)]
+	s.mu.Unlock()
+
+	if sink == nil {
+		path, err := s.path(tenantID, taskID)
+		if err != nil {
+			return snapshot{}, err
+		}
+		return snapshot{path: path, spilled: -1, finished: true}, nil
+	}
+	return sink.snapshot(), nil
+}
+
+// Read returns the lines of the log of the task with taskID in the tenant
+// of ctx selected by the query. Logs of tasks that never logged are empty.
+func (s *Store) Read(ctx context.Context, taskID string, query model.LogQuery) (model.LogPage, error) {
+	if s == nil {
+		return model.LogPage{Finished: true}, nil
+	}
+
+	snap, err := s.snapshot(tenant.From(ctx), taskID)
+	if err != nil {
+		return model.LogPage{}, fmt.Errorf("tasklog.Read: %w", err)
+	}
+	page, err := snap.read(query)
+	if err != nil {
+		return page, fmt.Errorf("tasklog.Read: %w", err)
+	}
+	return page, nil
+}
+
+// Follow passes the lines of the log of the task with taskID in the tenant
+// of ctx from offset to fn as they are written, until the task finishes, the
+// store is closed, ctx is done or fn fails. When no line was written for a
+// while, fn is called without lines, so callers can check their client is
+// still there.
+func (s *Store) Follow(ctx context.Context, taskID string, offset int64, fn func([]model.LogLine) error) error {
+	if s == nil {
+		return nil
+	}
+
+	tenantID := tenant.From(ctx)
+	timer := time.NewTimer(s.keepAlive)
+	defer timer.Stop()
+
+	for {
+		snap, err := s.snapshot(tenantID, taskID)
+		if err != nil {
+			return fmt.Errorf("tasklog.Follow: %w", err)
+		}
+		page, err := snap.read(model.LogQuery{Offset: offset, Limit: followBatch})
+		if err != nil {
+			return fmt.Errorf("tasklog.Follow: %w", err)
+		}
+		if len(page.Lines) > 0 {
+			if err := fn(page.Lines); err != nil {
+				return err
+			}
+			offset = page.NextOffset
+		}
+		if len(page.Lines) == followBatch {
+			continue
+		}
+		if snap.finished {
+			return nil
+		}
+
+		timer.Reset(s.keepAlive)
+		select {
+		case <-snap.notify:
+		case <-timer.C:
+			if err := fn(nil); err != nil {
+				return err
+			}
+		case <-s.closed:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// read selects the lines of the snapshot, reading the file first.
+func (snap snapshot) read(query model.LogQuery) (model.LogPage, error) {
+	page := model.LogPage{NextOffset: query.Offset, Finished: snap.finished}
+	var end int64 // offset following the last line seen
+	full := false
+
+	add := func(line model.LogLine) {
+		end = line.Offset + 1
+		switch {
+		case query.Tail > 0:
+			page.Lines = append(page.Lines, line)
+			if len(page.Lines) > query.Tail {
+				page.Lines = page.Lines[1:]
+			}
+		case full || line.Offset < query.Offset:
+		case query.Limit > 0 && len(page.Lines) == query.Limit:
+			full = true
+		default:
+			page.Lines = append(page.Lines, line)
+		}
+	}
+
+	if snap.spilled != 0 {
+		if err := readLines(snap.path, snap.spilled, add); err != nil {
+			return page, err
+		}
+	}
+	for _, line := range snap.lines {
+		add(line)
+	}
+
+	switch {
+	case query.Tail > 0:
+		page.NextOffset = end
+	case len(page.Lines) > 0:
+		page.NextOffset = page.Lines[len(page.Lines)-1].Offset + 1
+	default:
+		page.NextOffset = max(query.Offset, end)
+	}
+	return page, nil
+}
+
+// readLines passes the lines of the file with offsets below before, or all
+// of them when before is negative, to fn. A missing file has no lines.
+func readLines(path string, before int64, fn func(model.LogLine)) error {
+	f, err := os.Open(filepath.Clean(path))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open task log file: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16<<20)
+	for scanner.Scan() {
+		var line model.LogLine
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			// a torn last line of a crash must not hide the other lines
+			slog.Warn("Skipping malformed task log line", "file", path, "error", err)
+			continue
+		}
+		if before >= 0 && line.Offset >= before {
+			break
+		}
+		fn(line)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read task log file %s: %w", filepath.Base(path), err)
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+package tasklog
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"test-server/internal/domain/model"
+	"test-server/internal/tenant"
+)
+
+func offsets(lines []model.LogLine) []int64 {
+	result := make([]int64, 0, len(lines))
+	for _, line := range lines {
+		result = append(result, line.Offset)
+	}
+	return result
+}
+
+func TestStore_Read(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	// a line is about 100 bytes, so the buffer spills every few lines
+	store, err := Open(dir, 250, 0)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	sink := store.Sink(ctx, "task")
+	for i := 0; i < 10; i++ {
+		sink.Logf(model.LogInfo, "line %d", i)
+	}
+	_, err = os.Stat(filepath.Join(dir, tenant.Default, "task.jsonl"))
+	require.NoError(t, err, "buffered lines are spilled to the file")
+
+	testTable := []struct {
+		name        string
+		query       model.LogQuery
+		wantOffsets []int64
+		wantNext    int64
+	}{
+		{name: "all", wantOffsets: []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, wantNext: 10},
+		{name: "offset", query: model.LogQuery{Offset: 7}, wantOffsets: []int64{7, 8, 9}, wantNext: 10},
+		{name: "offset and limit", query: model.LogQuery{Offset: 2, Limit: 3}, wantOffsets: []int64{2, 3, 4}, wantNext: 5},
+		{name: "offset past the end", query: model.LogQuery{Offset: 12}, wantOffsets: []int64{}, wantNext: 12},
+		{name: "tail", query: model.LogQuery{Tail: 2}, wantOffsets: []int64{8, 9}, wantNext: 10},
+		{name: "tail longer than the log", query: model.LogQuery{Tail: 20}, wantOffsets: []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, wantNext: 10},
+	}
+
+	check := func(t *testing.T, finished bool) {
+		for _, tt := range testTable {
+			page, err := store.Read(ctx, "task", tt.query)
+			require.NoError(t, err, tt.name)
+			assert.Equal(t, tt.wantOffsets, offsets(page.Lines), tt.name)
+			assert.Equal(t, tt.wantNext, page.NextOffset, tt.name)
+			assert.Equal(t, finished, page.Finished, tt.name)
+		}
+	}
+	t.Run("running", func(t *testing.T) { check(t, false) })
+	require.NoError(t, sink.Close())
+	t.Run("finished", func(t *testing.T) { check(t, true) })
+
+	page, err := store.Read(ctx, "task", model.LogQuery{Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, model.LogInfo, page.Lines[0].Level)
+	assert.Equal(t, "line 0", page.Lines[0].Message)
+
+	// logs are scoped by tenant
+	page, err = store.Read(tenant.With(ctx, "acme"), "task", model.LogQuery{})
+	require.NoError(t, err)
+	assert.Empty(t, page.Lines)
+	assert.True(t, page.Finished)
+}
+
+func TestStore_MaxSize(t *testing.T) {
+	t.Parallel()
+
+	store, err := Open(t.TempDir(), 0, 300)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	sink := store.Sink(ctx, "task")
+	for i := 0; i < 10; i++ {
+		sink.Logf(model.LogDebug, "line %d", i)
+	}
+	require.NoError(t, sink.Close())
+
+	page, err := store.Read(ctx, "task", model.LogQuery{})
+	require.NoError(t, err)
+	require.Less(t, len(page.Lines), 10)
+	last := page.Lines[len(page.Lines)-1]
+	assert.Equal(t, model.LogWarn, last.Level)
+	assert.Equal(t, truncatedMessage, last.Message)
+}
+
+func TestStore_Follow(t *testing.T) {
+	t.Parallel()
+
+	store, err := Open(t.TempDir(), 150, 0)
+	require.NoError(t, err)
+	store.keepAlive = 10 * time.Millisecond
+
+	ctx := context.Background()
+	sink := store.Sink(ctx, "task")
+	sink.Logf(model.LogInfo, "before")
+
+	go func() {
+		for i := 0; i < 5; i++ {
+			time.Sleep(5 * time.Millisecond)
+			sink.Logf(model.LogInfo, "line %d", i)
+		}
+		time.Sleep(20 * time.Millisecond)
+		sink.Close()
+	}()
+
+	var followed []model.LogLine
+	keepAlives := 0
+	err = store.Follow(ctx, "task", 1, func(lines []model.LogLine) error {
+		if lines == nil {
+			keepAlives++
+		}
+		followed = append(followed, lines...)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, offsets(followed))
+	assert.Positive(t, keepAlives)
+
+	// following a finished log returns its lines
+	followed = nil
+	require.NoError(t, store.Follow(ctx, "task", 0, func(lines []model.LogLine) error {
+		followed = append(followed, lines...)
+		return nil
+	}))
+	assert.Len(t, followed, 6)
+
+	// follower errors end following
+	errGone := errors.New("client gone")
+	running := store.Sink(ctx, "running")
+	assert.ErrorIs(t, store.Follow(ctx, "running", 0, func([]model.LogLine) error { return errGone }), errGone)
+
+	// closing the store ends followers of running tasks
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		store.Close()
+	}()
+	require.NoError(t, store.Follow(ctx, "running", 0, func([]model.LogLine) error { return nil }))
+	require.NoError(t, running.Close())
+}
+
+func TestStore_Remove(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	store, err := Open(dir, 0, 0)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	finished := store.Sink(ctx, "finished")
+	finished.Logf(model.LogInfo, "done")
+	require.NoError(t, finished.Close())
+	running := store.Sink(ctx, "running")
+	running.Logf(model.LogInfo, "working")
+
+	store.Remove(tenant.Default, "finished")
+	store.Remove(tenant.Default, "running")
+	running.Logf(model.LogInfo, "dropped")
+	require.NoError(t, running.Close())
+
+	for _, taskID := range []string{"finished", "running"} {
+		page, err := store.Read(ctx, taskID, model.LogQuery{})
+		require.NoError(t, err)
+		assert.Empty(t, page.Lines, taskID)
+		_, err = os.Stat(filepath.Join(dir, tenant.Default, taskID+fileExt))
+		assert.ErrorIs(t, err, os.ErrNotExist, taskID)
+	}
+}
+
+func TestStore_PathOutsideDir(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	store, err := Open(filepath.Join(dir, "logs"), 0, 0)
+	require.NoError(t, err)
+
+	for _, tt := range []struct{ tenant, taskID string }{{"..", "task"}, {".", "task"}, {"acme", "../task"}} {
+		ctx := tenant.With(context.Background(), tt.tenant)
+		sink := store.Sink(ctx, tt.taskID)
+		assert.Nil(t, sink, tt)
+		sink.Logf(model.LogInfo, "dropped")
+		require.NoError(t, sink.Close())
+
+		_, err := store.Read(ctx, tt.taskID, model.LogQuery{})
+		assert.Error(t, err, tt)
+	}
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "nothing is written outside of the directory")
+}
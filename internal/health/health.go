@@ -0,0 +1,192 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// DefaultTimeout is used when the check timeout isn't configured.
+const DefaultTimeout = 2 * time.Second
+
+const (
+	StatusOK     = "ok"
+	StatusFailed = "failed"
+)
+
+var ErrShuttingDown = errors.New("graceful shutdown has started")
+
+// Check reports a problem with a dependency by returning an error.
+type Check func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Registry holds named liveness and readiness checks. Liveness checks should
+// only fail when the process can't recover without a restart, readiness
+// checks when it temporarily can't serve traffic.
+type Registry struct {
+	timeout time.Duration
+
+	mu        sync.RWMutex
+	liveness  []namedCheck
+	readiness []namedCheck
+}
+
+func NewRegistry(timeout time.Duration) *Registry {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	return &Registry{timeout: timeout}
+}
+
+func (r *Registry) AddLivenessCheck(name string, check Check) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.liveness = append(r.liveness, namedCheck{name: name, check: check})
+}
+
+func (r *Registry) AddReadinessCheck(name string, check Check) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.readiness = append(r.readiness, namedCheck{name: name, check: check})
+}
+
+type checkResult struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type checksResponse struct {
+	Status string                 `json:"status"`
+	Checks map[string]checkResult `json:"checks"`
+}
+
+// LivenessHandler serves results of the liveness checks.
+func (r *Registry) LivenessHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		r.mu.RLock()
+		checks := r.liveness
+		r.mu.RUnlock()
+
+		return r.serve(c, checks)
+	}
+}
+
+// ReadinessHandler serves results of the readiness checks.
+func (r *Registry) ReadinessHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		r.mu.RLock()
+		checks := r.readiness
+		r.mu.RUnlock()
+
+		return r.serve(c, checks)
+	}
+}
+
+// serve runs checks concurrently and responds with 503 if any of them failed.
+func (r *Registry) serve(c *fiber.Ctx, checks []namedCheck) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), r.timeout)
+	defer cancel()
+
+	results := make([]checkResult, len(checks))
+	var wg sync.WaitGroup
+	for i, nc := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = runCheck(ctx, nc.check)
+		}()
+	}
+	wg.Wait()
+
+	response := checksResponse{Status: StatusOK, Checks: make(map[string]checkResult, len(checks))}
+	for i, nc := range checks {
+		response.Checks[nc.name] = results[i]
+		if results[i].Status != StatusOK {
+			response.Status = StatusFailed
+		}
+	}
+
+	if response.Status != StatusOK {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(response)
+	}
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// runCheck returns the check result or a timeout failure if the check
+// doesn't return before ctx is done.
+func runCheck(ctx context.Context, check Check) checkResult {
+	done := make(chan error, 1)
+	go func() {
+		done <- check(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	if err != nil {
+		return checkResult{Status: StatusFailed, Error: err.Error()}
+	}
+	return checkResult{Status: StatusOK}
+}
+
+// DirWritable checks that a file can be created in dir.
+func DirWritable(dir string) Check {
+	return func(ctx context.Context) error {
+		f, err := os.CreateTemp(dir, ".healthcheck-*")
+		if err != nil {
+			return fmt.Errorf("directory %q isn't writable: %w", dir, err)
+		}
+		f.Close()
+		return os.Remove(f.Name())
+	}
+}
+
+// MaxInFlight checks that count returns less than limit. Non-positive limit
+// disables the check. Both are called on every check, so they can change at runtime.
+func MaxInFlight(count func() int, limit func() int) Check {
+	return func(ctx context.Context) error {
+		limit := limit()
+		if limit <= 0 {
+			return nil
+		}
+		if n := count(); n >= limit {
+			return fmt.Errorf("%d tasks in flight, limit is %d", n, limit)
+		}
+		return nil
+	}
+}
+
+// Shutdown tracks whether graceful shutdown has started, so readiness can
+// fail while the server drains.
+type Shutdown struct {
+	started atomic.Bool
+}
+
+// Start marks the beginning of graceful shutdown.
+func (s *Shutdown) Start() {
+	s.started.Store(true)
+}
+
+func (s *Shutdown) Check(ctx context.Context) error {
+	if s.started.Load() {
+		return ErrShuttingDown
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_ReadinessHandler(t *testing.T) {
+	t.Parallel()
+
+	failing := func(ctx context.Context) error { return errors.New("broken") }
+	passing := func(ctx context.Context) error { return nil }
+	blocking := func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	testTable := []struct {
+		name       string
+		checks     map[string]Check
+		wantStatus int
+		wantChecks map[string]checkResult
+	}{
+		{
+			name:       "no checks",
+			wantStatus: fiber.StatusOK,
+			wantChecks: map[string]checkResult{},
+		},
+		{
+			name:       "all passing",
+			checks:     map[string]Check{"a": passing, "b": passing},
+			wantStatus: fiber.StatusOK,
+			wantChecks: map[string]checkResult{"a": {Status: StatusOK}, "b": {Status: StatusOK}},
+		},
+		{
+			name:       "one failing",
+			checks:     map[string]Check{"a": passing, "b": failing},
+			wantStatus: fiber.StatusServiceUnavailable,
+			wantChecks: map[string]checkResult{"a": {Status: StatusOK}, "b": {Status: StatusFailed, Error: "broken"}},
+		},
+		{
+			name:       "timeout",
+			checks:     map[string]Check{"slow": blocking},
+			wantStatus: fiber.StatusServiceUnavailable,
+			wantChecks: map[string]checkResult{"slow": {Status: StatusFailed, Error: context.DeadlineExceeded.Error()}},
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			registry := NewRegistry(50 * time.Millisecond)
+			for name, check := range tt.checks {
+				registry.AddReadinessCheck(name, check)
+			}
+
+			app := fiber.New()
+			app.Get("/readyz", registry.ReadinessHandler())
+
+			resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/readyz", nil))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+
+			var body checksResponse
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			assert.Equal(t, tt.wantChecks, body.Checks)
+			if tt.wantStatus == fiber.StatusOK {
+				assert.Equal(t, StatusOK, body.Status)
+			} else {
+				assert.Equal(t, StatusFailed, body.Status)
+			}
+		})
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(0)
+	shutdown := &Shutdown{}
+	registry.AddReadinessCheck("shutdown", shutdown.Check)
+
+	app := fiber.New()
+	app.Get("/livez", registry.LivenessHandler())
+	app.Get("/readyz", registry.ReadinessHandler())
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/readyz", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	shutdown.Start()
+
+	resp, err = app.Test(httptest.NewRequest(fiber.MethodGet, "/readyz", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusServiceUnavailable, resp.StatusCode)
+
+	// the process is still alive while draining
+	resp, err = app.Test(httptest.NewRequest(fiber.MethodGet, "/livez", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+}
+
+func TestDirWritable(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, DirWritable(dir)(context.Background()))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "probe file must be removed")
+
+	assert.Error(t, DirWritable(filepath.Join(dir, "missing"))(context.Background()))
+}
+
+func TestMaxInFlight(t *testing.T) {
+	t.Parallel()
+
+	count, limit := 0, 2
+	check := MaxInFlight(func() int { return count }, func() int { return limit })
+
+	count = 1
+	assert.NoError(t, check(context.Background()))
+	count = 2
+	assert.Error(t, check(context.Background()))
+	limit = 0
+	assert.NoError(t, check(context.Background()), "zero limit disables the check")
+}
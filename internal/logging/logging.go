@@ -0,0 +1,96 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// New creates a logger writing records of the level and above to w in the
+// format. Records logged with a context also get the request id and trace
+// ids found in it. Empty level and format default to "info" and "text".
+// The level can be changed later with SetLevel.
+func New(w io.Writer, level, format string) (*slog.Logger, error) {
+	lvl := &slog.LevelVar{}
+	if level != "" {
+		if err := lvl.UnmarshalText([]byte(level)); err != nil {
+			return nil, fmt.Errorf("logging.New: invalid level %q: %w", level, err)
+		}
+	}
+
+	opts := &slog.HandlerOptions{Level: lvl}
+
+	var handler slog.Handler
+	switch strings.ToLower(format) {
+	case "", FormatText:
+		handler = slog.NewTextHandler(w, opts)
+	case FormatJSON:
+		handler = slog.NewJSONHandler(w, opts)
+	default:
+		return nil, fmt.Errorf("logging.New: unknown format %q", format)
+	}
+
+	return slog.New(contextHandler{Handler: handler, level: lvl}), nil
+}
+
+// SetLevel changes the level of the logger created by New.
+func SetLevel(logger *slog.Logger, level string) error {
+	h, ok := logger.Handler().(contextHandler)
+	if !ok {
+		return fmt.Errorf("logging.SetLevel: logger wasn't created by logging.New")
+	}
+	if err := h.level.UnmarshalText([]byte(level)); err != nil {
+		return fmt.Errorf("logging.SetLevel: invalid level %q: %w", level, err)
+	}
+	return nil
+}
+
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying the request id.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the request id carried by ctx or an empty string.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// contextHandler adds correlation attributes from the record context.
+type contextHandler struct {
+	slog.Handler
+	level *slog.LevelVar
+}
+
+func (h contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if id := RequestID(ctx); id != "" {
+		record.AddAttrs(slog.String("request_id", id))
+	}
+	if spanCtx := trace.SpanContextFromContext(ctx); spanCtx.IsValid() {
+		record.AddAttrs(
+			slog.String("trace_id", spanCtx.TraceID().String()),
+			slog.String("span_id", spanCtx.SpanID().String()),
+		)
+	}
+
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{Handler: h.Handler.WithAttrs(attrs), level: h.level}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{Handler: h.Handler.WithGroup(name), level: h.level}
+}
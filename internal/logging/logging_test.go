@@ -0,0 +1,85 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name    string
+		level   string
+		format  string
+		wantErr require.ErrorAssertionFunc
+	}{
+		{name: "defaults", wantErr: require.NoError},
+		{name: "json debug", level: "debug", format: "json", wantErr: require.NoError},
+		{name: "text warn", level: "WARN", format: "text", wantErr: require.NoError},
+		{name: "invalid level", level: "verbose", wantErr: require.Error},
+		{name: "invalid format", format: "xml", wantErr: require.Error},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := New(&bytes.Buffer{}, tt.level, tt.format)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestLogger_Correlation(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	logger, err := New(&buf, "info", FormatJSON)
+	require.NoError(t, err)
+
+	spanCtx := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(WithRequestID(context.Background(), "req-1"), spanCtx)
+
+	logger.DebugContext(ctx, "hidden")
+	logger.With("component", "test").InfoContext(ctx, "task finished", "task_id", "42")
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal(t, "task finished", record["msg"])
+	assert.Equal(t, "test", record["component"])
+	assert.Equal(t, "42", record["task_id"])
+	assert.Equal(t, "req-1", record["request_id"])
+	assert.Equal(t, spanCtx.TraceID().String(), record["trace_id"])
+	assert.Equal(t, spanCtx.SpanID().String(), record["span_id"])
+}
+
+func TestSetLevel(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	logger, err := New(&buf, "warn", FormatText)
+	require.NoError(t, err)
+	derived := logger.With("component", "test")
+
+	derived.Info("hidden")
+	assert.Empty(t, buf.String())
+
+	require.NoError(t, SetLevel(logger, "debug"))
+	derived.Debug("shown")
+	assert.Contains(t, buf.String(), "shown")
+
+	assert.Error(t, SetLevel(logger, "verbose"))
+	assert.Error(t, SetLevel(slog.New(slog.NewTextHandler(&buf, nil)), "info"))
+}
@@ -0,0 +1,111 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"test-server/internal/domain/model"
+)
+
+const namespace = "tasks_server"
+
+// Metrics are created once and registered with the registry of every app,
+// see NewRegistry, so apps don't conflict on the default registry.
+var (
+	HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Duration of HTTP requests by method, route and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	TaskDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "task_duration_seconds",
+		Help:      "Duration of task execution by final status.",
+		Buckets:   []float64{1, 5, 10, 30, 60, 120, 180, 300, 600},
+	}, []string{"status"})
+
+	TasksInFlight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "tasks_in_flight",
+		Help:      "Number of task goroutines currently executing.",
+	})
+
+	RepositoryOperationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "repository_operation_duration_seconds",
+		Help:      "Duration of tasks repository operations, including lock waiting.",
+		Buckets:   []float64{.00001, .00005, .0001, .0005, .001, .005, .01, .05, .1},
+	}, []string{"operation"})
+
+	TaskEvictions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "task_evictions_total",
+		Help:      "Number of tasks removed by the retention janitor by reason and task status.",
+	}, []string{"reason", "status"})
+)
+
+// ObserveRepositoryOperation records duration of the repository operation
+// started at start. Intended to be deferred at the beginning of the operation.
+func ObserveRepositoryOperation(operation string, start time.Time) {
+	RepositoryOperationDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+}
+
+// taskCountCollector reports the number of stored tasks by tenant and
+// status at scrape time.
+type taskCountCollector struct {
+	desc  *prometheus.Desc
+	count func() map[string]map[model.Status]int
+}
+
+// NewRegistry returns a registry with the Go runtime, process and server
+// metrics and the "tasks" gauge, which calls count on every scrape to get
+// the number of stored tasks by tenant and status.
+func NewRegistry(count func() map[string]map[model.Status]int) (*prometheus.Registry, error) {
+	registry := prometheus.NewRegistry()
+	for _, collector := range []prometheus.Collector{
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		HTTPRequestDuration,
+		TaskDuration,
+		TasksInFlight,
+		RepositoryOperationDuration,
+		TaskEvictions,
+		&taskCountCollector{
+			desc: prometheus.NewDesc(
+				prometheus.BuildFQName(namespace, "", "tasks"),
+				"Number of stored tasks by tenant and status.",
+				[]string{"tenant", "status"}, nil,
+			),
+			count: count,
+		},
+	} {
+		if err := registry.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
+
+func (c *taskCountCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *taskCountCollector) Collect(ch chan<- prometheus.Metric) {
+	for tenant, statuses := range c.count() {
+		for status, count := range statuses {
+			ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(count), tenant, string(status))
+		}
+	}
+}
+
+// Handler serves metrics gathered by gatherer in Prometheus text format.
+func Handler(gatherer prometheus.Gatherer) fiber.Handler {
+	return adaptor.HTTPHandler(promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{}))
+}
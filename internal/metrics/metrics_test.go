@@ -0,0 +1,58 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"test-server/internal/domain/model"
+)
+
+func TestTaskCountCollector(t *testing.T) {
+	t.Parallel()
+
+	collector := &taskCountCollector{
+		desc: prometheus.NewDesc("tasks_server_tasks", "Number of stored tasks by tenant and status.", []string{"tenant", "status"}, nil),
+		count: func() map[string]map[model.Status]int {
+			return map[string]map[model.Status]int{
+				"default": {model.Pending: 2, model.Completed: 5},
+				"acme":    {model.Failed: 1},
+			}
+		},
+	}
+
+	expected := `
+# HELP tasks_server_tasks Number of stored tasks by tenant and status.
+# TYPE tasks_server_tasks gauge
+tasks_server_tasks{status="completed",tenant="default"} 5
+tasks_server_tasks{status="failed",tenant="acme"} 1
+tasks_server_tasks{status="pending",tenant="default"} 2
+`
+	err := testutil.CollectAndCompare(collector, strings.NewReader(expected))
+	require.NoError(t, err)
+}
+
+func TestNewRegistry(t *testing.T) {
+	t.Parallel()
+
+	count := func() map[string]map[model.Status]int {
+		return map[string]map[model.Status]int{"default": {model.Pending: 1}}
+	}
+
+	// every app owns its registry, so creating a second one doesn't conflict
+	for range 2 {
+		registry, err := NewRegistry(count)
+		require.NoError(t, err)
+
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		names := make([]string, 0, len(families))
+		for _, family := range families {
+			names = append(names, family.GetName())
+		}
+		require.Contains(t, names, "tasks_server_tasks")
+	}
+}
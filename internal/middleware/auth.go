@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"test-server/internal/auth"
+)
+
+const APIKeyHeader = "X-API-Key"
+
+// AuthMiddleware authenticates requests presenting a token, an API key or
+// a JWT, in the "Authorization: Bearer" or X-API-Key header with the first
+// authenticator accepting it and attaches the principal to the user
+// context. Requests with an invalid token are rejected with 401, requests
+// without a token are left to RequireScope. With no authenticators
+// authentication is disabled and every request acts as auth.Anonymous.
+func AuthMiddleware(app *fiber.App, authenticators ...auth.Authenticator) {
+	app.Use(func(c *fiber.Ctx) error {
+		if len(authenticators) == 0 {
+			c.SetUserContext(auth.WithPrincipal(c.UserContext(), auth.Anonymous))
+			return c.Next()
+		}
+
+		token := bearerToken(c)
+		if token == "" {
+			return c.Next()
+		}
+
+		var err error
+		for _, authenticator := range authenticators {
+			var principal auth.Principal
+			principal, err = authenticator.Authenticate(c.UserContext(), token)
+			if errors.Is(err, auth.ErrInvalidCredentials) {
+				continue
+			}
+			if err != nil {
+				return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"ok":    false,
+					"error": err.Error(),
+				})
+			}
+			c.SetUserContext(auth.WithPrincipal(c.UserContext(), principal))
+			return c.Next()
+		}
+		// the reason isn't returned to the client, so it can't be used to probe keys
+		slog.DebugContext(c.UserContext(), "Authentication failed", "error", err)
+		return unauthorized(c, auth.ErrInvalidCredentials.Error())
+	})
+}
+
+// ClientCertMiddleware attaches the principal of the client certificate
+// verified by mutual TLS to the user context. Requests without a verified
+// certificate or with an unknown subject are left to AuthMiddleware, which
+// replaces the principal when the request also presents a token.
+func ClientCertMiddleware(app *fiber.App, certs *auth.CertAuthenticator) {
+	app.Use(func(c *fiber.Ctx) error {
+		state := c.Context().TLSConnectionState()
+		if state == nil || len(state.VerifiedChains) == 0 {
+			return c.Next()
+		}
+
+		principal, err := certs.AuthenticateCert(state.VerifiedChains[0][0])
+		if err != nil {
+			slog.DebugContext(c.UserContext(), "Client certificate authentication failed", "error", err)
+			return c.Next()
+		}
+		c.SetUserContext(auth.WithPrincipal(c.UserContext(), principal))
+		return c.Next()
+	})
+}
+
+// RequireScope rejects requests without a principal with 401 and requests
+// of principals lacking scope with 403.
+func RequireScope(scope string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		principal, ok := auth.PrincipalFrom(c.UserContext())
+		if !ok {
+			return unauthorized(c, "authentication is required")
+		}
+		if !principal.HasScope(scope) {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"ok":    false,
+				"error": "scope " + scope + " is required",
+			})
+		}
+		return c.Next()
+	}
+}
+
+func bearerToken(c *fiber.Ctx) string {
+	if key := c.Get(APIKeyHeader); key != "" {
+		return key
+	}
+	scheme, token, ok := strings.Cut(c.Get(fiber.HeaderAuthorization), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+func unauthorized(c *fiber.Ctx, message string) error {
+	c.Set(fiber.HeaderWWWAuthenticate, "Bearer")
+	return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+		"ok":    false,
+		"error": message,
+	})
+}
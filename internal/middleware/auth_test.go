@@ -0,0 +1,201 @@
+package middleware
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"test-server/internal/auth"
+)
+
+func TestAuthMiddleware(t *testing.T) {
+	t.Parallel()
+
+	keys, err := auth.NewKeyStore("", []string{
+		"reader:" + auth.HashKey("read-secret") + ":tasks:read",
+		"admin:" + auth.HashKey("admin-secret") + ":tasks:admin",
+	})
+	require.NoError(t, err)
+
+	app := fiber.New()
+	AuthMiddleware(app, keys)
+	app.Get("/tasks", RequireScope(auth.ScopeRead), func(c *fiber.Ctx) error {
+		principal, _ := auth.PrincipalFrom(c.UserContext())
+		return c.SendString(principal.ID)
+	})
+	app.Post("/tasks", RequireScope(auth.ScopeWrite), func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	testTable := []struct {
+		name          string
+		method        string
+		header        string
+		value         string
+		wantCode      int
+		wantPrincipal string
+	}{
+		{name: "missing key", method: fiber.MethodGet, wantCode: fiber.StatusUnauthorized},
+		{name: "invalid key", method: fiber.MethodGet, header: APIKeyHeader, value: "wrong", wantCode: fiber.StatusUnauthorized},
+		{name: "api key header", method: fiber.MethodGet, header: APIKeyHeader, value: "read-secret", wantCode: fiber.StatusOK, wantPrincipal: "key:reader"},
+		{name: "bearer token", method: fiber.MethodGet, header: fiber.HeaderAuthorization, value: "Bearer read-secret", wantCode: fiber.StatusOK, wantPrincipal: "key:reader"},
+		{name: "basic scheme", method: fiber.MethodGet, header: fiber.HeaderAuthorization, value: "Basic read-secret", wantCode: fiber.StatusUnauthorized},
+		{name: "missing scope", method: fiber.MethodPost, header: APIKeyHeader, value: "read-secret", wantCode: fiber.StatusForbidden},
+		{name: "admin scope", method: fiber.MethodPost, header: APIKeyHeader, value: "admin-secret", wantCode: fiber.StatusOK},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(tt.method, "/tasks", nil)
+			if tt.header != "" {
+				req.Header.Set(tt.header, tt.value)
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+			if tt.wantCode == fiber.StatusUnauthorized {
+				assert.Equal(t, "Bearer", resp.Header.Get(fiber.HeaderWWWAuthenticate))
+			}
+			if tt.wantPrincipal != "" {
+				body := make([]byte, len(tt.wantPrincipal)+1)
+				n, _ := resp.Body.Read(body)
+				assert.Equal(t, tt.wantPrincipal, string(body[:n]))
+			}
+		})
+	}
+}
+
+func TestAuthMiddleware_Disabled(t *testing.T) {
+	t.Parallel()
+
+	app := fiber.New()
+	AuthMiddleware(app)
+	app.Delete("/admin", RequireScope(auth.ScopeAdmin), func(c *fiber.Ctx) error {
+		principal, ok := auth.PrincipalFrom(c.UserContext())
+		assert.True(t, ok)
+		assert.Equal(t, auth.Anonymous, principal)
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodDelete, "/admin", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+}
+
+// selfSignedCert returns a certificate of commonName usable as its own CA.
+func selfSignedCert(t *testing.T, commonName string) (tls.Certificate, *x509.Certificate) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}, leaf
+}
+
+func TestClientCertMiddleware(t *testing.T) {
+	t.Parallel()
+
+	serverCert, _ := selfSignedCert(t, "server")
+	knownCert, knownLeaf := selfSignedCert(t, "worker")
+	unknownCert, unknownLeaf := selfSignedCert(t, "stranger")
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(knownLeaf)
+	clientCAs.AddCert(unknownLeaf)
+
+	certs, err := auth.NewCertAuthenticator([]string{"worker@acme:tasks:read"})
+	require.NoError(t, err)
+	keys, err := auth.NewKeyStore("", []string{"ci:" + auth.HashKey("secret") + ":tasks:write"})
+	require.NoError(t, err)
+
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	ClientCertMiddleware(app, certs)
+	AuthMiddleware(app, keys)
+	app.Get("/whoami", RequireScope(auth.ScopeRead), func(c *fiber.Ctx) error {
+		principal, _ := auth.PrincipalFrom(c.UserContext())
+		return c.SendString(principal.ID + "@" + principal.Tenant)
+	})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go app.Listener(tls.NewListener(listener, &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+		ClientCAs:    clientCAs,
+	}))
+	t.Cleanup(func() { _ = app.Shutdown() })
+
+	testTable := []struct {
+		name     string
+		cert     *tls.Certificate
+		key      string
+		wantCode int
+		wantBody string
+	}{
+		{name: "known certificate", cert: &knownCert, wantCode: fiber.StatusOK, wantBody: "cert:worker@acme"},
+		{name: "unknown certificate", cert: &unknownCert, wantCode: fiber.StatusUnauthorized},
+		{name: "no certificate", wantCode: fiber.StatusUnauthorized},
+		{name: "token replaces certificate", cert: &knownCert, key: "secret", wantCode: fiber.StatusForbidden},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// the server certificate is self-signed
+			clientConfig := &tls.Config{InsecureSkipVerify: true}
+			if tt.cert != nil {
+				clientConfig.Certificates = []tls.Certificate{*tt.cert}
+			}
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientConfig}}
+
+			req, err := http.NewRequest(fiber.MethodGet, "https://"+listener.Addr().String()+"/whoami", nil)
+			require.NoError(t, err)
+			if tt.key != "" {
+				req.Header.Set(APIKeyHeader, tt.key)
+			}
+
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+			if tt.wantBody != "" {
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantBody, string(body))
+			}
+		})
+	}
+}
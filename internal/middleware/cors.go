@@ -1,16 +1,108 @@
 package middleware
 
 import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 )
 
-func CorsMiddleware(app *fiber.App) {
-	app.Use(cors.New(cors.Config{
-		AllowOrigins:  "*",
-		AllowMethods:  "GET, POST, PUT, DELETE, OPTIONS",
-		AllowHeaders:  "Origin, Content-Type, Accept, Authorization",
-		ExposeHeaders: "Link",
-		MaxAge:        300,
-	}))
+// corsExposeHeaders are the response headers of the API readable by
+// cross-origin clients.
+const corsExposeHeaders = "Link, ETag, WWW-Authenticate, Retry-After, RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset, RateLimit-Policy, traceparent, X-Request-ID"
+
+// CorsOptions configures CorsMiddleware.
+type CorsOptions struct {
+	// AllowOrigins returns the allowed origin patterns, see config.ValidateOrigin.
+	// It's called for every request, so the list can change at runtime.
+	AllowOrigins func() []string
+	// AllowCredentials lets browsers send cookies and authorization headers.
+	AllowCredentials bool
+	// AllowMethods of preflight requests. The methods of the routes registered
+	// in the app are allowed when empty.
+	AllowMethods []string
+	// AllowHeaders of preflight requests.
+	AllowHeaders []string
+	// MaxAge is how long browsers may cache preflight responses.
+	MaxAge time.Duration
+}
+
+// CorsMiddleware allows cross-origin requests from the origins returned by
+// opts.AllowOrigins. The allowed methods are resolved on the first request,
+// once every route is registered.
+func CorsMiddleware(app *fiber.App, opts CorsOptions) {
+	var (
+		once    sync.Once
+		handler fiber.Handler
+	)
+	app.Use(func(c *fiber.Ctx) error {
+		once.Do(func() {
+			methods := opts.AllowMethods
+			if len(methods) == 0 {
+				methods = routeMethods(app)
+			}
+			handler = cors.New(cors.Config{
+				AllowOriginsFunc: func(origin string) bool {
+					return slices.ContainsFunc(opts.AllowOrigins(), func(pattern string) bool {
+						return matchOrigin(pattern, origin)
+					})
+				},
+				AllowCredentials: opts.AllowCredentials,
+				AllowMethods:     strings.Join(methods, ", "),
+				AllowHeaders:     strings.Join(opts.AllowHeaders, ", "),
+				ExposeHeaders:    corsExposeHeaders,
+				MaxAge:           int(opts.MaxAge.Seconds()),
+			})
+		})
+		return handler(c)
+	})
+}
+
+// routeMethods returns the sorted methods of the routes registered in app.
+func routeMethods(app *fiber.App) []string {
+	var methods []string
+	for _, route := range app.GetRoutes(true) {
+		if !slices.Contains(methods, route.Method) {
+			methods = append(methods, route.Method)
+		}
+	}
+	slices.Sort(methods)
+	return methods
+}
+
+// matchOrigin reports whether the lowercase origin matches the pattern.
+func matchOrigin(pattern, origin string) bool {
+	pattern = strings.ToLower(pattern)
+	if pattern == "*" || pattern == origin {
+		return true
+	}
+
+	prefix, suffix, ok := strings.Cut(pattern, "://*.")
+	if !ok {
+		return false
+	}
+	prefix, suffix = prefix+"://", "."+suffix
+	if len(origin) <= len(prefix)+len(suffix) || !strings.HasPrefix(origin, prefix) || !strings.HasSuffix(origin, suffix) {
+		return false
+	}
+	return validSubdomain(origin[len(prefix) : len(origin)-len(suffix)])
+}
+
+// validSubdomain accepts dot separated labels of letters, digits and dashes.
+func validSubdomain(s string) bool {
+	for _, label := range strings.Split(s, ".") {
+		if label == "" {
+			return false
+		}
+		for i := 0; i < len(label); i++ {
+			ch := label[i]
+			if (ch < 'a' || ch > 'z') && (ch < '0' || ch > '9') && ch != '-' {
+				return false
+			}
+		}
+	}
+	return true
 }
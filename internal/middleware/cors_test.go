@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCorsMiddleware(t *testing.T) {
+	t.Parallel()
+
+	app := fiber.New()
+	CorsMiddleware(app, CorsOptions{
+		AllowOrigins: func() []string {
+			return []string{"https://example.com", "https://*.example.org"}
+		},
+		AllowCredentials: true,
+		AllowHeaders:     []string{"Content-Type", "X-API-Key"},
+		MaxAge:           200 * time.Second,
+	})
+	handler := func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) }
+	app.Get("/tasks", handler)
+	app.Post("/tasks", handler)
+	app.Delete("/tasks/:id", handler)
+
+	testTable := []struct {
+		name       string
+		origin     string
+		wantOrigin string
+	}{
+		{name: "exact origin", origin: "https://example.com", wantOrigin: "https://example.com"},
+		{name: "subdomain", origin: "https://api.example.org", wantOrigin: "https://api.example.org"},
+		{name: "nested subdomain", origin: "https://a.b.example.org", wantOrigin: "https://a.b.example.org"},
+		{name: "uppercase origin", origin: "https://API.example.org", wantOrigin: "https://api.example.org"},
+		{name: "parent domain", origin: "https://example.org"},
+		{name: "other scheme", origin: "http://api.example.org"},
+		{name: "other domain", origin: "https://evil.com"},
+		{name: "suffix trick", origin: "https://evil.com/.example.org"},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(fiber.MethodOptions, "/tasks", nil)
+			req.Header.Set(fiber.HeaderOrigin, tt.origin)
+			req.Header.Set(fiber.HeaderAccessControlRequestMethod, fiber.MethodPost)
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, fiber.StatusNoContent, resp.StatusCode)
+			assert.Equal(t, tt.wantOrigin, resp.Header.Get(fiber.HeaderAccessControlAllowOrigin))
+			if tt.wantOrigin == "" {
+				return
+			}
+			assert.Equal(t, "true", resp.Header.Get(fiber.HeaderAccessControlAllowCredentials))
+			assert.Equal(t, "DELETE,GET,HEAD,POST", resp.Header.Get(fiber.HeaderAccessControlAllowMethods))
+			assert.Equal(t, "Content-Type,X-API-Key", resp.Header.Get(fiber.HeaderAccessControlAllowHeaders))
+			assert.Equal(t, "200", resp.Header.Get(fiber.HeaderAccessControlMaxAge))
+		})
+	}
+}
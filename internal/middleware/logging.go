@@ -1,20 +1,92 @@
 package middleware
 
 import (
-	"os"
+	"log/slog"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
-	"github.com/gofiber/fiber/v2/middleware/logger"
+	"github.com/gofiber/fiber/v2/utils"
+	"github.com/google/uuid"
+
+	"test-server/internal/auth"
+	"test-server/internal/logging"
+	"test-server/internal/tenant"
+)
+
+const (
+	RequestIDHeader = "X-Request-ID"
+
+	maxRequestIDLength = 128
 )
 
+// RequestIDMiddleware propagates the X-Request-ID request header or generates
+// a new id if it's missing or malformed. The id is returned in the response
+// header and attached to the user context, so it's logged with every record
+// of the request, including the ones of the task it starts.
+func RequestIDMiddleware(app *fiber.App) {
+	app.Use(func(c *fiber.Ctx) error {
+		id := c.Get(RequestIDHeader)
+		if !validRequestID(id) {
+			id = uuid.NewString()
+		} else {
+			id = utils.CopyString(id)
+		}
+
+		c.Set(RequestIDHeader, id)
+		c.SetUserContext(logging.WithRequestID(c.UserContext(), id))
+		return c.Next()
+	})
+}
+
+// validRequestID accepts non-empty ids of printable ASCII characters, so
+// clients can't inject arbitrary data into logs.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// LoggerMiddleware logs every request with its outcome. Server errors are
+// logged at error level, client errors at warn level.
 func LoggerMiddleware(app *fiber.App) {
-	app.Use(logger.New(logger.Config{
-		Format:        "${time} | ${status} | ${latency} | ${method} | ${path} | ${error}\n",
-		TimeFormat:    "15:04:05",
-		TimeZone:      "Local",
-		TimeInterval:  500 * time.Millisecond,
-		Output:        os.Stdout,
-		DisableColors: false,
-	}))
+	app.Use(func(c *fiber.Ctx) error {
+		start := time.Now()
+		err := c.Next()
+
+		status := responseStatus(c, err)
+
+		level := slog.LevelInfo
+		switch {
+		case status >= fiber.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= fiber.StatusBadRequest:
+			level = slog.LevelWarn
+		}
+
+		attrs := []slog.Attr{
+			slog.String("method", c.Method()),
+			slog.String("path", c.Path()),
+			slog.String("route", c.Route().Path),
+			slog.Int("status", status),
+			slog.Duration("latency", time.Since(start)),
+			slog.String("ip", c.IP()),
+		}
+		if principal, ok := auth.PrincipalFrom(c.UserContext()); ok {
+			attrs = append(attrs, slog.String("principal", principal.ID))
+		}
+		if tenantID, ok := tenant.Lookup(c.UserContext()); ok {
+			attrs = append(attrs, slog.String("tenant", tenantID))
+		}
+		if err != nil {
+			attrs = append(attrs, slog.String("error", err.Error()))
+		}
+		slog.LogAttrs(c.UserContext(), level, "request", attrs...)
+		return err
+	})
 }
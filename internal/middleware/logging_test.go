@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"test-server/internal/logging"
+)
+
+func TestRequestIDMiddleware(t *testing.T) {
+	t.Parallel()
+
+	app := fiber.New()
+	RequestIDMiddleware(app)
+	app.Get("/", func(c *fiber.Ctx) error {
+		return c.SendString(logging.RequestID(c.UserContext()))
+	})
+
+	testTable := []struct {
+		name      string
+		requestID string
+		wantID    string // empty means a generated uuid is expected
+	}{
+		{name: "propagated", requestID: "abc-123", wantID: "abc-123"},
+		{name: "missing"},
+		{name: "too long", requestID: strings.Repeat("a", maxRequestIDLength+1)},
+		{name: "non printable", requestID: "abc\tdef"},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+			if tt.requestID != "" {
+				req.Header.Set(RequestIDHeader, tt.requestID)
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			id := resp.Header.Get(RequestIDHeader)
+			if tt.wantID != "" {
+				assert.Equal(t, tt.wantID, id)
+			} else {
+				assert.NoError(t, uuid.Validate(id))
+			}
+
+			body := make([]byte, len(id)+1)
+			n, _ := resp.Body.Read(body)
+			assert.Equal(t, id, string(body[:n]), "request id must be attached to the user context")
+		})
+	}
+}
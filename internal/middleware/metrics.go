@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+
+	"test-server/internal/metrics"
+)
+
+// MetricsMiddleware records request duration by method, route pattern and
+// status code. Route patterns are used instead of paths to keep the number
+// of label values bounded.
+func MetricsMiddleware(app *fiber.App) {
+	app.Use(func(c *fiber.Ctx) error {
+		start := time.Now()
+		err := c.Next()
+
+		status := responseStatus(c, err)
+
+		// method is copied as fiber reuses its buffer after the handler returns
+		metrics.HTTPRequestDuration.
+			WithLabelValues(utils.CopyString(c.Method()), utils.CopyString(c.Route().Path), strconv.Itoa(status)).
+			Observe(time.Since(start).Seconds())
+		return err
+	})
+}
@@ -0,0 +1,191 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"test-server/internal/auth"
+	"test-server/internal/config"
+)
+
+// Rate limit response headers of the IETF RateLimit header fields draft.
+const (
+	RateLimitLimitHeader     = "RateLimit-Limit"
+	RateLimitRemainingHeader = "RateLimit-Remaining"
+	RateLimitResetHeader     = "RateLimit-Reset"
+	RateLimitPolicyHeader    = "RateLimit-Policy"
+)
+
+// defaultIdleTimeout is used when RateLimitOptions.IdleTimeout isn't set.
+const defaultIdleTimeout = 10 * time.Minute
+
+// routeMatches reports whether the request method and path match the route.
+func routeMatches(r config.RouteRateLimit, method, path string) bool {
+	if r.Method != "*" && r.Method != method {
+		return false
+	}
+
+	patternSegments := strings.Split(strings.Trim(r.Path, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(patternSegments) != len(pathSegments) {
+		return false
+	}
+	for i, segment := range patternSegments {
+		if !strings.HasPrefix(segment, ":") && segment != pathSegments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// RateLimitOptions configures RateLimitMiddleware.
+type RateLimitOptions struct {
+	// Rate and Burst limit requests not matching any of Routes. Zero Rate
+	// leaves them unlimited.
+	Rate  float64
+	Burst int
+	// Routes override the limit of matching requests, the first match wins.
+	// Every route has its own buckets.
+	Routes []config.RouteRateLimit
+	// IdleTimeout is how long a bucket is kept after it refilled.
+	IdleTimeout time.Duration
+}
+
+// RateLimitMiddleware limits requests of every client with token buckets.
+// Clients are the principals attached by AuthMiddleware, so it must be
+// registered after it, requests without a principal are identified by IP.
+// A request takes a token from the bucket of its client and route, requests
+// finding the bucket empty are rejected with 429 and Retry-After. Responses
+// carry the RateLimit-* headers.
+func RateLimitMiddleware(app *fiber.App, opts RateLimitOptions) {
+	store := newBucketStore(opts.IdleTimeout)
+	defaultLimit := config.RouteRateLimit{Rate: opts.Rate, Burst: opts.Burst}
+
+	app.Use(func(c *fiber.Ctx) error {
+		limit, scope := defaultLimit, ""
+		for i, route := range opts.Routes {
+			if routeMatches(route, c.Method(), c.Path()) {
+				limit, scope = route, strconv.Itoa(i)
+				break
+			}
+		}
+		if limit.Rate <= 0 {
+			return c.Next()
+		}
+
+		// auth.Anonymous is shared by every request when authentication is
+		// disabled, so it's identified by IP as well
+		client := "ip:" + c.IP()
+		if principal, ok := auth.PrincipalFrom(c.UserContext()); ok && principal.ID != auth.Anonymous.ID {
+			client = principal.ID
+		}
+
+		// the key is copied by string concatenation, fiber reuses its buffers
+		result := store.take(scope+"\x00"+client, limit, time.Now())
+
+		c.Set(RateLimitLimitHeader, strconv.Itoa(limit.Burst))
+		c.Set(RateLimitRemainingHeader, strconv.Itoa(result.remaining))
+		c.Set(RateLimitResetHeader, strconv.Itoa(ceilSeconds(result.reset)))
+		// the policy window is the time an empty bucket takes to refill
+		c.Set(RateLimitPolicyHeader, fmt.Sprintf("%d;w=%d", limit.Burst, ceilSeconds(seconds(float64(limit.Burst)/limit.Rate))))
+		if !result.allowed {
+			c.Set(fiber.HeaderRetryAfter, strconv.Itoa(ceilSeconds(result.retryAfter)))
+			return c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+				"ok":    false,
+				"error": "rate limit exceeded",
+			})
+		}
+		return c.Next()
+	})
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+// bucket is a token bucket refilled continuously. Tokens are computed on
+// every take from the time elapsed since the last one.
+type bucket struct {
+	tokens float64
+	last   time.Time
+	full   time.Time // when the bucket refills
+}
+
+type takeResult struct {
+	allowed   bool
+	remaining int
+	// reset is when the bucket is full again.
+	reset time.Duration
+	// retryAfter is when the next token is available.
+	retryAfter time.Duration
+}
+
+// bucketStore keeps the buckets in memory. Buckets that refilled and then
+// stayed idle for idleTimeout are evicted. A full bucket is recreated
+// identically on the next request, so eviction doesn't change the limits.
+type bucketStore struct {
+	idleTimeout time.Duration
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+func newBucketStore(idleTimeout time.Duration) *bucketStore {
+	if idleTimeout <= 0 {
+		idleTimeout = defaultIdleTimeout
+	}
+	return &bucketStore{idleTimeout: idleTimeout, buckets: make(map[string]*bucket)}
+}
+
+func (s *bucketStore) take(key string, limit config.RouteRateLimit, now time.Time) takeResult {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+
+	burst := float64(limit.Burst)
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: burst, last: now}
+		s.buckets[key] = b
+	}
+	b.tokens = min(burst, b.tokens+now.Sub(b.last).Seconds()*limit.Rate)
+	b.last = now
+
+	result := takeResult{allowed: b.tokens >= 1}
+	if result.allowed {
+		b.tokens--
+	} else {
+		result.retryAfter = seconds((1 - b.tokens) / limit.Rate)
+	}
+	result.remaining = int(b.tokens)
+	result.reset = seconds((burst - b.tokens) / limit.Rate)
+	b.full = now.Add(result.reset)
+	return result
+}
+
+// sweep evicts the buckets that refilled and were idle for idleTimeout
+// since. It runs at most once per idleTimeout. Caller must hold s.mu.
+func (s *bucketStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < s.idleTimeout {
+		return
+	}
+	s.lastSweep = now
+
+	for key, b := range s.buckets {
+		if now.Sub(b.full) >= s.idleTimeout {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
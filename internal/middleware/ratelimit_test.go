@@ -0,0 +1,110 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"test-server/internal/auth"
+	"test-server/internal/config"
+)
+
+func TestRateLimitMiddleware(t *testing.T) {
+	t.Parallel()
+
+	keys, err := auth.NewKeyStore("", []string{
+		"alice:" + auth.HashKey("alice-secret") + ":tasks:read",
+		"bob:" + auth.HashKey("bob-secret") + ":tasks:read",
+	})
+	require.NoError(t, err)
+
+	app := fiber.New()
+	AuthMiddleware(app, keys)
+	RateLimitMiddleware(app, RateLimitOptions{
+		Rate:  1,
+		Burst: 2,
+		Routes: []config.RouteRateLimit{
+			{Method: fiber.MethodPost, Path: "/tasks", Rate: 0.1, Burst: 1},
+			{Method: "*", Path: "/health", Rate: 0},
+		},
+	})
+	ok := func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) }
+	app.Get("/tasks/:id", ok)
+	app.Post("/tasks", ok)
+
+	send := func(method, path, key string) *http.Response {
+		req := httptest.NewRequest(method, path, nil)
+		if key != "" {
+			req.Header.Set(APIKeyHeader, key)
+		}
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp
+	}
+
+	// the default limit is shared by the routes without an override
+	resp := send(fiber.MethodGet, "/tasks/1", "alice-secret")
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Equal(t, "2", resp.Header.Get(RateLimitLimitHeader))
+	assert.Equal(t, "1", resp.Header.Get(RateLimitRemainingHeader))
+	assert.Equal(t, "1", resp.Header.Get(RateLimitResetHeader))
+	assert.Equal(t, "2;w=2", resp.Header.Get(RateLimitPolicyHeader))
+	assert.Equal(t, fiber.StatusOK, send(fiber.MethodGet, "/tasks/2", "alice-secret").StatusCode)
+	resp = send(fiber.MethodGet, "/tasks/3", "alice-secret")
+	assert.Equal(t, fiber.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, "1", resp.Header.Get(fiber.HeaderRetryAfter))
+	assert.Equal(t, "0", resp.Header.Get(RateLimitRemainingHeader))
+
+	// routes and clients have their own buckets
+	assert.Equal(t, fiber.StatusOK, send(fiber.MethodPost, "/tasks", "alice-secret").StatusCode)
+	resp = send(fiber.MethodPost, "/tasks", "alice-secret")
+	assert.Equal(t, fiber.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, "10", resp.Header.Get(fiber.HeaderRetryAfter))
+	assert.Equal(t, fiber.StatusOK, send(fiber.MethodGet, "/tasks/1", "bob-secret").StatusCode)
+
+	// unauthenticated clients are identified by IP
+	assert.Equal(t, fiber.StatusOK, send(fiber.MethodPost, "/tasks", "").StatusCode)
+	assert.Equal(t, fiber.StatusTooManyRequests, send(fiber.MethodPost, "/tasks", "").StatusCode)
+
+	// invalid keys are rejected before taking a bucket
+	for i := range 3 {
+		assert.Equal(t, fiber.StatusUnauthorized, send(fiber.MethodPost, "/tasks", fmt.Sprintf("random-%d", i)).StatusCode)
+	}
+
+	// zero rate routes aren't limited
+	for range 3 {
+		resp = send(fiber.MethodGet, "/health", "alice-secret")
+		assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+		assert.Empty(t, resp.Header.Get(RateLimitLimitHeader))
+	}
+}
+
+func TestBucketStore_Evicts(t *testing.T) {
+	t.Parallel()
+
+	store := newBucketStore(time.Minute)
+	limit := config.RouteRateLimit{Rate: 1, Burst: 120}
+	now := time.Now()
+
+	store.take("idle", limit, now)
+	store.take("drained", limit, now)
+	for range 119 {
+		store.take("drained", limit, now)
+	}
+
+	// "idle" refilled a second after the take, "drained" needs two minutes
+	store.take("other", limit, now.Add(61*time.Second))
+	assert.NotContains(t, store.buckets, "idle")
+	assert.Contains(t, store.buckets, "drained", "buckets are kept until they refill")
+
+	store.take("other", limit, now.Add(3*time.Minute+2*time.Second))
+	assert.NotContains(t, store.buckets, "drained")
+	assert.Contains(t, store.buckets, "other")
+}
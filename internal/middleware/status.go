@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// responseStatus returns the status code of the response to the request
+// after c.Next returned err. Errors are converted to responses by the error
+// handler only after middlewares return, so the status is resolved from err
+// the same way the default error handler does.
+func responseStatus(c *fiber.Ctx, err error) int {
+	if err == nil {
+		return c.Response().StatusCode()
+	}
+
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) {
+		return fiberErr.Code
+	}
+	return fiber.StatusInternalServerError
+}
@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseStatus(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name    string
+		handler fiber.Handler
+		want    int
+	}{
+		{
+			name:    "response",
+			handler: func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusCreated) },
+			want:    fiber.StatusCreated,
+		},
+		{
+			name:    "fiber error",
+			handler: func(c *fiber.Ctx) error { return fiber.ErrTooManyRequests },
+			want:    fiber.StatusTooManyRequests,
+		},
+		{
+			name:    "wrapped fiber error",
+			handler: func(c *fiber.Ctx) error { return fmt.Errorf("limit: %w", fiber.ErrTooManyRequests) },
+			want:    fiber.StatusTooManyRequests,
+		},
+		{
+			name:    "other error",
+			handler: func(c *fiber.Ctx) error { return errors.New("boom") },
+			want:    fiber.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app := fiber.New()
+			app.Use(func(c *fiber.Ctx) error {
+				err := c.Next()
+				c.Set("X-Status", strconv.Itoa(responseStatus(c, err)))
+				return err
+			})
+			app.Get("/", tt.handler)
+
+			resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+			require.NoError(t, err)
+			assert.Equal(t, strconv.Itoa(tt.want), resp.Header.Get("X-Status"))
+		})
+	}
+}
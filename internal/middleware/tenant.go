@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+
+	"test-server/internal/auth"
+	"test-server/internal/tenant"
+)
+
+const TenantHeader = "X-Tenant-ID"
+
+// TenantMiddleware attaches the tenant of the request to the user context.
+// Principals bound to a tenant act on it only. Other principals act on
+// tenant.Default, and only authenticated admins may select another tenant
+// with the X-Tenant-ID header. A header naming a tenant the principal can't
+// act on is rejected with 403. It must be registered after AuthMiddleware.
+func TenantMiddleware(app *fiber.App) {
+	app.Use(func(c *fiber.Ctx) error {
+		id := c.Get(TenantHeader)
+		if id != "" {
+			if err := tenant.Validate(id); err != nil {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"ok":    false,
+					"error": err.Error(),
+				})
+			}
+			id = utils.CopyString(id)
+		}
+
+		// empty when the principal may act on any tenant
+		bound := tenant.Default
+		if principal, ok := auth.PrincipalFrom(c.UserContext()); ok {
+			switch {
+			case principal.Tenant != "":
+				bound = principal.Tenant
+			case principal.ID != auth.Anonymous.ID && principal.HasScope(auth.ScopeAdmin):
+				bound = ""
+			}
+		}
+
+		switch {
+		case id == "" && bound != "":
+			id = bound
+		case id == "":
+			id = tenant.Default
+		case bound != "" && id != bound:
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"ok":    false,
+				"error": "principal isn't allowed to access tenant " + id,
+			})
+		}
+
+		c.SetUserContext(tenant.With(c.UserContext(), id))
+		return c.Next()
+	})
+}
@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"test-server/internal/auth"
+	"test-server/internal/tenant"
+)
+
+func TestTenantMiddleware(t *testing.T) {
+	t.Parallel()
+
+	keys, err := auth.NewKeyStore("", []string{
+		"shared:" + auth.HashKey("shared-secret") + ":tasks:read",
+		"acme@acme:" + auth.HashKey("acme-secret") + ":tasks:read",
+		"ops:" + auth.HashKey("ops-secret") + ":tasks:admin",
+		"acme-ops@acme:" + auth.HashKey("acme-ops-secret") + ":tasks:admin",
+	})
+	require.NoError(t, err)
+
+	app := fiber.New()
+	AuthMiddleware(app, keys)
+	TenantMiddleware(app)
+	app.Get("/tasks", func(c *fiber.Ctx) error {
+		return c.SendString(tenant.From(c.UserContext()))
+	})
+
+	testTable := []struct {
+		name       string
+		key        string
+		tenant     string
+		wantCode   int
+		wantTenant string
+	}{
+		{name: "default tenant", key: "shared-secret", wantCode: fiber.StatusOK, wantTenant: tenant.Default},
+		{name: "unbound principal with default tenant", key: "shared-secret", tenant: tenant.Default, wantCode: fiber.StatusOK, wantTenant: tenant.Default},
+		{name: "unbound principal with other tenant", key: "shared-secret", tenant: "globex", wantCode: fiber.StatusForbidden},
+		{name: "unbound admin", key: "ops-secret", wantCode: fiber.StatusOK, wantTenant: tenant.Default},
+		{name: "unbound admin with header", key: "ops-secret", tenant: "globex", wantCode: fiber.StatusOK, wantTenant: "globex"},
+		{name: "invalid header", key: "ops-secret", tenant: "a/b", wantCode: fiber.StatusBadRequest},
+		{name: "parent directory header", key: "ops-secret", tenant: "..", wantCode: fiber.StatusBadRequest},
+		{name: "bound principal", key: "acme-secret", wantCode: fiber.StatusOK, wantTenant: "acme"},
+		{name: "bound principal with own tenant", key: "acme-secret", tenant: "acme", wantCode: fiber.StatusOK, wantTenant: "acme"},
+		{name: "bound principal with other tenant", key: "acme-secret", tenant: "globex", wantCode: fiber.StatusForbidden},
+		{name: "bound admin with other tenant", key: "acme-ops-secret", tenant: "globex", wantCode: fiber.StatusForbidden},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(fiber.MethodGet, "/tasks", nil)
+			req.Header.Set(APIKeyHeader, tt.key)
+			if tt.tenant != "" {
+				req.Header.Set(TenantHeader, tt.tenant)
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+			if tt.wantTenant != "" {
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantTenant, string(body))
+			}
+		})
+	}
+}
+
+func TestTenantMiddleware_AuthDisabled(t *testing.T) {
+	t.Parallel()
+
+	app := fiber.New()
+	AuthMiddleware(app)
+	TenantMiddleware(app)
+	app.Get("/tasks", func(c *fiber.Ctx) error {
+		return c.SendString(tenant.From(c.UserContext()))
+	})
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/tasks", nil))
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, tenant.Default, string(body))
+
+	// anonymous requests can't pick a tenant
+	req := httptest.NewRequest(fiber.MethodGet, "/tasks", nil)
+	req.Header.Set(TenantHeader, "globex")
+	resp, err = app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+}
@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"test-server/internal/tracing"
+)
+
+// fiberHeaderCarrier adapts fiber request and response headers to the
+// propagation.TextMapCarrier interface.
+type fiberHeaderCarrier struct {
+	c *fiber.Ctx
+}
+
+var _ propagation.TextMapCarrier = fiberHeaderCarrier{}
+
+func (h fiberHeaderCarrier) Get(key string) string {
+	return h.c.Get(key)
+}
+
+func (h fiberHeaderCarrier) Set(key, value string) {
+	h.c.Set(key, value)
+}
+
+func (h fiberHeaderCarrier) Keys() []string {
+	keys := make([]string, 0)
+	h.c.Request().Header.VisitAll(func(key, _ []byte) {
+		keys = append(keys, string(key))
+	})
+	return keys
+}
+
+// TracingMiddleware starts a server span for every request. The span
+// continues the trace of the incoming W3C traceparent header if present, is
+// put into the user context for handlers, and its context is returned to the
+// client in the traceparent response header.
+func TracingMiddleware(app *fiber.App) {
+	app.Use(func(c *fiber.Ctx) error {
+		carrier := fiberHeaderCarrier{c: c}
+		propagator := otel.GetTextMapPropagator()
+		ctx := propagator.Extract(c.UserContext(), carrier)
+
+		// route isn't known until the request is matched, so the span is renamed after c.Next
+		ctx, span := tracing.Start(ctx, utils.CopyString(c.Method()),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", utils.CopyString(c.Method())),
+				attribute.String("url.path", utils.CopyString(c.Path())),
+			),
+		)
+		defer span.End()
+
+		c.SetUserContext(ctx)
+		propagator.Inject(ctx, carrier)
+
+		err := c.Next()
+
+		status := responseStatus(c, err)
+
+		route := utils.CopyString(c.Route().Path)
+		span.SetName(utils.CopyString(c.Method()) + " " + route)
+		span.SetAttributes(
+			attribute.String("http.route", route),
+			attribute.Int("http.response.status_code", status),
+		)
+		if status >= fiber.StatusInternalServerError {
+			span.SetStatus(codes.Error, utils.StatusMessage(status))
+		}
+		return err
+	})
+}
@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracingMiddleware(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	previousProvider, previousPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	})
+
+	app := fiber.New()
+	TracingMiddleware(app)
+
+	var handlerSpan trace.SpanContext
+	app.Get("/api/tasks/:id", func(c *fiber.Ctx) error {
+		handlerSpan = trace.SpanContextFromContext(c.UserContext())
+		return c.SendStatus(fiber.StatusNotFound)
+	})
+
+	testTable := []struct {
+		name        string
+		traceparent string
+		wantTraceID string
+	}{
+		{
+			name:        "continues incoming trace",
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			wantTraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+		},
+		{
+			name: "starts new trace",
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter.Reset()
+
+			req := httptest.NewRequest(fiber.MethodGet, "/api/tasks/42", nil)
+			if tt.traceparent != "" {
+				req.Header.Set("traceparent", tt.traceparent)
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+
+			spans := exporter.GetSpans()
+			require.Len(t, spans, 1)
+			span := spans[0]
+			assert.Equal(t, "GET /api/tasks/:id", span.Name)
+			assert.Equal(t, trace.SpanKindServer, span.SpanKind)
+			assert.Equal(t, span.SpanContext, handlerSpan)
+			if tt.wantTraceID != "" {
+				assert.Equal(t, tt.wantTraceID, span.SpanContext.TraceID().String())
+				assert.True(t, span.Parent.IsRemote())
+			} else {
+				assert.False(t, span.Parent.IsValid())
+			}
+
+			// response carries the server span, so clients can find the trace
+			assert.Contains(t, resp.Header.Get("traceparent"), span.SpanContext.TraceID().String()+"-"+span.SpanContext.SpanID().String())
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"regexp"
+)
+
+// Default is the tenant of requests that don't name one and of tasks
+// stored before tenants were introduced.
+const Default = "default"
+
+var ErrInvalidTenant = errors.New("tenant id must be 1-64 letters, digits, '.', '_' or '-' and not '.' or '..'")
+
+var idPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// Validate checks the tenant id. Ids can't contain '/', which separates
+// the tenant from the task id in storage keys, and can't be "." or "..",
+// since they name the directory of the task logs of the tenant.
+func Validate(id string) error {
+	if !idPattern.MatchString(id) || id == "." || id == ".." {
+		return ErrInvalidTenant
+	}
+	return nil
+}
+
+type tenantKey struct{}
+
+// With returns a copy of ctx carrying the tenant id.
+func With(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, id)
+}
+
+// From returns the tenant id carried by ctx or Default.
+func From(ctx context.Context) string {
+	if id, ok := Lookup(ctx); ok {
+		return id
+	}
+	return Default
+}
+
+// Lookup returns the tenant id carried by ctx, if any.
+func Lookup(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(tenantKey{}).(string)
+	return id, ok && id != ""
+}
@@ -0,0 +1,155 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	ServiceName = "test-server"
+
+	instrumentationName = "test-server"
+)
+
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterFile   = "file"
+	ExporterOTLP   = "otlp"
+)
+
+// Options configures the trace exporter.
+type Options struct {
+	// Exporter is one of "none", "stdout", "file" or "otlp". Empty means "none".
+	Exporter string
+	// File is the path spans are written to by the "file" exporter.
+	File string
+	// Endpoint is the host:port of the OTLP/HTTP collector, e.g. "localhost:4318".
+	Endpoint string
+	// Insecure disables TLS for the OTLP exporter.
+	Insecure bool
+	// Headers are comma separated key=value pairs sent to the OTLP collector.
+	Headers string
+	// SampleRatio is the fraction of new traces sampled. Sampling decision of
+	// the parent span is respected for propagated traces.
+	SampleRatio float64
+}
+
+// Setup installs the global tracer provider and W3C trace context propagator.
+// The returned function flushes pending spans and releases the exporter.
+func Setup(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if opts.Exporter == "" || opts.Exporter == ExporterNone {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, closeOutput, err := newExporter(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("tracing.Setup: failed to create %s exporter: %w", opts.Exporter, err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(ServiceName))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if closeErr := closeOutput(); err == nil {
+			err = closeErr
+		}
+		return err
+	}, nil
+}
+
+// newExporter creates the configured exporter and a function closing its output.
+func newExporter(ctx context.Context, opts Options) (sdktrace.SpanExporter, func() error, error) {
+	noop := func() error { return nil }
+
+	switch opts.Exporter {
+	case ExporterStdout:
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		return exporter, noop, err
+	case ExporterFile:
+		if opts.File == "" {
+			return nil, nil, fmt.Errorf("file isn't set")
+		}
+		if err := os.MkdirAll(filepath.Dir(opts.File), 0o750); err != nil {
+			return nil, nil, err
+		}
+		f, err := os.OpenFile(filepath.Clean(opts.File), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o640)
+		if err != nil {
+			return nil, nil, err
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			f.Close()
+			return nil, nil, err
+		}
+		return exporter, f.Close, nil
+	case ExporterOTLP:
+		clientOpts := []otlptracehttp.Option{}
+		if opts.Endpoint != "" {
+			clientOpts = append(clientOpts, otlptracehttp.WithEndpoint(opts.Endpoint))
+		}
+		if opts.Insecure {
+			clientOpts = append(clientOpts, otlptracehttp.WithInsecure())
+		}
+		if opts.Headers != "" {
+			headers := make(map[string]string)
+			for _, pair := range strings.Split(opts.Headers, ",") {
+				key, value, ok := strings.Cut(pair, "=")
+				if !ok {
+					return nil, nil, fmt.Errorf("header %q isn't a key=value pair", pair)
+				}
+				headers[strings.TrimSpace(key)] = strings.TrimSpace(value)
+			}
+			clientOpts = append(clientOpts, otlptracehttp.WithHeaders(headers))
+		}
+		exporter, err := otlptracehttp.New(ctx, clientOpts...)
+		return exporter, noop, err
+	}
+
+	return nil, nil, fmt.Errorf("unknown exporter %q", opts.Exporter)
+}
+
+// Start starts a span as a child of the span in ctx using the global tracer
+// provider. The provider is looked up on every call, so spans go to the
+// provider installed last, e.g. the in-memory one in tests.
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}
+
+// RecordError marks the span as failed with err. It is a no-op for nil err.
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// TaskID is the span attribute holding the task id.
+func TaskID(id string) attribute.KeyValue {
+	return attribute.String("task.id", id)
+}